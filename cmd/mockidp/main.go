@@ -0,0 +1,275 @@
+// ローカル開発・動作確認用の OpenID Connect のモックIDプロバイダー
+// 認可コードフロー(PKCE S256)のみに対応し、ログイン画面で入力したメールアドレスでIDトークンを発行する
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"html/template"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const KEY_ID = "mockidp"
+
+type authorizationCode struct {
+	clientID      string
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	email         string
+	name          string
+	emailVerified bool
+	expiresAt     time.Time
+}
+
+type mockIDP struct {
+	// IDトークンの発行者。バックエンドから到達できるURL
+	issuer string
+	// ブラウザから到達できるURL。認可エンドポイントのみこちらを用いる
+	publicURL string
+	clientID  string
+	key       *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]authorizationCode
+}
+
+var loginPage = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><title>Mock IdP</title></head>
+<body>
+<h1>Mock IdP ログイン</h1>
+<form method="post" action="{{.Action}}">
+{{range $key, $value := .Params}}<input type="hidden" name="{{$key}}" value="{{$value}}">
+{{end}}<p><label>メールアドレス <input name="email" value="admin@admin.com"></label></p>
+<p><label>表示名 <input name="name" value="Mock User"></label></p>
+<p><label><input type="checkbox" name="email_verified" value="true" checked> メールアドレス確認済み</label></p>
+<p><button type="submit">ログイン</button></p>
+</form>
+</body>
+</html>
+`))
+
+func main() {
+
+	issuer := getEnv("MOCK_IDP_ISSUER", "http://localhost:9090")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	idp := &mockIDP{
+		issuer:    strings.TrimSuffix(issuer, "/"),
+		publicURL: strings.TrimSuffix(getEnv("MOCK_IDP_PUBLIC_URL", issuer), "/"),
+		clientID:  getEnv("MOCK_IDP_CLIENT_ID", "lessonlink"),
+		key:       key,
+		codes:     map[string]authorizationCode{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("GET /authorize", idp.authorizeForm)
+	mux.HandleFunc("POST /authorize", idp.authorize)
+	mux.HandleFunc("POST /token", idp.token)
+	mux.HandleFunc("GET /jwks", idp.jwks)
+
+	bindAddress := getEnv("MOCK_IDP_BIND_ADDRESS", ":9090")
+	log.Printf("mock idp listening on %s issuer=%s", bindAddress, idp.issuer)
+	log.Fatalln(http.ListenAndServe(bindAddress, mux))
+}
+
+func (m *mockIDP) discovery(w http.ResponseWriter, r *http.Request) {
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                m.issuer,
+		"authorization_endpoint":                m.publicURL + "/authorize",
+		"token_endpoint":                        m.issuer + "/token",
+		"jwks_uri":                              m.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (m *mockIDP) authorizeForm(w http.ResponseWriter, r *http.Request) {
+
+	query := r.URL.Query()
+	if query.Get("response_type") != "code" || query.Get("client_id") != m.clientID || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	params := map[string]string{}
+	for _, name := range []string{"client_id", "redirect_uri", "state", "nonce", "code_challenge"} {
+		params[name] = query.Get(name)
+	}
+
+	_ = loginPage.Execute(w, map[string]any{
+		"Action": m.publicURL + "/authorize",
+		"Params": params,
+	})
+}
+
+func (m *mockIDP) authorize(w http.ResponseWriter, r *http.Request) {
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	redirectURI, err := url.Parse(r.PostForm.Get("redirect_uri"))
+	if err != nil || r.PostForm.Get("client_id") != m.clientID {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+
+	code := randomString()
+
+	m.mu.Lock()
+	m.codes[code] = authorizationCode{
+		clientID:      r.PostForm.Get("client_id"),
+		redirectURI:   redirectURI.String(),
+		codeChallenge: r.PostForm.Get("code_challenge"),
+		nonce:         r.PostForm.Get("nonce"),
+		email:         r.PostForm.Get("email"),
+		name:          r.PostForm.Get("name"),
+		emailVerified: r.PostForm.Get("email_verified") == "true",
+		expiresAt:     time.Now().Add(time.Minute),
+	}
+	m.mu.Unlock()
+
+	query := redirectURI.Query()
+	query.Set("code", code)
+	query.Set("state", r.PostForm.Get("state"))
+	redirectURI.RawQuery = query.Encode()
+
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+func (m *mockIDP) token(w http.ResponseWriter, r *http.Request) {
+
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID := r.PostForm.Get("client_id")
+	if basicClientID, _, ok := r.BasicAuth(); ok {
+		clientID, _ = url.QueryUnescape(basicClientID)
+	}
+
+	// 認可コードは一度だけ使える
+	m.mu.Lock()
+	code, ok := m.codes[r.PostForm.Get("code")]
+	delete(m.codes, r.PostForm.Get("code"))
+	m.mu.Unlock()
+
+	if r.PostForm.Get("grant_type") != "authorization_code" || !ok || time.Now().After(code.expiresAt) ||
+		code.clientID != clientID || code.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != code.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+		return
+	}
+
+	now := time.Now()
+	idToken, err := m.sign(map[string]any{
+		"iss":            m.issuer,
+		"sub":            "mock|" + strings.ToLower(code.email),
+		"aud":            code.clientID,
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          code.nonce,
+		"email":          code.email,
+		"email_verified": code.emailVerified,
+		"name":           code.name,
+	})
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (m *mockIDP) jwks(w http.ResponseWriter, r *http.Request) {
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": KEY_ID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (m *mockIDP) sign(claims map[string]any) (string, error) {
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": KEY_ID})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, m.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func getEnv(key string, defaultValue string) string {
+
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return defaultValue
+}
@@ -36,7 +36,7 @@ table "data_campuses" {
     columns = [column.campus]
   }
 }
-table "data_lessons" {
+table "data_campus_blocked_periods" {
   schema = schema.lessonlink
   column "id" {
     null           = false
@@ -47,11 +47,41 @@ table "data_lessons" {
     null = false
     type = varchar(16)
   }
-  column "name" {
+  column "title" {
     null = false
     type = varchar(32)
   }
-  column "duration" {
+  column "room_index" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "recurrence" {
+    null    = false
+    type    = varchar(16)
+    default = "daily"
+  }
+  column "weekday" {
+    null = true
+    type = int
+  }
+  column "target_date" {
+    null = true
+    type = date
+  }
+  column "start_time_hour" {
+    null = false
+    type = int
+  }
+  column "start_time_minutes" {
+    null = false
+    type = int
+  }
+  column "end_time_hour" {
+    null = false
+    type = int
+  }
+  column "end_time_minutes" {
     null = false
     type = int
   }
@@ -69,32 +99,95 @@ table "data_lessons" {
   primary_key {
     columns = [column.id]
   }
-  foreign_key "data_lessons_ibfk_1" {
+  foreign_key "data_campus_blocked_periods_ibfk_1" {
     columns     = [column.campus]
     ref_columns = [table.data_campuses.column.campus]
     on_update   = RESTRICT
     on_delete   = RESTRICT
   }
   index "campus" {
-    unique  = true
-    columns = [column.campus, column.name]
+    columns = [column.campus]
   }
 }
-table "data_roles" {
+table "data_campus_opening_hours" {
   schema = schema.lessonlink
   column "id" {
     null           = false
     type           = int
     auto_increment = true
   }
-  column "role_key" {
+  column "campus" {
     null = false
     type = varchar(16)
   }
-  column "role_name" {
+  column "weekday" {
+    null = false
+    type = int
+  }
+  column "open_time_hour" {
+    null = false
+    type = int
+  }
+  column "open_time_minutes" {
+    null = false
+    type = int
+  }
+  column "close_time_hour" {
+    null = false
+    type = int
+  }
+  column "close_time_minutes" {
+    null = false
+    type = int
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "data_campus_opening_hours_ibfk_1" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
+    unique  = true
+    columns = [column.campus, column.weekday]
+  }
+}
+table "data_campus_room_item_types" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "campus" {
     null = false
     type = varchar(16)
   }
+  column "item_tag" {
+    null = false
+    type = varchar(32)
+  }
+  column "label" {
+    null = false
+    type = varchar(32)
+  }
+  column "color" {
+    null = false
+    type = varchar(7)
+  }
   column "created_at" {
     null    = false
     type    = datetime
@@ -109,12 +202,18 @@ table "data_roles" {
   primary_key {
     columns = [column.id]
   }
-  index "role_key" {
+  foreign_key "data_campus_room_item_types_ibfk_1" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
     unique  = true
-    columns = [column.role_key]
+    columns = [column.campus, column.item_tag]
   }
 }
-table "data_rooms" {
+table "data_campus_scheduling_policies" {
   schema = schema.lessonlink
   column "id" {
     null           = false
@@ -125,14 +224,70 @@ table "data_rooms" {
     null = false
     type = varchar(16)
   }
-  column "room_index" {
+  column "grid_minutes" {
+    null    = false
+    type    = int
+    default = 1
+  }
+  column "min_lesson_duration" {
+    null    = false
+    type    = int
+    default = 1
+  }
+  column "max_lesson_duration" {
+    null    = false
+    type    = int
+    default = 300
+  }
+  column "min_gap_minutes" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "data_campus_scheduling_policies_ibfk_1" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
+    unique  = true
+    columns = [column.campus]
+  }
+}
+table "data_lessons" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "campus" {
     null = false
-    type = int
+    type = varchar(16)
   }
   column "name" {
     null = false
     type = varchar(32)
   }
+  column "duration" {
+    null = false
+    type = int
+  }
   column "created_at" {
     null    = false
     type    = datetime
@@ -147,7 +302,7 @@ table "data_rooms" {
   primary_key {
     columns = [column.id]
   }
-  foreign_key "data_rooms_ibfk_1" {
+  foreign_key "data_lessons_ibfk_1" {
     columns     = [column.campus]
     ref_columns = [table.data_campuses.column.campus]
     on_update   = RESTRICT
@@ -155,22 +310,66 @@ table "data_rooms" {
   }
   index "campus" {
     unique  = true
-    columns = [column.campus, column.room_index]
+    columns = [column.campus, column.name]
   }
 }
-table "sys_sessions" {
+table "data_permissions" {
   schema = schema.lessonlink
-  column "session_id" {
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "permission_key" {
     null = false
-    type = varchar(128)
+    type = varchar(32)
   }
-  column "user_id" {
+  column "permission_name" {
+    null = false
+    type = varchar(32)
+  }
+  column "order_index" {
     null = false
     type = int
   }
-  column "value" {
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "permission_key" {
+    unique  = true
+    columns = [column.permission_key]
+  }
+}
+table "data_role_permissions" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "role_key" {
     null = false
-    type = text
+    type = varchar(16)
+  }
+  column "permission_key" {
+    null = false
+    type = varchar(32)
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
   }
   column "updated_at" {
     null      = false
@@ -178,41 +377,707 @@ table "sys_sessions" {
     default   = sql("CURRENT_TIMESTAMP")
     on_update = sql("CURRENT_TIMESTAMP")
   }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "data_role_permissions_ibfk_1" {
+    columns     = [column.role_key]
+    ref_columns = [table.data_roles.column.role_key]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "data_role_permissions_ibfk_2" {
+    columns     = [column.permission_key]
+    ref_columns = [table.data_permissions.column.permission_key]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "permission_key" {
+    columns = [column.permission_key]
+  }
+  index "role_key" {
+    unique  = true
+    columns = [column.role_key, column.permission_key]
+  }
+}
+table "data_roles" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "role_key" {
+    null = false
+    type = varchar(16)
+  }
+  column "role_name" {
+    null = false
+    type = varchar(16)
+  }
+  column "builtin" {
+    null    = false
+    type    = int
+    default = 0
+  }
   column "created_at" {
     null    = false
     type    = datetime
     default = sql("CURRENT_TIMESTAMP")
   }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
   primary_key {
-    columns = [column.session_id]
+    columns = [column.id]
   }
-  index "user_id" {
-    columns = [column.user_id]
+  index "role_key" {
+    unique  = true
+    columns = [column.role_key]
   }
 }
-table "tbl_schedule_invisible_rooms" {
+table "data_rooms" {
   schema = schema.lessonlink
   column "id" {
     null           = false
     type           = int
     auto_increment = true
   }
-  column "schedule_id" {
+  column "campus" {
     null = false
-    type = int
+    type = varchar(16)
   }
   column "room_index" {
     null = false
     type = int
   }
+  column "name" {
+    null = false
+    type = varchar(32)
+  }
   column "created_at" {
     null    = false
     type    = datetime
     default = sql("CURRENT_TIMESTAMP")
   }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "data_rooms_ibfk_1" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
+    unique  = true
+    columns = [column.campus, column.room_index]
+  }
+}
+table "sys_sessions" {
+  schema = schema.lessonlink
+  column "session_id" {
+    null = false
+    type = varchar(128)
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "value" {
+    null = false
+    type = text
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.session_id]
+  }
+  index "user_id" {
+    columns = [column.user_id]
+  }
+}
+table "tbl_login_attempts" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_name" {
+    null = false
+    type = varchar(64)
+  }
+  column "user_id" {
+    null = true
+    type = int
+  }
+  column "ip_address" {
+    null = false
+    type = varchar(45)
+  }
+  column "user_agent" {
+    null    = false
+    type    = varchar(512)
+    default = ""
+  }
+  column "result" {
+    null = false
+    type = varchar(32)
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_login_attempts_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "ip_address" {
+    columns = [column.ip_address, column.created_at]
+  }
+  index "user_id" {
+    columns = [column.user_id, column.created_at]
+  }
+  index "user_name" {
+    columns = [column.user_name, column.created_at]
+  }
+}
+table "tbl_login_throttles" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "throttle_type" {
+    null = false
+    type = varchar(16)
+  }
+  column "throttle_key" {
+    null = false
+    type = varchar(64)
+  }
+  column "failure_count" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "last_failed_at" {
+    null = false
+    type = datetime
+  }
+  column "locked_until" {
+    null = true
+    type = datetime
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  index "throttle_type" {
+    unique  = true
+    columns = [column.throttle_type, column.throttle_key]
+  }
+}
+table "tbl_schedule_change_request_operations" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "change_request_id" {
+    null = false
+    type = int
+  }
+  column "operation" {
+    null = false
+    type = varchar(16)
+  }
+  column "identifier" {
+    null = false
+    type = varchar(36)
+  }
+  column "room_index" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "start_time_hour" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "start_time_minutes" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_change_request_operations_ibfk_1" {
+    columns     = [column.change_request_id]
+    ref_columns = [table.tbl_schedule_change_requests.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "change_request_id" {
+    columns = [column.change_request_id]
+  }
+}
+table "tbl_schedule_change_requests" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "history_index" {
+    null = false
+    type = int
+  }
+  column "request_user_id" {
+    null = false
+    type = int
+  }
+  column "comment" {
+    null = false
+    type = varchar(1000)
+  }
+  column "status" {
+    null    = false
+    type    = varchar(16)
+    default = "pending"
+  }
+  column "review_user_id" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "review_comment" {
+    null    = false
+    type    = varchar(256)
+    default = ""
+  }
+  column "applied_history_index" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_change_requests_ibfk_1" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_schedule_change_requests_ibfk_2" {
+    columns     = [column.request_user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "request_user_id" {
+    columns = [column.request_user_id]
+  }
+  index "schedule_id" {
+    columns = [column.schedule_id]
+  }
+}
+table "tbl_schedule_collaborators" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "permission" {
+    null = false
+    type = varchar(16)
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_collaborators_ibfk_1" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_schedule_collaborators_ibfk_2" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "schedule_id" {
+    unique  = true
+    columns = [column.schedule_id, column.user_id]
+  }
+  index "user_id" {
+    columns = [column.user_id]
+  }
+}
+table "tbl_schedule_comments" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "identifier" {
+    null    = false
+    type    = varchar(36)
+    default = ""
+  }
+  column "parent_id" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "author_user_id" {
+    null = false
+    type = int
+  }
+  column "body" {
+    null = false
+    type = varchar(1000)
+  }
+  column "resolved" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_comments_ibfk_1" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_schedule_comments_ibfk_2" {
+    columns     = [column.author_user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "author_user_id" {
+    columns = [column.author_user_id]
+  }
+  index "schedule_id" {
+    columns = [column.schedule_id]
+  }
+}
+table "tbl_schedule_day_bundle_members" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "bundle_id" {
+    null = false
+    type = int
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_day_bundle_members_ibfk_1" {
+    columns     = [column.bundle_id]
+    ref_columns = [table.tbl_schedule_day_bundles.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_schedule_day_bundle_members_ibfk_2" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "bundle_id" {
+    columns = [column.bundle_id]
+  }
+  index "schedule_id" {
+    unique  = true
+    columns = [column.schedule_id]
+  }
+}
+table "tbl_schedule_day_bundles" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "campus" {
+    null = false
+    type = varchar(16)
+  }
+  column "title" {
+    null = false
+    type = varchar(64)
+  }
+  column "refuse_collision" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "create_user" {
+    null = false
+    type = int
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_day_bundles_ibfk_1" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_schedule_day_bundles_ibfk_2" {
+    columns     = [column.create_user]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
+    columns = [column.campus]
+  }
+  index "create_user" {
+    columns = [column.create_user]
+  }
+}
+table "tbl_schedule_histories" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "history_index" {
+    null = false
+    type = int
+  }
+  column "start_time_hour" {
+    null = false
+    type = int
+  }
+  column "start_time_minutes" {
+    null = false
+    type = int
+  }
+  column "end_time_hour" {
+    null = false
+    type = int
+  }
+  column "end_time_minutes" {
+    null = false
+    type = int
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_schedule_histories_ibfk_1" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "schedule_id" {
+    columns = [column.schedule_id]
+  }
+  index "schedule_id_2" {
+    unique  = true
+    columns = [column.schedule_id, column.history_index]
+  }
+}
+table "tbl_schedule_invisible_rooms" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "room_index" {
+    null = false
+    type = int
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+}
+table "tbl_schedule_item_groups" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "schedule_id" {
+    null = false
+    type = int
+  }
+  column "history_index" {
+    null = false
+    type = int
+  }
+  column "group_identifier" {
+    null = false
+    type = varchar(36)
+  }
+  column "identifier" {
+    null = false
+    type = varchar(36)
+  }
+  column "offset_minutes" {
+    null = false
+    type = int
+  }
   primary_key {
     columns = [column.id]
   }
+  foreign_key "tbl_schedule_item_groups_ibfk_1" {
+    columns     = [column.schedule_id]
+    ref_columns = [table.tbl_schedules.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "schedule_id" {
+    columns = [column.schedule_id]
+  }
+  index "schedule_id_2" {
+    unique  = true
+    columns = [column.schedule_id, column.history_index, column.identifier]
+  }
 }
 table "tbl_schedule_items" {
   schema = schema.lessonlink
@@ -294,6 +1159,11 @@ table "tbl_schedule_room_items" {
     null = false
     type = varchar(36)
   }
+  column "title" {
+    null    = false
+    type    = varchar(64)
+    default = ""
+  }
   column "duration" {
     null = false
     type = int
@@ -318,6 +1188,11 @@ table "tbl_schedule_room_items" {
     null = false
     type = int
   }
+  column "pinned" {
+    null    = false
+    type    = int
+    default = 0
+  }
   primary_key {
     columns = [column.id]
   }
@@ -353,18 +1228,42 @@ table "tbl_schedules" {
     null = false
     type = varchar(64)
   }
+  column "target_date" {
+    null = true
+    type = date
+  }
   column "history_index" {
     null = false
     type = int
   }
-  column "start_time" {
+  column "start_time_hour" {
     null = false
     type = int
   }
-  column "end_time" {
+  column "start_time_minutes" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "end_time_hour" {
     null = false
     type = int
   }
+  column "end_time_minutes" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "status" {
+    null    = false
+    type    = varchar(16)
+    default = "draft"
+  }
+  column "review_comment" {
+    null    = false
+    type    = varchar(256)
+    default = ""
+  }
   column "create_user" {
     null = false
     type = int
@@ -415,6 +1314,287 @@ table "tbl_schedules" {
     columns = [column.last_update_user]
   }
 }
+table "tbl_user_api_tokens" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "token_name" {
+    null = false
+    type = varchar(64)
+  }
+  column "token_prefix" {
+    null = false
+    type = varchar(16)
+  }
+  column "token_hash" {
+    null = false
+    type = varchar(64)
+  }
+  column "scope" {
+    null = false
+    type = varchar(16)
+  }
+  column "expires_at" {
+    null = false
+    type = datetime
+  }
+  column "last_used_at" {
+    null = true
+    type = datetime
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_user_api_tokens_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "token_hash" {
+    unique  = true
+    columns = [column.token_hash]
+  }
+  index "user_id" {
+    columns = [column.user_id]
+  }
+}
+table "tbl_user_campus_roles" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "campus" {
+    null = false
+    type = varchar(16)
+  }
+  column "role_key" {
+    null = false
+    type = varchar(16)
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_user_campus_roles_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_user_campus_roles_ibfk_2" {
+    columns     = [column.campus]
+    ref_columns = [table.data_campuses.column.campus]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  foreign_key "tbl_user_campus_roles_ibfk_3" {
+    columns     = [column.role_key]
+    ref_columns = [table.data_roles.column.role_key]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "campus" {
+    columns = [column.campus]
+  }
+  index "role_key" {
+    columns = [column.role_key]
+  }
+  index "user_id" {
+    unique  = true
+    columns = [column.user_id, column.campus]
+  }
+}
+table "tbl_user_identities" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "issuer" {
+    null = false
+    type = varchar(255)
+  }
+  column "subject" {
+    null = false
+    type = varchar(255)
+  }
+  column "email" {
+    null = false
+    type = varchar(255)
+  }
+  column "last_login_at" {
+    null = false
+    type = datetime
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_user_identities_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "issuer" {
+    unique  = true
+    columns = [column.issuer, column.subject]
+  }
+  index "user_id" {
+    columns = [column.user_id]
+  }
+}
+table "tbl_user_recovery_codes" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "code_hash" {
+    null = false
+    type = varchar(64)
+  }
+  column "used" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_user_recovery_codes_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "user_id" {
+    unique  = true
+    columns = [column.user_id, column.code_hash]
+  }
+}
+table "tbl_user_two_factors" {
+  schema = schema.lessonlink
+  column "id" {
+    null           = false
+    type           = int
+    auto_increment = true
+  }
+  column "user_id" {
+    null = false
+    type = int
+  }
+  column "secret" {
+    null    = false
+    type    = varchar(64)
+    default = ""
+  }
+  column "enabled" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "required" {
+    null    = false
+    type    = int
+    default = 0
+  }
+  column "last_used_step" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "created_at" {
+    null    = false
+    type    = datetime
+    default = sql("CURRENT_TIMESTAMP")
+  }
+  column "updated_at" {
+    null      = false
+    type      = datetime
+    default   = sql("CURRENT_TIMESTAMP")
+    on_update = sql("CURRENT_TIMESTAMP")
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "tbl_user_two_factors_ibfk_1" {
+    columns     = [column.user_id]
+    ref_columns = [table.tbl_users.column.id]
+    on_update   = RESTRICT
+    on_delete   = RESTRICT
+  }
+  index "user_id" {
+    unique  = true
+    columns = [column.user_id]
+  }
+}
 table "tbl_users" {
   schema = schema.lessonlink
   column "id" {
@@ -0,0 +1,2 @@
+-- Modify "tbl_schedule_room_items" table
+ALTER TABLE `tbl_schedule_room_items` ADD COLUMN `pinned` int NOT NULL DEFAULT 0;
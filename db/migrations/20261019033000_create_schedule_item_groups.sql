@@ -0,0 +1,13 @@
+-- Create "tbl_schedule_item_groups" table
+CREATE TABLE `tbl_schedule_item_groups` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `schedule_id` int NOT NULL,
+  `history_index` int NOT NULL,
+  `group_identifier` varchar(36) NOT NULL,
+  `identifier` varchar(36) NOT NULL,
+  `offset_minutes` int NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `schedule_id` (`schedule_id`),
+  UNIQUE INDEX `schedule_id_2` (`schedule_id`, `history_index`, `identifier`),
+  CONSTRAINT `tbl_schedule_item_groups_ibfk_1` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
@@ -0,0 +1,2 @@
+-- Modify "tbl_schedules" table
+ALTER TABLE `tbl_schedules` RENAME COLUMN `start_time` TO `start_time_hour`, RENAME COLUMN `end_time` TO `end_time_hour`, ADD COLUMN `start_time_minutes` int NOT NULL DEFAULT 0 AFTER `start_time_hour`, ADD COLUMN `end_time_minutes` int NOT NULL DEFAULT 0 AFTER `end_time_hour`;
@@ -0,0 +1,31 @@
+-- Create "data_campus_scheduling_policies" table
+CREATE TABLE `data_campus_scheduling_policies` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `campus` varchar(16) NOT NULL,
+  `grid_minutes` int NOT NULL DEFAULT 1,
+  `min_lesson_duration` int NOT NULL DEFAULT 1,
+  `max_lesson_duration` int NOT NULL DEFAULT 300,
+  `min_gap_minutes` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `campus` (`campus`),
+  CONSTRAINT `data_campus_scheduling_policies_ibfk_1` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Create "data_campus_opening_hours" table
+CREATE TABLE `data_campus_opening_hours` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `campus` varchar(16) NOT NULL,
+  `weekday` int NOT NULL,
+  `open_time_hour` int NOT NULL,
+  `open_time_minutes` int NOT NULL,
+  `close_time_hour` int NOT NULL,
+  `close_time_minutes` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `campus` (`campus`, `weekday`),
+  CONSTRAINT `data_campus_opening_hours_ibfk_1` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Modify "tbl_schedules" table
+ALTER TABLE `tbl_schedules` ADD COLUMN `target_date` date NULL AFTER `title`;
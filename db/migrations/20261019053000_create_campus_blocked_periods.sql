@@ -0,0 +1,19 @@
+-- Create "data_campus_blocked_periods" table
+CREATE TABLE `data_campus_blocked_periods` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `campus` varchar(16) NOT NULL,
+  `title` varchar(32) NOT NULL,
+  `room_index` int NOT NULL DEFAULT 0,
+  `recurrence` varchar(16) NOT NULL DEFAULT "daily",
+  `weekday` int NULL,
+  `target_date` date NULL,
+  `start_time_hour` int NOT NULL,
+  `start_time_minutes` int NOT NULL,
+  `end_time_hour` int NOT NULL,
+  `end_time_minutes` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `campus` (`campus`),
+  CONSTRAINT `data_campus_blocked_periods_ibfk_1` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
@@ -0,0 +1,15 @@
+-- Create "data_campus_room_item_types" table
+CREATE TABLE `data_campus_room_item_types` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `campus` varchar(16) NOT NULL,
+  `item_tag` varchar(32) NOT NULL,
+  `label` varchar(32) NOT NULL,
+  `color` varchar(7) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `campus` (`campus`, `item_tag`),
+  CONSTRAINT `data_campus_room_item_types_ibfk_1` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Modify "tbl_schedule_room_items" table
+ALTER TABLE `tbl_schedule_room_items` ADD COLUMN `title` varchar(64) NOT NULL DEFAULT "" AFTER `identifier`;
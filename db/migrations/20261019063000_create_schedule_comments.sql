@@ -0,0 +1,17 @@
+-- Create "tbl_schedule_comments" table
+CREATE TABLE `tbl_schedule_comments` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `schedule_id` int NOT NULL,
+  `identifier` varchar(36) NOT NULL DEFAULT "",
+  `parent_id` int NOT NULL DEFAULT 0,
+  `author_user_id` int NOT NULL,
+  `body` varchar(1000) NOT NULL,
+  `resolved` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `author_user_id` (`author_user_id`),
+  INDEX `schedule_id` (`schedule_id`),
+  CONSTRAINT `tbl_schedule_comments_ibfk_1` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_schedule_comments_ibfk_2` FOREIGN KEY (`author_user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
@@ -0,0 +1,27 @@
+-- Create "tbl_schedule_day_bundles" table
+CREATE TABLE `tbl_schedule_day_bundles` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `campus` varchar(16) NOT NULL,
+  `title` varchar(64) NOT NULL,
+  `refuse_collision` int NOT NULL DEFAULT 0,
+  `create_user` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `campus` (`campus`),
+  INDEX `create_user` (`create_user`),
+  CONSTRAINT `tbl_schedule_day_bundles_ibfk_1` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_schedule_day_bundles_ibfk_2` FOREIGN KEY (`create_user`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Create "tbl_schedule_day_bundle_members" table
+CREATE TABLE `tbl_schedule_day_bundle_members` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `bundle_id` int NOT NULL,
+  `schedule_id` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `bundle_id` (`bundle_id`),
+  UNIQUE INDEX `schedule_id` (`schedule_id`),
+  CONSTRAINT `tbl_schedule_day_bundle_members_ibfk_1` FOREIGN KEY (`bundle_id`) REFERENCES `tbl_schedule_day_bundles` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_schedule_day_bundle_members_ibfk_2` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
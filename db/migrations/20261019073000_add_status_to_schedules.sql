@@ -0,0 +1,2 @@
+-- Modify "tbl_schedules" table
+ALTER TABLE `tbl_schedules` ADD COLUMN `status` varchar(16) NOT NULL DEFAULT "draft" AFTER `end_time_minutes`, ADD COLUMN `review_comment` varchar(256) NOT NULL DEFAULT "" AFTER `status`;
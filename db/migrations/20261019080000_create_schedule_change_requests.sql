@@ -0,0 +1,32 @@
+-- Create "tbl_schedule_change_requests" table
+CREATE TABLE `tbl_schedule_change_requests` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `schedule_id` int NOT NULL,
+  `history_index` int NOT NULL,
+  `request_user_id` int NOT NULL,
+  `comment` varchar(1000) NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT "pending",
+  `review_user_id` int NOT NULL DEFAULT 0,
+  `review_comment` varchar(256) NOT NULL DEFAULT "",
+  `applied_history_index` int NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `request_user_id` (`request_user_id`),
+  INDEX `schedule_id` (`schedule_id`),
+  CONSTRAINT `tbl_schedule_change_requests_ibfk_1` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_schedule_change_requests_ibfk_2` FOREIGN KEY (`request_user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Create "tbl_schedule_change_request_operations" table
+CREATE TABLE `tbl_schedule_change_request_operations` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `change_request_id` int NOT NULL,
+  `operation` varchar(16) NOT NULL,
+  `identifier` varchar(36) NOT NULL,
+  `room_index` int NOT NULL DEFAULT 0,
+  `start_time_hour` int NOT NULL DEFAULT 0,
+  `start_time_minutes` int NOT NULL DEFAULT 0,
+  PRIMARY KEY (`id`),
+  INDEX `change_request_id` (`change_request_id`),
+  CONSTRAINT `tbl_schedule_change_request_operations_ibfk_1` FOREIGN KEY (`change_request_id`) REFERENCES `tbl_schedule_change_requests` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
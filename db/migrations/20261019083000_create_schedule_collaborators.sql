@@ -0,0 +1,14 @@
+-- Create "tbl_schedule_collaborators" table
+CREATE TABLE `tbl_schedule_collaborators` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `schedule_id` int NOT NULL,
+  `user_id` int NOT NULL,
+  `permission` varchar(16) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `schedule_id` (`schedule_id`, `user_id`),
+  INDEX `user_id` (`user_id`),
+  CONSTRAINT `tbl_schedule_collaborators_ibfk_1` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_schedule_collaborators_ibfk_2` FOREIGN KEY (`user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
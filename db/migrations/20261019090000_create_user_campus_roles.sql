@@ -0,0 +1,19 @@
+-- Create "tbl_user_campus_roles" table
+CREATE TABLE `tbl_user_campus_roles` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `campus` varchar(16) NOT NULL,
+  `role_key` varchar(16) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `campus` (`campus`),
+  INDEX `role_key` (`role_key`),
+  UNIQUE INDEX `user_id` (`user_id`, `campus`),
+  CONSTRAINT `tbl_user_campus_roles_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_user_campus_roles_ibfk_2` FOREIGN KEY (`campus`) REFERENCES `data_campuses` (`campus`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `tbl_user_campus_roles_ibfk_3` FOREIGN KEY (`role_key`) REFERENCES `data_roles` (`role_key`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- 既存のユーザーは全ての校舎に現在のロールで割り当てる
+INSERT INTO `tbl_user_campus_roles` (`user_id`, `campus`, `role_key`)
+SELECT `tbl_users`.`id`, `data_campuses`.`campus`, `tbl_users`.`role_key` FROM `tbl_users` CROSS JOIN `data_campuses`;
@@ -0,0 +1,52 @@
+-- Modify "data_roles" table
+ALTER TABLE `data_roles` ADD COLUMN `builtin` int NOT NULL DEFAULT 0 AFTER `role_name`;
+-- Create "data_permissions" table
+CREATE TABLE `data_permissions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `permission_key` varchar(32) NOT NULL,
+  `permission_name` varchar(32) NOT NULL,
+  `order_index` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `permission_key` (`permission_key`)
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Create "data_role_permissions" table
+CREATE TABLE `data_role_permissions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `role_key` varchar(16) NOT NULL,
+  `permission_key` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `permission_key` (`permission_key`),
+  UNIQUE INDEX `role_key` (`role_key`, `permission_key`),
+  CONSTRAINT `data_role_permissions_ibfk_1` FOREIGN KEY (`role_key`) REFERENCES `data_roles` (`role_key`) ON UPDATE RESTRICT ON DELETE RESTRICT,
+  CONSTRAINT `data_role_permissions_ibfk_2` FOREIGN KEY (`permission_key`) REFERENCES `data_permissions` (`permission_key`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- 既定のロールは編集不可
+UPDATE `data_roles` SET `builtin` = 1 WHERE `role_key` IN ('owner', 'editor', 'viewer');
+
+INSERT INTO `data_permissions` (`permission_key`, `permission_name`, `order_index`) VALUES
+('schedule.view_draft', '公開前スケジュールの閲覧', 1),
+('schedule.create', 'スケジュールの作成', 2),
+('schedule.edit', 'スケジュールの編集', 3),
+('schedule.manage', '全スケジュールの管理', 4),
+('schedule.review', 'スケジュールのレビュー', 5),
+('schedule.comment', 'スケジュールへのコメント', 6),
+('bundle.edit', 'バンドルの編集', 7),
+('lesson.edit', '講座の編集', 8),
+('room.edit', '教室の編集', 9),
+('campus.edit', '校舎設定の編集', 10),
+('user.manage', 'ユーザーの管理', 11),
+('role.manage', 'ロールの管理', 12);
+
+-- 既定のロールにこれまでと同じ権限を割り当てる
+INSERT INTO `data_role_permissions` (`role_key`, `permission_key`)
+SELECT 'owner', `permission_key` FROM `data_permissions`;
+
+INSERT INTO `data_role_permissions` (`role_key`, `permission_key`) VALUES
+('editor', 'schedule.view_draft'),
+('editor', 'schedule.edit'),
+('editor', 'schedule.comment'),
+('editor', 'bundle.edit');
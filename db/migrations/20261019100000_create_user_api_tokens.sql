@@ -0,0 +1,17 @@
+-- Create "tbl_user_api_tokens" table
+CREATE TABLE `tbl_user_api_tokens` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `token_name` varchar(64) NOT NULL,
+  `token_prefix` varchar(16) NOT NULL,
+  `token_hash` varchar(64) NOT NULL,
+  `scope` varchar(16) NOT NULL,
+  `expires_at` datetime NOT NULL,
+  `last_used_at` datetime NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `token_hash` (`token_hash`),
+  INDEX `user_id` (`user_id`),
+  CONSTRAINT `tbl_user_api_tokens_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
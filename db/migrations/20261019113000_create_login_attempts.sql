@@ -0,0 +1,29 @@
+-- Create "tbl_login_attempts" table
+CREATE TABLE `tbl_login_attempts` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_name` varchar(64) NOT NULL,
+  `user_id` int NULL,
+  `ip_address` varchar(45) NOT NULL,
+  `user_agent` varchar(512) NOT NULL DEFAULT "",
+  `result` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  INDEX `ip_address` (`ip_address`, `created_at`),
+  INDEX `user_id` (`user_id`, `created_at`),
+  INDEX `user_name` (`user_name`, `created_at`),
+  CONSTRAINT `tbl_login_attempts_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `tbl_users` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
+-- Create "tbl_login_throttles" table
+CREATE TABLE `tbl_login_throttles` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `throttle_type` varchar(16) NOT NULL,
+  `throttle_key` varchar(64) NOT NULL,
+  `failure_count` int NOT NULL DEFAULT 0,
+  `last_failed_at` datetime NOT NULL,
+  `locked_until` datetime NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `throttle_type` (`throttle_type`, `throttle_key`)
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
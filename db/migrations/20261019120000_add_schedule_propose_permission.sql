@@ -0,0 +1,8 @@
+-- 変更提案は閲覧者にも許可していたため、既定のロールすべてに割り当てる
+INSERT INTO `data_permissions` (`permission_key`, `permission_name`, `order_index`) VALUES
+('schedule.propose', 'スケジュールへの変更提案', 13);
+
+INSERT INTO `data_role_permissions` (`role_key`, `permission_key`) VALUES
+('owner', 'schedule.propose'),
+('editor', 'schedule.propose'),
+('viewer', 'schedule.propose');
@@ -0,0 +1,14 @@
+-- Create "tbl_schedule_histories" table
+CREATE TABLE `tbl_schedule_histories` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `schedule_id` int NOT NULL,
+  `history_index` int NOT NULL,
+  `start_time_hour` int NOT NULL,
+  `start_time_minutes` int NOT NULL,
+  `end_time_hour` int NOT NULL,
+  `end_time_minutes` int NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `schedule_id` (`schedule_id`),
+  UNIQUE INDEX `schedule_id_2` (`schedule_id`, `history_index`),
+  CONSTRAINT `tbl_schedule_histories_ibfk_1` FOREIGN KEY (`schedule_id`) REFERENCES `tbl_schedules` (`id`) ON UPDATE RESTRICT ON DELETE RESTRICT
+) CHARSET utf8mb4 COLLATE utf8mb4_0900_ai_ci;
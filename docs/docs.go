@@ -18,17 +18,35 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/campus/list": {
+        "/bundle/list/{campus}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "キャンパスリスト取得",
+                "summary": "同日スケジュールのまとまり一覧取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.CampusListResponse"
+                            "$ref": "#/definitions/presenter.DayBundleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -40,6 +58,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -52,27 +79,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/lesson/{campus}": {
-            "post": {
+        "/bundle/{bundle_id}": {
+            "put": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "講座追加",
+                "summary": "同日スケジュールのまとまり更新",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "校舎",
-                        "name": "campus",
+                        "type": "integer",
+                        "description": "DayBundleID",
+                        "name": "bundle_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "講座追加リクエスト",
+                        "description": "まとまり更新リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.LessonAddRequestData"
+                            "$ref": "#/definitions/controller.DayBundleRequestData"
                         }
                     }
                 ],
@@ -80,7 +107,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.LessonAddResponse"
+                            "$ref": "#/definitions/presenter.DayBundleResponse"
                         }
                     },
                     "400": {
@@ -101,6 +128,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -120,29 +156,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/lesson/{campus}/list": {
-            "get": {
+            },
+            "delete": {
+                "description": "スケジュール自体は削除されません",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "講座一覧取得",
+                "summary": "同日スケジュールのまとまり削除",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "校舎",
-                        "name": "campus",
+                        "type": "integer",
+                        "description": "DayBundleID",
+                        "name": "bundle_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/presenter.LessonListResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -162,6 +194,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -183,35 +224,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/lesson/{lessonid}": {
-            "patch": {
+        "/bundle/{bundle_id}/collisions": {
+            "get": {
+                "description": "まとまりに含まれる各スケジュールの最新の履歴を比較し、同じ教室・同じ時間帯を使用しているアイテムを返します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "講座編集",
+                "summary": "同日スケジュール間の教室衝突取得",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "講座",
-                        "name": "lessonid",
+                        "type": "integer",
+                        "description": "DayBundleID",
+                        "name": "bundle_id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "講座編集リクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.LessonEditRequestData"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.LessonEditResponse"
+                            "$ref": "#/definitions/presenter.DayBundleCollisionGetResponse"
                         }
                     },
                     "400": {
@@ -253,12 +286,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/room/{campus}/edit": {
+        "/bundle/{campus}": {
             "post": {
+                "description": "同じ日に並行して実施するスケジュールをまとめます。refuse_collisionを有効にすると、他のスケジュールと教室の使用時間が重なる配置を拒否します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "教室編集",
+                "summary": "同日スケジュールのまとまり登録",
                 "parameters": [
                     {
                         "type": "string",
@@ -268,12 +302,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "教室編集リクエスト",
+                        "description": "まとまり登録リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.RoomEditRequestData"
+                            "$ref": "#/definitions/controller.DayBundleRequestData"
                         }
                     }
                 ],
@@ -281,7 +315,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.RoomEditResponse"
+                            "$ref": "#/definitions/presenter.DayBundleResponse"
                         }
                     },
                     "400": {
@@ -302,6 +336,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -323,35 +366,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/room/{campus}/list": {
+        "/campus/list": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "教室リスト取得",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "校舎",
-                        "name": "campus",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "キャンパスリスト取得",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.RoomListResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/presenter.CampusListResponse"
                         }
                     },
                     "401": {
@@ -363,15 +388,6 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -384,12 +400,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/create/{campus}": {
-            "post": {
+        "/campus/{campus}/blocked-periods": {
+            "get": {
+                "description": "校舎に登録されている利用不可の時間帯(昼休み・全体集会・設備点検など)を返します。room_indexが0の時間帯は全教室に適用されます",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール作成",
+                "summary": "校舎の利用不可時間帯取得",
                 "parameters": [
                     {
                         "type": "string",
@@ -397,22 +414,13 @@ const docTemplate = `{
                         "name": "campus",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "スケジュール作成リクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.ScheduleCreateRequestData"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleCreateResponse"
+                            "$ref": "#/definitions/presenter.CampusBlockedPeriodGetResponse"
                         }
                     },
                     "400": {
@@ -452,14 +460,13 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/schedule/list/{campus}": {
-            "get": {
+            },
+            "put": {
+                "description": "校舎の利用不可時間帯を一括で置き換えます。room_indexを省略または0にすると全教室に適用します。recurrenceはdaily(既定)・weekly・dateで、weeklyはweekday(0:日曜～6:土曜)、dateはtarget_date(YYYY-MM-DD)を指定します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュールリスト取得",
+                "summary": "校舎の利用不可時間帯編集",
                 "parameters": [
                     {
                         "type": "string",
@@ -467,13 +474,22 @@ const docTemplate = `{
                         "name": "campus",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "利用不可時間帯編集リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.CampusBlockedPeriodEditRequestData"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleListResponse"
+                            "$ref": "#/definitions/presenter.CampusBlockedPeriodEditResponse"
                         }
                     },
                     "400": {
@@ -494,6 +510,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -515,32 +540,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}": {
+        "/campus/{campus}/policy": {
             "get": {
+                "description": "未設定の校舎は既定値(1分刻み・講座時間1～300分・間隔0分・終日開校)を返します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュールリスト取得",
+                "summary": "校舎の配置ポリシー取得",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "履歴番号",
-                        "name": "history",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleGetResponse"
+                            "$ref": "#/definitions/presenter.CampusPolicyGetResponse"
                         }
                     },
                     "400": {
@@ -581,26 +601,27 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
+                "description": "開始時刻の刻み・講座時間の範囲・講座間の最小間隔・曜日ごとの開校時間を設定します。weekdayは0(日曜)～6(土曜)で、opening_hoursに含めない曜日は休校日になります。opening_hoursが空の場合は終日開校として扱います",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール保存",
+                "summary": "校舎の配置ポリシー編集",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "スケジュール保存リクエスト",
+                        "description": "配置ポリシー編集リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.ScheduleSaveRequestData"
+                            "$ref": "#/definitions/controller.CampusPolicyEditRequestData"
                         }
                     }
                 ],
@@ -608,7 +629,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleSaveResponse"
+                            "$ref": "#/definitions/presenter.CampusPolicyEditResponse"
                         }
                     },
                     "400": {
@@ -629,6 +650,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -648,24 +678,30 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/campus/{campus}/room-item-types": {
+            "get": {
+                "description": "校舎に登録されている講座・清掃以外のアイテムの種類(行事・試験・会議・予約枠など)と表示名・表示色を返します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール削除",
+                "summary": "校舎のアイテム種類取得",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CampusRoomItemTypeGetResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -704,26 +740,37 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/schedule/{schedule_id}/duplicate": {
-            "post": {
+            },
+            "put": {
+                "description": "校舎のアイテムの種類を一括で置き換えます。item_tagは英小文字で始まる英小文字・数字・アンダースコア(32文字以内)で、lesson・cleaningは指定できません。colorは#RRGGBB形式です。削除した種類の配置済みアイテムはそのまま残りますが、新たに配置することはできません",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール複製",
+                "summary": "校舎のアイテム種類編集",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "アイテム種類編集リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.CampusRoomItemTypeEditRequestData"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.CampusRoomItemTypeEditResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -743,8 +790,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -752,8 +799,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -764,27 +820,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/item-divide": {
+        "/lesson/{campus}": {
             "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール編集アイテムリスト分割",
+                "summary": "講座追加",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "スケジュール保存リクエスト",
+                        "description": "講座追加リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.ScheduleItemDivideRequestData"
+                            "$ref": "#/definitions/controller.LessonAddRequestData"
                         }
                     }
                 ],
@@ -792,7 +848,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                            "$ref": "#/definitions/presenter.LessonAddResponse"
                         }
                     },
                     "400": {
@@ -834,35 +890,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/item-join": {
-            "post": {
+        "/lesson/{campus}/list": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール編集アイテムリスト分割",
+                "summary": "講座一覧取得",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "アイテム結合リクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.ScheduleItemJoinRequestData"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                            "$ref": "#/definitions/presenter.LessonListResponse"
                         }
                     },
                     "400": {
@@ -904,27 +951,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/item-move": {
-            "post": {
+        "/lesson/{lessonid}": {
+            "patch": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール編集アイテム移動",
+                "summary": "講座編集",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "講座",
+                        "name": "lessonid",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "アイテム移動リクエスト",
+                        "description": "講座編集リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.ScheduleItemMoveRequestData"
+                            "$ref": "#/definitions/controller.LessonEditRequestData"
                         }
                     }
                 ],
@@ -932,7 +979,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                            "$ref": "#/definitions/presenter.LessonEditResponse"
                         }
                     },
                     "400": {
@@ -974,27 +1021,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/item-return-list": {
+        "/role": {
             "post": {
+                "description": "権限を割り当てたロールを追加します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール編集アイテムリスト移動",
+                "summary": "ロール追加",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "アイテムリスト移動リクエスト",
+                        "description": "ロール追加リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.ScheduleItemReturnListRequestData"
+                            "$ref": "#/definitions/controller.RoleAddRequestData"
                         }
                     }
                 ],
@@ -1002,7 +1043,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                            "$ref": "#/definitions/presenter.RoleListResponse"
                         }
                     },
                     "400": {
@@ -1023,8 +1064,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1044,44 +1094,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/item-shift": {
-            "post": {
+        "/role/list": {
+            "get": {
+                "description": "ロールと割り当てられた権限、設定できる権限の一覧を取得します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール編集アイテムシフト",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "アイテムシフトリクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.ScheduleItemShiftRequestData"
-                        }
-                    }
-                ],
+                "summary": "ロール一覧取得",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/presenter.RoleListResponse"
                         }
                     },
                     "401": {
@@ -1093,15 +1117,6 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1114,27 +1129,28 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/room/invisible": {
+        "/role/{role_key}": {
             "put": {
+                "description": "追加したロールの名称と権限を変更します。既定のロールは変更できません",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "非表示ルーム登録",
+                "summary": "ロール編集",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "RoleKey",
+                        "name": "role_key",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "非表示ルームリクエスト",
+                        "description": "ロール編集リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.InvisibleRoomSaveRequestData"
+                            "$ref": "#/definitions/controller.RoleEditRequestData"
                         }
                     }
                 ],
@@ -1142,7 +1158,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.InvisibleRoomSaveResponse"
+                            "$ref": "#/definitions/presenter.RoleListResponse"
                         }
                     },
                     "400": {
@@ -1163,6 +1179,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1184,35 +1209,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/time": {
-            "patch": {
+        "/room/{campus}/edit": {
+            "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュール時間変更",
+                "summary": "教室編集",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "スケジュール時間変更リクエスト",
+                        "description": "教室編集リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.ScheduleTimeEditRequestData"
+                            "$ref": "#/definitions/controller.RoomEditRequestData"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                            "$ref": "#/definitions/presenter.RoomEditResponse"
                         }
                     },
                     "400": {
@@ -1254,35 +1279,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedule/{schedule_id}/title": {
-            "patch": {
+        "/room/{campus}/list": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "スケジュールタイトル保存",
+                "summary": "教室リスト取得",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ScheduleID",
-                        "name": "schedule_id",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "タイトル保存リクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.ScheduleSaveTitleRequestData"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.ScheduleSaveTitleResponse"
+                            "$ref": "#/definitions/presenter.RoomListResponse"
                         }
                     },
                     "400": {
@@ -1324,28 +1340,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/user": {
-            "put": {
+        "/schedule/create/{campus}": {
+            "post": {
+                "description": "終了時刻は24時0分まで指定できます。実施日(YYYY-MM-DD)を指定すると、その曜日の開校時間で検証します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザー更新",
+                "summary": "スケジュール作成",
                 "parameters": [
                     {
-                        "description": "ユーザー更新リクエスト",
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "スケジュール作成リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.UserUpdateRequestData"
+                            "$ref": "#/definitions/controller.ScheduleCreateRequestData"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserUpdateResponse"
+                            "$ref": "#/definitions/presenter.ScheduleCreateResponse"
                         }
                     },
                     "400": {
@@ -1385,28 +1409,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/schedule/list/{campus}": {
+            "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザー追加",
+                "summary": "スケジュールリスト取得",
                 "parameters": [
                     {
-                        "description": "ユーザー作成リクエスト",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/controller.UserAddRequestData"
-                        }
+                        "type": "string",
+                        "description": "校舎",
+                        "name": "campus",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ステータス(draft / in_review / published / archived)",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserAddResponse"
+                            "$ref": "#/definitions/presenter.ScheduleListResponse"
                         }
                     },
                     "400": {
@@ -1427,8 +1457,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1448,17 +1478,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/list": {
+        "/schedule/{schedule_id}": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザー一覧取得",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/presenter.UserListResponse"
+                "summary": "スケジュールリスト取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "履歴番号",
+                        "name": "history",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleGetResponse"
                         }
                     },
                     "400": {
@@ -1479,6 +1524,24 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1489,22 +1552,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/login": {
+            },
             "post": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザーログイン",
+                "summary": "スケジュール保存",
                 "parameters": [
                     {
-                        "description": "ユーザーログイン情報",
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "スケジュール保存リクエスト",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/controller.UserLoginParams"
+                            "$ref": "#/definitions/controller.ScheduleSaveRequestData"
                         }
                     }
                 ],
@@ -1512,41 +1580,72 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                            "$ref": "#/definitions/presenter.ScheduleSaveResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/user/logout": {
-            "post": {
+            },
+            "delete": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザーログアウト",
+                "summary": "スケジュール削除",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "204": {
-                        "description": "No Content",
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1558,26 +1657,47 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/user/self": {
+        "/schedule/{schedule_id}/change-requests": {
             "get": {
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ログインユーザー取得",
+                "summary": "スケジュールの変更提案一覧取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserGetResponse"
+                            "$ref": "#/definitions/presenter.ScheduleChangeRequestListResponse"
                         }
                     },
                     "400": {
@@ -1598,6 +1718,24 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1608,28 +1746,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/user/{userid}": {
-            "get": {
+            },
+            "post": {
+                "description": "編集権限のないユーザーも、閲覧できるスケジュールに対してアイテムの操作を提案できます",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザー取得",
+                "summary": "スケジュールの変更提案",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "UserID",
-                        "name": "userid",
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "変更提案リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleChangeRequestAddRequestData"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserGetResponse"
+                            "$ref": "#/definitions/presenter.ScheduleChangeRequestResponse"
                         }
                     },
                     "400": {
@@ -1650,6 +1796,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1669,17 +1824,27 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/schedule/{schedule_id}/change-requests/{change_request_id}/approve": {
+            "put": {
+                "description": "最新の履歴に提案を適用し、新しい履歴として保存します",
                 "produces": [
                     "application/json"
                 ],
-                "summary": "ユーザー削除",
+                "summary": "スケジュールの変更提案承認",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "UserID",
-                        "name": "userid",
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ChangeRequestID",
+                        "name": "change_request_id",
                         "in": "path",
                         "required": true
                     }
@@ -1688,7 +1853,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presenter.UserDeleteResponse"
+                            "$ref": "#/definitions/presenter.ScheduleChangeRequestResponse"
                         }
                     },
                     "400": {
@@ -1709,6 +1874,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1729,281 +1903,5262 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.InvisibleRoomSaveRequestData": {
-            "type": "object",
-            "required": [
-                "invisible_rooms"
-            ],
-            "properties": {
-                "invisible_rooms": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                }
-            }
-        },
-        "controller.LessonAddRequestData": {
-            "type": "object",
-            "required": [
-                "duration",
-                "lesson_name"
-            ],
-            "properties": {
-                "duration": {
-                    "type": "integer"
-                },
-                "lesson_name": {
-                    "type": "string"
-                }
-            }
-        },
-        "controller.LessonEditRequestData": {
-            "type": "object",
-            "required": [
-                "duration",
-                "lesson_name"
-            ],
-            "properties": {
-                "duration": {
-                    "type": "integer"
-                },
-                "lesson_name": {
-                    "type": "string"
-                }
-            }
         },
-        "controller.RoomEditData": {
-            "type": "object",
-            "required": [
-                "room_index",
-                "room_name"
-            ],
-            "properties": {
-                "room_index": {
+        "/schedule/{schedule_id}/change-requests/{change_request_id}/diff": {
+            "get": {
+                "description": "提案を現在のスケジュールに適用した場合に配置が変わるアイテムを返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの変更提案と現在の状態の差分取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ChangeRequestID",
+                        "name": "change_request_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleChangeRequestDiffResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/change-requests/{change_request_id}/reject": {
+            "put": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの変更提案却下",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ChangeRequestID",
+                        "name": "change_request_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "却下リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleChangeRequestRejectRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleChangeRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/collaborators": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの所有者・共同編集者一覧取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCollaboratorListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/collaborators/{user_id}": {
+            "put": {
+                "description": "スケジュールの所有者またはオーナーが、指定したユーザーに編集または閲覧の権限を付与します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの共同編集者追加・権限変更",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "UserID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "権限設定リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleCollaboratorEditRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCollaboratorListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの共同編集者削除",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "UserID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCollaboratorListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/comments": {
+            "get": {
+                "description": "識別子を指定した場合はそのアイテムのコメントのみを返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールコメント一覧取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "アイテムの識別子",
+                        "name": "identifier",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "識別子を省略した場合はスケジュール全体へのコメントになります。parent_idを指定した場合はそのコメントへの返信になります",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールコメント投稿",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "コメント投稿リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleCommentAddRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/comments/{comment_id}": {
+            "put": {
+                "description": "投稿者のみ編集できます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールコメント編集",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "CommentID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "コメント編集リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleCommentEditRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "投稿者のみ削除できます。スレッドの起点を削除した場合は返信も削除されます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールコメント削除",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "CommentID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/comments/{comment_id}/resolve": {
+            "put": {
+                "description": "投稿者またはスケジュールを編集できるユーザーが変更できます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールコメント解決状態切り替え",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "CommentID",
+                        "name": "comment_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "解決状態切り替えリクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleCommentResolveRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCommentListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/duplicate": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール複製",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-divide": {
+            "post": {
+                "description": "divide_minutes_list、parts、divide_minutes の順に優先して分割します。先頭の分割アイテムが元の識別子を引き継ぎます\nbreak_minutes を指定すると、配置済みアイテムの分割後の各アイテムの間に休憩時間を空けます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムリスト分割",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "スケジュール保存リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemDivideRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-group": {
+            "post": {
+                "description": "グループのアイテムは先頭からの開始位置(分)を保ったまま、移動・シフト・一覧へ戻すを一括で行います",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムグループ作成",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテムグループ作成リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemGroupRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-join": {
+            "post": {
+                "description": "identifiers を指定すると、同じ講座の複数のアイテムを結合します。先頭に指定したアイテムの識別子を引き継ぎます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムリスト分割",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテム結合リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemJoinRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-move": {
+            "post": {
+                "description": "mode に push を指定すると、重なった後続のアイテムを後ろへ押し出して配置します(省略時は exact)。item_tag には lesson・cleaning のほか校舎に登録したアイテムの種類を指定でき、title で任意の名称を付けられます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテム移動",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテム移動リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemMoveRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-pin": {
+            "post": {
+                "description": "固定したアイテムはシフトなどの自動配置で移動しません",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテム固定切り替え",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテム固定リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemPinRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-return-list": {
+            "post": {
+                "description": "mode に pull を指定すると、空いた時間を後続のアイテムで詰めます(省略時は keep)",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムリスト移動",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテムリスト移動リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemReturnListRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-shift": {
+            "post": {
+                "description": "strategy: pack(省略時) / pack_start / pack_end / distribute / fixed_gap(gap_minutes) / grid(grid_minutes)\n対象は room_index、room_indexes、all_rooms のいずれかで指定します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムシフト",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテムシフトリクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemShiftRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-time-shift": {
+            "post": {
+                "description": "identifiers、または room_indexes と時刻の範囲で選択したアイテムを shift_minutes 分ずらします(負の値は前へずらします)",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテム時刻一括移動",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテム時刻一括移動リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemTimeShiftRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/item-ungroup": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテムグループ解除",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "アイテムグループ解除リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleItemUngroupRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/items/{identifier}/suggestions": {
+            "get": {
+                "description": "一覧のアイテムを配置できる空き枠を、前後の空き時間が少ない順・希望教室・開始時刻が早い順に上位から返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集アイテム配置候補取得",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "アイテム識別子",
+                        "name": "identifier",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "講座ID",
+                        "name": "lesson_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "履歴番号",
+                        "name": "history",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "候補の刻み時間(分) 省略時は5分",
+                        "name": "step",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "希望教室番号",
+                        "name": "room_index",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "候補の件数(最大100件) 省略時は20件",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemSuggestionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/owner": {
+            "put": {
+                "description": "移譲前の所有者は編集権限を持つ共同編集者として残ります",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールの所有権移譲",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "所有権移譲リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleOwnerTransferRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleCollaboratorListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/room-clear": {
+            "post": {
+                "description": "教室(all_rooms 指定時はスケジュール全体)のアイテムを講座リストへ戻します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集教室クリア",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "教室クリアリクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleRoomClearRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/room-copy": {
+            "post": {
+                "description": "教室に配置されたアイテムを新しい識別子で別の教室へ複製します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集教室複製",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "教室複製リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleRoomCopyRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/room-swap": {
+            "post": {
+                "description": "2つの教室に配置されたアイテムを入れ替えます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール編集教室入れ替え",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "教室入れ替えリクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleRoomSwapRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/room/invisible": {
+            "put": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "非表示ルーム登録",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "非表示ルームリクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.InvisibleRoomSaveRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.InvisibleRoomSaveResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/status": {
+            "put": {
+                "description": "作成中 → レビュー待ち → 公開済み → アーカイブ済みの順に遷移する",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールのステータス変更",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ステータス変更リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleStatusChangeRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleStatusChangeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/time": {
+            "patch": {
+                "description": "mode: strict(省略時。範囲外の講座があれば変更不可、履歴を初期化) / translate / scale / return_list(いずれも新しい履歴として保存し、元に戻せます。translate と scale は固定アイテムを動かしません)。target_date(YYYY-MM-DD)を指定すると実施日も変更します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュール時間変更",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "スケジュール時間変更リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleTimeEditRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleItemEditResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/schedule/{schedule_id}/title": {
+            "patch": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "スケジュールタイトル保存",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ScheduleID",
+                        "name": "schedule_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "タイトル保存リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.ScheduleSaveTitleRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.ScheduleSaveTitleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user": {
+            "put": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー更新",
+                "parameters": [
+                    {
+                        "description": "ユーザー更新リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserUpdateRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserUpdateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー追加",
+                "parameters": [
+                    {
+                        "description": "ユーザー作成リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserAddRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserAddResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/list": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー一覧取得",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login": {
+            "post": {
+                "description": "2段階認証が必要なユーザーの場合はセッションを作成せず、two_factor_requiredをtrueで返します。続けて/user/login/two-factorに認証コードを送信してください",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーログイン",
+                "parameters": [
+                    {
+                        "description": "ユーザーログイン情報",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserLoginParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login/two-factor": {
+            "post": {
+                "description": "パスワード認証後に認証コードを送信してログインを完了します。2段階認証の登録が必要な場合は、このリクエストで登録も完了しリカバリーコードを返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "2段階認証",
+                "parameters": [
+                    {
+                        "description": "認証コード",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.TwoFactorCodeParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginTwoFactorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/login/two-factor/enroll": {
+            "post": {
+                "description": "2段階認証が必須とされている未登録のユーザーが、パスワード認証後に認証アプリへ登録する情報を取得します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ログイン時の2段階認証登録",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorEnrollResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/logout": {
+            "post": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーログアウト",
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/oidc/callback": {
+            "get": {
+                "description": "IDプロバイダーからのリダイレクトを受けてログインします。ログイン後のリダイレクト先が設定されている場合はリダイレクトします。2段階認証が必要なユーザーの場合はセッションを作成せず、two_factor_requiredをtrueで返します(リダイレクトする場合はクエリパラメーターtwo_factorを付与します)。続けて/user/login/two-factorに認証コードを送信してください",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "シングルサインオンのコールバック",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "認可コード",
+                        "name": "code",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ログイン開始時のstate",
+                        "name": "state",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IDプロバイダーのエラー",
+                        "name": "error",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginResponse"
+                        }
+                    },
+                    "302": {
+                        "description": "Found"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/oidc/login": {
+            "get": {
+                "description": "OpenID ConnectのIDプロバイダーの認可エンドポイントへリダイレクトします",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "シングルサインオン開始",
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ログインユーザー取得",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self/api-tokens": {
+            "get": {
+                "description": "ログインユーザーが発行したAPIトークンを取得します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "APIトークン一覧",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.APITokenListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "スクリプトなどから利用するAPIトークンを発行します。トークンはAuthorization: Bearerヘッダーで送信します。平文のトークンはこのレスポンスでのみ返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "APIトークン発行",
+                "parameters": [
+                    {
+                        "description": "APIトークン発行リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.APITokenAddRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.APITokenAddResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self/api-tokens/{token_id}": {
+            "delete": {
+                "description": "ログインユーザーが発行したAPIトークンを失効させます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "APIトークン失効",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "TokenID",
+                        "name": "token_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.APITokenListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self/two-factor": {
+            "get": {
+                "description": "ログインしているユーザーの2段階認証の設定を取得します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "2段階認証の設定取得",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorStatusResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "認証コードを確認して2段階認証を無効にします。オーナーによって必須とされている場合は無効にできません",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "2段階認証の無効化",
+                "parameters": [
+                    {
+                        "description": "認証コード",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.TwoFactorCodeParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self/two-factor/confirm": {
+            "post": {
+                "description": "認証アプリに表示されたコードを確認して2段階認証を有効にします。平文のリカバリーコードはこのレスポンスでのみ返します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "2段階認証の登録完了",
+                "parameters": [
+                    {
+                        "description": "認証コード",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.TwoFactorCodeParams"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorConfirmResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/self/two-factor/enroll": {
+            "post": {
+                "description": "認証アプリに登録するシークレットとURIを発行します。/user/self/two-factor/confirmで認証コードを確認すると有効になります",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "2段階認証の登録開始",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorEnrollResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserGetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "削除するユーザーが所有するスケジュールは引き継ぎ先のユーザーへ移譲します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー削除",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "引き継ぎ先のユーザーID",
+                        "name": "successor_user_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserDeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/campus-roles": {
+            "get": {
+                "description": "キャンパスごとに有効なロールを取得します。自分以外のユーザーはオーナーのみ取得できます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "キャンパスごとのロール取得",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserCampusRoleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "キャンパスごとのロールの割り当てを置き換えます。オーナーのみ実行でき、対象ユーザーはログアウトされます",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "キャンパスごとのロール設定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "キャンパスロール設定リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserCampusRoleEditRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserCampusRoleListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/deactivate": {
+            "put": {
+                "description": "ユーザーを削除せずにログインできない状態にします。引き継ぎ先を指定した場合は所有するスケジュールを移譲します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー無効化",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "無効化リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserDeactivateRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserDeleteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/login-attempts": {
+            "get": {
+                "description": "ユーザーの直近のログイン試行の記録と、ログインの失敗によるロックの状態を取得します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーのログイン試行の一覧",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginAttemptListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/restore": {
+            "put": {
+                "description": "無効化・削除したユーザーをログイン可能な状態に戻します。移譲したスケジュールの所有権は戻りません",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザー復元",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserRestoreResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/two-factor": {
+            "delete": {
+                "description": "端末を紛失した場合などに、ユーザーの2段階認証の登録とリカバリーコードを削除します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーの2段階認証のリセット",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/two-factor/required": {
+            "put": {
+                "description": "ユーザーに2段階認証の利用を必須とするかを設定します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーの2段階認証の必須設定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "必須設定リクエスト",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.UserTwoFactorRequiredEditRequestData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.TwoFactorStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{userid}/unlock": {
+            "put": {
+                "description": "ログインの失敗が続いたことによるユーザーのロックを解除し、失敗回数を消去します",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "ユーザーのロック解除",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "UserID",
+                        "name": "userid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presenter.UserLoginAttemptListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.APITokenAddRequestData": {
+            "type": "object",
+            "required": [
+                "expires_in_days",
+                "name",
+                "scope"
+            ],
+            "properties": {
+                "expires_in_days": {
+                    "description": "有効日数(1〜365日)",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "read: 参照のみ write: 更新を含む全て",
+                    "type": "string"
+                }
+            }
+        },
+        "controller.CampusBlockedPeriodData": {
+            "type": "object",
+            "required": [
+                "end_time_hour",
+                "end_time_minutes",
+                "start_time_hour",
+                "start_time_minutes",
+                "title"
+            ],
+            "properties": {
+                "end_time_hour": {
+                    "type": "integer"
+                },
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "recurrence": {
+                    "type": "string"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                },
+                "target_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.CampusBlockedPeriodEditRequestData": {
+            "type": "object",
+            "required": [
+                "blocked_periods"
+            ],
+            "properties": {
+                "blocked_periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.CampusBlockedPeriodData"
+                    }
+                }
+            }
+        },
+        "controller.CampusOpeningHourData": {
+            "type": "object",
+            "required": [
+                "close_time_hour",
+                "close_time_minutes",
+                "open_time_hour",
+                "open_time_minutes",
+                "weekday"
+            ],
+            "properties": {
+                "close_time_hour": {
+                    "type": "integer"
+                },
+                "close_time_minutes": {
+                    "type": "integer"
+                },
+                "open_time_hour": {
+                    "type": "integer"
+                },
+                "open_time_minutes": {
+                    "type": "integer"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.CampusPolicyEditRequestData": {
+            "type": "object",
+            "required": [
+                "grid_minutes",
+                "max_lesson_duration",
+                "min_gap_minutes",
+                "min_lesson_duration",
+                "opening_hours"
+            ],
+            "properties": {
+                "grid_minutes": {
+                    "type": "integer"
+                },
+                "max_lesson_duration": {
+                    "type": "integer"
+                },
+                "min_gap_minutes": {
+                    "type": "integer"
+                },
+                "min_lesson_duration": {
+                    "type": "integer"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.CampusOpeningHourData"
+                    }
+                }
+            }
+        },
+        "controller.CampusRoomItemTypeData": {
+            "type": "object",
+            "required": [
+                "color",
+                "item_tag",
+                "label"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "item_tag": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.CampusRoomItemTypeEditRequestData": {
+            "type": "object",
+            "required": [
+                "room_item_types"
+            ],
+            "properties": {
+                "room_item_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.CampusRoomItemTypeData"
+                    }
+                }
+            }
+        },
+        "controller.DayBundleRequestData": {
+            "type": "object",
+            "required": [
+                "refuse_collision",
+                "schedule_ids",
+                "title"
+            ],
+            "properties": {
+                "refuse_collision": {
+                    "type": "boolean"
+                },
+                "schedule_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.InvisibleRoomSaveRequestData": {
+            "type": "object",
+            "required": [
+                "invisible_rooms"
+            ],
+            "properties": {
+                "invisible_rooms": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "controller.LessonAddRequestData": {
+            "type": "object",
+            "required": [
+                "duration",
+                "lesson_name"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "lesson_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.LessonEditRequestData": {
+            "type": "object",
+            "required": [
+                "duration",
+                "lesson_name"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "lesson_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.RoleAddRequestData": {
+            "type": "object",
+            "required": [
+                "permissions",
+                "role_key",
+                "role_name"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_key": {
+                    "description": "英小文字で始まる英小文字・数字・アンダースコア(16文字以内)",
+                    "type": "string"
+                },
+                "role_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.RoleEditRequestData": {
+            "type": "object",
+            "required": [
+                "permissions",
+                "role_name"
+            ],
+            "properties": {
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.RoomEditData": {
+            "type": "object",
+            "required": [
+                "room_index",
+                "room_name"
+            ],
+            "properties": {
+                "room_index": {
+                    "type": "integer"
+                },
+                "room_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.RoomEditRequestData": {
+            "type": "object",
+            "required": [
+                "room_list"
+            ],
+            "properties": {
+                "room_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.RoomEditData"
+                    }
+                }
+            }
+        },
+        "controller.ScheduleChangeRequestAddRequestData": {
+            "type": "object",
+            "required": [
+                "comment",
+                "history_index",
+                "operations"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "history_index": {
+                    "description": "提案の基準とする履歴番号。0の場合は最新",
+                    "type": "integer"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.ScheduleChangeRequestOperationData"
+                    }
+                }
+            }
+        },
+        "controller.ScheduleChangeRequestOperationData": {
+            "type": "object",
+            "required": [
+                "identifier",
+                "operation"
+            ],
+            "properties": {
+                "identifier": {
+                    "type": "string"
+                },
+                "operation": {
+                    "description": "move: 教室・時刻を指定して配置 / return: 一覧へ戻す",
+                    "type": "string"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleChangeRequestRejectRequestData": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleCollaboratorEditRequestData": {
+            "type": "object",
+            "required": [
+                "permission"
+            ],
+            "properties": {
+                "permission": {
+                    "description": "edit: 編集可能 / view: 閲覧のみ",
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleCommentAddRequestData": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleCommentEditRequestData": {
+            "type": "object",
+            "required": [
+                "body"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleCommentResolveRequestData": {
+            "type": "object",
+            "required": [
+                "resolved"
+            ],
+            "properties": {
+                "resolved": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controller.ScheduleCreateRequestData": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "start_time"
+            ],
+            "properties": {
+                "end_time": {
+                    "type": "integer"
+                },
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                },
+                "target_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleItemDivideRequestData": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "identifier",
+                "lesson_id"
+            ],
+            "properties": {
+                "break_minutes": {
+                    "type": "integer"
+                },
+                "divide_minutes": {
+                    "type": "integer"
+                },
+                "divide_minutes_list": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "lesson_id": {
+                    "type": "integer"
+                },
+                "parts": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleItemGroupMemberRequestData": {
+            "type": "object",
+            "required": [
+                "identifier",
+                "offset_minutes"
+            ],
+            "properties": {
+                "identifier": {
+                    "type": "string"
+                },
+                "offset_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleItemGroupRequestData": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "members"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.ScheduleItemGroupMemberRequestData"
+                    }
+                }
+            }
+        },
+        "controller.ScheduleItemJoinRequestData": {
+            "type": "object",
+            "required": [
+                "history_index"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "join_from_identifier": {
+                    "type": "string"
+                },
+                "join_to_identifier": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleItemMoveRequestData": {
+            "type": "object",
+            "required": [
+                "duration",
+                "end_time_hour",
+                "end_time_minutes",
+                "history_index",
+                "identifier",
+                "item_tag",
+                "lesson_id",
+                "room_index",
+                "start_time_hour",
+                "start_time_minute"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "end_time_hour": {
+                    "type": "integer"
+                },
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "item_tag": {
+                    "type": "string"
+                },
+                "lesson_id": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minute": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleItemPinRequestData": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "identifier",
+                "pinned"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "controller.ScheduleItemReturnListRequestData": {
+            "type": "object",
+            "required": [
+                "duration",
+                "history_index",
+                "identifier",
+                "lesson_id"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "lesson_id": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleItemShiftRequestData": {
+            "type": "object",
+            "required": [
+                "history_index"
+            ],
+            "properties": {
+                "all_rooms": {
+                    "type": "boolean"
+                },
+                "gap_minutes": {
+                    "type": "integer"
+                },
+                "grid_minutes": {
+                    "type": "integer"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "room_indexes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "strategy": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleItemTimeShiftRequestData": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "shift_minutes"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "identifiers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "range_end_time_hour": {
+                    "type": "integer"
+                },
+                "range_end_time_minutes": {
+                    "type": "integer"
+                },
+                "range_start_time_hour": {
+                    "type": "integer"
+                },
+                "range_start_time_minute": {
+                    "type": "integer"
+                },
+                "room_indexes": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "shift_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleItemUngroupRequestData": {
+            "type": "object",
+            "required": [
+                "group_identifier",
+                "history_index"
+            ],
+            "properties": {
+                "group_identifier": {
+                    "type": "string"
+                },
+                "history_index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleOwnerTransferRequestData": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleRoomClearRequestData": {
+            "type": "object",
+            "required": [
+                "history_index"
+            ],
+            "properties": {
+                "all_rooms": {
+                    "type": "boolean"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "room_index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleRoomCopyRequestData": {
+            "type": "object",
+            "required": [
+                "from_room_index",
+                "history_index",
+                "to_room_index"
+            ],
+            "properties": {
+                "from_room_index": {
+                    "type": "integer"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "to_room_index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleRoomSwapRequestData": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "room_index",
+                "target_room_index"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "target_room_index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleSaveRequestData": {
+            "type": "object",
+            "required": [
+                "history_index"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.ScheduleSaveTitleRequestData": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleStatusChangeRequestData": {
+            "type": "object",
+            "required": [
+                "action",
+                "reason"
+            ],
+            "properties": {
+                "action": {
+                    "description": "submit / approve / reject / publish / archive",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "差し戻し理由。reject の場合は必須",
+                    "type": "string"
+                }
+            }
+        },
+        "controller.ScheduleTimeEditRequestData": {
+            "type": "object",
+            "required": [
+                "end_time",
+                "start_time"
+            ],
+            "properties": {
+                "end_time": {
+                    "type": "integer"
+                },
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "start_time": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                },
+                "target_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.TwoFactorCodeParams": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "認証アプリに表示された6桁のコード、またはリカバリーコード",
+                    "type": "string"
+                }
+            }
+        },
+        "controller.UserAddRequestData": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "name",
+                "password",
+                "role_key",
+                "user_name"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "role_key": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.UserCampusRoleEditItem": {
+            "type": "object",
+            "required": [
+                "campus",
+                "role_key"
+            ],
+            "properties": {
+                "campus": {
+                    "type": "string"
+                },
+                "role_key": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.UserCampusRoleEditRequestData": {
+            "type": "object",
+            "required": [
+                "campus_roles"
+            ],
+            "properties": {
+                "campus_roles": {
+                    "description": "指定がないキャンパスはユーザー全体のロールが適用される",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.UserCampusRoleEditItem"
+                    }
+                }
+            }
+        },
+        "controller.UserDeactivateRequestData": {
+            "type": "object",
+            "required": [
+                "successor_user_id"
+            ],
+            "properties": {
+                "successor_user_id": {
+                    "description": "所有するスケジュールの引き継ぎ先。0の場合は引き継がない",
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.UserLoginParams": {
+            "type": "object",
+            "required": [
+                "password",
+                "user_name"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.UserTwoFactorRequiredEditRequestData": {
+            "type": "object",
+            "required": [
+                "required"
+            ],
+            "properties": {
+                "required": {
+                    "description": "trueの場合、未登録のユーザーは次回ログイン時に登録が求められ、本人による無効化もできなくなる",
+                    "type": "boolean"
+                }
+            }
+        },
+        "controller.UserUpdateRequestData": {
+            "type": "object",
+            "required": [
+                "confirm_password",
+                "display_name",
+                "id",
+                "password",
+                "role_key",
+                "user_name"
+            ],
+            "properties": {
+                "confirm_password": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "room_name": {
+                "password": {
+                    "type": "string"
+                },
+                "role_key": {
+                    "type": "string"
+                },
+                "user_name": {
                     "type": "string"
                 }
             }
         },
-        "controller.RoomEditRequestData": {
+        "presenter.APITokenAddResponse": {
             "type": "object",
             "required": [
-                "room_list"
+                "api_token",
+                "token"
             ],
             "properties": {
-                "room_list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controller.RoomEditData"
-                    }
+                "api_token": {
+                    "$ref": "#/definitions/presenter.APITokenDTO"
+                },
+                "token": {
+                    "description": "平文のトークンはこのレスポンスでのみ返す",
+                    "type": "string"
                 }
             }
         },
-        "controller.ScheduleCreateRequestData": {
+        "presenter.APITokenDTO": {
             "type": "object",
             "required": [
-                "end_time",
-                "start_time"
+                "created_at",
+                "expired",
+                "expires_at",
+                "last_used_at",
+                "name",
+                "scope",
+                "token_id",
+                "token_prefix"
             ],
             "properties": {
-                "end_time": {
-                    "type": "integer"
+                "created_at": {
+                    "type": "string"
                 },
-                "start_time": {
+                "expired": {
+                    "type": "boolean"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "last_used_at": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "token_id": {
                     "type": "integer"
+                },
+                "token_prefix": {
+                    "type": "string"
                 }
             }
         },
-        "controller.ScheduleItemDivideRequestData": {
+        "presenter.APITokenListResponse": {
             "type": "object",
             "required": [
-                "divide_minutes",
-                "history_index",
-                "identifier",
-                "lesson_id"
+                "api_tokens"
             ],
             "properties": {
-                "divide_minutes": {
+                "api_tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.APITokenDTO"
+                    }
+                }
+            }
+        },
+        "presenter.CampusBlockedPeriodDTO": {
+            "type": "object",
+            "required": [
+                "end_time_hour",
+                "end_time_minutes",
+                "recurrence",
+                "room_index",
+                "start_time_hour",
+                "start_time_minutes",
+                "target_date",
+                "title",
+                "weekday"
+            ],
+            "properties": {
+                "end_time_hour": {
                     "type": "integer"
                 },
-                "history_index": {
+                "end_time_minutes": {
                     "type": "integer"
                 },
-                "identifier": {
+                "recurrence": {
                     "type": "string"
                 },
-                "lesson_id": {
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                },
+                "target_date": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "weekday": {
                     "type": "integer"
                 }
             }
         },
-        "controller.ScheduleItemJoinRequestData": {
+        "presenter.CampusBlockedPeriodEditResponse": {
             "type": "object",
             "required": [
-                "history_index",
-                "join_from_identifier",
-                "join_to_identifier"
+                "msg"
             ],
             "properties": {
-                "history_index": {
-                    "type": "integer"
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.CampusBlockedPeriodGetResponse": {
+            "type": "object",
+            "required": [
+                "blocked_periods",
+                "campus"
+            ],
+            "properties": {
+                "blocked_periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusBlockedPeriodDTO"
+                    }
                 },
-                "join_from_identifier": {
+                "campus": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.CampusListDTO": {
+            "type": "object",
+            "required": [
+                "campus",
+                "campus_name"
+            ],
+            "properties": {
+                "campus": {
                     "type": "string"
                 },
-                "join_to_identifier": {
+                "campus_name": {
                     "type": "string"
                 }
             }
         },
-        "controller.ScheduleItemMoveRequestData": {
+        "presenter.CampusListResponse": {
             "type": "object",
             "required": [
-                "duration",
-                "end_time_hour",
-                "end_time_minutes",
-                "history_index",
-                "identifier",
-                "item_tag",
-                "lesson_id",
-                "room_index",
-                "start_time_hour",
-                "start_time_minute"
+                "campuses",
+                "msg"
             ],
             "properties": {
-                "duration": {
+                "campuses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusListDTO"
+                    }
+                },
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.CampusOpeningHourDTO": {
+            "type": "object",
+            "required": [
+                "close_time_hour",
+                "close_time_minutes",
+                "open_time_hour",
+                "open_time_minutes",
+                "weekday"
+            ],
+            "properties": {
+                "close_time_hour": {
                     "type": "integer"
                 },
-                "end_time_hour": {
+                "close_time_minutes": {
                     "type": "integer"
                 },
-                "end_time_minutes": {
+                "open_time_hour": {
                     "type": "integer"
                 },
-                "history_index": {
+                "open_time_minutes": {
                     "type": "integer"
                 },
-                "identifier": {
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.CampusPolicyEditResponse": {
+            "type": "object",
+            "required": [
+                "msg"
+            ],
+            "properties": {
+                "msg": {
                     "type": "string"
-                },
-                "item_tag": {
+                }
+            }
+        },
+        "presenter.CampusPolicyGetResponse": {
+            "type": "object",
+            "required": [
+                "campus",
+                "grid_minutes",
+                "max_lesson_duration",
+                "min_gap_minutes",
+                "min_lesson_duration",
+                "opening_hours"
+            ],
+            "properties": {
+                "campus": {
                     "type": "string"
                 },
-                "lesson_id": {
+                "grid_minutes": {
                     "type": "integer"
                 },
-                "room_index": {
+                "max_lesson_duration": {
                     "type": "integer"
                 },
-                "start_time_hour": {
+                "min_gap_minutes": {
                     "type": "integer"
                 },
-                "start_time_minute": {
+                "min_lesson_duration": {
                     "type": "integer"
+                },
+                "opening_hours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusOpeningHourDTO"
+                    }
                 }
             }
         },
-        "controller.ScheduleItemReturnListRequestData": {
+        "presenter.CampusRoomItemTypeDTO": {
             "type": "object",
             "required": [
-                "duration",
-                "history_index",
-                "identifier",
-                "lesson_id"
+                "color",
+                "item_tag",
+                "label"
             ],
             "properties": {
-                "duration": {
+                "color": {
+                    "type": "string"
+                },
+                "item_tag": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.CampusRoomItemTypeEditResponse": {
+            "type": "object",
+            "required": [
+                "msg"
+            ],
+            "properties": {
+                "msg": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.CampusRoomItemTypeGetResponse": {
+            "type": "object",
+            "required": [
+                "campus",
+                "room_item_types"
+            ],
+            "properties": {
+                "campus": {
+                    "type": "string"
+                },
+                "room_item_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusRoomItemTypeDTO"
+                    }
+                }
+            }
+        },
+        "presenter.DayBundleCollisionGetResponse": {
+            "type": "object",
+            "required": [
+                "collisions",
+                "day_bundle"
+            ],
+            "properties": {
+                "collisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.RoomCollisionDTO"
+                    }
+                },
+                "day_bundle": {
+                    "$ref": "#/definitions/presenter.DayBundleResponse"
+                }
+            }
+        },
+        "presenter.DayBundleListResponse": {
+            "type": "object",
+            "required": [
+                "campus",
+                "day_bundles"
+            ],
+            "properties": {
+                "campus": {
+                    "type": "string"
+                },
+                "day_bundles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.DayBundleResponse"
+                    }
+                }
+            }
+        },
+        "presenter.DayBundleResponse": {
+            "type": "object",
+            "required": [
+                "campus",
+                "created_user_id",
+                "day_bundle_id",
+                "refuse_collision",
+                "schedule_ids",
+                "title"
+            ],
+            "properties": {
+                "campus": {
+                    "type": "string"
+                },
+                "created_user_id": {
                     "type": "integer"
                 },
-                "history_index": {
+                "day_bundle_id": {
                     "type": "integer"
                 },
-                "identifier": {
-                    "type": "string"
+                "refuse_collision": {
+                    "type": "boolean"
                 },
-                "lesson_id": {
-                    "type": "integer"
+                "schedule_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "controller.ScheduleItemShiftRequestData": {
+        "presenter.InvisibleRoomSaveResponse": {
             "type": "object",
             "required": [
-                "history_index",
-                "room_index"
+                "msg"
             ],
             "properties": {
-                "history_index": {
-                    "type": "integer"
-                },
-                "room_index": {
-                    "type": "integer"
+                "msg": {
+                    "type": "string"
                 }
             }
         },
-        "controller.ScheduleSaveRequestData": {
+        "presenter.LessonAddResponse": {
             "type": "object",
             "required": [
-                "history_index"
+                "msg"
             ],
             "properties": {
-                "history_index": {
-                    "type": "integer"
+                "msg": {
+                    "type": "string"
                 }
             }
         },
-        "controller.ScheduleSaveTitleRequestData": {
+        "presenter.LessonEditResponse": {
             "type": "object",
             "required": [
-                "title"
+                "msg"
             ],
             "properties": {
-                "title": {
+                "msg": {
                     "type": "string"
                 }
             }
         },
-        "controller.ScheduleTimeEditRequestData": {
+        "presenter.LessonListDTO": {
             "type": "object",
             "required": [
-                "end_time",
-                "start_time"
+                "id",
+                "lesson_duration",
+                "lesson_name"
             ],
             "properties": {
-                "end_time": {
+                "id": {
                     "type": "integer"
                 },
-                "start_time": {
+                "lesson_duration": {
                     "type": "integer"
+                },
+                "lesson_name": {
+                    "type": "string"
                 }
             }
         },
-        "controller.UserAddRequestData": {
+        "presenter.LessonListResponse": {
             "type": "object",
             "required": [
-                "confirm_password",
-                "name",
-                "password",
-                "role_key",
+                "lessons"
+            ],
+            "properties": {
+                "lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.LessonListDTO"
+                    }
+                }
+            }
+        },
+        "presenter.LoginAttemptDTO": {
+            "type": "object",
+            "required": [
+                "created_at",
+                "id",
+                "ip_address",
+                "result",
+                "user_agent",
                 "user_name"
             ],
             "properties": {
-                "confirm_password": {
+                "created_at": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
+                    "type": "integer"
+                },
+                "ip_address": {
                     "type": "string"
                 },
-                "password": {
+                "result": {
+                    "description": "success, failure, blocked, two_factor_pending, two_factor_failure",
                     "type": "string"
                 },
-                "role_key": {
+                "user_agent": {
                     "type": "string"
                 },
                 "user_name": {
@@ -2011,14 +7166,22 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.UserLoginParams": {
+        "presenter.LoginUserDTO": {
             "type": "object",
             "required": [
-                "password",
+                "id",
+                "name",
+                "role_key",
                 "user_name"
             ],
             "properties": {
-                "password": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role_key": {
                     "type": "string"
                 },
                 "user_name": {
@@ -2026,71 +7189,113 @@ const docTemplate = `{
                 }
             }
         },
-        "controller.UserUpdateRequestData": {
+        "presenter.PermissionDTO": {
             "type": "object",
             "required": [
-                "confirm_password",
-                "display_name",
-                "id",
-                "password",
-                "role_key",
-                "user_name"
+                "permission_key",
+                "permission_name"
             ],
             "properties": {
-                "confirm_password": {
+                "permission_key": {
                     "type": "string"
                 },
-                "display_name": {
+                "permission_name": {
                     "type": "string"
+                }
+            }
+        },
+        "presenter.RoleDTO": {
+            "type": "object",
+            "required": [
+                "builtin",
+                "permissions",
+                "role_key",
+                "role_name"
+            ],
+            "properties": {
+                "builtin": {
+                    "description": "既定のロールは変更できない",
+                    "type": "boolean"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "password": {
-                    "type": "string"
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
                 "role_key": {
                     "type": "string"
                 },
-                "user_name": {
+                "role_name": {
                     "type": "string"
                 }
             }
         },
-        "presenter.CampusListDTO": {
+        "presenter.RoleListResponse": {
             "type": "object",
             "required": [
-                "campus",
-                "campus_name"
+                "permissions",
+                "roles"
             ],
             "properties": {
-                "campus": {
-                    "type": "string"
+                "permissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.PermissionDTO"
+                    }
                 },
-                "campus_name": {
-                    "type": "string"
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.RoleDTO"
+                    }
                 }
             }
         },
-        "presenter.CampusListResponse": {
+        "presenter.RoomCollisionDTO": {
             "type": "object",
             "required": [
-                "campuses",
-                "msg"
+                "end_time_hour",
+                "end_time_minutes",
+                "identifier",
+                "other_identifier",
+                "other_schedule_id",
+                "room_index",
+                "schedule_id",
+                "start_time_hour",
+                "start_time_minutes"
             ],
             "properties": {
-                "campuses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/presenter.CampusListDTO"
-                    }
+                "end_time_hour": {
+                    "type": "integer"
                 },
-                "msg": {
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "other_identifier": {
                     "type": "string"
+                },
+                "other_schedule_id": {
+                    "type": "integer"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "presenter.InvisibleRoomSaveResponse": {
+        "presenter.RoomEditResponse": {
             "type": "object",
             "required": [
                 "msg"
@@ -2101,121 +7306,259 @@ const docTemplate = `{
                 }
             }
         },
-        "presenter.LessonAddResponse": {
+        "presenter.RoomListDTO": {
             "type": "object",
             "required": [
-                "msg"
+                "room_index",
+                "room_name"
             ],
             "properties": {
-                "msg": {
+                "room_index": {
+                    "type": "integer"
+                },
+                "room_name": {
                     "type": "string"
                 }
             }
         },
-        "presenter.LessonEditResponse": {
+        "presenter.RoomListResponse": {
             "type": "object",
             "required": [
-                "msg"
+                "rooms"
             ],
             "properties": {
-                "msg": {
-                    "type": "string"
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.RoomListDTO"
+                    }
                 }
             }
         },
-        "presenter.LessonListDTO": {
+        "presenter.ScheduleChangeRequestDiffResponse": {
             "type": "object",
             "required": [
-                "id",
-                "lesson_duration",
-                "lesson_name"
+                "applicable",
+                "apply_error",
+                "base_history_index",
+                "change_request_id",
+                "changes",
+                "current_history_index"
             ],
             "properties": {
-                "id": {
+                "applicable": {
+                    "type": "boolean"
+                },
+                "apply_error": {
+                    "type": "string"
+                },
+                "base_history_index": {
                     "type": "integer"
                 },
-                "lesson_duration": {
+                "change_request_id": {
                     "type": "integer"
                 },
-                "lesson_name": {
-                    "type": "string"
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemPlacementChangeDTO"
+                    }
+                },
+                "current_history_index": {
+                    "type": "integer"
                 }
             }
         },
-        "presenter.LessonListResponse": {
+        "presenter.ScheduleChangeRequestListResponse": {
             "type": "object",
             "required": [
-                "lessons"
+                "change_requests",
+                "schedule_id"
             ],
             "properties": {
-                "lessons": {
+                "change_requests": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presenter.LessonListDTO"
+                        "$ref": "#/definitions/presenter.ScheduleChangeRequestResponse"
+                    }
+                },
+                "schedule_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.ScheduleChangeRequestResponse": {
+            "type": "object",
+            "required": [
+                "applied_history_index",
+                "change_request_id",
+                "comment",
+                "created_at",
+                "history_index",
+                "operations",
+                "request_user_id",
+                "request_user_name",
+                "review_comment",
+                "review_user_id",
+                "review_user_name",
+                "schedule_id",
+                "status",
+                "updated_at"
+            ],
+            "properties": {
+                "applied_history_index": {
+                    "type": "integer"
+                },
+                "change_request_id": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "history_index": {
+                    "type": "integer"
+                },
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemOperationDTO"
                     }
+                },
+                "request_user_id": {
+                    "type": "integer"
+                },
+                "request_user_name": {
+                    "type": "string"
+                },
+                "review_comment": {
+                    "type": "string"
+                },
+                "review_user_id": {
+                    "type": "integer"
+                },
+                "review_user_name": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
-        "presenter.LoginUserDTO": {
+        "presenter.ScheduleCollaboratorDTO": {
             "type": "object",
             "required": [
-                "id",
-                "name",
-                "role_key",
+                "permission",
+                "user_id",
                 "user_name"
             ],
             "properties": {
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
+                "permission": {
                     "type": "string"
                 },
-                "role_key": {
-                    "type": "string"
+                "user_id": {
+                    "type": "integer"
                 },
                 "user_name": {
                     "type": "string"
                 }
             }
         },
-        "presenter.RoomEditResponse": {
+        "presenter.ScheduleCollaboratorListResponse": {
             "type": "object",
             "required": [
-                "msg"
+                "collaborators",
+                "owner_user_id",
+                "owner_user_name",
+                "schedule_id"
             ],
             "properties": {
-                "msg": {
+                "collaborators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleCollaboratorDTO"
+                    }
+                },
+                "owner_user_id": {
+                    "type": "integer"
+                },
+                "owner_user_name": {
                     "type": "string"
+                },
+                "schedule_id": {
+                    "type": "integer"
                 }
             }
         },
-        "presenter.RoomListDTO": {
+        "presenter.ScheduleCommentDTO": {
             "type": "object",
             "required": [
-                "room_index",
-                "room_name"
+                "author_name",
+                "author_user_id",
+                "body",
+                "comment_id",
+                "created_at",
+                "identifier",
+                "replies",
+                "resolved",
+                "updated_at"
             ],
             "properties": {
-                "room_index": {
+                "author_name": {
+                    "type": "string"
+                },
+                "author_user_id": {
                     "type": "integer"
                 },
-                "room_name": {
+                "body": {
+                    "type": "string"
+                },
+                "comment_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleCommentDTO"
+                    }
+                },
+                "resolved": {
+                    "type": "boolean"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "presenter.RoomListResponse": {
+        "presenter.ScheduleCommentListResponse": {
             "type": "object",
             "required": [
-                "rooms"
+                "comments",
+                "schedule_id"
             ],
             "properties": {
-                "rooms": {
+                "comments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presenter.RoomListDTO"
+                        "$ref": "#/definitions/presenter.ScheduleCommentDTO"
                     }
+                },
+                "schedule_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -2233,33 +7576,76 @@ const docTemplate = `{
         "presenter.ScheduleGetResponse": {
             "type": "object",
             "required": [
+                "blocked_periods",
                 "campus",
+                "collaborators",
                 "created_user_id",
                 "history_index",
+                "item_comment_counts",
+                "item_group_list",
                 "lesson_item_list",
+                "review_comment",
+                "room_item_types",
                 "room_lesson_list",
                 "rooms",
                 "schedule_end_time",
+                "schedule_end_time_minutes",
                 "schedule_id",
                 "schedule_start_time",
+                "schedule_start_time_minutes",
+                "status",
+                "target_date",
                 "title"
             ],
             "properties": {
+                "blocked_periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusBlockedPeriodDTO"
+                    }
+                },
                 "campus": {
                     "type": "string"
                 },
+                "collaborators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleCollaboratorDTO"
+                    }
+                },
                 "created_user_id": {
                     "type": "integer"
                 },
                 "history_index": {
                     "type": "integer"
                 },
+                "item_comment_counts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemCommentCount"
+                    }
+                },
+                "item_group_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemGroup"
+                    }
+                },
                 "lesson_item_list": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/presenter.ScheduleLessonItem"
                     }
                 },
+                "review_comment": {
+                    "type": "string"
+                },
+                "room_item_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.CampusRoomItemTypeDTO"
+                    }
+                },
                 "room_lesson_list": {
                     "type": "array",
                     "items": {
@@ -2275,17 +7661,48 @@ const docTemplate = `{
                 "schedule_end_time": {
                     "type": "integer"
                 },
+                "schedule_end_time_minutes": {
+                    "type": "integer"
+                },
                 "schedule_id": {
                     "type": "integer"
                 },
                 "schedule_start_time": {
                     "type": "integer"
                 },
+                "schedule_start_time_minutes": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "target_date": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
+        "presenter.ScheduleItemCommentCount": {
+            "type": "object",
+            "required": [
+                "count",
+                "identifier",
+                "unresolved_count"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "unresolved_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "presenter.ScheduleItemEditLessonItem": {
             "type": "object",
             "required": [
@@ -2301,74 +7718,240 @@ const docTemplate = `{
                 "identifier": {
                     "type": "string"
                 },
-                "lesson_id": {
-                    "type": "integer"
-                },
-                "lesson_name": {
-                    "type": "string"
+                "lesson_id": {
+                    "type": "integer"
+                },
+                "lesson_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.ScheduleItemEditResponse": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "item_group_list",
+                "lesson_item_list",
+                "room_lesson_list"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "item_group_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemGroup"
+                    }
+                },
+                "lesson_item_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemEditLessonItem"
+                    }
+                },
+                "room_lesson_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemEditRoomLesson"
+                    }
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "presenter.ScheduleItemEditRoomLesson": {
+            "type": "object",
+            "required": [
+                "duration",
+                "end_time_hour",
+                "end_time_minutes",
+                "identifier",
+                "item_tag",
+                "lesson_id",
+                "lesson_name",
+                "pinned",
+                "room_index",
+                "start_time_hour",
+                "start_time_minutes",
+                "title"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer"
+                },
+                "end_time_hour": {
+                    "type": "integer"
+                },
+                "end_time_minutes": {
+                    "type": "integer"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "item_tag": {
+                    "type": "string"
+                },
+                "lesson_id": {
+                    "type": "integer"
+                },
+                "lesson_name": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "type": "boolean"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.ScheduleItemGroup": {
+            "type": "object",
+            "required": [
+                "group_identifier",
+                "members"
+            ],
+            "properties": {
+                "group_identifier": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemGroupMember"
+                    }
+                }
+            }
+        },
+        "presenter.ScheduleItemGroupMember": {
+            "type": "object",
+            "required": [
+                "identifier",
+                "offset_minutes"
+            ],
+            "properties": {
+                "identifier": {
+                    "type": "string"
+                },
+                "offset_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.ScheduleItemOperationDTO": {
+            "type": "object",
+            "required": [
+                "identifier",
+                "operation",
+                "room_index",
+                "start_time_hour",
+                "start_time_minutes"
+            ],
+            "properties": {
+                "identifier": {
+                    "type": "string"
+                },
+                "operation": {
+                    "type": "string"
+                },
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presenter.ScheduleItemPlacementChangeDTO": {
+            "type": "object",
+            "required": [
+                "changed_since_base",
+                "current",
+                "identifier",
+                "proposed"
+            ],
+            "properties": {
+                "changed_since_base": {
+                    "type": "boolean"
+                },
+                "current": {
+                    "$ref": "#/definitions/presenter.ScheduleItemPlacementDTO"
+                },
+                "identifier": {
+                    "type": "string"
+                },
+                "proposed": {
+                    "$ref": "#/definitions/presenter.ScheduleItemPlacementDTO"
                 }
             }
         },
-        "presenter.ScheduleItemEditResponse": {
+        "presenter.ScheduleItemPlacementDTO": {
             "type": "object",
             "required": [
-                "history_index",
-                "lesson_item_list",
-                "room_lesson_list"
+                "end_time_hour",
+                "end_time_minutes",
+                "room_index",
+                "start_time_hour",
+                "start_time_minutes"
             ],
             "properties": {
-                "history_index": {
+                "end_time_hour": {
                     "type": "integer"
                 },
-                "lesson_item_list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/presenter.ScheduleItemEditLessonItem"
-                    }
+                "end_time_minutes": {
+                    "type": "integer"
                 },
-                "room_lesson_list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/presenter.ScheduleItemEditRoomLesson"
-                    }
+                "room_index": {
+                    "type": "integer"
+                },
+                "start_time_hour": {
+                    "type": "integer"
+                },
+                "start_time_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "presenter.ScheduleItemEditRoomLesson": {
+        "presenter.ScheduleItemSuggestion": {
             "type": "object",
             "required": [
-                "duration",
                 "end_time_hour",
                 "end_time_minutes",
-                "identifier",
-                "item_tag",
-                "lesson_id",
-                "lesson_name",
+                "gap_count",
+                "preferred_room",
                 "room_index",
                 "start_time_hour",
                 "start_time_minutes"
             ],
             "properties": {
-                "duration": {
-                    "type": "integer"
-                },
                 "end_time_hour": {
                     "type": "integer"
                 },
                 "end_time_minutes": {
                     "type": "integer"
                 },
-                "identifier": {
-                    "type": "string"
-                },
-                "item_tag": {
-                    "type": "string"
-                },
-                "lesson_id": {
+                "gap_count": {
                     "type": "integer"
                 },
-                "lesson_name": {
-                    "type": "string"
+                "preferred_room": {
+                    "type": "boolean"
                 },
                 "room_index": {
                     "type": "integer"
@@ -2381,6 +7964,24 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.ScheduleItemSuggestionResponse": {
+            "type": "object",
+            "required": [
+                "history_index",
+                "suggestions"
+            ],
+            "properties": {
+                "history_index": {
+                    "type": "integer"
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.ScheduleItemSuggestion"
+                    }
+                }
+            }
+        },
         "presenter.ScheduleLessonItem": {
             "type": "object",
             "required": [
@@ -2411,7 +8012,9 @@ const docTemplate = `{
                 "created_user_name",
                 "last_update_date_time",
                 "last_update_user_name",
+                "review_comment",
                 "schedule_id",
+                "status",
                 "title"
             ],
             "properties": {
@@ -2427,9 +8030,15 @@ const docTemplate = `{
                 "last_update_user_name": {
                     "type": "string"
                 },
+                "review_comment": {
+                    "type": "string"
+                },
                 "schedule_id": {
                     "type": "integer"
                 },
+                "status": {
+                    "type": "string"
+                },
                 "title": {
                     "type": "string"
                 }
@@ -2478,9 +8087,11 @@ const docTemplate = `{
                 "item_tag",
                 "lesson_id",
                 "lesson_name",
+                "pinned",
                 "room_index",
                 "start_time_hour",
-                "start_time_minutes"
+                "start_time_minutes",
+                "title"
             ],
             "properties": {
                 "duration": {
@@ -2504,6 +8115,9 @@ const docTemplate = `{
                 "lesson_name": {
                     "type": "string"
                 },
+                "pinned": {
+                    "type": "boolean"
+                },
                 "room_index": {
                     "type": "integer"
                 },
@@ -2512,6 +8126,9 @@ const docTemplate = `{
                 },
                 "start_time_minutes": {
                     "type": "integer"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
@@ -2541,6 +8158,86 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.ScheduleStatusChangeResponse": {
+            "type": "object",
+            "required": [
+                "review_comment",
+                "schedule_id",
+                "status"
+            ],
+            "properties": {
+                "review_comment": {
+                    "type": "string"
+                },
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.TwoFactorConfirmResponse": {
+            "type": "object",
+            "required": [
+                "recovery_codes",
+                "two_factor"
+            ],
+            "properties": {
+                "recovery_codes": {
+                    "description": "平文のリカバリーコードはこのレスポンスでのみ返す",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "two_factor": {
+                    "$ref": "#/definitions/presenter.TwoFactorStatusResponse"
+                }
+            }
+        },
+        "presenter.TwoFactorEnrollResponse": {
+            "type": "object",
+            "required": [
+                "provisioning_uri",
+                "secret"
+            ],
+            "properties": {
+                "provisioning_uri": {
+                    "description": "認証アプリに読み込ませる otpauth:// 形式のURI(QRコードにして表示する)",
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "認証アプリに手入力する場合のシークレット",
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.TwoFactorStatusResponse": {
+            "type": "object",
+            "required": [
+                "enabled",
+                "remaining_recovery_codes",
+                "required",
+                "user_id"
+            ],
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "remaining_recovery_codes": {
+                    "description": "未使用のリカバリーコードの数",
+                    "type": "integer"
+                },
+                "required": {
+                    "description": "オーナーによって2段階認証の利用が必須とされているか",
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "presenter.UserAddResponse": {
             "type": "object",
             "required": [
@@ -2552,6 +8249,52 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.UserCampusRoleDTO": {
+            "type": "object",
+            "required": [
+                "assigned",
+                "campus",
+                "campus_name",
+                "role_key"
+            ],
+            "properties": {
+                "assigned": {
+                    "description": "falseの場合はユーザー全体のロールが適用されている",
+                    "type": "boolean"
+                },
+                "campus": {
+                    "type": "string"
+                },
+                "campus_name": {
+                    "type": "string"
+                },
+                "role_key": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.UserCampusRoleListResponse": {
+            "type": "object",
+            "required": [
+                "campus_roles",
+                "role_key",
+                "user_id"
+            ],
+            "properties": {
+                "campus_roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.UserCampusRoleDTO"
+                    }
+                },
+                "role_key": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "presenter.UserDTO": {
             "type": "object",
             "required": [
@@ -2578,11 +8321,33 @@ const docTemplate = `{
         "presenter.UserDeleteResponse": {
             "type": "object",
             "required": [
-                "msg"
+                "msg",
+                "revoked_schedule_ids",
+                "successor_user_id",
+                "transferred_schedules",
+                "user_id"
             ],
             "properties": {
                 "msg": {
                     "type": "string"
+                },
+                "revoked_schedule_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "successor_user_id": {
+                    "type": "integer"
+                },
+                "transferred_schedules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.UserTransferredSchedule"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -2623,11 +8388,69 @@ const docTemplate = `{
                 }
             }
         },
+        "presenter.UserLoginAttemptListResponse": {
+            "type": "object",
+            "required": [
+                "attempts",
+                "failure_count",
+                "locked",
+                "locked_until",
+                "user_id"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presenter.LoginAttemptDTO"
+                    }
+                },
+                "failure_count": {
+                    "description": "直近のログインの失敗回数",
+                    "type": "integer"
+                },
+                "locked": {
+                    "description": "ログインの失敗によりロックされているか",
+                    "type": "boolean"
+                },
+                "locked_until": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
         "presenter.UserLoginResponse": {
             "type": "object",
             "required": [
                 "login_user",
-                "msg"
+                "msg",
+                "two_factor_enrollment_required",
+                "two_factor_required"
+            ],
+            "properties": {
+                "login_user": {
+                    "$ref": "#/definitions/presenter.LoginUserDTO"
+                },
+                "msg": {
+                    "type": "string"
+                },
+                "two_factor_enrollment_required": {
+                    "description": "trueの場合は認証コードの入力前に2段階認証の登録が必要",
+                    "type": "boolean"
+                },
+                "two_factor_required": {
+                    "description": "trueの場合はログインが完了しておらず、認証コードの入力が必要",
+                    "type": "boolean"
+                }
+            }
+        },
+        "presenter.UserLoginTwoFactorResponse": {
+            "type": "object",
+            "required": [
+                "login_user",
+                "msg",
+                "recovery_codes"
             ],
             "properties": {
                 "login_user": {
@@ -2635,6 +8458,55 @@ const docTemplate = `{
                 },
                 "msg": {
                     "type": "string"
+                },
+                "recovery_codes": {
+                    "description": "ログイン時に2段階認証の登録を完了した場合のみ返す。それ以外は空",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "presenter.UserRestoreResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "msg",
+                "name",
+                "role_key",
+                "user_name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "msg": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "role_key": {
+                    "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presenter.UserTransferredSchedule": {
+            "type": "object",
+            "required": [
+                "schedule_id",
+                "title"
+            ],
+            "properties": {
+                "schedule_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
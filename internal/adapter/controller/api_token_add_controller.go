@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IAPITokenAddController interface {
+		Execute(c echo.Context) error
+	}
+
+	APITokenAddController struct {
+		inputPort usecase.IAPITokenAddInputPort
+		presenter presenter.IAPITokenAddPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewAPITokenAddController(
+	inputPort usecase.IAPITokenAddInputPort,
+	presenter presenter.IAPITokenAddPresenter,
+	logger ILogWriter,
+) IAPITokenAddController {
+	return &APITokenAddController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	APITokenAddRequestData struct {
+		Name string `json:"name"`
+		// read: 参照のみ write: 更新を含む全て
+		Scope string `json:"scope"`
+		// 有効日数(1〜365日)
+		ExpiresInDays int `json:"expires_in_days"`
+	}
+)
+
+// @Summary APIトークン発行
+// @Description スクリプトなどから利用するAPIトークンを発行します。トークンはAuthorization: Bearerヘッダーで送信します。平文のトークンはこのレスポンスでのみ返します
+// @Produce json
+// @Param request body APITokenAddRequestData true "APIトークン発行リクエスト"
+// @Success 200 {object} presenter.APITokenAddResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/api-tokens [post]
+func (h *APITokenAddController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	var requestData APITokenAddRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, usecase.APITokenAddInput{
+		Name:          requestData.Name,
+		Scope:         requestData.Scope,
+		ExpiresInDays: requestData.ExpiresInDays,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IAPITokenListController interface {
+		Execute(c echo.Context) error
+	}
+
+	APITokenListController struct {
+		inputPort usecase.IAPITokenListInputPort
+		presenter presenter.IAPITokenListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewAPITokenListController(
+	inputPort usecase.IAPITokenListInputPort,
+	presenter presenter.IAPITokenListPresenter,
+	logger ILogWriter,
+) IAPITokenListController {
+	return &APITokenListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary APIトークン一覧
+// @Description ログインユーザーが発行したAPIトークンを取得します
+// @Produce json
+// @Success 200 {object} presenter.APITokenListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/api-tokens [get]
+func (h *APITokenListController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IAPITokenRevokeController interface {
+		Execute(c echo.Context) error
+	}
+
+	APITokenRevokeController struct {
+		inputPort usecase.IAPITokenRevokeInputPort
+		presenter presenter.IAPITokenRevokePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewAPITokenRevokeController(
+	inputPort usecase.IAPITokenRevokeInputPort,
+	presenter presenter.IAPITokenRevokePresenter,
+	logger ILogWriter,
+) IAPITokenRevokeController {
+	return &APITokenRevokeController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary APIトークン失効
+// @Description ログインユーザーが発行したAPIトークンを失効させます
+// @Produce json
+// @Param token_id path int true "TokenID"
+// @Success 200 {object} presenter.APITokenListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/api-tokens/{token_id} [delete]
+func (h *APITokenRevokeController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	tokenID, err := strconv.Atoi(c.Param("token_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "APIトークンIDが不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, tokenID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusBlockedPeriodEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusBlockedPeriodEditController struct {
+		inputPort usecase.ICampusBlockedPeriodEditInputPort
+		presenter presenter.ICampusBlockedPeriodEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusBlockedPeriodEditController(
+	inputPort usecase.ICampusBlockedPeriodEditInputPort,
+	presenter presenter.ICampusBlockedPeriodEditPresenter,
+	logger ILogWriter,
+) ICampusBlockedPeriodEditController {
+	return &CampusBlockedPeriodEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	CampusBlockedPeriodEditRequestData struct {
+		BlockedPeriods []CampusBlockedPeriodData `json:"blocked_periods"`
+	}
+
+	CampusBlockedPeriodData struct {
+		Title            string `json:"title"`
+		RoomIndex        int    `json:"room_index,omitempty"`
+		Recurrence       string `json:"recurrence,omitempty"`
+		Weekday          int    `json:"weekday,omitempty"`
+		TargetDate       string `json:"target_date,omitempty"`
+		StartTimeHour    int    `json:"start_time_hour"`
+		StartTimeMinutes int    `json:"start_time_minutes"`
+		EndTimeHour      int    `json:"end_time_hour"`
+		EndTimeMinutes   int    `json:"end_time_minutes"`
+	}
+)
+
+// @Summary 校舎の利用不可時間帯編集
+// @Description 校舎の利用不可時間帯を一括で置き換えます。room_indexを省略または0にすると全教室に適用します。recurrenceはdaily(既定)・weekly・dateで、weeklyはweekday(0:日曜～6:土曜)、dateはtarget_date(YYYY-MM-DD)を指定します
+// @Produce json
+// @Param campus path string true "校舎"
+// @Param request body CampusBlockedPeriodEditRequestData true "利用不可時間帯編集リクエスト"
+// @Success 200 {object} presenter.CampusBlockedPeriodEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/blocked-periods [put]
+func (h *CampusBlockedPeriodEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	var requestData CampusBlockedPeriodEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	err = h.inputPort.Execute(c.Request().Context(), userID, campus, lo.Map(requestData.BlockedPeriods, func(item CampusBlockedPeriodData, _ int) usecase.CampusBlockedPeriodInput {
+		return usecase.CampusBlockedPeriodInput{
+			Title:            item.Title,
+			RoomIndex:        item.RoomIndex,
+			Recurrence:       item.Recurrence,
+			Weekday:          item.Weekday,
+			TargetDate:       item.TargetDate,
+			StartTimeHour:    item.StartTimeHour,
+			StartTimeMinutes: item.StartTimeMinutes,
+			EndTimeHour:      item.EndTimeHour,
+			EndTimeMinutes:   item.EndTimeMinutes,
+		}
+	}))
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present())
+}
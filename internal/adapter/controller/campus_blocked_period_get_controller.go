@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusBlockedPeriodGetController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusBlockedPeriodGetController struct {
+		inputPort usecase.ICampusBlockedPeriodGetInputPort
+		presenter presenter.ICampusBlockedPeriodGetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusBlockedPeriodGetController(
+	inputPort usecase.ICampusBlockedPeriodGetInputPort,
+	presenter presenter.ICampusBlockedPeriodGetPresenter,
+	logger ILogWriter,
+) ICampusBlockedPeriodGetController {
+	return &CampusBlockedPeriodGetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 校舎の利用不可時間帯取得
+// @Description 校舎に登録されている利用不可の時間帯(昼休み・全体集会・設備点検など)を返します。room_indexが0の時間帯は全教室に適用されます
+// @Produce json
+// @Param campus path string true "校舎"
+// @Success 200 {object} presenter.CampusBlockedPeriodGetResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/blocked-periods [get]
+func (h *CampusBlockedPeriodGetController) Execute(c echo.Context) error {
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), campus)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
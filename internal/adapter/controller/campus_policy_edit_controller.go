@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusPolicyEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusPolicyEditController struct {
+		inputPort usecase.ICampusPolicyEditInputPort
+		presenter presenter.ICampusPolicyEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusPolicyEditController(
+	inputPort usecase.ICampusPolicyEditInputPort,
+	presenter presenter.ICampusPolicyEditPresenter,
+	logger ILogWriter,
+) ICampusPolicyEditController {
+	return &CampusPolicyEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	CampusPolicyEditRequestData struct {
+		GridMinutes       int                     `json:"grid_minutes"`
+		MinLessonDuration int                     `json:"min_lesson_duration"`
+		MaxLessonDuration int                     `json:"max_lesson_duration"`
+		MinGapMinutes     int                     `json:"min_gap_minutes"`
+		OpeningHours      []CampusOpeningHourData `json:"opening_hours"`
+	}
+
+	CampusOpeningHourData struct {
+		Weekday          int `json:"weekday"`
+		OpenTimeHour     int `json:"open_time_hour"`
+		OpenTimeMinutes  int `json:"open_time_minutes"`
+		CloseTimeHour    int `json:"close_time_hour"`
+		CloseTimeMinutes int `json:"close_time_minutes"`
+	}
+)
+
+// @Summary 校舎の配置ポリシー編集
+// @Description 開始時刻の刻み・講座時間の範囲・講座間の最小間隔・曜日ごとの開校時間を設定します。weekdayは0(日曜)～6(土曜)で、opening_hoursに含めない曜日は休校日になります。opening_hoursが空の場合は終日開校として扱います
+// @Produce json
+// @Param campus path string true "校舎"
+// @Param request body CampusPolicyEditRequestData true "配置ポリシー編集リクエスト"
+// @Success 200 {object} presenter.CampusPolicyEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/policy [put]
+func (h *CampusPolicyEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	var requestData CampusPolicyEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	err = h.inputPort.Execute(c.Request().Context(), userID, campus, usecase.CampusPolicyEditInput{
+		GridMinutes:       requestData.GridMinutes,
+		MinLessonDuration: requestData.MinLessonDuration,
+		MaxLessonDuration: requestData.MaxLessonDuration,
+		MinGapMinutes:     requestData.MinGapMinutes,
+		OpeningHours: lo.Map(requestData.OpeningHours, func(item CampusOpeningHourData, _ int) usecase.CampusOpeningHourInput {
+			return usecase.CampusOpeningHourInput{
+				Weekday:          item.Weekday,
+				OpenTimeHour:     item.OpenTimeHour,
+				OpenTimeMinutes:  item.OpenTimeMinutes,
+				CloseTimeHour:    item.CloseTimeHour,
+				CloseTimeMinutes: item.CloseTimeMinutes,
+			}
+		}),
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present())
+}
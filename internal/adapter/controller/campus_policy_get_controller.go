@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusPolicyGetController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusPolicyGetController struct {
+		inputPort usecase.ICampusPolicyGetInputPort
+		presenter presenter.ICampusPolicyGetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusPolicyGetController(
+	inputPort usecase.ICampusPolicyGetInputPort,
+	presenter presenter.ICampusPolicyGetPresenter,
+	logger ILogWriter,
+) ICampusPolicyGetController {
+	return &CampusPolicyGetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 校舎の配置ポリシー取得
+// @Description 未設定の校舎は既定値(1分刻み・講座時間1～300分・間隔0分・終日開校)を返します
+// @Produce json
+// @Param campus path string true "校舎"
+// @Success 200 {object} presenter.CampusPolicyGetResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/policy [get]
+func (h *CampusPolicyGetController) Execute(c echo.Context) error {
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), campus)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusRoomItemTypeEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusRoomItemTypeEditController struct {
+		inputPort usecase.ICampusRoomItemTypeEditInputPort
+		presenter presenter.ICampusRoomItemTypeEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusRoomItemTypeEditController(
+	inputPort usecase.ICampusRoomItemTypeEditInputPort,
+	presenter presenter.ICampusRoomItemTypeEditPresenter,
+	logger ILogWriter,
+) ICampusRoomItemTypeEditController {
+	return &CampusRoomItemTypeEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	CampusRoomItemTypeEditRequestData struct {
+		RoomItemTypes []CampusRoomItemTypeData `json:"room_item_types"`
+	}
+
+	CampusRoomItemTypeData struct {
+		ItemTag string `json:"item_tag"`
+		Label   string `json:"label"`
+		Color   string `json:"color"`
+	}
+)
+
+// @Summary 校舎のアイテム種類編集
+// @Description 校舎のアイテムの種類を一括で置き換えます。item_tagは英小文字で始まる英小文字・数字・アンダースコア(32文字以内)で、lesson・cleaningは指定できません。colorは#RRGGBB形式です。削除した種類の配置済みアイテムはそのまま残りますが、新たに配置することはできません
+// @Produce json
+// @Param campus path string true "校舎"
+// @Param request body CampusRoomItemTypeEditRequestData true "アイテム種類編集リクエスト"
+// @Success 200 {object} presenter.CampusRoomItemTypeEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/room-item-types [put]
+func (h *CampusRoomItemTypeEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	var requestData CampusRoomItemTypeEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	err = h.inputPort.Execute(c.Request().Context(), userID, campus, lo.Map(requestData.RoomItemTypes, func(item CampusRoomItemTypeData, _ int) usecase.CampusRoomItemTypeInput {
+		return usecase.CampusRoomItemTypeInput{
+			ItemTag: item.ItemTag,
+			Label:   item.Label,
+			Color:   item.Color,
+		}
+	}))
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present())
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ICampusRoomItemTypeGetController interface {
+		Execute(c echo.Context) error
+	}
+
+	CampusRoomItemTypeGetController struct {
+		inputPort usecase.ICampusRoomItemTypeGetInputPort
+		presenter presenter.ICampusRoomItemTypeGetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewCampusRoomItemTypeGetController(
+	inputPort usecase.ICampusRoomItemTypeGetInputPort,
+	presenter presenter.ICampusRoomItemTypeGetPresenter,
+	logger ILogWriter,
+) ICampusRoomItemTypeGetController {
+	return &CampusRoomItemTypeGetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 校舎のアイテム種類取得
+// @Description 校舎に登録されている講座・清掃以外のアイテムの種類(行事・試験・会議・予約枠など)と表示名・表示色を返します
+// @Produce json
+// @Param campus path string true "校舎"
+// @Success 200 {object} presenter.CampusRoomItemTypeGetResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /campus/{campus}/room-item-types [get]
+func (h *CampusRoomItemTypeGetController) Execute(c echo.Context) error {
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), campus)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IDayBundleAddController interface {
+		Execute(c echo.Context) error
+	}
+
+	DayBundleAddController struct {
+		inputPort usecase.IDayBundleAddInputPort
+		presenter presenter.IDayBundlePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewDayBundleAddController(
+	inputPort usecase.IDayBundleAddInputPort,
+	presenter presenter.IDayBundlePresenter,
+	logger ILogWriter,
+) IDayBundleAddController {
+	return &DayBundleAddController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	DayBundleRequestData struct {
+		Title           string `json:"title"`
+		ScheduleIDs     []int  `json:"schedule_ids"`
+		RefuseCollision bool   `json:"refuse_collision"`
+	}
+)
+
+// @Summary 同日スケジュールのまとまり登録
+// @Description 同じ日に並行して実施するスケジュールをまとめます。refuse_collisionを有効にすると、他のスケジュールと教室の使用時間が重なる配置を拒否します
+// @Produce json
+// @Param campus path string true "校舎"
+// @Param request body DayBundleRequestData true "まとまり登録リクエスト"
+// @Success 200 {object} presenter.DayBundleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bundle/{campus} [post]
+func (h *DayBundleAddController) Execute(c echo.Context) error {
+
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	var requestData DayBundleRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, campus, usecase.DayBundleInput{
+		Title:           requestData.Title,
+		ScheduleIDs:     requestData.ScheduleIDs,
+		RefuseCollision: requestData.RefuseCollision,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IDayBundleCollisionGetController interface {
+		Execute(c echo.Context) error
+	}
+
+	DayBundleCollisionGetController struct {
+		inputPort usecase.IDayBundleCollisionGetInputPort
+		presenter presenter.IDayBundleCollisionGetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewDayBundleCollisionGetController(
+	inputPort usecase.IDayBundleCollisionGetInputPort,
+	presenter presenter.IDayBundleCollisionGetPresenter,
+	logger ILogWriter,
+) IDayBundleCollisionGetController {
+	return &DayBundleCollisionGetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 同日スケジュール間の教室衝突取得
+// @Description まとまりに含まれる各スケジュールの最新の履歴を比較し、同じ教室・同じ時間帯を使用しているアイテムを返します
+// @Produce json
+// @Param bundle_id path int true "DayBundleID"
+// @Success 200 {object} presenter.DayBundleCollisionGetResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bundle/{bundle_id}/collisions [get]
+func (h *DayBundleCollisionGetController) Execute(c echo.Context) error {
+
+	dayBundleID, err := strconv.Atoi(c.Param("bundle_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "まとまりのIDが不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), dayBundleID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
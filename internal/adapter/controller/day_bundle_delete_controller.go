@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IDayBundleDeleteController interface {
+		Execute(c echo.Context) error
+	}
+
+	DayBundleDeleteController struct {
+		inputPort usecase.IDayBundleDeleteInputPort
+		logger    ILogWriter
+	}
+)
+
+func NewDayBundleDeleteController(
+	inputPort usecase.IDayBundleDeleteInputPort,
+	logger ILogWriter,
+) IDayBundleDeleteController {
+	return &DayBundleDeleteController{
+		inputPort: inputPort,
+		logger:    logger,
+	}
+}
+
+// @Summary 同日スケジュールのまとまり削除
+// @Description スケジュール自体は削除されません
+// @Produce json
+// @Param bundle_id path int true "DayBundleID"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bundle/{bundle_id} [delete]
+func (h *DayBundleDeleteController) Execute(c echo.Context) error {
+
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	dayBundleID, err := strconv.Atoi(c.Param("bundle_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "まとまりのIDが不正です",
+		})
+	}
+
+	err = h.inputPort.Execute(c.Request().Context(), userID, dayBundleID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
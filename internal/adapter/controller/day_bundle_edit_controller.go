@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IDayBundleEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	DayBundleEditController struct {
+		inputPort usecase.IDayBundleEditInputPort
+		presenter presenter.IDayBundlePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewDayBundleEditController(
+	inputPort usecase.IDayBundleEditInputPort,
+	presenter presenter.IDayBundlePresenter,
+	logger ILogWriter,
+) IDayBundleEditController {
+	return &DayBundleEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 同日スケジュールのまとまり更新
+// @Description
+// @Produce json
+// @Param bundle_id path int true "DayBundleID"
+// @Param request body DayBundleRequestData true "まとまり更新リクエスト"
+// @Success 200 {object} presenter.DayBundleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bundle/{bundle_id} [put]
+func (h *DayBundleEditController) Execute(c echo.Context) error {
+
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	dayBundleID, err := strconv.Atoi(c.Param("bundle_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "まとまりのIDが不正です",
+		})
+	}
+
+	var requestData DayBundleRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, dayBundleID, usecase.DayBundleInput{
+		Title:           requestData.Title,
+		ScheduleIDs:     requestData.ScheduleIDs,
+		RefuseCollision: requestData.RefuseCollision,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IDayBundleListController interface {
+		Execute(c echo.Context) error
+	}
+
+	DayBundleListController struct {
+		inputPort usecase.IDayBundleListInputPort
+		presenter presenter.IDayBundleListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewDayBundleListController(
+	inputPort usecase.IDayBundleListInputPort,
+	presenter presenter.IDayBundleListPresenter,
+	logger ILogWriter,
+) IDayBundleListController {
+	return &DayBundleListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 同日スケジュールのまとまり一覧取得
+// @Description
+// @Produce json
+// @Param campus path string true "校舎"
+// @Success 200 {object} presenter.DayBundleListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bundle/list/{campus} [get]
+func (h *DayBundleListController) Execute(c echo.Context) error {
+
+	campus := c.Param("campus")
+	if campus == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "校舎識別子が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), campus)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
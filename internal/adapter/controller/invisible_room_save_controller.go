@@ -54,7 +54,7 @@ type (
 func (h *InvisibleRoomController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -76,7 +76,7 @@ func (h *InvisibleRoomController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), roleKey, userID, scheduleID, requestData.InvisibleRooms)
+	err = h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.InvisibleRooms)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
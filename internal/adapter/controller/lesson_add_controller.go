@@ -53,7 +53,7 @@ type (
 // @Router /lesson/{campus} [post]
 func (h *LessonAddController) Execute(c echo.Context) error {
 
-	_, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -75,7 +75,7 @@ func (h *LessonAddController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), role, campus, usecase.LessonAddInputDTO{
+	err = h.inputPort.Execute(c.Request().Context(), userID, campus, usecase.LessonAddInputDTO{
 		LessonName: requestData.LessonName,
 		Duration:   requestData.Duration,
 	})
@@ -55,7 +55,7 @@ type (
 func (h *LessonEditController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -78,7 +78,7 @@ func (h *LessonEditController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c, roleKey, userID, usecase.LessonEditInputDTO{
+	err = h.inputPort.Execute(c, userID, usecase.LessonEditInputDTO{
 		ID:         lessonid,
 		LessonName: requestData.LessonName,
 		Duration:   requestData.Duration,
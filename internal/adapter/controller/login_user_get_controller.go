@@ -43,7 +43,7 @@ func NewLoginUserGetController(
 // @Router /user/self [get]
 func (h *LoginUserGetController) Execute(c echo.Context) error {
 
-	userID, _, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
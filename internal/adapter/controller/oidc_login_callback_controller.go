@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IOIDCLoginCallbackController interface {
+		Execute(c echo.Context) error
+	}
+
+	OIDCLoginCallbackController struct {
+		inputPort usecase.IOIDCLoginCallbackInputPort
+		presenter presenter.IUserLoginPresenter
+		env       configs.EnvConfig
+		logger    ILogWriter
+	}
+)
+
+func NewOIDCLoginCallbackController(
+	inputPort usecase.IOIDCLoginCallbackInputPort,
+	presenter presenter.IUserLoginPresenter,
+	env configs.EnvConfig,
+	logger ILogWriter,
+) IOIDCLoginCallbackController {
+	return &OIDCLoginCallbackController{
+		inputPort: inputPort,
+		presenter: presenter,
+		env:       env,
+		logger:    logger,
+	}
+}
+
+// @Summary シングルサインオンのコールバック
+// @Description IDプロバイダーからのリダイレクトを受けてログインします。ログイン後のリダイレクト先が設定されている場合はリダイレクトします。2段階認証が必要なユーザーの場合はセッションを作成せず、two_factor_requiredをtrueで返します(リダイレクトする場合はクエリパラメーターtwo_factorを付与します)。続けて/user/login/two-factorに認証コードを送信してください
+// @Produce json
+// @Param code query string false "認可コード"
+// @Param state query string false "ログイン開始時のstate"
+// @Param error query string false "IDプロバイダーのエラー"
+// @Success 200 {object} presenter.UserLoginResponse
+// @Success 302
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/oidc/callback [get]
+func (h *OIDCLoginCallbackController) Execute(c echo.Context) error {
+
+	if errorCode := c.QueryParam("error"); errorCode != "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"msg": "IDプロバイダーでのログインに失敗しました:" + errorCode,
+		})
+	}
+
+	input := usecase.OIDCLoginCallbackInput{
+		Code:  c.QueryParam("code"),
+		State: c.QueryParam("state"),
+	}
+
+	// 保持していた値は一度だけ使えるよう取り出したら破棄する
+	sess, err := session.Get(OIDC_SESSION_NAME, c)
+	if err == nil {
+
+		input.SavedState, _ = sess.Values[OIDC_SESSION_STATE].(string)
+		input.Nonce, _ = sess.Values[OIDC_SESSION_NONCE].(string)
+		input.CodeVerifier, _ = sess.Values[OIDC_SESSION_CODE_VERIFIER].(string)
+
+		sess.Options.MaxAge = -1
+		_ = sess.Save(c.Request(), c.Response())
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), input)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	// 2段階認証が必要な場合は、パスワードでのログインと同様に認証コードの入力まで一時的に保持する
+	if result.TwoFactorRequired {
+
+		if err := setTwoFactorPendingSession(c, result.User.ID); err != nil {
+			status, msg := h.logger.WriteErrLog(c, err)
+			return c.JSON(status, map[string]any{
+				"msg": msg,
+			})
+		}
+
+	} else {
+		setSessionCookie(c, h.env, result.SessionID)
+	}
+
+	if h.env.OIDCPostLoginRedirectURL != "" {
+		return c.Redirect(http.StatusFound, toPostLoginRedirectURL(h.env.OIDCPostLoginRedirectURL, result))
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
+
+// 2段階認証が必要な場合は、リダイレクト先で認証コードの入力を求められるようクエリパラメーターで伝える
+func toPostLoginRedirectURL(redirectURL string, result *usecase.UserLoginOutput) string {
+
+	if !result.TwoFactorRequired {
+		return redirectURL
+	}
+
+	parsed, err := url.Parse(redirectURL)
+	if err != nil {
+		return redirectURL
+	}
+
+	query := parsed.Query()
+	query.Set("two_factor", "required")
+	if result.TwoFactorEnrollmentRequired {
+		query.Set("two_factor", "enrollment_required")
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String()
+}
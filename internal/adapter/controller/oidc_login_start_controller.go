@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+const (
+	// ログイン開始からコールバックまでの値を保持するセッション
+	OIDC_SESSION_NAME          = "lessonlink_oidc"
+	OIDC_SESSION_STATE         = "state"
+	OIDC_SESSION_NONCE         = "nonce"
+	OIDC_SESSION_CODE_VERIFIER = "code_verifier"
+)
+
+type (
+	IOIDCLoginStartController interface {
+		Execute(c echo.Context) error
+	}
+
+	OIDCLoginStartController struct {
+		inputPort usecase.IOIDCLoginStartInputPort
+		logger    ILogWriter
+	}
+)
+
+func NewOIDCLoginStartController(
+	inputPort usecase.IOIDCLoginStartInputPort,
+	logger ILogWriter,
+) IOIDCLoginStartController {
+	return &OIDCLoginStartController{
+		inputPort: inputPort,
+		logger:    logger,
+	}
+}
+
+// @Summary シングルサインオン開始
+// @Description OpenID ConnectのIDプロバイダーの認可エンドポイントへリダイレクトします
+// @Produce json
+// @Success 302
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/oidc/login [get]
+func (h *OIDCLoginStartController) Execute(c echo.Context) error {
+
+	result, err := h.inputPort.Execute(c.Request().Context())
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	sess, err := session.Get(OIDC_SESSION_NAME, c)
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	// IDプロバイダーからのリダイレクトでも送信されるようLaxにする
+	sess.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   600,
+		Secure:   os.Getenv("LOCAL_TEST") != "true",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+	sess.Values[OIDC_SESSION_STATE] = result.State
+	sess.Values[OIDC_SESSION_NONCE] = result.Nonce
+	sess.Values[OIDC_SESSION_CODE_VERIFIER] = result.CodeVerifier
+
+	if err := sess.Save(c.Request(), c.Response()); err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.Redirect(http.StatusFound, result.AuthorizationURL)
+}
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleAddController interface {
+		Execute(c echo.Context) error
+	}
+
+	RoleAddController struct {
+		inputPort usecase.IRoleAddInputPort
+		presenter presenter.IRoleAddPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewRoleAddController(
+	inputPort usecase.IRoleAddInputPort,
+	presenter presenter.IRoleAddPresenter,
+	logger ILogWriter,
+) IRoleAddController {
+	return &RoleAddController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	RoleAddRequestData struct {
+		// 英小文字で始まる英小文字・数字・アンダースコア(16文字以内)
+		RoleKey     string   `json:"role_key"`
+		RoleName    string   `json:"role_name"`
+		Permissions []string `json:"permissions"`
+	}
+)
+
+// @Summary ロール追加
+// @Description 権限を割り当てたロールを追加します
+// @Produce json
+// @Param request body RoleAddRequestData true "ロール追加リクエスト"
+// @Success 200 {object} presenter.RoleListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /role [post]
+func (h *RoleAddController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	var requestData RoleAddRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, usecase.RoleAddInput{
+		RoleKey:     requestData.RoleKey,
+		RoleName:    requestData.RoleName,
+		Permissions: requestData.Permissions,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	RoleEditController struct {
+		inputPort usecase.IRoleEditInputPort
+		presenter presenter.IRoleEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewRoleEditController(
+	inputPort usecase.IRoleEditInputPort,
+	presenter presenter.IRoleEditPresenter,
+	logger ILogWriter,
+) IRoleEditController {
+	return &RoleEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	RoleEditRequestData struct {
+		RoleName    string   `json:"role_name"`
+		Permissions []string `json:"permissions"`
+	}
+)
+
+// @Summary ロール編集
+// @Description 追加したロールの名称と権限を変更します。既定のロールは変更できません
+// @Produce json
+// @Param role_key path string true "RoleKey"
+// @Param request body RoleEditRequestData true "ロール編集リクエスト"
+// @Success 200 {object} presenter.RoleListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /role/{role_key} [put]
+func (h *RoleEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	var requestData RoleEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, usecase.RoleEditInput{
+		RoleKey:     c.Param("role_key"),
+		RoleName:    requestData.RoleName,
+		Permissions: requestData.Permissions,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
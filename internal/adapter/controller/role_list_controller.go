@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleListController interface {
+		Execute(c echo.Context) error
+	}
+
+	RoleListController struct {
+		inputPort usecase.IRoleListInputPort
+		presenter presenter.IRoleListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewRoleListController(
+	inputPort usecase.IRoleListInputPort,
+	presenter presenter.IRoleListPresenter,
+	logger ILogWriter,
+) IRoleListController {
+	return &RoleListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary ロール一覧取得
+// @Description ロールと割り当てられた権限、設定できる権限の一覧を取得します
+// @Produce json
+// @Success 200 {object} presenter.RoleListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /role/list [get]
+func (h *RoleListController) Execute(c echo.Context) error {
+
+	result, err := h.inputPort.Execute(c.Request().Context())
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -59,7 +59,7 @@ type (
 func (h *RoomEditController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	_, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -81,7 +81,7 @@ func (h *RoomEditController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), roleKey, campus, usecase.RoomsEditInputDTO{
+	err = h.inputPort.Execute(c.Request().Context(), userID, campus, usecase.RoomsEditInputDTO{
 		Rooms: lo.Map(requestData.RoomList, func(item RoomEditData, _ int) usecase.RoomEditInputDTO {
 			return usecase.RoomEditInputDTO{
 				Index: item.RoomIndex,
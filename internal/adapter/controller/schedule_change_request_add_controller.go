@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleChangeRequestAddController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleChangeRequestAddController struct {
+		inputPort usecase.IScheduleChangeRequestAddInputPort
+		presenter presenter.IScheduleChangeRequestPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleChangeRequestAddController(
+	inputPort usecase.IScheduleChangeRequestAddInputPort,
+	presenter presenter.IScheduleChangeRequestPresenter,
+	logger ILogWriter,
+) IScheduleChangeRequestAddController {
+	return &ScheduleChangeRequestAddController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleChangeRequestAddRequestData struct {
+		// 提案の基準とする履歴番号。0の場合は最新
+		HistoryIndex int                                  `json:"history_index"`
+		Comment      string                               `json:"comment"`
+		Operations   []ScheduleChangeRequestOperationData `json:"operations"`
+	}
+
+	ScheduleChangeRequestOperationData struct {
+		// move: 教室・時刻を指定して配置 / return: 一覧へ戻す
+		Operation        string `json:"operation"`
+		Identifier       string `json:"identifier"`
+		RoomIndex        int    `json:"room_index,omitempty"`
+		StartTimeHour    int    `json:"start_time_hour,omitempty"`
+		StartTimeMinutes int    `json:"start_time_minutes,omitempty"`
+	}
+)
+
+// @Summary スケジュールの変更提案
+// @Description 編集権限のないユーザーも、閲覧できるスケジュールに対してアイテムの操作を提案できます
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleChangeRequestAddRequestData true "変更提案リクエスト"
+// @Success 200 {object} presenter.ScheduleChangeRequestResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/change-requests [post]
+func (h *ScheduleChangeRequestAddController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleChangeRequestAddRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, usecase.ScheduleChangeRequestAddInput{
+		HistoryIndex: requestData.HistoryIndex,
+		Comment:      requestData.Comment,
+		Operations: lo.Map(requestData.Operations, func(item ScheduleChangeRequestOperationData, _ int) usecase.ScheduleItemOperationDTO {
+			return usecase.ScheduleItemOperationDTO{
+				Operation:        item.Operation,
+				Identifier:       item.Identifier,
+				RoomIndex:        item.RoomIndex,
+				StartTimeHour:    item.StartTimeHour,
+				StartTimeMinutes: item.StartTimeMinutes,
+			}
+		}),
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleChangeRequestDiffController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleChangeRequestDiffController struct {
+		inputPort usecase.IScheduleChangeRequestDiffInputPort
+		presenter presenter.IScheduleChangeRequestDiffPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleChangeRequestDiffController(
+	inputPort usecase.IScheduleChangeRequestDiffInputPort,
+	presenter presenter.IScheduleChangeRequestDiffPresenter,
+	logger ILogWriter,
+) IScheduleChangeRequestDiffController {
+	return &ScheduleChangeRequestDiffController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary スケジュールの変更提案と現在の状態の差分取得
+// @Description 提案を現在のスケジュールに適用した場合に配置が変わるアイテムを返します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param change_request_id path int true "ChangeRequestID"
+// @Success 200 {object} presenter.ScheduleChangeRequestDiffResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/change-requests/{change_request_id}/diff [get]
+func (h *ScheduleChangeRequestDiffController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	changeRequestID, err := strconv.Atoi(c.Param("change_request_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "変更提案のIDが不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, changeRequestID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
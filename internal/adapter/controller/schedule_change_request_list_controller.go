@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleChangeRequestListController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleChangeRequestListController struct {
+		inputPort usecase.IScheduleChangeRequestListInputPort
+		presenter presenter.IScheduleChangeRequestListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleChangeRequestListController(
+	inputPort usecase.IScheduleChangeRequestListInputPort,
+	presenter presenter.IScheduleChangeRequestListPresenter,
+	logger ILogWriter,
+) IScheduleChangeRequestListController {
+	return &ScheduleChangeRequestListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary スケジュールの変更提案一覧取得
+// @Description
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Success 200 {object} presenter.ScheduleChangeRequestListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/change-requests [get]
+func (h *ScheduleChangeRequestListController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleChangeRequestRejectController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleChangeRequestRejectController struct {
+		inputPort usecase.IScheduleChangeRequestRejectInputPort
+		presenter presenter.IScheduleChangeRequestPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleChangeRequestRejectController(
+	inputPort usecase.IScheduleChangeRequestRejectInputPort,
+	presenter presenter.IScheduleChangeRequestPresenter,
+	logger ILogWriter,
+) IScheduleChangeRequestRejectController {
+	return &ScheduleChangeRequestRejectController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleChangeRequestRejectRequestData struct {
+		Reason string `json:"reason"`
+	}
+)
+
+// @Summary スケジュールの変更提案却下
+// @Description
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param change_request_id path int true "ChangeRequestID"
+// @Param request body ScheduleChangeRequestRejectRequestData true "却下リクエスト"
+// @Success 200 {object} presenter.ScheduleChangeRequestResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/change-requests/{change_request_id}/reject [put]
+func (h *ScheduleChangeRequestRejectController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	changeRequestID, err := strconv.Atoi(c.Param("change_request_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "変更提案のIDが不正です",
+		})
+	}
+
+	var requestData ScheduleChangeRequestRejectRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, changeRequestID, requestData.Reason)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
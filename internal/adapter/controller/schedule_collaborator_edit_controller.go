@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCollaboratorEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCollaboratorEditController struct {
+		inputPort usecase.IScheduleCollaboratorEditInputPort
+		presenter presenter.IScheduleCollaboratorListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCollaboratorEditController(
+	inputPort usecase.IScheduleCollaboratorEditInputPort,
+	presenter presenter.IScheduleCollaboratorListPresenter,
+	logger ILogWriter,
+) IScheduleCollaboratorEditController {
+	return &ScheduleCollaboratorEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleCollaboratorEditRequestData struct {
+		// edit: 編集可能 / view: 閲覧のみ
+		Permission string `json:"permission"`
+	}
+)
+
+// @Summary スケジュールの共同編集者追加・権限変更
+// @Description スケジュールの所有者またはオーナーが、指定したユーザーに編集または閲覧の権限を付与します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param user_id path int true "UserID"
+// @Param request body ScheduleCollaboratorEditRequestData true "権限設定リクエスト"
+// @Success 200 {object} presenter.ScheduleCollaboratorListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/collaborators/{user_id} [put]
+func (h *ScheduleCollaboratorEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	targetUserID, err := strconv.Atoi(c.Param("user_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDが不正です",
+		})
+	}
+
+	var requestData ScheduleCollaboratorEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, targetUserID, requestData.Permission)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
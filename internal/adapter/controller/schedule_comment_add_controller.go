@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCommentAddController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCommentAddController struct {
+		inputPort usecase.IScheduleCommentAddInputPort
+		presenter presenter.IScheduleCommentListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCommentAddController(
+	inputPort usecase.IScheduleCommentAddInputPort,
+	presenter presenter.IScheduleCommentListPresenter,
+	logger ILogWriter,
+) IScheduleCommentAddController {
+	return &ScheduleCommentAddController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleCommentAddRequestData struct {
+		Identifier string `json:"identifier,omitempty"`
+		ParentID   int    `json:"parent_id,omitempty"`
+		Body       string `json:"body"`
+	}
+)
+
+// @Summary スケジュールコメント投稿
+// @Description 識別子を省略した場合はスケジュール全体へのコメントになります。parent_idを指定した場合はそのコメントへの返信になります
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleCommentAddRequestData true "コメント投稿リクエスト"
+// @Success 200 {object} presenter.ScheduleCommentListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/comments [post]
+func (h *ScheduleCommentAddController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleCommentAddRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, usecase.ScheduleCommentAddInput{
+		Identifier: requestData.Identifier,
+		ParentID:   requestData.ParentID,
+		Body:       requestData.Body,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCommentDeleteController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCommentDeleteController struct {
+		inputPort usecase.IScheduleCommentDeleteInputPort
+		presenter presenter.IScheduleCommentListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCommentDeleteController(
+	inputPort usecase.IScheduleCommentDeleteInputPort,
+	presenter presenter.IScheduleCommentListPresenter,
+	logger ILogWriter,
+) IScheduleCommentDeleteController {
+	return &ScheduleCommentDeleteController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary スケジュールコメント削除
+// @Description 投稿者のみ削除できます。スレッドの起点を削除した場合は返信も削除されます
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param comment_id path int true "CommentID"
+// @Success 200 {object} presenter.ScheduleCommentListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/comments/{comment_id} [delete]
+func (h *ScheduleCommentDeleteController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "コメントIDが不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, commentID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCommentEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCommentEditController struct {
+		inputPort usecase.IScheduleCommentEditInputPort
+		presenter presenter.IScheduleCommentListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCommentEditController(
+	inputPort usecase.IScheduleCommentEditInputPort,
+	presenter presenter.IScheduleCommentListPresenter,
+	logger ILogWriter,
+) IScheduleCommentEditController {
+	return &ScheduleCommentEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleCommentEditRequestData struct {
+		Body string `json:"body"`
+	}
+)
+
+// @Summary スケジュールコメント編集
+// @Description 投稿者のみ編集できます
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param comment_id path int true "CommentID"
+// @Param request body ScheduleCommentEditRequestData true "コメント編集リクエスト"
+// @Success 200 {object} presenter.ScheduleCommentListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/comments/{comment_id} [put]
+func (h *ScheduleCommentEditController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "コメントIDが不正です",
+		})
+	}
+
+	var requestData ScheduleCommentEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, commentID, requestData.Body)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
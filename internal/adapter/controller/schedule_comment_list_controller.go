@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCommentListController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCommentListController struct {
+		inputPort usecase.IScheduleCommentListInputPort
+		presenter presenter.IScheduleCommentListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCommentListController(
+	inputPort usecase.IScheduleCommentListInputPort,
+	presenter presenter.IScheduleCommentListPresenter,
+	logger ILogWriter,
+) IScheduleCommentListController {
+	return &ScheduleCommentListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary スケジュールコメント一覧取得
+// @Description 識別子を指定した場合はそのアイテムのコメントのみを返します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param identifier query string false "アイテムの識別子"
+// @Success 200 {object} presenter.ScheduleCommentListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/comments [get]
+func (h *ScheduleCommentListController) Execute(c echo.Context) error {
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, c.QueryParam("identifier"))
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleCommentResolveController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleCommentResolveController struct {
+		inputPort usecase.IScheduleCommentResolveInputPort
+		presenter presenter.IScheduleCommentListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleCommentResolveController(
+	inputPort usecase.IScheduleCommentResolveInputPort,
+	presenter presenter.IScheduleCommentListPresenter,
+	logger ILogWriter,
+) IScheduleCommentResolveController {
+	return &ScheduleCommentResolveController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleCommentResolveRequestData struct {
+		Resolved bool `json:"resolved"`
+	}
+)
+
+// @Summary スケジュールコメント解決状態切り替え
+// @Description 投稿者またはスケジュールを編集できるユーザーが変更できます
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param comment_id path int true "CommentID"
+// @Param request body ScheduleCommentResolveRequestData true "解決状態切り替えリクエスト"
+// @Success 200 {object} presenter.ScheduleCommentListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/comments/{comment_id}/resolve [put]
+func (h *ScheduleCommentResolveController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	commentID, err := strconv.Atoi(c.Param("comment_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "コメントIDが不正です",
+		})
+	}
+
+	var requestData ScheduleCommentResolveRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, commentID, requestData.Resolved)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -35,13 +35,16 @@ func NewScheduleCreateController(
 
 type (
 	ScheduleCreateRequestData struct {
-		StartTime int `json:"start_time"`
-		EndTime   int `json:"end_time"`
+		TargetDate       string `json:"target_date,omitempty"`
+		StartTime        int    `json:"start_time"`
+		StartTimeMinutes int    `json:"start_time_minutes,omitempty"`
+		EndTime          int    `json:"end_time"`
+		EndTimeMinutes   int    `json:"end_time_minutes,omitempty"`
 	}
 )
 
 // @Summary スケジュール作成
-// @Description
+// @Description 終了時刻は24時0分まで指定できます。実施日(YYYY-MM-DD)を指定すると、その曜日の開校時間で検証します
 // @Produce json
 // @Param campus path string true "校舎"
 // @Param request body ScheduleCreateRequestData true "スケジュール作成リクエスト"
@@ -56,7 +59,7 @@ func (h *ScheduleCreateController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -72,7 +75,13 @@ func (h *ScheduleCreateController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), roleKey, userID, campus, requestData.StartTime, requestData.EndTime)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, campus, usecase.ScheduleTimeInput{
+		TargetDate:       requestData.TargetDate,
+		StartTimeHour:    requestData.StartTime,
+		StartTimeMinutes: requestData.StartTimeMinutes,
+		EndTimeHour:      requestData.EndTime,
+		EndTimeMinutes:   requestData.EndTimeMinutes,
+	})
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -45,7 +45,7 @@ func (h *ScheduleDuplicateController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -59,7 +59,7 @@ func (h *ScheduleDuplicateController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID)
+	err = h.inputPort.Execute(c.Request().Context(), userID, scheduleID)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
 )
 
@@ -41,11 +42,19 @@ func NewScheduleGetController(
 // @Success 200 {object} presenter.ScheduleGetResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /schedule/{schedule_id} [get]
 func (h *ScheduleGetController) Execute(c echo.Context) error {
 
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
 	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
@@ -67,7 +76,7 @@ func (h *ScheduleGetController) Execute(c echo.Context) error {
 		historyIndex = inputHistoryIndex
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), scheduleID, historyIndex)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, historyIndex)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
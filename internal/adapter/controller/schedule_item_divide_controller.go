@@ -36,15 +36,19 @@ func NewScheduleItemDivideController(
 
 type (
 	ScheduleItemDivideRequestData struct {
-		HistoryIndex  int    `json:"history_index"`
-		LessonID      int    `json:"lesson_id"`
-		Identifier    string `json:"identifier"`
-		DivideMinutes int    `json:"divide_minutes"`
+		HistoryIndex      int    `json:"history_index"`
+		LessonID          int    `json:"lesson_id"`
+		Identifier        string `json:"identifier"`
+		DivideMinutes     int    `json:"divide_minutes,omitempty"`
+		Parts             int    `json:"parts,omitempty"`
+		DivideMinutesList []int  `json:"divide_minutes_list,omitempty"`
+		BreakMinutes      int    `json:"break_minutes,omitempty"`
 	}
 )
 
 // @Summary スケジュール編集アイテムリスト分割
-// @Description
+// @Description divide_minutes_list、parts、divide_minutes の順に優先して分割します。先頭の分割アイテムが元の識別子を引き継ぎます
+// @Description break_minutes を指定すると、配置済みアイテムの分割後の各アイテムの間に休憩時間を空けます
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleItemDivideRequestData true "スケジュール保存リクエスト"
@@ -59,7 +63,7 @@ func (h *ScheduleItemDivideController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -81,10 +85,13 @@ func (h *ScheduleItemDivideController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemDivideInput{
-		LessonID:      requestData.LessonID,
-		Identifier:    requestData.Identifier,
-		DivideMinutes: requestData.DivideMinutes,
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemDivideInput{
+		LessonID:          requestData.LessonID,
+		Identifier:        requestData.Identifier,
+		DivideMinutes:     requestData.DivideMinutes,
+		Parts:             requestData.Parts,
+		DivideMinutesList: requestData.DivideMinutesList,
+		BreakMinutes:      requestData.BreakMinutes,
 	})
 
 	if err != nil {
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleItemGroupController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleItemGroupController struct {
+		inputPort usecase.IScheduleItemGroupInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleItemGroupController(
+	inputPort usecase.IScheduleItemGroupInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleItemGroupController {
+	return &ScheduleItemGroupController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleItemGroupRequestData struct {
+		HistoryIndex int                                  `json:"history_index"`
+		Members      []ScheduleItemGroupMemberRequestData `json:"members"`
+	}
+
+	ScheduleItemGroupMemberRequestData struct {
+		Identifier    string `json:"identifier"`
+		OffsetMinutes int    `json:"offset_minutes"`
+	}
+)
+
+// @Summary スケジュール編集アイテムグループ作成
+// @Description グループのアイテムは先頭からの開始位置(分)を保ったまま、移動・シフト・一覧へ戻すを一括で行います
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleItemGroupRequestData true "アイテムグループ作成リクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/item-group [post]
+func (h *ScheduleItemGroupController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleItemGroupRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemGroupInput{
+		Members: lo.Map(requestData.Members, func(member ScheduleItemGroupMemberRequestData, _ int) usecase.ScheduleItemGroupMemberInput {
+			return usecase.ScheduleItemGroupMemberInput{
+				Identifier:    member.Identifier,
+				OffsetMinutes: member.OffsetMinutes,
+			}
+		}),
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
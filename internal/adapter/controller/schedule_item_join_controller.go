@@ -36,14 +36,15 @@ func NewScheduleItemJoinController(
 
 type (
 	ScheduleItemJoinRequestData struct {
-		HistoryIndex       int    `json:"history_index"`
-		JoinFromIdentifier string `json:"join_from_identifier"`
-		JoinToIdentifier   string `json:"join_to_identifier"`
+		HistoryIndex       int      `json:"history_index"`
+		JoinFromIdentifier string   `json:"join_from_identifier,omitempty"`
+		JoinToIdentifier   string   `json:"join_to_identifier,omitempty"`
+		Identifiers        []string `json:"identifiers,omitempty"`
 	}
 )
 
 // @Summary スケジュール編集アイテムリスト分割
-// @Description
+// @Description identifiers を指定すると、同じ講座の複数のアイテムを結合します。先頭に指定したアイテムの識別子を引き継ぎます
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleItemJoinRequestData true "アイテム結合リクエスト"
@@ -58,7 +59,7 @@ func (h *ScheduleItemJoinController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -80,7 +81,11 @@ func (h *ScheduleItemJoinController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.HistoryIndex, requestData.JoinFromIdentifier, requestData.JoinToIdentifier)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemJoinInput{
+		JoinFromIdentifier: requestData.JoinFromIdentifier,
+		JoinToIdentifier:   requestData.JoinToIdentifier,
+		Identifiers:        requestData.Identifiers,
+	})
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
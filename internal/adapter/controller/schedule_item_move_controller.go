@@ -40,17 +40,19 @@ type (
 		LessonID        int    `json:"lesson_id"`
 		ItemTag         string `json:"item_tag"`
 		Identifier      string `json:"identifier"`
+		Title           string `json:"title,omitempty"`
 		Duration        int    `json:"duration"`
 		StartTimeHour   int    `json:"start_time_hour"`
 		StartTimeMinute int    `json:"start_time_minute"`
 		EndTimeHour     int    `json:"end_time_hour"`
 		EndTimeMinutes  int    `json:"end_time_minutes"`
 		RoomIndex       int    `json:"room_index"`
+		Mode            string `json:"mode,omitempty"`
 	}
 )
 
 // @Summary スケジュール編集アイテム移動
-// @Description
+// @Description mode に push を指定すると、重なった後続のアイテムを後ろへ押し出して配置します(省略時は exact)。item_tag には lesson・cleaning のほか校舎に登録したアイテムの種類を指定でき、title で任意の名称を付けられます
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleItemMoveRequestData true "アイテム移動リクエスト"
@@ -65,7 +67,7 @@ func (h *ScheduleItemMoveController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -87,16 +89,18 @@ func (h *ScheduleItemMoveController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemMoveInput{
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemMoveInput{
 		LessonID:        requestData.LessonID,
 		ItemTag:         requestData.ItemTag,
 		Identifier:      requestData.Identifier,
+		Title:           requestData.Title,
 		Duration:        requestData.Duration,
 		StartTimeHour:   requestData.StartTimeHour,
 		StartTimeMinute: requestData.StartTimeMinute,
 		EndTimeHour:     requestData.EndTimeHour,
 		EndTimeMinutes:  requestData.EndTimeMinutes,
 		RoomIndex:       requestData.RoomIndex,
+		Mode:            requestData.Mode,
 	})
 
 	if err != nil {
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleItemPinController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleItemPinController struct {
+		inputPort usecase.IScheduleItemPinInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleItemPinController(
+	inputPort usecase.IScheduleItemPinInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleItemPinController {
+	return &ScheduleItemPinController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleItemPinRequestData struct {
+		HistoryIndex int    `json:"history_index"`
+		Identifier   string `json:"identifier"`
+		Pinned       bool   `json:"pinned"`
+	}
+)
+
+// @Summary スケジュール編集アイテム固定切り替え
+// @Description 固定したアイテムはシフトなどの自動配置で移動しません
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleItemPinRequestData true "アイテム固定リクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/item-pin [post]
+func (h *ScheduleItemPinController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleItemPinRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemPinInput{
+		Identifier: requestData.Identifier,
+		Pinned:     requestData.Pinned,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
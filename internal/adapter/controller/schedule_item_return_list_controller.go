@@ -40,11 +40,12 @@ type (
 		LessonID     int    `json:"lesson_id"`
 		Identifier   string `json:"identifier"`
 		Duration     int    `json:"duration"`
+		Mode         string `json:"mode,omitempty"`
 	}
 )
 
 // @Summary スケジュール編集アイテムリスト移動
-// @Description
+// @Description mode に pull を指定すると、空いた時間を後続のアイテムで詰めます(省略時は keep)
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleItemReturnListRequestData true "アイテムリスト移動リクエスト"
@@ -59,7 +60,7 @@ func (h *ScheduleItemReturnListController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -81,10 +82,11 @@ func (h *ScheduleItemReturnListController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemReturnListInput{
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemReturnListInput{
 		LessonID:   requestData.LessonID,
 		Identifier: requestData.Identifier,
 		Duration:   requestData.Duration,
+		Mode:       requestData.Mode,
 	})
 
 	if err != nil {
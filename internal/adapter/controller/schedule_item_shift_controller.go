@@ -36,13 +36,19 @@ func NewScheduleItemShiftController(
 
 type (
 	ScheduleItemShiftRequestData struct {
-		HistoryIndex int `json:"history_index"`
-		RoomIndex    int `json:"room_index"`
+		HistoryIndex int    `json:"history_index"`
+		RoomIndex    int    `json:"room_index,omitempty"`
+		RoomIndexes  []int  `json:"room_indexes,omitempty"`
+		AllRooms     bool   `json:"all_rooms,omitempty"`
+		Strategy     string `json:"strategy,omitempty"`
+		GapMinutes   int    `json:"gap_minutes,omitempty"`
+		GridMinutes  int    `json:"grid_minutes,omitempty"`
 	}
 )
 
 // @Summary スケジュール編集アイテムシフト
-// @Description
+// @Description strategy: pack(省略時) / pack_start / pack_end / distribute / fixed_gap(gap_minutes) / grid(grid_minutes)
+// @Description 対象は room_index、room_indexes、all_rooms のいずれかで指定します
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleItemShiftRequestData true "アイテムシフトリクエスト"
@@ -57,7 +63,7 @@ func (h *ScheduleItemShiftController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -71,7 +77,7 @@ func (h *ScheduleItemShiftController) Execute(c echo.Context) error {
 		})
 	}
 
-	var requestData ScheduleItemMoveRequestData
+	var requestData ScheduleItemShiftRequestData
 
 	if err := c.Bind(&requestData); err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
@@ -79,7 +85,14 @@ func (h *ScheduleItemShiftController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.HistoryIndex, requestData.RoomIndex)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemShiftInput{
+		RoomIndex:   requestData.RoomIndex,
+		RoomIndexes: requestData.RoomIndexes,
+		AllRooms:    requestData.AllRooms,
+		Strategy:    requestData.Strategy,
+		GapMinutes:  requestData.GapMinutes,
+		GridMinutes: requestData.GridMinutes,
+	})
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleItemSuggestionController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleItemSuggestionController struct {
+		inputPort usecase.IScheduleItemSuggestionInputPort
+		presenter presenter.IScheduleItemSuggestionPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleItemSuggestionController(
+	inputPort usecase.IScheduleItemSuggestionInputPort,
+	presenter presenter.IScheduleItemSuggestionPresenter,
+	logger ILogWriter,
+) IScheduleItemSuggestionController {
+	return &ScheduleItemSuggestionController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleItemSuggestionRequestData struct {
+		Identifier         string `param:"identifier"`
+		LessonID           int    `query:"lesson_id"`
+		HistoryIndex       int    `query:"history"`
+		StepMinutes        int    `query:"step"`
+		PreferredRoomIndex int    `query:"room_index"`
+		Limit              int    `query:"limit"`
+	}
+)
+
+// @Summary スケジュール編集アイテム配置候補取得
+// @Description 一覧のアイテムを配置できる空き枠を、前後の空き時間が少ない順・希望教室・開始時刻が早い順に上位から返します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param identifier path string true "アイテム識別子"
+// @Param lesson_id query int true "講座ID"
+// @Param history query int false "履歴番号"
+// @Param step query int false "候補の刻み時間(分) 省略時は5分"
+// @Param room_index query int false "希望教室番号"
+// @Param limit query int false "候補の件数(最大100件) 省略時は20件"
+// @Success 200 {object} presenter.ScheduleItemSuggestionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/items/{identifier}/suggestions [get]
+func (h *ScheduleItemSuggestionController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleItemSuggestionRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemSuggestionInput{
+		LessonID:           requestData.LessonID,
+		Identifier:         requestData.Identifier,
+		StepMinutes:        requestData.StepMinutes,
+		PreferredRoomIndex: requestData.PreferredRoomIndex,
+		Limit:              requestData.Limit,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleItemTimeShiftController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleItemTimeShiftController struct {
+		inputPort usecase.IScheduleItemTimeShiftInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleItemTimeShiftController(
+	inputPort usecase.IScheduleItemTimeShiftInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleItemTimeShiftController {
+	return &ScheduleItemTimeShiftController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleItemTimeShiftRequestData struct {
+		HistoryIndex         int      `json:"history_index"`
+		Identifiers          []string `json:"identifiers,omitempty"`
+		RoomIndexes          []int    `json:"room_indexes,omitempty"`
+		RangeStartTimeHour   int      `json:"range_start_time_hour,omitempty"`
+		RangeStartTimeMinute int      `json:"range_start_time_minute,omitempty"`
+		RangeEndTimeHour     int      `json:"range_end_time_hour,omitempty"`
+		RangeEndTimeMinutes  int      `json:"range_end_time_minutes,omitempty"`
+		ShiftMinutes         int      `json:"shift_minutes"`
+	}
+)
+
+// @Summary スケジュール編集アイテム時刻一括移動
+// @Description identifiers、または room_indexes と時刻の範囲で選択したアイテムを shift_minutes 分ずらします(負の値は前へずらします)
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleItemTimeShiftRequestData true "アイテム時刻一括移動リクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/item-time-shift [post]
+func (h *ScheduleItemTimeShiftController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleItemTimeShiftRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemTimeShiftInput{
+		Identifiers:          requestData.Identifiers,
+		RoomIndexes:          requestData.RoomIndexes,
+		RangeStartTimeHour:   requestData.RangeStartTimeHour,
+		RangeStartTimeMinute: requestData.RangeStartTimeMinute,
+		RangeEndTimeHour:     requestData.RangeEndTimeHour,
+		RangeEndTimeMinutes:  requestData.RangeEndTimeMinutes,
+		ShiftMinutes:         requestData.ShiftMinutes,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleItemUngroupController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleItemUngroupController struct {
+		inputPort usecase.IScheduleItemUngroupInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleItemUngroupController(
+	inputPort usecase.IScheduleItemUngroupInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleItemUngroupController {
+	return &ScheduleItemUngroupController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleItemUngroupRequestData struct {
+		HistoryIndex    int    `json:"history_index"`
+		GroupIdentifier string `json:"group_identifier"`
+	}
+)
+
+// @Summary スケジュール編集アイテムグループ解除
+// @Description
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleItemUngroupRequestData true "アイテムグループ解除リクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/item-ungroup [post]
+func (h *ScheduleItemUngroupController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleItemUngroupRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleItemUngroupInput{
+		GroupIdentifier: requestData.GroupIdentifier,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
 	schedulelist "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/schedule_list"
 )
 
@@ -36,6 +37,7 @@ func NewScheduleListController(
 // @Description
 // @Produce json
 // @Param campus path string true "校舎"
+// @Param status query string false "ステータス(draft / in_review / published / archived)"
 // @Success 200 {object} presenter.ScheduleListResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
@@ -44,6 +46,13 @@ func NewScheduleListController(
 // @Router /schedule/list/{campus} [get]
 func (h *ScheduleListController) Execute(c echo.Context) error {
 
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
 	campus := c.Param("campus")
 	if campus == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{
@@ -51,7 +60,7 @@ func (h *ScheduleListController) Execute(c echo.Context) error {
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), campus)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, campus, c.QueryParam("status"))
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
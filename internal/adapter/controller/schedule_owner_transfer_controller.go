@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleOwnerTransferController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleOwnerTransferController struct {
+		inputPort usecase.IScheduleOwnerTransferInputPort
+		presenter presenter.IScheduleCollaboratorListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleOwnerTransferController(
+	inputPort usecase.IScheduleOwnerTransferInputPort,
+	presenter presenter.IScheduleCollaboratorListPresenter,
+	logger ILogWriter,
+) IScheduleOwnerTransferController {
+	return &ScheduleOwnerTransferController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleOwnerTransferRequestData struct {
+		UserID int `json:"user_id"`
+	}
+)
+
+// @Summary スケジュールの所有権移譲
+// @Description 移譲前の所有者は編集権限を持つ共同編集者として残ります
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleOwnerTransferRequestData true "所有権移譲リクエスト"
+// @Success 200 {object} presenter.ScheduleCollaboratorListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/owner [put]
+func (h *ScheduleOwnerTransferController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleOwnerTransferRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.UserID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
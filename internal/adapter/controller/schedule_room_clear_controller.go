@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleRoomClearController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleRoomClearController struct {
+		inputPort usecase.IScheduleRoomClearInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleRoomClearController(
+	inputPort usecase.IScheduleRoomClearInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleRoomClearController {
+	return &ScheduleRoomClearController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleRoomClearRequestData struct {
+		HistoryIndex int  `json:"history_index"`
+		RoomIndex    int  `json:"room_index,omitempty"`
+		AllRooms     bool `json:"all_rooms,omitempty"`
+	}
+)
+
+// @Summary スケジュール編集教室クリア
+// @Description 教室(all_rooms 指定時はスケジュール全体)のアイテムを講座リストへ戻します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleRoomClearRequestData true "教室クリアリクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/room-clear [post]
+func (h *ScheduleRoomClearController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleRoomClearRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleRoomClearInput{
+		RoomIndex: requestData.RoomIndex,
+		AllRooms:  requestData.AllRooms,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
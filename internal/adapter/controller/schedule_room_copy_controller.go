@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleRoomCopyController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleRoomCopyController struct {
+		inputPort usecase.IScheduleRoomCopyInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleRoomCopyController(
+	inputPort usecase.IScheduleRoomCopyInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleRoomCopyController {
+	return &ScheduleRoomCopyController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleRoomCopyRequestData struct {
+		HistoryIndex  int `json:"history_index"`
+		FromRoomIndex int `json:"from_room_index"`
+		ToRoomIndex   int `json:"to_room_index"`
+	}
+)
+
+// @Summary スケジュール編集教室複製
+// @Description 教室に配置されたアイテムを新しい識別子で別の教室へ複製します
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleRoomCopyRequestData true "教室複製リクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/room-copy [post]
+func (h *ScheduleRoomCopyController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleRoomCopyRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleRoomCopyInput{
+		FromRoomIndex: requestData.FromRoomIndex,
+		ToRoomIndex:   requestData.ToRoomIndex,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
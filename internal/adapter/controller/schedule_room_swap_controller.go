@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleRoomSwapController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleRoomSwapController struct {
+		inputPort usecase.IScheduleRoomSwapInputPort
+		presenter presenter.IScheduleItemEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleRoomSwapController(
+	inputPort usecase.IScheduleRoomSwapInputPort,
+	presenter presenter.IScheduleItemEditPresenter,
+	logger ILogWriter,
+) IScheduleRoomSwapController {
+	return &ScheduleRoomSwapController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleRoomSwapRequestData struct {
+		HistoryIndex    int `json:"history_index"`
+		RoomIndex       int `json:"room_index"`
+		TargetRoomIndex int `json:"target_room_index"`
+	}
+)
+
+// @Summary スケジュール編集教室入れ替え
+// @Description 2つの教室に配置されたアイテムを入れ替えます
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleRoomSwapRequestData true "教室入れ替えリクエスト"
+// @Success 200 {object} presenter.ScheduleItemEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/room-swap [post]
+func (h *ScheduleRoomSwapController) Execute(c echo.Context) error {
+
+	var err error
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleRoomSwapRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.HistoryIndex, usecase.ScheduleRoomSwapInput{
+		RoomIndex:       requestData.RoomIndex,
+		TargetRoomIndex: requestData.TargetRoomIndex,
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -56,7 +56,7 @@ func (h *ScheduleSaveTitleController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -78,7 +78,7 @@ func (h *ScheduleSaveTitleController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.Title)
+	err = h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.Title)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IScheduleStatusChangeController interface {
+		Execute(c echo.Context) error
+	}
+
+	ScheduleStatusChangeController struct {
+		inputPort usecase.IScheduleStatusChangeInputPort
+		presenter presenter.IScheduleStatusChangePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewScheduleStatusChangeController(
+	inputPort usecase.IScheduleStatusChangeInputPort,
+	presenter presenter.IScheduleStatusChangePresenter,
+	logger ILogWriter,
+) IScheduleStatusChangeController {
+	return &ScheduleStatusChangeController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	ScheduleStatusChangeRequestData struct {
+		// submit / approve / reject / publish / archive
+		Action string `json:"action"`
+		// 差し戻し理由。reject の場合は必須
+		Reason string `json:"reason"`
+	}
+)
+
+// @Summary スケジュールのステータス変更
+// @Description 作成中 → レビュー待ち → 公開済み → アーカイブ済みの順に遷移する
+// @Produce json
+// @Param schedule_id path int true "ScheduleID"
+// @Param request body ScheduleStatusChangeRequestData true "ステータス変更リクエスト"
+// @Success 200 {object} presenter.ScheduleStatusChangeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /schedule/{schedule_id}/status [put]
+func (h *ScheduleStatusChangeController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	scheduleID, err := strconv.Atoi(c.Param("schedule_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "スケジュールIDが不正です",
+		})
+	}
+
+	var requestData ScheduleStatusChangeRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, scheduleID, requestData.Action, requestData.Reason)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
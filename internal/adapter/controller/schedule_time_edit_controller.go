@@ -32,13 +32,17 @@ func NewScheduleTimeEditController(
 
 type (
 	ScheduleTimeEditRequestData struct {
-		StartTime int `json:"start_time"`
-		EndTime   int `json:"end_time"`
+		TargetDate       string `json:"target_date,omitempty"`
+		StartTime        int    `json:"start_time"`
+		StartTimeMinutes int    `json:"start_time_minutes,omitempty"`
+		EndTime          int    `json:"end_time"`
+		EndTimeMinutes   int    `json:"end_time_minutes,omitempty"`
+		Mode             string `json:"mode,omitempty"`
 	}
 )
 
 // @Summary スケジュール時間変更
-// @Description
+// @Description mode: strict(省略時。範囲外の講座があれば変更不可、履歴を初期化) / translate / scale / return_list(いずれも新しい履歴として保存し、元に戻せます。translate と scale は固定アイテムを動かしません)。target_date(YYYY-MM-DD)を指定すると実施日も変更します
 // @Produce json
 // @Param schedule_id path int true "ScheduleID"
 // @Param request body ScheduleTimeEditRequestData true "スケジュール時間変更リクエスト"
@@ -53,7 +57,7 @@ func (h *ScheduleTimeEditController) Execute(c echo.Context) error {
 	var err error
 
 	// セッション情報を取得
-	userID, role, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -75,7 +79,13 @@ func (h *ScheduleTimeEditController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), role, userID, scheduleID, requestData.StartTime, requestData.EndTime)
+	err = h.inputPort.Execute(c.Request().Context(), userID, scheduleID, usecase.ScheduleTimeInput{
+		TargetDate:       requestData.TargetDate,
+		StartTimeHour:    requestData.StartTime,
+		StartTimeMinutes: requestData.StartTimeMinutes,
+		EndTimeHour:      requestData.EndTime,
+		EndTimeMinutes:   requestData.EndTimeMinutes,
+	}, requestData.Mode)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ITwoFactorDisableController interface {
+		Execute(c echo.Context) error
+	}
+
+	TwoFactorDisableController struct {
+		inputPort usecase.ITwoFactorDisableInputPort
+		presenter presenter.ITwoFactorDisablePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewTwoFactorDisableController(
+	inputPort usecase.ITwoFactorDisableInputPort,
+	presenter presenter.ITwoFactorDisablePresenter,
+	logger ILogWriter,
+) ITwoFactorDisableController {
+	return &TwoFactorDisableController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 2段階認証の無効化
+// @Description 認証コードを確認して2段階認証を無効にします。オーナーによって必須とされている場合は無効にできません
+// @Produce json
+// @Param request body controller.TwoFactorCodeParams true "認証コード"
+// @Success 200 {object} presenter.TwoFactorStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/two-factor [delete]
+func (h *TwoFactorDisableController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	var requestData TwoFactorCodeParams
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, utility.Trimmer(requestData.Code))
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ITwoFactorEnrollController interface {
+		Execute(c echo.Context) error
+	}
+
+	TwoFactorEnrollController struct {
+		inputPort usecase.ITwoFactorEnrollInputPort
+		presenter presenter.ITwoFactorEnrollPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewTwoFactorEnrollController(
+	inputPort usecase.ITwoFactorEnrollInputPort,
+	presenter presenter.ITwoFactorEnrollPresenter,
+	logger ILogWriter,
+) ITwoFactorEnrollController {
+	return &TwoFactorEnrollController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 2段階認証の登録開始
+// @Description 認証アプリに登録するシークレットとURIを発行します。/user/self/two-factor/confirmで認証コードを確認すると有効になります
+// @Produce json
+// @Success 200 {object} presenter.TwoFactorEnrollResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/two-factor/enroll [post]
+func (h *TwoFactorEnrollController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
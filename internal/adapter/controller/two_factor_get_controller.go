@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	ITwoFactorGetController interface {
+		Execute(c echo.Context) error
+	}
+
+	TwoFactorGetController struct {
+		inputPort usecase.ITwoFactorGetInputPort
+		presenter presenter.ITwoFactorGetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewTwoFactorGetController(
+	inputPort usecase.ITwoFactorGetInputPort,
+	presenter presenter.ITwoFactorGetPresenter,
+	logger ILogWriter,
+) ITwoFactorGetController {
+	return &TwoFactorGetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary 2段階認証の設定取得
+// @Description ログインしているユーザーの2段階認証の設定を取得します
+// @Produce json
+// @Success 200 {object} presenter.TwoFactorStatusResponse
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/self/two-factor [get]
+func (h *TwoFactorGetController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
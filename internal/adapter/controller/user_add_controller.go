@@ -56,7 +56,7 @@ type (
 func (h *UserAddController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -83,7 +83,7 @@ func (h *UserAddController) Execute(c echo.Context) error {
 		UserName:    requestData.UserName,
 		Password:    requestData.Password,
 		DisplayName: requestData.Name,
-	}, roleKey, userID)
+	}, userID)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -0,0 +1,105 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserCampusRoleEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserCampusRoleEditController struct {
+		inputPort usecase.IUserCampusRoleEditInputPort
+		presenter presenter.IUserCampusRoleEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserCampusRoleEditController(
+	inputPort usecase.IUserCampusRoleEditInputPort,
+	presenter presenter.IUserCampusRoleEditPresenter,
+	logger ILogWriter,
+) IUserCampusRoleEditController {
+	return &UserCampusRoleEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	UserCampusRoleEditRequestData struct {
+		// 指定がないキャンパスはユーザー全体のロールが適用される
+		CampusRoles []UserCampusRoleEditItem `json:"campus_roles"`
+	}
+
+	UserCampusRoleEditItem struct {
+		Campus  string `json:"campus"`
+		RoleKey string `json:"role_key"`
+	}
+)
+
+// @Summary キャンパスごとのロール設定
+// @Description キャンパスごとのロールの割り当てを置き換えます。オーナーのみ実行でき、対象ユーザーはログアウトされます
+// @Produce json
+// @Param userid path string true "UserID"
+// @Param request body UserCampusRoleEditRequestData true "キャンパスロール設定リクエスト"
+// @Success 200 {object} presenter.UserCampusRoleListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/campus-roles [put]
+func (h *UserCampusRoleEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDParam := c.Param("userid")
+	userIDint, err := strconv.Atoi(userIDParam)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	var requestData UserCampusRoleEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	input := lo.Map(requestData.CampusRoles, func(item UserCampusRoleEditItem, _ int) usecase.UserCampusRoleInput {
+		return usecase.UserCampusRoleInput{
+			Campus:  item.Campus,
+			RoleKey: item.RoleKey,
+		}
+	})
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, userIDint, input)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
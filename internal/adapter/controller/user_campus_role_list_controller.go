@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserCampusRoleListController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserCampusRoleListController struct {
+		inputPort usecase.IUserCampusRoleListInputPort
+		presenter presenter.IUserCampusRoleListPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserCampusRoleListController(
+	inputPort usecase.IUserCampusRoleListInputPort,
+	presenter presenter.IUserCampusRoleListPresenter,
+	logger ILogWriter,
+) IUserCampusRoleListController {
+	return &UserCampusRoleListController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary キャンパスごとのロール取得
+// @Description キャンパスごとに有効なロールを取得します。自分以外のユーザーはオーナーのみ取得できます
+// @Produce json
+// @Param userid path string true "UserID"
+// @Success 200 {object} presenter.UserCampusRoleListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/campus-roles [get]
+func (h *UserCampusRoleListController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDParam := c.Param("userid")
+	userIDint, err := strconv.Atoi(userIDParam)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, userIDint)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserDeactivateController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserDeactivateController struct {
+		inputPort usecase.IUserDeactivateInputPort
+		presenter presenter.IUserDeactivatePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserDeactivateController(
+	inputPort usecase.IUserDeactivateInputPort,
+	presenter presenter.IUserDeactivatePresenter,
+	logger ILogWriter,
+) IUserDeactivateController {
+	return &UserDeactivateController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	UserDeactivateRequestData struct {
+		// 所有するスケジュールの引き継ぎ先。0の場合は引き継がない
+		SuccessorUserID int `json:"successor_user_id"`
+	}
+)
+
+// @Summary ユーザー無効化
+// @Description ユーザーを削除せずにログインできない状態にします。引き継ぎ先を指定した場合は所有するスケジュールを移譲します
+// @Produce json
+// @Param userid path string true "UserID"
+// @Param request body UserDeactivateRequestData true "無効化リクエスト"
+// @Success 200 {object} presenter.UserDeleteResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/deactivate [put]
+func (h *UserDeactivateController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDParam := c.Param("userid")
+	userIDint, err := strconv.Atoi(userIDParam)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	var requestData UserDeactivateRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userIDint, requestData.SuccessorUserID, userID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -35,9 +35,10 @@ func NewUserDeleteController(
 }
 
 // @Summary ユーザー削除
-// @Description
+// @Description 削除するユーザーが所有するスケジュールは引き継ぎ先のユーザーへ移譲します
 // @Produce json
 // @Param userid path string true "UserID"
+// @Param successor_user_id query int false "引き継ぎ先のユーザーID"
 // @Success 200 {object} presenter.UserDeleteResponse
 // @Failure 400 {object} map[string]string
 // @Failure 401 {object} map[string]string
@@ -47,7 +48,7 @@ func NewUserDeleteController(
 func (h *UserDeleteController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, map[string]string{
 			"msg": err.Error(),
@@ -62,7 +63,18 @@ func (h *UserDeleteController) Execute(c echo.Context) error {
 		})
 	}
 
-	err = h.inputPort.Execute(c.Request().Context(), roleKey, userIDint, userID)
+	// 所有するスケジュールの引き継ぎ先。所有するスケジュールがない場合は省略できる
+	successorUserID := 0
+	if successorParam := c.QueryParam("successor_user_id"); successorParam != "" {
+		successorUserID, err = strconv.Atoi(successorParam)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"msg": "引き継ぎ先のユーザーIDの形式が不正です",
+			})
+		}
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userIDint, successorUserID, userID)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
@@ -71,5 +83,5 @@ func (h *UserDeleteController) Execute(c echo.Context) error {
 		})
 	}
 
-	return c.JSON(http.StatusOK, h.presenter.Present())
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
 }
@@ -43,14 +43,14 @@ func NewUserListController(
 // @Router /user/list [get]
 func (h *UserListController) Execute(c echo.Context) error {
 
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
 		})
 	}
 
-	result, err := h.inputPort.Execute(c.Request().Context(), userID, roleKey)
+	result, err := h.inputPort.Execute(c.Request().Context(), userID)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
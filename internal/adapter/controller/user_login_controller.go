@@ -3,7 +3,10 @@ package controller
 import (
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/gorilla/sessions"
+	"github.com/labstack/echo-contrib/session"
 	"github.com/labstack/echo/v4"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
@@ -11,6 +14,15 @@ import (
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
 )
 
+const (
+	// パスワード認証から2段階認証までの間、認証済みのユーザーを保持するセッション
+	TWO_FACTOR_SESSION_NAME       = "lessonlink_2fa"
+	TWO_FACTOR_SESSION_USER_ID    = "user_id"
+	TWO_FACTOR_SESSION_EXPIRES_AT = "expires_at"
+	// 認証コードの入力を待つ時間(秒)
+	TWO_FACTOR_SESSION_MAX_AGE = 300
+)
+
 type (
 	IUserLoginController interface {
 		Execute(c echo.Context) error
@@ -44,12 +56,13 @@ type UserLoginParams struct {
 }
 
 // @Summary ユーザーログイン
-// @Description
 // @Produce json
 // @Param request body controller.UserLoginParams true "ユーザーログイン情報"
+// @Description 2段階認証が必要なユーザーの場合はセッションを作成せず、two_factor_requiredをtrueで返します。続けて/user/login/two-factorに認証コードを送信してください
 // @Success 200 {object} presenter.UserLoginResponse
 // @Failure 400 {object} presenter.UserLoginResponse
 // @Failure 401 {object} presenter.UserLoginResponse
+// @Failure 429 {object} presenter.UserLoginResponse
 // @Failure 500 {object} presenter.UserLoginResponse
 // @Router /user/login [post]
 func (h *UserLoginController) Execute(c echo.Context) error {
@@ -70,7 +83,12 @@ func (h *UserLoginController) Execute(c echo.Context) error {
 	password := utility.Trimmer(bodyParams.Password)
 
 	// ユースケースを実行
-	result, err := h.inputPort.Execute(c.Request().Context(), usecase.UserLoginInput{UserName: userName, UserRawPassword: password})
+	result, err := h.inputPort.Execute(c.Request().Context(), usecase.UserLoginInput{
+		UserName:        userName,
+		UserRawPassword: password,
+		IPAddress:       c.RealIP(),
+		UserAgent:       c.Request().UserAgent(),
+	})
 
 	if err != nil {
 
@@ -98,14 +116,35 @@ func (h *UserLoginController) Execute(c echo.Context) error {
 		})
 	}
 
+	// 2段階認証が必要な場合は、認証コードの入力まで認証済みのユーザーを一時的に保持する
+	if result.TwoFactorRequired {
+
+		if err := setTwoFactorPendingSession(c, result.User.ID); err != nil {
+			status, msg := h.logger.WriteErrLog(c, err)
+			return c.JSON(status, map[string]any{
+				"msg": msg,
+			})
+		}
+
+		return c.JSON(http.StatusOK, h.presenter.Present(result))
+	}
+
+	setSessionCookie(c, h.env, result.SessionID)
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
+
+// ログインしたセッションのCookieを設定する
+func setSessionCookie(c echo.Context, env configs.EnvConfig, sessionID string) {
+
 	sameSite := http.SameSiteStrictMode
 	if os.Getenv("LOCAL_TEST") == "true" {
 		sameSite = http.SameSiteNoneMode
 	}
 
 	cookie := &http.Cookie{
-		Name:     h.env.SessionName,
-		Value:    result.SessionID,
+		Name:     env.SessionName,
+		Value:    sessionID,
 		Path:     "/",
 		MaxAge:   3600,
 		Domain:   "",
@@ -115,6 +154,60 @@ func (h *UserLoginController) Execute(c echo.Context) error {
 	}
 
 	c.SetCookie(cookie)
+}
 
-	return c.JSON(http.StatusOK, h.presenter.Present(result))
+// 2段階認証を待っているユーザーを保持する
+func setTwoFactorPendingSession(c echo.Context, userID int) error {
+
+	sess, err := session.Get(TWO_FACTOR_SESSION_NAME, c)
+	if err != nil {
+		return err
+	}
+
+	sameSite := http.SameSiteStrictMode
+	if os.Getenv("LOCAL_TEST") == "true" {
+		sameSite = http.SameSiteNoneMode
+	}
+
+	sess.Options = &sessions.Options{
+		Path:     "/",
+		MaxAge:   TWO_FACTOR_SESSION_MAX_AGE,
+		Secure:   os.Getenv("LOCAL_TEST") != "true",
+		HttpOnly: true,
+		SameSite: sameSite,
+	}
+	sess.Values[TWO_FACTOR_SESSION_USER_ID] = userID
+	sess.Values[TWO_FACTOR_SESSION_EXPIRES_AT] = time.Now().Add(TWO_FACTOR_SESSION_MAX_AGE * time.Second).Unix()
+
+	return sess.Save(c.Request(), c.Response())
+}
+
+// 2段階認証を待っているユーザーを取得する。期限切れの場合は0
+func getTwoFactorPendingUserID(c echo.Context) int {
+
+	sess, err := session.Get(TWO_FACTOR_SESSION_NAME, c)
+	if err != nil {
+		return 0
+	}
+
+	userID, _ := sess.Values[TWO_FACTOR_SESSION_USER_ID].(int)
+	expiresAt, _ := sess.Values[TWO_FACTOR_SESSION_EXPIRES_AT].(int64)
+
+	if time.Now().Unix() >= expiresAt {
+		return 0
+	}
+
+	return userID
+}
+
+// ログインが完了したら2段階認証を待っているユーザーを破棄する
+func clearTwoFactorPendingSession(c echo.Context) {
+
+	sess, err := session.Get(TWO_FACTOR_SESSION_NAME, c)
+	if err != nil {
+		return
+	}
+
+	sess.Options.MaxAge = -1
+	_ = sess.Save(c.Request(), c.Response())
 }
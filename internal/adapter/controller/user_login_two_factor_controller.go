@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserLoginTwoFactorController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserLoginTwoFactorController struct {
+		inputPort usecase.IUserLoginTwoFactorInputPort
+		presenter presenter.IUserLoginTwoFactorPresenter
+		env       configs.EnvConfig
+		logger    ILogWriter
+	}
+)
+
+func NewUserLoginTwoFactorController(
+	inputPort usecase.IUserLoginTwoFactorInputPort,
+	presenter presenter.IUserLoginTwoFactorPresenter,
+	env configs.EnvConfig,
+	logger ILogWriter,
+) IUserLoginTwoFactorController {
+	return &UserLoginTwoFactorController{
+		inputPort: inputPort,
+		presenter: presenter,
+		env:       env,
+		logger:    logger,
+	}
+}
+
+type TwoFactorCodeParams struct {
+	// 認証アプリに表示された6桁のコード、またはリカバリーコード
+	Code string `json:"code"`
+}
+
+// @Summary 2段階認証
+// @Description パスワード認証後に認証コードを送信してログインを完了します。2段階認証の登録が必要な場合は、このリクエストで登録も完了しリカバリーコードを返します
+// @Produce json
+// @Param request body controller.TwoFactorCodeParams true "認証コード"
+// @Success 200 {object} presenter.UserLoginTwoFactorResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 429 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/login/two-factor [post]
+func (h *UserLoginTwoFactorController) Execute(c echo.Context) error {
+
+	var bodyParams TwoFactorCodeParams
+
+	if err := c.Bind(&bodyParams); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), usecase.UserLoginTwoFactorInput{
+		UserID:    getTwoFactorPendingUserID(c),
+		Code:      utility.Trimmer(bodyParams.Code),
+		IPAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	})
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	clearTwoFactorPendingSession(c)
+	setSessionCookie(c, h.env, result.SessionID)
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
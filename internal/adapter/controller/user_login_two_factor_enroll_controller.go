@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserLoginTwoFactorEnrollController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserLoginTwoFactorEnrollController struct {
+		inputPort usecase.IUserLoginTwoFactorEnrollInputPort
+		presenter presenter.ITwoFactorEnrollPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserLoginTwoFactorEnrollController(
+	inputPort usecase.IUserLoginTwoFactorEnrollInputPort,
+	presenter presenter.ITwoFactorEnrollPresenter,
+	logger ILogWriter,
+) IUserLoginTwoFactorEnrollController {
+	return &UserLoginTwoFactorEnrollController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary ログイン時の2段階認証登録
+// @Description 2段階認証が必須とされている未登録のユーザーが、パスワード認証後に認証アプリへ登録する情報を取得します
+// @Produce json
+// @Success 200 {object} presenter.TwoFactorEnrollResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/login/two-factor/enroll [post]
+func (h *UserLoginTwoFactorEnrollController) Execute(c echo.Context) error {
+
+	result, err := h.inputPort.Execute(c.Request().Context(), getTwoFactorPendingUserID(c))
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
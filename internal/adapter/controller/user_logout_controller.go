@@ -50,7 +50,7 @@ func NewUserLogoutController(
 // @Router /user/logout [post]
 func (h *UserLogoutController) Execute(c echo.Context) error {
 
-	userID, _, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		err := log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ログイン情報が確認できません"))
 		h.logger.WriteErrLog(c, err)
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserRestoreController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserRestoreController struct {
+		inputPort usecase.IUserRestoreInputPort
+		presenter presenter.IUserRestorePresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserRestoreController(
+	inputPort usecase.IUserRestoreInputPort,
+	presenter presenter.IUserRestorePresenter,
+	logger ILogWriter,
+) IUserRestoreController {
+	return &UserRestoreController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary ユーザー復元
+// @Description 無効化・削除したユーザーをログイン可能な状態に戻します。移譲したスケジュールの所有権は戻りません
+// @Produce json
+// @Param userid path string true "UserID"
+// @Success 200 {object} presenter.UserRestoreResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/restore [put]
+func (h *UserRestoreController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDParam := c.Param("userid")
+	userIDint, err := strconv.Atoi(userIDParam)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userIDint, userID)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserTwoFactorRequiredEditController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserTwoFactorRequiredEditController struct {
+		inputPort usecase.IUserTwoFactorRequiredEditInputPort
+		presenter presenter.IUserTwoFactorRequiredEditPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserTwoFactorRequiredEditController(
+	inputPort usecase.IUserTwoFactorRequiredEditInputPort,
+	presenter presenter.IUserTwoFactorRequiredEditPresenter,
+	logger ILogWriter,
+) IUserTwoFactorRequiredEditController {
+	return &UserTwoFactorRequiredEditController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+type (
+	UserTwoFactorRequiredEditRequestData struct {
+		// trueの場合、未登録のユーザーは次回ログイン時に登録が求められ、本人による無効化もできなくなる
+		Required bool `json:"required"`
+	}
+)
+
+// @Summary ユーザーの2段階認証の必須設定
+// @Description ユーザーに2段階認証の利用を必須とするかを設定します
+// @Produce json
+// @Param userid path string true "UserID"
+// @Param request body UserTwoFactorRequiredEditRequestData true "必須設定リクエスト"
+// @Success 200 {object} presenter.TwoFactorStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/two-factor/required [put]
+func (h *UserTwoFactorRequiredEditController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDint, err := strconv.Atoi(c.Param("userid"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	var requestData UserTwoFactorRequiredEditRequestData
+
+	if err := c.Bind(&requestData); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "リクエスト形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, userIDint, requestData.Required)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
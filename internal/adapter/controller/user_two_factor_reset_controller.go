@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserTwoFactorResetController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserTwoFactorResetController struct {
+		inputPort usecase.IUserTwoFactorResetInputPort
+		presenter presenter.IUserTwoFactorResetPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserTwoFactorResetController(
+	inputPort usecase.IUserTwoFactorResetInputPort,
+	presenter presenter.IUserTwoFactorResetPresenter,
+	logger ILogWriter,
+) IUserTwoFactorResetController {
+	return &UserTwoFactorResetController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary ユーザーの2段階認証のリセット
+// @Description 端末を紛失した場合などに、ユーザーの2段階認証の登録とリカバリーコードを削除します
+// @Produce json
+// @Param userid path string true "UserID"
+// @Success 200 {object} presenter.TwoFactorStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/two-factor [delete]
+func (h *UserTwoFactorResetController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDint, err := strconv.Atoi(c.Param("userid"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, userIDint)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
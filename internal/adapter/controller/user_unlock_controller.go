@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
+	session_util "github.com/typedef-tokyo/lessonlink-backend/internal/adapter/utility"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserUnlockController interface {
+		Execute(c echo.Context) error
+	}
+
+	UserUnlockController struct {
+		inputPort usecase.IUserUnlockInputPort
+		presenter presenter.IUserUnlockPresenter
+		logger    ILogWriter
+	}
+)
+
+func NewUserUnlockController(
+	inputPort usecase.IUserUnlockInputPort,
+	presenter presenter.IUserUnlockPresenter,
+	logger ILogWriter,
+) IUserUnlockController {
+	return &UserUnlockController{
+		inputPort: inputPort,
+		presenter: presenter,
+		logger:    logger,
+	}
+}
+
+// @Summary ユーザーのロック解除
+// @Description ログインの失敗が続いたことによるユーザーのロックを解除し、失敗回数を消去します
+// @Produce json
+// @Param userid path string true "UserID"
+// @Success 200 {object} presenter.UserLoginAttemptListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /user/{userid}/unlock [put]
+func (h *UserUnlockController) Execute(c echo.Context) error {
+
+	// セッション情報を取得
+	userID, err := session_util.GetSessionData(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": err.Error(),
+		})
+	}
+
+	userIDint, err := strconv.Atoi(c.Param("userid"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"msg": "ユーザーIDの形式が不正です",
+		})
+	}
+
+	result, err := h.inputPort.Execute(c.Request().Context(), userID, userIDint)
+
+	if err != nil {
+		status, msg := h.logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]any{
+			"msg": msg,
+		})
+	}
+
+	return c.JSON(http.StatusOK, h.presenter.Present(result))
+}
@@ -57,7 +57,7 @@ type (
 func (h *UserUpdateController) Execute(c echo.Context) error {
 
 	// セッション情報を取得
-	userID, roleKey, err := session_util.GetSessionData(c)
+	userID, err := session_util.GetSessionData(c)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{
 			"msg": err.Error(),
@@ -85,7 +85,7 @@ func (h *UserUpdateController) Execute(c echo.Context) error {
 		UserName:    requestData.UserName,
 		Password:    requestData.Password,
 		DisplayName: requestData.DisplayName,
-	}, userID, roleKey)
+	}, userID)
 
 	if err != nil {
 		status, msg := h.logger.WriteErrLog(c, err)
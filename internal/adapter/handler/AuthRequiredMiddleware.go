@@ -3,21 +3,28 @@ package handler
 import (
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
 	logWriter "github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/logger"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/constants"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
 )
 
-func AuthRequiredMiddleware(env configs.EnvConfig, sessionRepository repository.SessionRepository, logger *logWriter.LogWriter) echo.MiddlewareFunc {
+func AuthRequiredMiddleware(env configs.EnvConfig, sessionRepository repository.SessionRepository, apiTokenAuthenticate usecase.IAPITokenAuthenticateInputPort, logger *logWriter.LogWriter) echo.MiddlewareFunc {
 
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 
 		return func(c echo.Context) error {
 
+			// Authorizationヘッダーがある場合はAPIトークンで認証する
+			if authorization := c.Request().Header.Get(echo.HeaderAuthorization); authorization != "" {
+				return authenticateAPIToken(c, next, authorization, apiTokenAuthenticate, logger)
+			}
+
 			cookie, err := c.Cookie(env.SessionName)
 			if err != nil {
 				// Cookieが無い場合・取得できなかった場合
@@ -69,10 +76,42 @@ func AuthRequiredMiddleware(env configs.EnvConfig, sessionRepository repository.
 
 			c.SetCookie(newCookie)
 
+			// ロールはキャンパスごとに異なるためコンテキストには持たせず、認可時に最新のユーザー情報からキャンパスごとに解決する
 			c.Set(constants.USER_IDENTIFIER, sessionEntity.UserID)
-			c.Set(constants.ROLE_IDENTIFIER, sessionEntity.RoleKey)
 
 			return next(c)
 		}
 	}
 }
+
+func authenticateAPIToken(c echo.Context, next echo.HandlerFunc, authorization string, apiTokenAuthenticate usecase.IAPITokenAuthenticateInputPort, logger *logWriter.LogWriter) error {
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	token = strings.TrimSpace(token)
+	if !ok || token == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"msg": "APIトークンが不正です"})
+	}
+
+	result, err := apiTokenAuthenticate.Execute(c.Request().Context(), token)
+	if err != nil {
+		status, msg := logger.WriteErrLog(c, err)
+		return c.JSON(status, map[string]interface{}{
+			"msg": msg,
+		})
+	}
+
+	if result == nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"msg": "APIトークンが無効か有効期限切れです"})
+	}
+
+	// 参照のみのトークンでは更新系のリクエストを受け付けない
+	method := c.Request().Method
+	if result.Scope.IsReadOnly() && method != http.MethodGet && method != http.MethodHead {
+		return c.JSON(http.StatusForbidden, map[string]string{"msg": "このAPIトークンでは参照のみ可能です"})
+	}
+
+	c.Set(constants.USER_IDENTIFIER, result.Session.UserID)
+	c.Set(constants.API_TOKEN_AUTH_IDENTIFIER, true)
+
+	return next(c)
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/constants"
+)
+
+// APIトークンでの認証を受け付けず、ログインしたセッションからの操作のみ許可する
+func SessionOnlyMiddleware() echo.MiddlewareFunc {
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+
+		return func(c echo.Context) error {
+
+			if isAPIToken, _ := c.Get(constants.API_TOKEN_AUTH_IDENTIFIER).(bool); isAPIToken {
+				return c.JSON(http.StatusForbidden, map[string]string{"msg": "この操作はAPIトークンでは実行できません"})
+			}
+
+			return next(c)
+		}
+	}
+}
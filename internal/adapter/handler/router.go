@@ -14,6 +14,7 @@ import (
 	logWriter "github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/logger"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/server"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
 )
 
@@ -22,32 +23,89 @@ func NewRouter(
 	env configs.EnvConfig,
 	logWriter *logWriter.LogWriter,
 	sessionRepository repository.SessionRepository,
+	apiTokenAuthenticate usecase.IAPITokenAuthenticateInputPort,
+	apiTokenListController controller.IAPITokenListController,
+	apiTokenAddController controller.IAPITokenAddController,
+	apiTokenRevokeController controller.IAPITokenRevokeController,
 	campusListController controller.ICampusListController,
+	campusPolicyGetController controller.ICampusPolicyGetController,
+	campusPolicyEditController controller.ICampusPolicyEditController,
+	campusBlockedPeriodGetController controller.ICampusBlockedPeriodGetController,
+	campusBlockedPeriodEditController controller.ICampusBlockedPeriodEditController,
+	campusRoomItemTypeGetController controller.ICampusRoomItemTypeGetController,
+	campusRoomItemTypeEditController controller.ICampusRoomItemTypeEditController,
+	dayBundleListController controller.IDayBundleListController,
+	dayBundleAddController controller.IDayBundleAddController,
+	dayBundleEditController controller.IDayBundleEditController,
+	dayBundleDeleteController controller.IDayBundleDeleteController,
+	dayBundleCollisionGetController controller.IDayBundleCollisionGetController,
 	lessonListController controller.ILessonListController,
 	lessonAddController controller.ILessonAddController,
 	lessonEditController controller.ILessonEditController,
+	roleListController controller.IRoleListController,
+	roleAddController controller.IRoleAddController,
+	roleEditController controller.IRoleEditController,
 	roomListController controller.IRoomListController,
 	roomEditController controller.IRoomEditController,
 	loginUserGetController controller.ILoginUserGetController,
+	scheduleChangeRequestListController controller.IScheduleChangeRequestListController,
+	scheduleChangeRequestAddController controller.IScheduleChangeRequestAddController,
+	scheduleChangeRequestDiffController controller.IScheduleChangeRequestDiffController,
+	scheduleChangeRequestApproveController controller.IScheduleChangeRequestApproveController,
+	scheduleChangeRequestRejectController controller.IScheduleChangeRequestRejectController,
+	scheduleCollaboratorListController controller.IScheduleCollaboratorListController,
+	scheduleCollaboratorEditController controller.IScheduleCollaboratorEditController,
+	scheduleCollaboratorDeleteController controller.IScheduleCollaboratorDeleteController,
+	scheduleOwnerTransferController controller.IScheduleOwnerTransferController,
+	scheduleCommentListController controller.IScheduleCommentListController,
+	scheduleCommentAddController controller.IScheduleCommentAddController,
+	scheduleCommentEditController controller.IScheduleCommentEditController,
+	scheduleCommentDeleteController controller.IScheduleCommentDeleteController,
+	scheduleCommentResolveController controller.IScheduleCommentResolveController,
 	scheduleCreateController controller.IScheduleCreateController,
 	scheduleDeleteController controller.IScheduleDeleteController,
 	scheduleDuplicateController controller.IScheduleDuplicateController,
 	scheduleGetController controller.IScheduleGetController,
 	scheduleItemDivideController controller.IScheduleItemDivideController,
+	scheduleItemGroupController controller.IScheduleItemGroupController,
 	scheduleItemJoinController controller.IScheduleItemJoinController,
 	scheduleItemMoveController controller.IScheduleItemMoveController,
+	scheduleItemPinController controller.IScheduleItemPinController,
 	scheduleItemReturnListController controller.IScheduleItemReturnListController,
 	scheduleItemShiftController controller.IScheduleItemShiftController,
+	scheduleItemSuggestionController controller.IScheduleItemSuggestionController,
+	scheduleItemTimeShiftController controller.IScheduleItemTimeShiftController,
+	scheduleItemUngroupController controller.IScheduleItemUngroupController,
 	scheduleListController controller.IScheduleListController,
+	scheduleRoomClearController controller.IScheduleRoomClearController,
+	scheduleRoomCopyController controller.IScheduleRoomCopyController,
+	scheduleRoomSwapController controller.IScheduleRoomSwapController,
 	scheduleSaveController controller.IScheduleSaveController,
 	scheduleSaveTitleController controller.IScheduleSaveTitleController,
+	scheduleStatusChangeController controller.IScheduleStatusChangeController,
 	scheduleTimeEditController controller.IScheduleTimeEditController,
 	invisibleRoomController controller.IInvisibleRoomController,
+	twoFactorGetController controller.ITwoFactorGetController,
+	twoFactorEnrollController controller.ITwoFactorEnrollController,
+	twoFactorConfirmController controller.ITwoFactorConfirmController,
+	twoFactorDisableController controller.ITwoFactorDisableController,
 	userListController controller.IUserListController,
 	userAddController controller.IUserAddController,
+	userCampusRoleListController controller.IUserCampusRoleListController,
+	userCampusRoleEditController controller.IUserCampusRoleEditController,
 	userDeleteController controller.IUserDeleteController,
+	userDeactivateController controller.IUserDeactivateController,
+	userRestoreController controller.IUserRestoreController,
+	userTwoFactorRequiredEditController controller.IUserTwoFactorRequiredEditController,
+	userTwoFactorResetController controller.IUserTwoFactorResetController,
+	userLoginAttemptListController controller.IUserLoginAttemptListController,
+	userUnlockController controller.IUserUnlockController,
 	userGetController controller.IUserGetController,
 	userLoginController controller.IUserLoginController,
+	userLoginTwoFactorController controller.IUserLoginTwoFactorController,
+	userLoginTwoFactorEnrollController controller.IUserLoginTwoFactorEnrollController,
+	oidcLoginStartController controller.IOIDCLoginStartController,
+	oidcLoginCallbackController controller.IOIDCLoginCallbackController,
 	userLogoutController controller.IUserLogoutController,
 	userUpdateController controller.IUserUpdateController,
 ) *echo.Echo {
@@ -55,6 +113,10 @@ func NewRouter(
 	api := sever.Engine.Group("/api")
 	user := api.Group("/user")
 	user.POST("/login", userLoginController.Execute)
+	user.POST("/login/two-factor", userLoginTwoFactorController.Execute)
+	user.POST("/login/two-factor/enroll", userLoginTwoFactorEnrollController.Execute)
+	user.GET("/oidc/login", oidcLoginStartController.Execute)
+	user.GET("/oidc/callback", oidcLoginCallbackController.Execute)
 
 	sever.Engine.Use(middleware.RequestID())
 	if env.LogErrorRequestDump {
@@ -70,7 +132,7 @@ func NewRouter(
 				transactionID := c.Response().Header().Get(echo.HeaderXRequestID)
 
 				userID := -1
-				_userID, _, err := session_util.GetSessionData(c)
+				_userID, err := session_util.GetSessionData(c)
 				if err == nil {
 					userID = _userID.Value()
 				}
@@ -99,16 +161,27 @@ func NewRouter(
 
 	// -------認証必須-------------------
 	auth := api.Group("")
-	auth.Use(AuthRequiredMiddleware(env, sessionRepository, logWriter))
+	auth.Use(AuthRequiredMiddleware(env, sessionRepository, apiTokenAuthenticate, logWriter))
 
 	campus := auth.Group("/campus")
 	campus.GET("/list", campusListController.Execute)
+	campus.GET("/:campus/policy", campusPolicyGetController.Execute)
+	campus.PUT("/:campus/policy", campusPolicyEditController.Execute)
+	campus.GET("/:campus/blocked-periods", campusBlockedPeriodGetController.Execute)
+	campus.PUT("/:campus/blocked-periods", campusBlockedPeriodEditController.Execute)
+	campus.GET("/:campus/room-item-types", campusRoomItemTypeGetController.Execute)
+	campus.PUT("/:campus/room-item-types", campusRoomItemTypeEditController.Execute)
 
 	lesson := auth.Group("/lesson")
 	lesson.GET("/:campus/list", lessonListController.Execute)
 	lesson.POST("/:campus", lessonAddController.Execute)
 	lesson.PATCH("/:lessonid", lessonEditController.Execute)
 
+	role := auth.Group("/role")
+	role.GET("/list", roleListController.Execute)
+	role.POST("", roleAddController.Execute)
+	role.PUT("/:role_key", roleEditController.Execute)
+
 	room := auth.Group("/room")
 	room.GET("/:campus/list", roomListController.Execute)
 	room.POST("/:campus/edit", roomEditController.Execute)
@@ -123,11 +196,41 @@ func NewRouter(
 	schedule.POST("/:schedule_id/item-divide", scheduleItemDivideController.Execute)
 	schedule.POST("/:schedule_id/item-join", scheduleItemJoinController.Execute)
 	schedule.POST("/:schedule_id/item-shift", scheduleItemShiftController.Execute)
+	schedule.POST("/:schedule_id/item-time-shift", scheduleItemTimeShiftController.Execute)
+	schedule.POST("/:schedule_id/item-pin", scheduleItemPinController.Execute)
+	schedule.POST("/:schedule_id/item-group", scheduleItemGroupController.Execute)
+	schedule.POST("/:schedule_id/item-ungroup", scheduleItemUngroupController.Execute)
+	schedule.POST("/:schedule_id/room-swap", scheduleRoomSwapController.Execute)
+	schedule.POST("/:schedule_id/room-copy", scheduleRoomCopyController.Execute)
+	schedule.POST("/:schedule_id/room-clear", scheduleRoomClearController.Execute)
+	schedule.GET("/:schedule_id/items/:identifier/suggestions", scheduleItemSuggestionController.Execute)
 	schedule.PATCH("/:schedule_id/title", scheduleSaveTitleController.Execute)
 	schedule.DELETE("/:schedule_id", scheduleDeleteController.Execute)
 	schedule.POST("/:schedule_id/duplicate", scheduleDuplicateController.Execute)
 	schedule.PUT("/:schedule_id/room/invisible", invisibleRoomController.Execute)
 	schedule.PATCH("/:schedule_id/time", scheduleTimeEditController.Execute)
+	schedule.PUT("/:schedule_id/status", scheduleStatusChangeController.Execute)
+	schedule.GET("/:schedule_id/comments", scheduleCommentListController.Execute)
+	schedule.POST("/:schedule_id/comments", scheduleCommentAddController.Execute)
+	schedule.PUT("/:schedule_id/comments/:comment_id", scheduleCommentEditController.Execute)
+	schedule.DELETE("/:schedule_id/comments/:comment_id", scheduleCommentDeleteController.Execute)
+	schedule.PUT("/:schedule_id/comments/:comment_id/resolve", scheduleCommentResolveController.Execute)
+	schedule.GET("/:schedule_id/change-requests", scheduleChangeRequestListController.Execute)
+	schedule.POST("/:schedule_id/change-requests", scheduleChangeRequestAddController.Execute)
+	schedule.GET("/:schedule_id/change-requests/:change_request_id/diff", scheduleChangeRequestDiffController.Execute)
+	schedule.PUT("/:schedule_id/change-requests/:change_request_id/approve", scheduleChangeRequestApproveController.Execute)
+	schedule.PUT("/:schedule_id/change-requests/:change_request_id/reject", scheduleChangeRequestRejectController.Execute)
+	schedule.GET("/:schedule_id/collaborators", scheduleCollaboratorListController.Execute)
+	schedule.PUT("/:schedule_id/collaborators/:user_id", scheduleCollaboratorEditController.Execute)
+	schedule.DELETE("/:schedule_id/collaborators/:user_id", scheduleCollaboratorDeleteController.Execute)
+	schedule.PUT("/:schedule_id/owner", scheduleOwnerTransferController.Execute)
+
+	dayBundle := auth.Group("/bundle")
+	dayBundle.GET("/list/:campus", dayBundleListController.Execute)
+	dayBundle.POST("/:campus", dayBundleAddController.Execute)
+	dayBundle.PUT("/:bundle_id", dayBundleEditController.Execute)
+	dayBundle.DELETE("/:bundle_id", dayBundleDeleteController.Execute)
+	dayBundle.GET("/:bundle_id/collisions", dayBundleCollisionGetController.Execute)
 
 	authUser := auth.Group("/user")
 	authUser.GET("/list", userListController.Execute)
@@ -136,8 +239,29 @@ func NewRouter(
 	authUser.POST("", userAddController.Execute)
 	authUser.PUT("", userUpdateController.Execute)
 	authUser.DELETE("/:userid", userDeleteController.Execute)
+	authUser.PUT("/:userid/deactivate", userDeactivateController.Execute)
+	authUser.PUT("/:userid/restore", userRestoreController.Execute)
+	authUser.GET("/:userid/campus-roles", userCampusRoleListController.Execute)
+	authUser.PUT("/:userid/campus-roles", userCampusRoleEditController.Execute)
+	authUser.PUT("/:userid/two-factor/required", userTwoFactorRequiredEditController.Execute)
+	authUser.DELETE("/:userid/two-factor", userTwoFactorResetController.Execute)
+	authUser.GET("/:userid/login-attempts", userLoginAttemptListController.Execute)
+	authUser.PUT("/:userid/unlock", userUnlockController.Execute)
 	authUser.POST("/logout", userLogoutController.Execute)
 
+	// APIトークンの管理はログインしたセッションからのみ行う
+	apiToken := authUser.Group("/self/api-tokens", SessionOnlyMiddleware())
+	apiToken.GET("", apiTokenListController.Execute)
+	apiToken.POST("", apiTokenAddController.Execute)
+	apiToken.DELETE("/:token_id", apiTokenRevokeController.Execute)
+
+	// 2段階認証の設定もログインしたセッションからのみ行う
+	twoFactor := authUser.Group("/self/two-factor", SessionOnlyMiddleware())
+	twoFactor.GET("", twoFactorGetController.Execute)
+	twoFactor.POST("/enroll", twoFactorEnrollController.Execute)
+	twoFactor.POST("/confirm", twoFactorConfirmController.Execute)
+	twoFactor.DELETE("", twoFactorDisableController.Execute)
+
 	initSwagger(env, sever)
 
 	return sever.Engine
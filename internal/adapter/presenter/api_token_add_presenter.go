@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IAPITokenAddPresenter interface {
+	Present(result *usecase.APITokenAddOutput) *APITokenAddResponse
+}
+
+type APITokenAddPresenter struct {
+}
+
+func NewAPITokenAddPresenter() IAPITokenAddPresenter {
+	return &APITokenAddPresenter{}
+}
+
+type (
+	APITokenAddResponse struct {
+		// 平文のトークンはこのレスポンスでのみ返す
+		Token    string       `json:"token"`
+		APIToken *APITokenDTO `json:"api_token"`
+	}
+)
+
+func (h *APITokenAddPresenter) Present(result *usecase.APITokenAddOutput) *APITokenAddResponse {
+
+	return &APITokenAddResponse{
+		Token:    result.Token,
+		APIToken: toAPITokenDTO(result.APIToken),
+	}
+}
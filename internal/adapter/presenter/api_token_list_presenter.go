@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IAPITokenListPresenter interface {
+	Present(result *usecase.APITokenListOutput) *APITokenListResponse
+}
+
+type APITokenListPresenter struct {
+}
+
+func NewAPITokenListPresenter() IAPITokenListPresenter {
+	return &APITokenListPresenter{}
+}
+
+type (
+	APITokenListResponse struct {
+		APITokens []*APITokenDTO `json:"api_tokens"`
+	}
+
+	APITokenDTO struct {
+		TokenID     int        `json:"token_id"`
+		Name        string     `json:"name"`
+		TokenPrefix string     `json:"token_prefix"`
+		Scope       string     `json:"scope"`
+		ExpiresAt   time.Time  `json:"expires_at"`
+		LastUsedAt  *time.Time `json:"last_used_at"`
+		Expired     bool       `json:"expired"`
+		CreatedAt   time.Time  `json:"created_at"`
+	}
+)
+
+func (h *APITokenListPresenter) Present(result *usecase.APITokenListOutput) *APITokenListResponse {
+
+	return toAPITokenListResponse(result)
+}
+
+func toAPITokenListResponse(result *usecase.APITokenListOutput) *APITokenListResponse {
+
+	return &APITokenListResponse{
+		APITokens: lo.Map(result.APITokens, func(item usecase.APITokenDTO, _ int) *APITokenDTO {
+			return toAPITokenDTO(item)
+		}),
+	}
+}
+
+func toAPITokenDTO(apiToken usecase.APITokenDTO) *APITokenDTO {
+
+	return &APITokenDTO{
+		TokenID:     apiToken.ID,
+		Name:        apiToken.Name,
+		TokenPrefix: apiToken.TokenPrefix,
+		Scope:       apiToken.Scope,
+		ExpiresAt:   apiToken.ExpiresAt,
+		LastUsedAt:  apiToken.LastUsedAt,
+		Expired:     apiToken.Expired,
+		CreatedAt:   apiToken.CreatedAt,
+	}
+}
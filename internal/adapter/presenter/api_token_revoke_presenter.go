@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IAPITokenRevokePresenter interface {
+	Present(result *usecase.APITokenListOutput) *APITokenListResponse
+}
+
+type APITokenRevokePresenter struct {
+}
+
+func NewAPITokenRevokePresenter() IAPITokenRevokePresenter {
+	return &APITokenRevokePresenter{}
+}
+
+func (h *APITokenRevokePresenter) Present(result *usecase.APITokenListOutput) *APITokenListResponse {
+
+	return toAPITokenListResponse(result)
+}
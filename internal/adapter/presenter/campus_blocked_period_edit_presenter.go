@@ -0,0 +1,25 @@
+package presenter
+
+type ICampusBlockedPeriodEditPresenter interface {
+	Present() *CampusBlockedPeriodEditResponse
+}
+
+type CampusBlockedPeriodEditPresenter struct {
+}
+
+func NewCampusBlockedPeriodEditPresenter() ICampusBlockedPeriodEditPresenter {
+	return &CampusBlockedPeriodEditPresenter{}
+}
+
+type (
+	CampusBlockedPeriodEditResponse struct {
+		Msg string `json:"msg"`
+	}
+)
+
+func (h *CampusBlockedPeriodEditPresenter) Present() *CampusBlockedPeriodEditResponse {
+
+	return &CampusBlockedPeriodEditResponse{
+		Msg: "更新しました",
+	}
+}
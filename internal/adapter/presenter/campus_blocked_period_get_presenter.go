@@ -0,0 +1,61 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ICampusBlockedPeriodGetPresenter interface {
+	Present(result *usecase.CampusBlockedPeriodGetOutput) *CampusBlockedPeriodGetResponse
+}
+
+type CampusBlockedPeriodGetPresenter struct {
+}
+
+func NewCampusBlockedPeriodGetPresenter() ICampusBlockedPeriodGetPresenter {
+	return &CampusBlockedPeriodGetPresenter{}
+}
+
+type (
+	CampusBlockedPeriodGetResponse struct {
+		Campus         string                    `json:"campus"`
+		BlockedPeriods []*CampusBlockedPeriodDTO `json:"blocked_periods"`
+	}
+
+	CampusBlockedPeriodDTO struct {
+		Title            string `json:"title"`
+		RoomIndex        int    `json:"room_index"`
+		Recurrence       string `json:"recurrence"`
+		Weekday          int    `json:"weekday"`
+		TargetDate       string `json:"target_date"`
+		StartTimeHour    int    `json:"start_time_hour"`
+		StartTimeMinutes int    `json:"start_time_minutes"`
+		EndTimeHour      int    `json:"end_time_hour"`
+		EndTimeMinutes   int    `json:"end_time_minutes"`
+	}
+)
+
+func (h *CampusBlockedPeriodGetPresenter) Present(result *usecase.CampusBlockedPeriodGetOutput) *CampusBlockedPeriodGetResponse {
+
+	return &CampusBlockedPeriodGetResponse{
+		Campus:         result.Campus,
+		BlockedPeriods: ToCampusBlockedPeriodDTOs(result.BlockedPeriods),
+	}
+}
+
+func ToCampusBlockedPeriodDTOs(blockedPeriods []*usecase.CampusBlockedPeriodDTO) []*CampusBlockedPeriodDTO {
+
+	return lo.Map(blockedPeriods, func(item *usecase.CampusBlockedPeriodDTO, _ int) *CampusBlockedPeriodDTO {
+		return &CampusBlockedPeriodDTO{
+			Title:            item.Title,
+			RoomIndex:        item.RoomIndex,
+			Recurrence:       item.Recurrence,
+			Weekday:          item.Weekday,
+			TargetDate:       item.TargetDate,
+			StartTimeHour:    item.StartTimeHour,
+			StartTimeMinutes: item.StartTimeMinutes,
+			EndTimeHour:      item.EndTimeHour,
+			EndTimeMinutes:   item.EndTimeMinutes,
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package presenter
+
+type ICampusPolicyEditPresenter interface {
+	Present() *CampusPolicyEditResponse
+}
+
+type CampusPolicyEditPresenter struct {
+}
+
+func NewCampusPolicyEditPresenter() ICampusPolicyEditPresenter {
+	return &CampusPolicyEditPresenter{}
+}
+
+type (
+	CampusPolicyEditResponse struct {
+		Msg string `json:"msg"`
+	}
+)
+
+func (h *CampusPolicyEditPresenter) Present() *CampusPolicyEditResponse {
+
+	return &CampusPolicyEditResponse{
+		Msg: "更新しました",
+	}
+}
@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ICampusPolicyGetPresenter interface {
+	Present(result *usecase.CampusPolicyGetOutput) *CampusPolicyGetResponse
+}
+
+type CampusPolicyGetPresenter struct {
+}
+
+func NewCampusPolicyGetPresenter() ICampusPolicyGetPresenter {
+	return &CampusPolicyGetPresenter{}
+}
+
+type (
+	CampusPolicyGetResponse struct {
+		Campus            string                  `json:"campus"`
+		GridMinutes       int                     `json:"grid_minutes"`
+		MinLessonDuration int                     `json:"min_lesson_duration"`
+		MaxLessonDuration int                     `json:"max_lesson_duration"`
+		MinGapMinutes     int                     `json:"min_gap_minutes"`
+		OpeningHours      []*CampusOpeningHourDTO `json:"opening_hours"`
+	}
+
+	CampusOpeningHourDTO struct {
+		Weekday          int `json:"weekday"`
+		OpenTimeHour     int `json:"open_time_hour"`
+		OpenTimeMinutes  int `json:"open_time_minutes"`
+		CloseTimeHour    int `json:"close_time_hour"`
+		CloseTimeMinutes int `json:"close_time_minutes"`
+	}
+)
+
+func (h *CampusPolicyGetPresenter) Present(result *usecase.CampusPolicyGetOutput) *CampusPolicyGetResponse {
+
+	return &CampusPolicyGetResponse{
+		Campus:            result.Campus,
+		GridMinutes:       result.GridMinutes,
+		MinLessonDuration: result.MinLessonDuration,
+		MaxLessonDuration: result.MaxLessonDuration,
+		MinGapMinutes:     result.MinGapMinutes,
+		OpeningHours: lo.Map(result.OpeningHours, func(item *usecase.CampusOpeningHourDTO, _ int) *CampusOpeningHourDTO {
+			return &CampusOpeningHourDTO{
+				Weekday:          item.Weekday,
+				OpenTimeHour:     item.OpenTimeHour,
+				OpenTimeMinutes:  item.OpenTimeMinutes,
+				CloseTimeHour:    item.CloseTimeHour,
+				CloseTimeMinutes: item.CloseTimeMinutes,
+			}
+		}),
+	}
+}
@@ -0,0 +1,25 @@
+package presenter
+
+type ICampusRoomItemTypeEditPresenter interface {
+	Present() *CampusRoomItemTypeEditResponse
+}
+
+type CampusRoomItemTypeEditPresenter struct {
+}
+
+func NewCampusRoomItemTypeEditPresenter() ICampusRoomItemTypeEditPresenter {
+	return &CampusRoomItemTypeEditPresenter{}
+}
+
+type (
+	CampusRoomItemTypeEditResponse struct {
+		Msg string `json:"msg"`
+	}
+)
+
+func (h *CampusRoomItemTypeEditPresenter) Present() *CampusRoomItemTypeEditResponse {
+
+	return &CampusRoomItemTypeEditResponse{
+		Msg: "更新しました",
+	}
+}
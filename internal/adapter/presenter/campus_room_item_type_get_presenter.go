@@ -0,0 +1,49 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ICampusRoomItemTypeGetPresenter interface {
+	Present(result *usecase.CampusRoomItemTypeGetOutput) *CampusRoomItemTypeGetResponse
+}
+
+type CampusRoomItemTypeGetPresenter struct {
+}
+
+func NewCampusRoomItemTypeGetPresenter() ICampusRoomItemTypeGetPresenter {
+	return &CampusRoomItemTypeGetPresenter{}
+}
+
+type (
+	CampusRoomItemTypeGetResponse struct {
+		Campus        string                   `json:"campus"`
+		RoomItemTypes []*CampusRoomItemTypeDTO `json:"room_item_types"`
+	}
+
+	CampusRoomItemTypeDTO struct {
+		ItemTag string `json:"item_tag"`
+		Label   string `json:"label"`
+		Color   string `json:"color"`
+	}
+)
+
+func (h *CampusRoomItemTypeGetPresenter) Present(result *usecase.CampusRoomItemTypeGetOutput) *CampusRoomItemTypeGetResponse {
+
+	return &CampusRoomItemTypeGetResponse{
+		Campus:        result.Campus,
+		RoomItemTypes: ToCampusRoomItemTypeDTOs(result.RoomItemTypes),
+	}
+}
+
+func ToCampusRoomItemTypeDTOs(itemTypes []*usecase.CampusRoomItemTypeDTO) []*CampusRoomItemTypeDTO {
+
+	return lo.Map(itemTypes, func(item *usecase.CampusRoomItemTypeDTO, _ int) *CampusRoomItemTypeDTO {
+		return &CampusRoomItemTypeDTO{
+			ItemTag: item.ItemTag,
+			Label:   item.Label,
+			Color:   item.Color,
+		}
+	})
+}
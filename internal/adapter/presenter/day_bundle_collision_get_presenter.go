@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IDayBundleCollisionGetPresenter interface {
+	Present(result *usecase.DayBundleCollisionGetOutput) *DayBundleCollisionGetResponse
+}
+
+type DayBundleCollisionGetPresenter struct {
+}
+
+func NewDayBundleCollisionGetPresenter() IDayBundleCollisionGetPresenter {
+	return &DayBundleCollisionGetPresenter{}
+}
+
+type (
+	DayBundleCollisionGetResponse struct {
+		DayBundle  *DayBundleResponse  `json:"day_bundle"`
+		Collisions []*RoomCollisionDTO `json:"collisions"`
+	}
+
+	RoomCollisionDTO struct {
+		RoomIndex        int    `json:"room_index"`
+		StartTimeHour    int    `json:"start_time_hour"`
+		StartTimeMinutes int    `json:"start_time_minutes"`
+		EndTimeHour      int    `json:"end_time_hour"`
+		EndTimeMinutes   int    `json:"end_time_minutes"`
+		ScheduleID       int    `json:"schedule_id"`
+		Identifier       string `json:"identifier"`
+		OtherScheduleID  int    `json:"other_schedule_id"`
+		OtherIdentifier  string `json:"other_identifier"`
+	}
+)
+
+func (h *DayBundleCollisionGetPresenter) Present(result *usecase.DayBundleCollisionGetOutput) *DayBundleCollisionGetResponse {
+
+	return &DayBundleCollisionGetResponse{
+		DayBundle: toDayBundleResponse(result.DayBundle),
+		Collisions: lo.Map(result.Collisions, func(item *usecase.RoomCollisionDTO, _ int) *RoomCollisionDTO {
+			return &RoomCollisionDTO{
+				RoomIndex:        item.RoomIndex,
+				StartTimeHour:    item.StartTimeHour,
+				StartTimeMinutes: item.StartTimeMinutes,
+				EndTimeHour:      item.EndTimeHour,
+				EndTimeMinutes:   item.EndTimeMinutes,
+				ScheduleID:       item.ScheduleID,
+				Identifier:       item.Identifier,
+				OtherScheduleID:  item.OtherScheduleID,
+				OtherIdentifier:  item.OtherIdentifier,
+			}
+		}),
+	}
+}
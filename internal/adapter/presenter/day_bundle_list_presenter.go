@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IDayBundleListPresenter interface {
+	Present(result *usecase.DayBundleListOutput) *DayBundleListResponse
+}
+
+type DayBundleListPresenter struct {
+}
+
+func NewDayBundleListPresenter() IDayBundleListPresenter {
+	return &DayBundleListPresenter{}
+}
+
+type (
+	DayBundleListResponse struct {
+		Campus     string               `json:"campus"`
+		DayBundles []*DayBundleResponse `json:"day_bundles"`
+	}
+)
+
+func (h *DayBundleListPresenter) Present(result *usecase.DayBundleListOutput) *DayBundleListResponse {
+
+	return &DayBundleListResponse{
+		Campus: result.Campus,
+		DayBundles: lo.Map(result.DayBundles, func(item *usecase.DayBundleDTO, _ int) *DayBundleResponse {
+			return toDayBundleResponse(item)
+		}),
+	}
+}
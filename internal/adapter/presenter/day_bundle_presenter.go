@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IDayBundlePresenter interface {
+	Present(result *usecase.DayBundleDTO) *DayBundleResponse
+}
+
+type DayBundlePresenter struct {
+}
+
+func NewDayBundlePresenter() IDayBundlePresenter {
+	return &DayBundlePresenter{}
+}
+
+type (
+	DayBundleResponse struct {
+		DayBundleID     int    `json:"day_bundle_id"`
+		Campus          string `json:"campus"`
+		Title           string `json:"title"`
+		ScheduleIDs     []int  `json:"schedule_ids"`
+		RefuseCollision bool   `json:"refuse_collision"`
+		CreatedUserID   int    `json:"created_user_id"`
+	}
+)
+
+func (h *DayBundlePresenter) Present(result *usecase.DayBundleDTO) *DayBundleResponse {
+
+	return toDayBundleResponse(result)
+}
+
+func toDayBundleResponse(item *usecase.DayBundleDTO) *DayBundleResponse {
+
+	return &DayBundleResponse{
+		DayBundleID:     item.DayBundleID,
+		Campus:          item.Campus,
+		Title:           item.Title,
+		ScheduleIDs:     item.ScheduleIDs,
+		RefuseCollision: item.RefuseCollision,
+		CreatedUserID:   item.CreatedUserID,
+	}
+}
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleAddPresenter interface {
+		Present(result *usecase.RoleListOutput) *RoleListResponse
+	}
+
+	RoleAddPresenter struct {
+	}
+)
+
+func NewRoleAddPresenter() IRoleAddPresenter {
+	return &RoleAddPresenter{}
+}
+
+func (h *RoleAddPresenter) Present(result *usecase.RoleListOutput) *RoleListResponse {
+
+	return toRoleListResponse(result)
+}
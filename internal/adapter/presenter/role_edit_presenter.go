@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleEditPresenter interface {
+		Present(result *usecase.RoleListOutput) *RoleListResponse
+	}
+
+	RoleEditPresenter struct {
+	}
+)
+
+func NewRoleEditPresenter() IRoleEditPresenter {
+	return &RoleEditPresenter{}
+}
+
+func (h *RoleEditPresenter) Present(result *usecase.RoleListOutput) *RoleListResponse {
+
+	return toRoleListResponse(result)
+}
@@ -0,0 +1,64 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IRoleListPresenter interface {
+		Present(result *usecase.RoleListOutput) *RoleListResponse
+	}
+
+	RoleListPresenter struct {
+	}
+)
+
+func NewRoleListPresenter() IRoleListPresenter {
+	return &RoleListPresenter{}
+}
+
+type (
+	RoleListResponse struct {
+		Roles       []RoleDTO       `json:"roles"`
+		Permissions []PermissionDTO `json:"permissions"`
+	}
+
+	RoleDTO struct {
+		RoleKey  string `json:"role_key"`
+		RoleName string `json:"role_name"`
+		// 既定のロールは変更できない
+		Builtin     bool     `json:"builtin"`
+		Permissions []string `json:"permissions"`
+	}
+
+	PermissionDTO struct {
+		PermissionKey  string `json:"permission_key"`
+		PermissionName string `json:"permission_name"`
+	}
+)
+
+func (h *RoleListPresenter) Present(result *usecase.RoleListOutput) *RoleListResponse {
+
+	return toRoleListResponse(result)
+}
+
+func toRoleListResponse(result *usecase.RoleListOutput) *RoleListResponse {
+
+	return &RoleListResponse{
+		Roles: lo.Map(result.Roles, func(item usecase.RoleDTO, _ int) RoleDTO {
+			return RoleDTO{
+				RoleKey:     item.RoleKey,
+				RoleName:    item.RoleName,
+				Builtin:     item.Builtin,
+				Permissions: item.Permissions,
+			}
+		}),
+		Permissions: lo.Map(result.Permissions, func(item usecase.PermissionDTO, _ int) PermissionDTO {
+			return PermissionDTO{
+				PermissionKey:  item.PermissionKey,
+				PermissionName: item.PermissionName,
+			}
+		}),
+	}
+}
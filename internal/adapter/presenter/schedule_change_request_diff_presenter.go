@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleChangeRequestDiffPresenter interface {
+	Present(result *usecase.ScheduleChangeRequestDiffOutput) *ScheduleChangeRequestDiffResponse
+}
+
+type ScheduleChangeRequestDiffPresenter struct {
+}
+
+func NewScheduleChangeRequestDiffPresenter() IScheduleChangeRequestDiffPresenter {
+	return &ScheduleChangeRequestDiffPresenter{}
+}
+
+type (
+	ScheduleChangeRequestDiffResponse struct {
+		ChangeRequestID     int                              `json:"change_request_id"`
+		BaseHistoryIndex    int                              `json:"base_history_index"`
+		CurrentHistoryIndex int                              `json:"current_history_index"`
+		Applicable          bool                             `json:"applicable"`
+		ApplyError          string                           `json:"apply_error"`
+		Changes             []ScheduleItemPlacementChangeDTO `json:"changes"`
+	}
+
+	ScheduleItemPlacementChangeDTO struct {
+		Identifier       string                    `json:"identifier"`
+		ChangedSinceBase bool                      `json:"changed_since_base"`
+		Current          *ScheduleItemPlacementDTO `json:"current"`
+		Proposed         *ScheduleItemPlacementDTO `json:"proposed"`
+	}
+
+	ScheduleItemPlacementDTO struct {
+		RoomIndex        int `json:"room_index"`
+		StartTimeHour    int `json:"start_time_hour"`
+		StartTimeMinutes int `json:"start_time_minutes"`
+		EndTimeHour      int `json:"end_time_hour"`
+		EndTimeMinutes   int `json:"end_time_minutes"`
+	}
+)
+
+func (h *ScheduleChangeRequestDiffPresenter) Present(result *usecase.ScheduleChangeRequestDiffOutput) *ScheduleChangeRequestDiffResponse {
+
+	return &ScheduleChangeRequestDiffResponse{
+		ChangeRequestID:     result.ChangeRequestID,
+		BaseHistoryIndex:    result.BaseHistoryIndex,
+		CurrentHistoryIndex: result.CurrentHistoryIndex,
+		Applicable:          result.Applicable,
+		ApplyError:          result.ApplyError,
+		Changes: lo.Map(result.Changes, func(item usecase.ScheduleItemPlacementChangeDTO, _ int) ScheduleItemPlacementChangeDTO {
+			return ScheduleItemPlacementChangeDTO{
+				Identifier:       item.Identifier,
+				ChangedSinceBase: item.ChangedSinceBase,
+				Current:          toScheduleItemPlacementDTO(item.Current),
+				Proposed:         toScheduleItemPlacementDTO(item.Proposed),
+			}
+		}),
+	}
+}
+
+// 未配置の場合は nil
+func toScheduleItemPlacementDTO(item *usecase.ScheduleItemPlacementDTO) *ScheduleItemPlacementDTO {
+
+	if item == nil {
+		return nil
+	}
+
+	return &ScheduleItemPlacementDTO{
+		RoomIndex:        item.RoomIndex,
+		StartTimeHour:    item.StartTimeHour,
+		StartTimeMinutes: item.StartTimeMinutes,
+		EndTimeHour:      item.EndTimeHour,
+		EndTimeMinutes:   item.EndTimeMinutes,
+	}
+}
@@ -0,0 +1,34 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleChangeRequestListPresenter interface {
+	Present(result *usecase.ScheduleChangeRequestListOutput) *ScheduleChangeRequestListResponse
+}
+
+type ScheduleChangeRequestListPresenter struct {
+}
+
+func NewScheduleChangeRequestListPresenter() IScheduleChangeRequestListPresenter {
+	return &ScheduleChangeRequestListPresenter{}
+}
+
+type (
+	ScheduleChangeRequestListResponse struct {
+		ScheduleID     int                              `json:"schedule_id"`
+		ChangeRequests []*ScheduleChangeRequestResponse `json:"change_requests"`
+	}
+)
+
+func (h *ScheduleChangeRequestListPresenter) Present(result *usecase.ScheduleChangeRequestListOutput) *ScheduleChangeRequestListResponse {
+
+	return &ScheduleChangeRequestListResponse{
+		ScheduleID: result.ScheduleID,
+		ChangeRequests: lo.Map(result.ChangeRequests, func(item *usecase.ScheduleChangeRequestDTO, _ int) *ScheduleChangeRequestResponse {
+			return toScheduleChangeRequestResponse(item)
+		}),
+	}
+}
@@ -0,0 +1,79 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleChangeRequestPresenter interface {
+	Present(result *usecase.ScheduleChangeRequestDTO) *ScheduleChangeRequestResponse
+}
+
+type ScheduleChangeRequestPresenter struct {
+}
+
+func NewScheduleChangeRequestPresenter() IScheduleChangeRequestPresenter {
+	return &ScheduleChangeRequestPresenter{}
+}
+
+type (
+	ScheduleChangeRequestResponse struct {
+		ChangeRequestID     int                        `json:"change_request_id"`
+		ScheduleID          int                        `json:"schedule_id"`
+		HistoryIndex        int                        `json:"history_index"`
+		RequestUserID       int                        `json:"request_user_id"`
+		RequestUserName     string                     `json:"request_user_name"`
+		Comment             string                     `json:"comment"`
+		Operations          []ScheduleItemOperationDTO `json:"operations"`
+		Status              string                     `json:"status"`
+		ReviewUserID        int                        `json:"review_user_id"`
+		ReviewUserName      string                     `json:"review_user_name"`
+		ReviewComment       string                     `json:"review_comment"`
+		AppliedHistoryIndex int                        `json:"applied_history_index"`
+		CreatedAt           time.Time                  `json:"created_at"`
+		UpdatedAt           time.Time                  `json:"updated_at"`
+	}
+
+	ScheduleItemOperationDTO struct {
+		Operation        string `json:"operation"`
+		Identifier       string `json:"identifier"`
+		RoomIndex        int    `json:"room_index"`
+		StartTimeHour    int    `json:"start_time_hour"`
+		StartTimeMinutes int    `json:"start_time_minutes"`
+	}
+)
+
+func (h *ScheduleChangeRequestPresenter) Present(result *usecase.ScheduleChangeRequestDTO) *ScheduleChangeRequestResponse {
+
+	return toScheduleChangeRequestResponse(result)
+}
+
+func toScheduleChangeRequestResponse(item *usecase.ScheduleChangeRequestDTO) *ScheduleChangeRequestResponse {
+
+	return &ScheduleChangeRequestResponse{
+		ChangeRequestID: item.ChangeRequestID,
+		ScheduleID:      item.ScheduleID,
+		HistoryIndex:    item.HistoryIndex,
+		RequestUserID:   item.RequestUserID,
+		RequestUserName: item.RequestUserName,
+		Comment:         item.Comment,
+		Operations: lo.Map(item.Operations, func(operation usecase.ScheduleItemOperationDTO, _ int) ScheduleItemOperationDTO {
+			return ScheduleItemOperationDTO{
+				Operation:        operation.Operation,
+				Identifier:       operation.Identifier,
+				RoomIndex:        operation.RoomIndex,
+				StartTimeHour:    operation.StartTimeHour,
+				StartTimeMinutes: operation.StartTimeMinutes,
+			}
+		}),
+		Status:              item.Status,
+		ReviewUserID:        item.ReviewUserID,
+		ReviewUserName:      item.ReviewUserName,
+		ReviewComment:       item.ReviewComment,
+		AppliedHistoryIndex: item.AppliedHistoryIndex,
+		CreatedAt:           item.CreatedAt,
+		UpdatedAt:           item.UpdatedAt,
+	}
+}
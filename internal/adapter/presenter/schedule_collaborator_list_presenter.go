@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleCollaboratorListPresenter interface {
+	Present(result *usecase.ScheduleCollaboratorListOutput) *ScheduleCollaboratorListResponse
+}
+
+type ScheduleCollaboratorListPresenter struct {
+}
+
+func NewScheduleCollaboratorListPresenter() IScheduleCollaboratorListPresenter {
+	return &ScheduleCollaboratorListPresenter{}
+}
+
+type (
+	ScheduleCollaboratorListResponse struct {
+		ScheduleID    int                       `json:"schedule_id"`
+		OwnerUserID   int                       `json:"owner_user_id"`
+		OwnerUserName string                    `json:"owner_user_name"`
+		Collaborators []ScheduleCollaboratorDTO `json:"collaborators"`
+	}
+
+	ScheduleCollaboratorDTO struct {
+		UserID     int    `json:"user_id"`
+		UserName   string `json:"user_name"`
+		Permission string `json:"permission"`
+	}
+)
+
+func (h *ScheduleCollaboratorListPresenter) Present(result *usecase.ScheduleCollaboratorListOutput) *ScheduleCollaboratorListResponse {
+
+	return &ScheduleCollaboratorListResponse{
+		ScheduleID:    result.ScheduleID,
+		OwnerUserID:   result.OwnerUserID,
+		OwnerUserName: result.OwnerUserName,
+		Collaborators: toScheduleCollaboratorDTOs(result.Collaborators),
+	}
+}
+
+func toScheduleCollaboratorDTOs(collaborators []usecase.ScheduleCollaboratorDTO) []ScheduleCollaboratorDTO {
+
+	return lo.Map(collaborators, func(item usecase.ScheduleCollaboratorDTO, _ int) ScheduleCollaboratorDTO {
+		return ScheduleCollaboratorDTO{
+			UserID:     item.UserID,
+			UserName:   item.UserName,
+			Permission: item.Permission,
+		}
+	})
+}
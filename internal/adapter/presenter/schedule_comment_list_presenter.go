@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleCommentListPresenter interface {
+	Present(result *usecase.ScheduleCommentListOutput) *ScheduleCommentListResponse
+}
+
+type ScheduleCommentListPresenter struct {
+}
+
+func NewScheduleCommentListPresenter() IScheduleCommentListPresenter {
+	return &ScheduleCommentListPresenter{}
+}
+
+type (
+	ScheduleCommentListResponse struct {
+		ScheduleID int                   `json:"schedule_id"`
+		Comments   []*ScheduleCommentDTO `json:"comments"`
+	}
+
+	ScheduleCommentDTO struct {
+		CommentID    int                   `json:"comment_id"`
+		Identifier   string                `json:"identifier"`
+		AuthorUserID int                   `json:"author_user_id"`
+		AuthorName   string                `json:"author_name"`
+		Body         string                `json:"body"`
+		Resolved     bool                  `json:"resolved"`
+		CreatedAt    time.Time             `json:"created_at"`
+		UpdatedAt    time.Time             `json:"updated_at"`
+		Replies      []*ScheduleCommentDTO `json:"replies"`
+	}
+)
+
+func (h *ScheduleCommentListPresenter) Present(result *usecase.ScheduleCommentListOutput) *ScheduleCommentListResponse {
+
+	return &ScheduleCommentListResponse{
+		ScheduleID: result.ScheduleID,
+		Comments:   toScheduleCommentDTOs(result.Comments),
+	}
+}
+
+func toScheduleCommentDTOs(comments []*usecase.ScheduleCommentDTO) []*ScheduleCommentDTO {
+
+	return lo.Map(comments, func(item *usecase.ScheduleCommentDTO, _ int) *ScheduleCommentDTO {
+		return &ScheduleCommentDTO{
+			CommentID:    item.CommentID,
+			Identifier:   item.Identifier,
+			AuthorUserID: item.AuthorUserID,
+			AuthorName:   item.AuthorName,
+			Body:         item.Body,
+			Resolved:     item.Resolved,
+			CreatedAt:    item.CreatedAt,
+			UpdatedAt:    item.UpdatedAt,
+			Replies:      toScheduleCommentDTOs(item.Replies),
+		}
+	})
+}
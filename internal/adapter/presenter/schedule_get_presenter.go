@@ -22,16 +22,32 @@ func NewScheduleGet(
 
 type (
 	ScheduleGetResponse struct {
-		ScheduleID        int                  `json:"schedule_id"`
-		Campus            string               `json:"campus"`
-		Title             string               `json:"title"`
-		ScheduleStartTime int                  `json:"schedule_start_time"`
-		ScheduleEndTime   int                  `json:"schedule_end_time"`
-		HistoryIndex      int                  `json:"history_index"`
-		Rooms             []ScheduleRoomDTO    `json:"rooms"`
-		LessonItemList    []ScheduleLessonItem `json:"lesson_item_list"`
-		RoomLessonList    []ScheduleRoomLesson `json:"room_lesson_list"`
-		CreatedUserID     int                  `json:"created_user_id"`
+		ScheduleID               int                        `json:"schedule_id"`
+		Campus                   string                     `json:"campus"`
+		Title                    string                     `json:"title"`
+		TargetDate               string                     `json:"target_date"`
+		Status                   string                     `json:"status"`
+		ReviewComment            string                     `json:"review_comment"`
+		ScheduleStartTime        int                        `json:"schedule_start_time"`
+		ScheduleStartTimeMinutes int                        `json:"schedule_start_time_minutes"`
+		ScheduleEndTime          int                        `json:"schedule_end_time"`
+		ScheduleEndTimeMinutes   int                        `json:"schedule_end_time_minutes"`
+		HistoryIndex             int                        `json:"history_index"`
+		Rooms                    []ScheduleRoomDTO          `json:"rooms"`
+		LessonItemList           []ScheduleLessonItem       `json:"lesson_item_list"`
+		RoomLessonList           []ScheduleRoomLesson       `json:"room_lesson_list"`
+		ItemGroupList            []ScheduleItemGroup        `json:"item_group_list"`
+		BlockedPeriods           []*CampusBlockedPeriodDTO  `json:"blocked_periods"`
+		RoomItemTypes            []*CampusRoomItemTypeDTO   `json:"room_item_types"`
+		ItemCommentCounts        []ScheduleItemCommentCount `json:"item_comment_counts"`
+		CreatedUserID            int                        `json:"created_user_id"`
+		Collaborators            []ScheduleCollaboratorDTO  `json:"collaborators"`
+	}
+
+	ScheduleItemCommentCount struct {
+		Identifier      string `json:"identifier"`
+		Count           int    `json:"count"`
+		UnresolvedCount int    `json:"unresolved_count"`
 	}
 
 	ScheduleRoomDTO struct {
@@ -52,24 +68,31 @@ type (
 		LessonID         int    `json:"lesson_id"`
 		Identifier       string `json:"identifier"`
 		LessonName       string `json:"lesson_name"`
+		Title            string `json:"title"`
 		Duration         int    `json:"duration"`
 		StartTimeHour    int    `json:"start_time_hour"`
 		StartTimeMinutes int    `json:"start_time_minutes"`
 		EndTimeHour      int    `json:"end_time_hour"`
 		EndTimeMinutes   int    `json:"end_time_minutes"`
 		RoomIndex        int    `json:"room_index"`
+		Pinned           bool   `json:"pinned"`
 	}
 )
 
 func (h *ScheduleGetPresenter) Present(result *usecase.ScheduleGetOutput) *ScheduleGetResponse {
 
 	return &ScheduleGetResponse{
-		ScheduleID:        result.ScheduleID,
-		Campus:            result.Campus,
-		Title:             result.Title,
-		ScheduleStartTime: result.ScheduleTime.StartTime,
-		ScheduleEndTime:   result.ScheduleTime.EndTime,
-		HistoryIndex:      result.HistoryIndex,
+		ScheduleID:               result.ScheduleID,
+		Campus:                   result.Campus,
+		Title:                    result.Title,
+		TargetDate:               result.TargetDate,
+		Status:                   result.Status,
+		ReviewComment:            result.ReviewComment,
+		ScheduleStartTime:        result.ScheduleTime.StartTime,
+		ScheduleStartTimeMinutes: result.ScheduleTime.StartTimeMinutes,
+		ScheduleEndTime:          result.ScheduleTime.EndTime,
+		ScheduleEndTimeMinutes:   result.ScheduleTime.EndTimeMinutes,
+		HistoryIndex:             result.HistoryIndex,
 		Rooms: lo.Map(result.Rooms, func(item usecase.ScheduleRoomDTO, _ int) ScheduleRoomDTO {
 			return ScheduleRoomDTO{
 				RoomIndex: item.RoomIndex,
@@ -91,14 +114,27 @@ func (h *ScheduleGetPresenter) Present(result *usecase.ScheduleGetOutput) *Sched
 				LessonID:         item.LessonID,
 				Identifier:       item.Identifier,
 				LessonName:       item.LessonName,
+				Title:            item.Title,
 				Duration:         item.Duration,
 				StartTimeHour:    item.StartTime.ScheduleItemTimeHour,
 				StartTimeMinutes: item.StartTime.ScheduleItemTimeMinutes,
 				EndTimeHour:      item.EndTime.ScheduleItemTimeHour,
 				EndTimeMinutes:   item.EndTime.ScheduleItemTimeMinutes,
 				RoomIndex:        item.RoomIndex,
+				Pinned:           item.Pinned,
+			}
+		}),
+		ItemGroupList:  presentScheduleItemGroups(result.ItemGroupList),
+		BlockedPeriods: ToCampusBlockedPeriodDTOs(result.BlockedPeriods),
+		RoomItemTypes:  ToCampusRoomItemTypeDTOs(result.RoomItemTypes),
+		ItemCommentCounts: lo.Map(result.ItemCommentCounts, func(item usecase.ScheduleItemCommentCountDTO, _ int) ScheduleItemCommentCount {
+			return ScheduleItemCommentCount{
+				Identifier:      item.Identifier,
+				Count:           item.Count,
+				UnresolvedCount: item.UnresolvedCount,
 			}
 		}),
 		CreatedUserID: result.CreatedUserID,
+		Collaborators: toScheduleCollaboratorDTOs(result.Collaborators),
 	}
 }
@@ -21,6 +21,8 @@ type (
 		HistoryIndex   int                          `json:"history_index"`
 		LessonItemList []ScheduleItemEditLessonItem `json:"lesson_item_list"`
 		RoomLessonList []ScheduleItemEditRoomLesson `json:"room_lesson_list"`
+		ItemGroupList  []ScheduleItemGroup          `json:"item_group_list"`
+		Warnings       []string                     `json:"warnings,omitempty"`
 	}
 
 	ScheduleItemEditLessonItem struct {
@@ -30,17 +32,29 @@ type (
 		Duration   int    `json:"duration"`
 	}
 
+	ScheduleItemGroup struct {
+		GroupIdentifier string                    `json:"group_identifier"`
+		Members         []ScheduleItemGroupMember `json:"members"`
+	}
+
+	ScheduleItemGroupMember struct {
+		Identifier    string `json:"identifier"`
+		OffsetMinutes int    `json:"offset_minutes"`
+	}
+
 	ScheduleItemEditRoomLesson struct {
 		ItemTag         string `json:"item_tag"`
 		LessonID        int    `json:"lesson_id"`
 		Identifier      string `json:"identifier"`
 		LessonName      string `json:"lesson_name"`
+		Title           string `json:"title"`
 		Duration        int    `json:"duration"`
 		StartTimeHour   int    `json:"start_time_hour"`
 		StartTimeMinute int    `json:"start_time_minutes"`
 		EndTimeHour     int    `json:"end_time_hour"`
 		EndTimeMinute   int    `json:"end_time_minutes"`
 		RoomIndex       int    `json:"room_index"`
+		Pinned          bool   `json:"pinned"`
 	}
 )
 
@@ -63,13 +77,32 @@ func (h *ScheduleItemEditPresenter) Present(result *port.ScheduleItemEditOutput)
 				LessonID:        item.LessonID,
 				Identifier:      item.Identifier,
 				LessonName:      item.LessonName,
+				Title:           item.Title,
 				Duration:        item.Duration,
 				StartTimeHour:   item.StartTime.ScheduleItemTimeHour,
 				StartTimeMinute: item.StartTime.ScheduleItemTimeMinutes,
 				EndTimeHour:     item.EndTime.ScheduleItemTimeHour,
 				EndTimeMinute:   item.EndTime.ScheduleItemTimeMinutes,
 				RoomIndex:       item.RoomIndex,
+				Pinned:          item.Pinned,
 			}
 		}),
+		ItemGroupList: presentScheduleItemGroups(scheduleItem.ItemGroupList),
+		Warnings:      result.Warnings,
 	}
 }
+
+func presentScheduleItemGroups(itemGroups []port.ScheduleItemGroup) []ScheduleItemGroup {
+
+	return lo.Map(itemGroups, func(group port.ScheduleItemGroup, _ int) ScheduleItemGroup {
+		return ScheduleItemGroup{
+			GroupIdentifier: group.GroupIdentifier,
+			Members: lo.Map(group.Members, func(member port.ScheduleItemGroupMember, _ int) ScheduleItemGroupMember {
+				return ScheduleItemGroupMember{
+					Identifier:    member.Identifier,
+					OffsetMinutes: member.OffsetMinutes,
+				}
+			}),
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleItemSuggestionPresenter interface {
+	Present(result *usecase.ScheduleItemSuggestionOutput) *ScheduleItemSuggestionResponse
+}
+
+type ScheduleItemSuggestionPresenter struct {
+}
+
+func NewScheduleItemSuggestionPresenter() IScheduleItemSuggestionPresenter {
+	return &ScheduleItemSuggestionPresenter{}
+}
+
+type (
+	ScheduleItemSuggestionResponse struct {
+		HistoryIndex int                      `json:"history_index"`
+		Suggestions  []ScheduleItemSuggestion `json:"suggestions"`
+	}
+
+	ScheduleItemSuggestion struct {
+		RoomIndex        int  `json:"room_index"`
+		StartTimeHour    int  `json:"start_time_hour"`
+		StartTimeMinutes int  `json:"start_time_minutes"`
+		EndTimeHour      int  `json:"end_time_hour"`
+		EndTimeMinutes   int  `json:"end_time_minutes"`
+		GapCount         int  `json:"gap_count"`
+		PreferredRoom    bool `json:"preferred_room"`
+	}
+)
+
+func (h *ScheduleItemSuggestionPresenter) Present(result *usecase.ScheduleItemSuggestionOutput) *ScheduleItemSuggestionResponse {
+
+	return &ScheduleItemSuggestionResponse{
+		HistoryIndex: result.HistoryIndex,
+		Suggestions: lo.Map(result.Suggestions, func(item usecase.ScheduleItemSuggestionDTO, _ int) ScheduleItemSuggestion {
+			return ScheduleItemSuggestion{
+				RoomIndex:        item.RoomIndex,
+				StartTimeHour:    item.StartTime.Hour,
+				StartTimeMinutes: item.StartTime.Minutes,
+				EndTimeHour:      item.EndTime.Hour,
+				EndTimeMinutes:   item.EndTime.Minutes,
+				GapCount:         item.GapCount,
+				PreferredRoom:    item.IsPreferredRoom,
+			}
+		}),
+	}
+}
@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IScheduleStatusChangePresenter interface {
+	Present(result *usecase.ScheduleStatusChangeOutput) *ScheduleStatusChangeResponse
+}
+
+type ScheduleStatusChangePresenter struct {
+}
+
+func NewScheduleStatusChangePresenter() IScheduleStatusChangePresenter {
+	return &ScheduleStatusChangePresenter{}
+}
+
+type (
+	ScheduleStatusChangeResponse struct {
+		ScheduleID    int    `json:"schedule_id"`
+		Status        string `json:"status"`
+		ReviewComment string `json:"review_comment"`
+	}
+)
+
+func (h *ScheduleStatusChangePresenter) Present(result *usecase.ScheduleStatusChangeOutput) *ScheduleStatusChangeResponse {
+
+	return &ScheduleStatusChangeResponse{
+		ScheduleID:    result.ScheduleID,
+		Status:        result.Status,
+		ReviewComment: result.ReviewComment,
+	}
+}
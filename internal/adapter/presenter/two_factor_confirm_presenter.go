@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ITwoFactorConfirmPresenter interface {
+	Present(result *usecase.TwoFactorConfirmOutput) *TwoFactorConfirmResponse
+}
+
+type TwoFactorConfirmPresenter struct {
+}
+
+func NewTwoFactorConfirmPresenter() ITwoFactorConfirmPresenter {
+	return &TwoFactorConfirmPresenter{}
+}
+
+type (
+	TwoFactorConfirmResponse struct {
+		// 平文のリカバリーコードはこのレスポンスでのみ返す
+		RecoveryCodes []string                 `json:"recovery_codes"`
+		TwoFactor     *TwoFactorStatusResponse `json:"two_factor"`
+	}
+)
+
+func (h *TwoFactorConfirmPresenter) Present(result *usecase.TwoFactorConfirmOutput) *TwoFactorConfirmResponse {
+
+	return &TwoFactorConfirmResponse{
+		RecoveryCodes: result.RecoveryCodes,
+		TwoFactor:     toTwoFactorStatusResponse(result.Status),
+	}
+}
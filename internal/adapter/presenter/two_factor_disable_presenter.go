@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ITwoFactorDisablePresenter interface {
+	Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse
+}
+
+type TwoFactorDisablePresenter struct {
+}
+
+func NewTwoFactorDisablePresenter() ITwoFactorDisablePresenter {
+	return &TwoFactorDisablePresenter{}
+}
+
+func (h *TwoFactorDisablePresenter) Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse {
+
+	return toTwoFactorStatusResponse(result)
+}
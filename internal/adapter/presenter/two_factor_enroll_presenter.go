@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ITwoFactorEnrollPresenter interface {
+	Present(result *usecase.TwoFactorEnrollOutput) *TwoFactorEnrollResponse
+}
+
+type TwoFactorEnrollPresenter struct {
+}
+
+func NewTwoFactorEnrollPresenter() ITwoFactorEnrollPresenter {
+	return &TwoFactorEnrollPresenter{}
+}
+
+type (
+	TwoFactorEnrollResponse struct {
+		// 認証アプリに手入力する場合のシークレット
+		Secret string `json:"secret"`
+		// 認証アプリに読み込ませる otpauth:// 形式のURI(QRコードにして表示する)
+		ProvisioningURI string `json:"provisioning_uri"`
+	}
+)
+
+func (h *TwoFactorEnrollPresenter) Present(result *usecase.TwoFactorEnrollOutput) *TwoFactorEnrollResponse {
+
+	return &TwoFactorEnrollResponse{
+		Secret:          result.Secret,
+		ProvisioningURI: result.ProvisioningURI,
+	}
+}
@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type ITwoFactorGetPresenter interface {
+	Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse
+}
+
+type TwoFactorGetPresenter struct {
+}
+
+func NewTwoFactorGetPresenter() ITwoFactorGetPresenter {
+	return &TwoFactorGetPresenter{}
+}
+
+type (
+	TwoFactorStatusResponse struct {
+		UserID  int  `json:"user_id"`
+		Enabled bool `json:"enabled"`
+		// オーナーによって2段階認証の利用が必須とされているか
+		Required bool `json:"required"`
+		// 未使用のリカバリーコードの数
+		RemainingRecoveryCodes int `json:"remaining_recovery_codes"`
+	}
+)
+
+func (h *TwoFactorGetPresenter) Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse {
+
+	return toTwoFactorStatusResponse(result)
+}
+
+func toTwoFactorStatusResponse(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse {
+
+	return &TwoFactorStatusResponse{
+		UserID:                 result.UserID,
+		Enabled:                result.Enabled,
+		Required:               result.Required,
+		RemainingRecoveryCodes: result.RemainingRecoveryCodes,
+	}
+}
@@ -0,0 +1,23 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserCampusRoleEditPresenter interface {
+		Present(result *usecase.UserCampusRoleListOutput) *UserCampusRoleListResponse
+	}
+
+	UserCampusRoleEditPresenter struct {
+	}
+)
+
+func NewUserCampusRoleEditPresenter() IUserCampusRoleEditPresenter {
+	return &UserCampusRoleEditPresenter{}
+}
+
+func (h *UserCampusRoleEditPresenter) Present(result *usecase.UserCampusRoleListOutput) *UserCampusRoleListResponse {
+
+	return toUserCampusRoleListResponse(result)
+}
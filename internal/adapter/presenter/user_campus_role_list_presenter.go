@@ -0,0 +1,56 @@
+package presenter
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserCampusRoleListPresenter interface {
+		Present(result *usecase.UserCampusRoleListOutput) *UserCampusRoleListResponse
+	}
+
+	UserCampusRoleListPresenter struct {
+	}
+)
+
+func NewUserCampusRoleListPresenter() IUserCampusRoleListPresenter {
+	return &UserCampusRoleListPresenter{}
+}
+
+type (
+	UserCampusRoleListResponse struct {
+		UserID      int                 `json:"user_id"`
+		RoleKey     string              `json:"role_key"`
+		CampusRoles []UserCampusRoleDTO `json:"campus_roles"`
+	}
+
+	UserCampusRoleDTO struct {
+		Campus     string `json:"campus"`
+		CampusName string `json:"campus_name"`
+		RoleKey    string `json:"role_key"`
+		// falseの場合はユーザー全体のロールが適用されている
+		Assigned bool `json:"assigned"`
+	}
+)
+
+func (h *UserCampusRoleListPresenter) Present(result *usecase.UserCampusRoleListOutput) *UserCampusRoleListResponse {
+
+	return toUserCampusRoleListResponse(result)
+}
+
+func toUserCampusRoleListResponse(result *usecase.UserCampusRoleListOutput) *UserCampusRoleListResponse {
+
+	return &UserCampusRoleListResponse{
+		UserID:  result.UserID,
+		RoleKey: result.RoleKey,
+		CampusRoles: lo.Map(result.CampusRoles, func(item usecase.UserCampusRoleDTO, _ int) UserCampusRoleDTO {
+			return UserCampusRoleDTO{
+				Campus:     item.Campus,
+				CampusName: item.CampusName,
+				RoleKey:    item.RoleKey,
+				Assigned:   item.Assigned,
+			}
+		}),
+	}
+}
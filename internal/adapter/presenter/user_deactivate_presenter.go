@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserDeactivatePresenter interface {
+	Present(result *usecase.UserScheduleHandOverOutput) *UserDeleteResponse
+}
+
+type UserDeactivatePresenter struct {
+}
+
+func NewUserDeactivatePresenter() IUserDeactivatePresenter {
+	return &UserDeactivatePresenter{}
+}
+
+func (h *UserDeactivatePresenter) Present(result *usecase.UserScheduleHandOverOutput) *UserDeleteResponse {
+
+	return toUserScheduleHandOverResponse("ユーザーを無効化しました", result)
+}
@@ -1,7 +1,12 @@
 package presenter
 
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
 type IUserDeletePresenter interface {
-	Present() *UserDeleteResponse
+	Present(result *usecase.UserScheduleHandOverOutput) *UserDeleteResponse
 }
 
 type UserDeletePresenter struct {
@@ -13,13 +18,36 @@ func NewUserDeletePresenter() IUserDeletePresenter {
 
 type (
 	UserDeleteResponse struct {
-		Msg string `json:"msg"`
+		Msg                  string                    `json:"msg"`
+		UserID               int                       `json:"user_id"`
+		SuccessorUserID      int                       `json:"successor_user_id"`
+		TransferredSchedules []UserTransferredSchedule `json:"transferred_schedules"`
+		RevokedScheduleIDs   []int                     `json:"revoked_schedule_ids"`
+	}
+
+	UserTransferredSchedule struct {
+		ScheduleID int    `json:"schedule_id"`
+		Title      string `json:"title"`
 	}
 )
 
-func (h *UserDeletePresenter) Present() *UserDeleteResponse {
+func (h *UserDeletePresenter) Present(result *usecase.UserScheduleHandOverOutput) *UserDeleteResponse {
+
+	return toUserScheduleHandOverResponse("ユーザーを削除しました", result)
+}
+
+func toUserScheduleHandOverResponse(msg string, result *usecase.UserScheduleHandOverOutput) *UserDeleteResponse {
 
 	return &UserDeleteResponse{
-		Msg: "ユーザーを削除しました",
+		Msg:             msg,
+		UserID:          result.UserID,
+		SuccessorUserID: result.SuccessorUserID,
+		TransferredSchedules: lo.Map(result.TransferredSchedules, func(item usecase.UserTransferredScheduleDTO, _ int) UserTransferredSchedule {
+			return UserTransferredSchedule{
+				ScheduleID: item.ScheduleID,
+				Title:      item.Title,
+			}
+		}),
+		RevokedScheduleIDs: result.RevokedScheduleIDs,
 	}
 }
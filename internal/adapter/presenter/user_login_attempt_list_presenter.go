@@ -0,0 +1,66 @@
+package presenter
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserLoginAttemptListPresenter interface {
+	Present(result *usecase.UserLoginAttemptListOutput) *UserLoginAttemptListResponse
+}
+
+type UserLoginAttemptListPresenter struct {
+}
+
+func NewUserLoginAttemptListPresenter() IUserLoginAttemptListPresenter {
+	return &UserLoginAttemptListPresenter{}
+}
+
+type (
+	UserLoginAttemptListResponse struct {
+		UserID int `json:"user_id"`
+		// ログインの失敗によりロックされているか
+		Locked      bool       `json:"locked"`
+		LockedUntil *time.Time `json:"locked_until"`
+		// 直近のログインの失敗回数
+		FailureCount int                `json:"failure_count"`
+		Attempts     []*LoginAttemptDTO `json:"attempts"`
+	}
+
+	LoginAttemptDTO struct {
+		ID        int    `json:"id"`
+		UserName  string `json:"user_name"`
+		IPAddress string `json:"ip_address"`
+		UserAgent string `json:"user_agent"`
+		// success, failure, blocked, two_factor_pending, two_factor_failure
+		Result    string    `json:"result"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+)
+
+func (h *UserLoginAttemptListPresenter) Present(result *usecase.UserLoginAttemptListOutput) *UserLoginAttemptListResponse {
+
+	return toUserLoginAttemptListResponse(result)
+}
+
+func toUserLoginAttemptListResponse(result *usecase.UserLoginAttemptListOutput) *UserLoginAttemptListResponse {
+
+	return &UserLoginAttemptListResponse{
+		UserID:       result.UserID,
+		Locked:       result.Locked,
+		LockedUntil:  result.LockedUntil,
+		FailureCount: result.FailureCount,
+		Attempts: lo.Map(result.Attempts, func(item usecase.LoginAttemptDTO, _ int) *LoginAttemptDTO {
+			return &LoginAttemptDTO{
+				ID:        item.ID,
+				UserName:  item.UserName,
+				IPAddress: item.IPAddress,
+				UserAgent: item.UserAgent,
+				Result:    item.Result,
+				CreatedAt: item.CreatedAt,
+			}
+		}),
+	}
+}
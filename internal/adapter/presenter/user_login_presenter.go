@@ -26,18 +26,36 @@ type (
 	UserLoginResponse struct {
 		Msg       string       `json:"msg"`
 		LoginUser LoginUserDTO `json:"login_user"`
+		// trueの場合はログインが完了しておらず、認証コードの入力が必要
+		TwoFactorRequired bool `json:"two_factor_required"`
+		// trueの場合は認証コードの入力前に2段階認証の登録が必要
+		TwoFactorEnrollmentRequired bool `json:"two_factor_enrollment_required"`
 	}
 )
 
 func (h *UserLoginPresenter) Present(result *usecase.UserLoginOutput) *UserLoginResponse {
 
+	msg := "ログインが完了しました"
+	if result.TwoFactorEnrollmentRequired {
+		msg = "2段階認証の登録が必要です"
+	} else if result.TwoFactorRequired {
+		msg = "認証コードを入力してください"
+	}
+
 	return &UserLoginResponse{
-		Msg: "ログインが完了しました",
-		LoginUser: LoginUserDTO{
-			Id:       result.User.ID,
-			Name:     result.User.Name,
-			UserName: result.User.UserName,
-			RoleKey:  result.User.RoleKey,
-		},
+		Msg:                         msg,
+		LoginUser:                   toLoginUserDTO(result.User),
+		TwoFactorRequired:           result.TwoFactorRequired,
+		TwoFactorEnrollmentRequired: result.TwoFactorEnrollmentRequired,
+	}
+}
+
+func toLoginUserDTO(user *usecase.UserLoginOutputDTO) LoginUserDTO {
+
+	return LoginUserDTO{
+		Id:       user.ID,
+		Name:     user.Name,
+		UserName: user.UserName,
+		RoleKey:  user.RoleKey,
 	}
 }
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserLoginTwoFactorPresenter interface {
+	Present(result *usecase.UserLoginOutput) *UserLoginTwoFactorResponse
+}
+
+type UserLoginTwoFactorPresenter struct {
+}
+
+func NewUserLoginTwoFactorPresenter() IUserLoginTwoFactorPresenter {
+	return &UserLoginTwoFactorPresenter{}
+}
+
+type (
+	UserLoginTwoFactorResponse struct {
+		Msg       string       `json:"msg"`
+		LoginUser LoginUserDTO `json:"login_user"`
+		// ログイン時に2段階認証の登録を完了した場合のみ返す。それ以外は空
+		RecoveryCodes []string `json:"recovery_codes"`
+	}
+)
+
+func (h *UserLoginTwoFactorPresenter) Present(result *usecase.UserLoginOutput) *UserLoginTwoFactorResponse {
+
+	recoveryCodes := result.RecoveryCodes
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	return &UserLoginTwoFactorResponse{
+		Msg:           "ログインが完了しました",
+		LoginUser:     toLoginUserDTO(result.User),
+		RecoveryCodes: recoveryCodes,
+	}
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type (
+	IUserRestorePresenter interface {
+		Present(result *usecase.UserRestoreOutput) *UserRestoreResponse
+	}
+
+	UserRestorePresenter struct {
+	}
+)
+
+func NewUserRestorePresenter() IUserRestorePresenter {
+	return &UserRestorePresenter{}
+}
+
+type (
+	UserRestoreResponse struct {
+		Msg      string `json:"msg"`
+		ID       int    `json:"id"`
+		Name     string `json:"name"`
+		UserName string `json:"user_name"`
+		RoleKey  string `json:"role_key"`
+	}
+)
+
+func (h *UserRestorePresenter) Present(result *usecase.UserRestoreOutput) *UserRestoreResponse {
+
+	return &UserRestoreResponse{
+		Msg:      "ユーザーを復元しました",
+		ID:       result.ID,
+		Name:     result.Name,
+		UserName: result.UserName,
+		RoleKey:  result.RoleKey,
+	}
+}
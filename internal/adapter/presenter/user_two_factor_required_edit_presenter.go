@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserTwoFactorRequiredEditPresenter interface {
+	Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse
+}
+
+type UserTwoFactorRequiredEditPresenter struct {
+}
+
+func NewUserTwoFactorRequiredEditPresenter() IUserTwoFactorRequiredEditPresenter {
+	return &UserTwoFactorRequiredEditPresenter{}
+}
+
+func (h *UserTwoFactorRequiredEditPresenter) Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse {
+
+	return toTwoFactorStatusResponse(result)
+}
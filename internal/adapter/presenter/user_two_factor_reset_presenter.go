@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserTwoFactorResetPresenter interface {
+	Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse
+}
+
+type UserTwoFactorResetPresenter struct {
+}
+
+func NewUserTwoFactorResetPresenter() IUserTwoFactorResetPresenter {
+	return &UserTwoFactorResetPresenter{}
+}
+
+func (h *UserTwoFactorResetPresenter) Present(result *usecase.TwoFactorStatusOutput) *TwoFactorStatusResponse {
+
+	return toTwoFactorStatusResponse(result)
+}
@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase"
+)
+
+type IUserUnlockPresenter interface {
+	Present(result *usecase.UserLoginAttemptListOutput) *UserLoginAttemptListResponse
+}
+
+type UserUnlockPresenter struct {
+}
+
+func NewUserUnlockPresenter() IUserUnlockPresenter {
+	return &UserUnlockPresenter{}
+}
+
+func (h *UserUnlockPresenter) Present(result *usecase.UserLoginAttemptListOutput) *UserLoginAttemptListResponse {
+
+	return toUserLoginAttemptListResponse(result)
+}
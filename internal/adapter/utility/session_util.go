@@ -9,17 +9,12 @@ import (
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
 
-func GetSessionData(c echo.Context) (vo.UserID, vo.RoleKey, error) {
+func GetSessionData(c echo.Context) (vo.UserID, error) {
 
 	userID, ok := c.Get(constants.USER_IDENTIFIER).(vo.UserID)
 	if !ok || !userID.IsValid() {
-		return vo.USER_ID_INVALID, vo.ROLE_KEY_INVALID, log.WrapErrorWithStackTrace(errors.New("ユーザーIDが取得できません"))
+		return vo.USER_ID_INVALID, log.WrapErrorWithStackTrace(errors.New("ユーザーIDが取得できません"))
 	}
 
-	roleKey, ok := c.Get(constants.ROLE_IDENTIFIER).(vo.RoleKey)
-	if !ok || !roleKey.IsValid() {
-		return vo.USER_ID_INVALID, vo.ROLE_KEY_INVALID, log.WrapErrorWithStackTrace(errors.New("ロールIDが取得できません"))
-	}
-
-	return userID, roleKey, nil
+	return userID, nil
 }
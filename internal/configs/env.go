@@ -17,6 +17,23 @@ type EnvConfig struct {
 	DbName              string `envconfig:"DB_NAME" required:"true"`
 	SessionName         string `envconfig:"SESSION_NAME" required:"true"`
 	SessionSecretKey    string `envconfig:"SESSION_SECRET_KEY" required:"true"`
+	// ロードバランサー等が付与したX-Forwarded-Forから接続元IPアドレスを取得する
+	TrustXForwardedFor bool `envconfig:"TRUST_X_FORWARDED_FOR" default:"false"`
+
+	// OpenID Connect によるシングルサインオン
+	OIDCEnabled              bool     `envconfig:"OIDC_ENABLED" default:"false"`
+	OIDCIssuerURL            string   `envconfig:"OIDC_ISSUER_URL"`
+	OIDCClientID             string   `envconfig:"OIDC_CLIENT_ID"`
+	OIDCClientSecret         string   `envconfig:"OIDC_CLIENT_SECRET"`
+	OIDCRedirectURL          string   `envconfig:"OIDC_REDIRECT_URL"`
+	OIDCScopes               []string `envconfig:"OIDC_SCOPES" default:"openid,email,profile"`
+	OIDCPostLoginRedirectURL string   `envconfig:"OIDC_POST_LOGIN_REDIRECT_URL"`
+	// 初回ログイン時に、確認済みのメールアドレスと同じユーザー名の既存ユーザーへ自動で紐づける
+	OIDCAutoLinkEnabled     bool   `envconfig:"OIDC_AUTO_LINK_ENABLED" default:"false"`
+	OIDCProvisioningEnabled bool   `envconfig:"OIDC_PROVISIONING_ENABLED" default:"false"`
+	OIDCProvisioningRoleKey string `envconfig:"OIDC_PROVISIONING_ROLE_KEY" default:"viewer"`
+	// 自動での紐づけと作成を許可するメールアドレスのドメイン。未設定の場合はいずれも行わない
+	OIDCAllowedEmailDomains []string `envconfig:"OIDC_ALLOWED_EMAIL_DOMAINS"`
 }
 
 func LoadConfig() EnvConfig {
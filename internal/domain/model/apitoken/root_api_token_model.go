@@ -0,0 +1,153 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+const (
+	// 発行するトークンの接頭辞。ログ等に漏れた際に判別しやすくする
+	TOKEN_PREFIX = "llt_"
+	// 一覧で識別できるよう保存しておく先頭の文字数
+	TOKEN_DISPLAY_PREFIX_LENGTH = 12
+	// 最終利用日時を更新する間隔
+	LAST_USED_UPDATE_INTERVAL = time.Minute
+)
+
+type RootAPITokenModelSlice []*RootAPITokenModel
+
+func (r RootAPITokenModelSlice) FindByID(id vo.APITokenID) (*RootAPITokenModel, bool) {
+
+	return lo.Find(r, func(item *RootAPITokenModel) bool {
+		return item.id == id
+	})
+}
+
+// ユーザーが発行した個人用のAPIトークン。トークン自体はハッシュ化した値のみ保持する
+type RootAPITokenModel struct {
+	id          vo.APITokenID
+	userID      vo.UserID
+	name        vo.APITokenName
+	tokenPrefix string
+	tokenHash   string
+	scope       vo.APITokenScope
+	expiresAt   time.Time
+	lastUsedAt  time.Time
+	createdAt   time.Time
+}
+
+func NewRootAPITokenModel(
+	id vo.APITokenID,
+	userID vo.UserID,
+	name vo.APITokenName,
+	tokenPrefix string,
+	tokenHash string,
+	scope vo.APITokenScope,
+	expiresAt time.Time,
+	lastUsedAt time.Time,
+	createdAt time.Time,
+) *RootAPITokenModel {
+
+	return &RootAPITokenModel{
+		id:          id,
+		userID:      userID,
+		name:        name,
+		tokenPrefix: tokenPrefix,
+		tokenHash:   tokenHash,
+		scope:       scope,
+		expiresAt:   expiresAt,
+		lastUsedAt:  lastUsedAt,
+		createdAt:   createdAt,
+	}
+}
+
+// トークンを発行する。平文のトークンは発行時にのみ返す
+func NewCreateRootAPITokenModel(
+	userID vo.UserID,
+	name vo.APITokenName,
+	scope vo.APITokenScope,
+	lifetimeDays vo.APITokenLifetimeDays,
+) (*RootAPITokenModel, string, error) {
+
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	token := TOKEN_PREFIX + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(random)
+	now := time.Now()
+
+	return &RootAPITokenModel{
+		id:          vo.API_TOKEN_ID_INITIAL,
+		userID:      userID,
+		name:        name,
+		tokenPrefix: token[:TOKEN_DISPLAY_PREFIX_LENGTH],
+		tokenHash:   hash.HashToken(token),
+		scope:       scope,
+		expiresAt:   lifetimeDays.ExpiresAt(now),
+		createdAt:   now,
+	}, token, nil
+}
+
+func (r RootAPITokenModel) ID() vo.APITokenID {
+	return r.id
+}
+
+func (r RootAPITokenModel) UserID() vo.UserID {
+	return r.userID
+}
+
+func (r RootAPITokenModel) Name() vo.APITokenName {
+	return r.name
+}
+
+func (r RootAPITokenModel) TokenPrefix() string {
+	return r.tokenPrefix
+}
+
+func (r RootAPITokenModel) TokenHash() string {
+	return r.tokenHash
+}
+
+func (r RootAPITokenModel) Scope() vo.APITokenScope {
+	return r.scope
+}
+
+func (r RootAPITokenModel) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+
+// 一度も利用されていない場合はゼロ値
+func (r RootAPITokenModel) LastUsedAt() time.Time {
+	return r.lastUsedAt
+}
+
+func (r RootAPITokenModel) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r RootAPITokenModel) IsOwnedBy(userID vo.UserID) bool {
+	return r.userID == userID
+}
+
+func (r RootAPITokenModel) IsExpiredAt(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+// 最終利用日時を記録する。頻繁な書き込みを避けるため一定間隔内の利用は記録しない
+func (r *RootAPITokenModel) MarkUsedAt(now time.Time) bool {
+
+	if !r.lastUsedAt.IsZero() && now.Sub(r.lastUsedAt) < LAST_USED_UPDATE_INTERVAL {
+		return false
+	}
+
+	r.lastUsedAt = now
+
+	return true
+}
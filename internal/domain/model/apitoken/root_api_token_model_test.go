@@ -0,0 +1,116 @@
+package apitoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+)
+
+func TestNewCreateRootAPITokenModel(t *testing.T) {
+
+	apiToken, token, err := NewCreateRootAPITokenModel(vo.UserID(1), vo.APITokenName("エクスポート"), vo.API_TOKEN_SCOPE_READ, vo.APITokenLifetimeDays(30))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(token, TOKEN_PREFIX) {
+		t.Errorf("got token %s, want prefix %s", token, TOKEN_PREFIX)
+	}
+
+	if apiToken.TokenPrefix() != token[:TOKEN_DISPLAY_PREFIX_LENGTH] {
+		t.Errorf("got token prefix %s, want %s", apiToken.TokenPrefix(), token[:TOKEN_DISPLAY_PREFIX_LENGTH])
+	}
+
+	// 平文のトークンは保持しない
+	if apiToken.TokenHash() == token || apiToken.TokenHash() != hash.HashToken(token) {
+		t.Errorf("got token hash %s", apiToken.TokenHash())
+	}
+
+	if got := apiToken.ExpiresAt().Sub(apiToken.CreatedAt()); got != 30*24*time.Hour {
+		t.Errorf("got lifetime %v, want %v", got, 30*24*time.Hour)
+	}
+
+	if !apiToken.LastUsedAt().IsZero() {
+		t.Errorf("got last used at %v, want zero", apiToken.LastUsedAt())
+	}
+}
+
+func TestAPITokenUsage(t *testing.T) {
+
+	createdAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.AddDate(0, 0, 30)
+
+	tests := []struct {
+		name           string
+		lastUsedAt     time.Time
+		now            time.Time
+		wantExpired    bool
+		wantMarked     bool
+		wantLastUsedAt time.Time
+	}{
+		{
+			name:           "初めて利用する",
+			now:            createdAt.Add(time.Hour),
+			wantMarked:     true,
+			wantLastUsedAt: createdAt.Add(time.Hour),
+		},
+		{
+			name:           "前回の利用から一定間隔内は記録しない",
+			lastUsedAt:     createdAt.Add(time.Hour),
+			now:            createdAt.Add(time.Hour + 30*time.Second),
+			wantLastUsedAt: createdAt.Add(time.Hour),
+		},
+		{
+			name:           "前回の利用から一定間隔を過ぎると記録する",
+			lastUsedAt:     createdAt.Add(time.Hour),
+			now:            createdAt.Add(time.Hour + LAST_USED_UPDATE_INTERVAL),
+			wantMarked:     true,
+			wantLastUsedAt: createdAt.Add(time.Hour + LAST_USED_UPDATE_INTERVAL),
+		},
+		{
+			name:           "有効期限の直前",
+			now:            expiresAt.Add(-time.Second),
+			wantMarked:     true,
+			wantLastUsedAt: expiresAt.Add(-time.Second),
+		},
+		{
+			name:           "有効期限ちょうどで期限切れ",
+			now:            expiresAt,
+			wantExpired:    true,
+			wantMarked:     true,
+			wantLastUsedAt: expiresAt,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			apiToken := NewRootAPITokenModel(
+				vo.APITokenID(1),
+				vo.UserID(1),
+				vo.APITokenName("エクスポート"),
+				"llt_ABCDEFGH",
+				"hash",
+				vo.API_TOKEN_SCOPE_READ,
+				expiresAt,
+				tt.lastUsedAt,
+				createdAt,
+			)
+
+			if got := apiToken.IsExpiredAt(tt.now); got != tt.wantExpired {
+				t.Errorf("got expired %t, want %t", got, tt.wantExpired)
+			}
+
+			if got := apiToken.MarkUsedAt(tt.now); got != tt.wantMarked {
+				t.Errorf("got marked %t, want %t", got, tt.wantMarked)
+			}
+
+			if !apiToken.LastUsedAt().Equal(tt.wantLastUsedAt) {
+				t.Errorf("got last used at %v, want %v", apiToken.LastUsedAt(), tt.wantLastUsedAt)
+			}
+		})
+	}
+}
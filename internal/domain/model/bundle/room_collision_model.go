@@ -0,0 +1,90 @@
+package bundle
+
+import (
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type RoomCollisionModelSlice []*RoomCollisionModel
+
+// baseに含まれない衝突のみを返す。編集前から存在する衝突で操作できなくならないようにする
+func (r RoomCollisionModelSlice) Excluding(base RoomCollisionModelSlice) RoomCollisionModelSlice {
+
+	return lo.Filter(r, func(item *RoomCollisionModel, _ int) bool {
+		return !lo.SomeBy(base, func(baseItem *RoomCollisionModel) bool {
+			return baseItem.isSame(item)
+		})
+	})
+}
+
+// 異なるスケジュールのアイテムが同じ教室・同じ時間帯を使用している状態
+type RoomCollisionModel struct {
+	roomIndex       vo.RoomIndex
+	startTime       vo.ScheduleLessonTime
+	endTime         vo.ScheduleLessonTime
+	scheduleID      vo.ScheduleID
+	identifier      vo.Identifier
+	otherScheduleID vo.ScheduleID
+	otherIdentifier vo.Identifier
+}
+
+func NewRoomCollisionModel(
+	roomIndex vo.RoomIndex,
+	startTime vo.ScheduleLessonTime,
+	endTime vo.ScheduleLessonTime,
+	scheduleID vo.ScheduleID,
+	identifier vo.Identifier,
+	otherScheduleID vo.ScheduleID,
+	otherIdentifier vo.Identifier,
+) *RoomCollisionModel {
+
+	return &RoomCollisionModel{
+		roomIndex:       roomIndex,
+		startTime:       startTime,
+		endTime:         endTime,
+		scheduleID:      scheduleID,
+		identifier:      identifier,
+		otherScheduleID: otherScheduleID,
+		otherIdentifier: otherIdentifier,
+	}
+}
+
+func (r RoomCollisionModel) RoomIndex() vo.RoomIndex {
+	return r.roomIndex
+}
+
+// 重なっている時間帯の開始
+func (r RoomCollisionModel) StartTime() vo.ScheduleLessonTime {
+	return r.startTime
+}
+
+// 重なっている時間帯の終了
+func (r RoomCollisionModel) EndTime() vo.ScheduleLessonTime {
+	return r.endTime
+}
+
+func (r RoomCollisionModel) ScheduleID() vo.ScheduleID {
+	return r.scheduleID
+}
+
+func (r RoomCollisionModel) Identifier() vo.Identifier {
+	return r.identifier
+}
+
+func (r RoomCollisionModel) OtherScheduleID() vo.ScheduleID {
+	return r.otherScheduleID
+}
+
+func (r RoomCollisionModel) OtherIdentifier() vo.Identifier {
+	return r.otherIdentifier
+}
+
+// 時間帯は移動で変わるため、アイテムの組み合わせと教室で同一とみなす
+func (r RoomCollisionModel) isSame(other *RoomCollisionModel) bool {
+
+	return r.roomIndex == other.roomIndex &&
+		r.scheduleID == other.scheduleID &&
+		r.identifier == other.identifier &&
+		r.otherScheduleID == other.otherScheduleID &&
+		r.otherIdentifier == other.otherIdentifier
+}
@@ -0,0 +1,116 @@
+package bundle
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type RootDayBundleModelSlice []*RootDayBundleModel
+
+// 同じ日に並行して実施するスケジュールのまとまり。教室の取り合いをスケジュールをまたいで検出する
+type RootDayBundleModel struct {
+	id              vo.DayBundleID
+	campus          vo.Campus
+	title           vo.DayBundleTitle
+	scheduleIDs     []vo.ScheduleID
+	refuseCollision bool
+	createUser      vo.UserID
+}
+
+func NewRootDayBundleModel(
+	id vo.DayBundleID,
+	campus vo.Campus,
+	title vo.DayBundleTitle,
+	scheduleIDs []vo.ScheduleID,
+	refuseCollision bool,
+	createUser vo.UserID,
+) *RootDayBundleModel {
+
+	return &RootDayBundleModel{
+		id:              id,
+		campus:          campus,
+		title:           title,
+		scheduleIDs:     scheduleIDs,
+		refuseCollision: refuseCollision,
+		createUser:      createUser,
+	}
+}
+
+func NewCreateRootDayBundleModel(
+	campus vo.Campus,
+	title vo.DayBundleTitle,
+	scheduleIDs []vo.ScheduleID,
+	refuseCollision bool,
+	createUser vo.UserID,
+) (*RootDayBundleModel, error) {
+
+	if err := validateScheduleIDs(scheduleIDs); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &RootDayBundleModel{
+		id:              vo.DAY_BUNDLE_ID_INITIAL,
+		campus:          campus,
+		title:           title,
+		scheduleIDs:     scheduleIDs,
+		refuseCollision: refuseCollision,
+		createUser:      createUser,
+	}, nil
+}
+
+func validateScheduleIDs(scheduleIDs []vo.ScheduleID) error {
+
+	if len(scheduleIDs) < 2 {
+		return errors.New("同日スケジュールのまとまりには2つ以上のスケジュールを指定してください")
+	}
+
+	if len(lo.Uniq(scheduleIDs)) != len(scheduleIDs) {
+		return errors.New("同じスケジュールが重複して指定されています")
+	}
+
+	return nil
+}
+
+func (r RootDayBundleModel) ID() vo.DayBundleID {
+	return r.id
+}
+
+func (r RootDayBundleModel) Campus() vo.Campus {
+	return r.campus
+}
+
+func (r RootDayBundleModel) Title() vo.DayBundleTitle {
+	return r.title
+}
+
+func (r RootDayBundleModel) ScheduleIDs() []vo.ScheduleID {
+	return r.scheduleIDs
+}
+
+func (r RootDayBundleModel) RefuseCollision() bool {
+	return r.refuseCollision
+}
+
+func (r RootDayBundleModel) CreateUser() vo.UserID {
+	return r.createUser
+}
+
+func (r RootDayBundleModel) Contains(scheduleID vo.ScheduleID) bool {
+	return lo.Contains(r.scheduleIDs, scheduleID)
+}
+
+func (r *RootDayBundleModel) Edit(title vo.DayBundleTitle, scheduleIDs []vo.ScheduleID, refuseCollision bool) error {
+
+	if err := validateScheduleIDs(scheduleIDs); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.title = title
+	r.scheduleIDs = scheduleIDs
+	r.refuseCollision = refuseCollision
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package changerequest
+
+import (
+	"errors"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrChangeRequestAlreadyReviewed = errors.New("既にレビュー済みの変更提案です")
+var ErrChangeRequestRejectReasonEmpty = errors.New("却下の理由が未設定です")
+
+type RootChangeRequestModelSlice []*RootChangeRequestModel
+
+// 編集権限のないユーザーがスケジュールの特定の履歴に対して提案する、アイテム操作のまとまり
+type RootChangeRequestModel struct {
+	id                  vo.ChangeRequestID
+	scheduleID          vo.ScheduleID
+	historyIndex        vo.HistoryIndex
+	requestUserID       vo.UserID
+	comment             vo.CommentBody
+	operations          schedule.ScheduleItemOperationModelSlice
+	status              vo.ChangeRequestStatus
+	reviewUserID        vo.UserID
+	reviewComment       vo.ScheduleReviewComment
+	appliedHistoryIndex vo.HistoryIndex
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+func NewRootChangeRequestModel(
+	id vo.ChangeRequestID,
+	scheduleID vo.ScheduleID,
+	historyIndex vo.HistoryIndex,
+	requestUserID vo.UserID,
+	comment vo.CommentBody,
+	operations schedule.ScheduleItemOperationModelSlice,
+	status vo.ChangeRequestStatus,
+	reviewUserID vo.UserID,
+	reviewComment vo.ScheduleReviewComment,
+	appliedHistoryIndex vo.HistoryIndex,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *RootChangeRequestModel {
+
+	return &RootChangeRequestModel{
+		id:                  id,
+		scheduleID:          scheduleID,
+		historyIndex:        historyIndex,
+		requestUserID:       requestUserID,
+		comment:             comment,
+		operations:          operations,
+		status:              status,
+		reviewUserID:        reviewUserID,
+		reviewComment:       reviewComment,
+		appliedHistoryIndex: appliedHistoryIndex,
+		createdAt:           createdAt,
+		updatedAt:           updatedAt,
+	}
+}
+
+func NewCreateRootChangeRequestModel(
+	scheduleID vo.ScheduleID,
+	historyIndex vo.HistoryIndex,
+	requestUserID vo.UserID,
+	comment vo.CommentBody,
+	operations schedule.ScheduleItemOperationModelSlice,
+) (*RootChangeRequestModel, error) {
+
+	if len(operations) == 0 {
+		return nil, log.WrapErrorWithStackTrace(errors.New("提案するアイテムの操作が指定されていません"))
+	}
+
+	now := time.Now()
+
+	return &RootChangeRequestModel{
+		id:                  vo.CHANGE_REQUEST_ID_INITIAL,
+		scheduleID:          scheduleID,
+		historyIndex:        historyIndex,
+		requestUserID:       requestUserID,
+		comment:             comment,
+		operations:          operations,
+		status:              vo.CHANGE_REQUEST_STATUS_PENDING,
+		reviewUserID:        vo.USER_ID_INITIAL,
+		reviewComment:       vo.SCHEDULE_REVIEW_COMMENT_NONE,
+		appliedHistoryIndex: vo.HISTORY_INDEX_USE_LATEST,
+		createdAt:           now,
+		updatedAt:           now,
+	}, nil
+}
+
+func (r RootChangeRequestModel) ID() vo.ChangeRequestID {
+	return r.id
+}
+
+func (r RootChangeRequestModel) ScheduleID() vo.ScheduleID {
+	return r.scheduleID
+}
+
+// 提案の基準となった履歴番号
+func (r RootChangeRequestModel) HistoryIndex() vo.HistoryIndex {
+	return r.historyIndex
+}
+
+func (r RootChangeRequestModel) RequestUserID() vo.UserID {
+	return r.requestUserID
+}
+
+func (r RootChangeRequestModel) Comment() vo.CommentBody {
+	return r.comment
+}
+
+func (r RootChangeRequestModel) Operations() schedule.ScheduleItemOperationModelSlice {
+	return r.operations
+}
+
+func (r RootChangeRequestModel) Status() vo.ChangeRequestStatus {
+	return r.status
+}
+
+func (r RootChangeRequestModel) ReviewUserID() vo.UserID {
+	return r.reviewUserID
+}
+
+func (r RootChangeRequestModel) ReviewComment() vo.ScheduleReviewComment {
+	return r.reviewComment
+}
+
+// 承認により作成された履歴番号。未承認の場合は 0
+func (r RootChangeRequestModel) AppliedHistoryIndex() vo.HistoryIndex {
+	return r.appliedHistoryIndex
+}
+
+func (r RootChangeRequestModel) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r RootChangeRequestModel) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r RootChangeRequestModel) BelongsTo(scheduleID vo.ScheduleID) bool {
+	return r.scheduleID == scheduleID
+}
+
+func (r *RootChangeRequestModel) Approve(reviewUserID vo.UserID, appliedHistoryIndex vo.HistoryIndex) error {
+
+	if !r.status.IsPending() {
+		return log.WrapErrorWithStackTrace(ErrChangeRequestAlreadyReviewed)
+	}
+
+	r.status = vo.CHANGE_REQUEST_STATUS_APPROVED
+	r.reviewUserID = reviewUserID
+	r.appliedHistoryIndex = appliedHistoryIndex
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
+func (r *RootChangeRequestModel) Reject(reviewUserID vo.UserID, reason vo.ScheduleReviewComment) error {
+
+	if !r.status.IsPending() {
+		return log.WrapErrorWithStackTrace(ErrChangeRequestAlreadyReviewed)
+	}
+
+	if reason.IsNone() {
+		return log.WrapErrorWithStackTrace(ErrChangeRequestRejectReasonEmpty)
+	}
+
+	r.status = vo.CHANGE_REQUEST_STATUS_REJECTED
+	r.reviewUserID = reviewUserID
+	r.reviewComment = reason
+	r.updatedAt = time.Now()
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package comment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type RootCommentModelSlice []*RootCommentModel
+
+func (r RootCommentModelSlice) FindByID(id vo.CommentID) (*RootCommentModel, bool) {
+
+	return lo.Find(r, func(item *RootCommentModel) bool {
+		return item.id == id
+	})
+}
+
+func (r RootCommentModelSlice) FilterByIdentifier(identifier vo.Identifier) RootCommentModelSlice {
+
+	return lo.Filter(r, func(item *RootCommentModel, _ int) bool {
+		return item.identifier == identifier
+	})
+}
+
+// スレッドの起点となるコメント
+func (r RootCommentModelSlice) Threads() RootCommentModelSlice {
+
+	return lo.Filter(r, func(item *RootCommentModel, _ int) bool {
+		return !item.IsReply()
+	})
+}
+
+func (r RootCommentModelSlice) Replies(parentID vo.CommentID) RootCommentModelSlice {
+
+	return lo.Filter(r, func(item *RootCommentModel, _ int) bool {
+		return item.parentID == parentID
+	})
+}
+
+// アイテムに付けられたコメントを識別子ごとにまとめる
+func (r RootCommentModelSlice) GroupByItemIdentifier() map[vo.Identifier]RootCommentModelSlice {
+
+	itemComments := lo.Filter(r, func(item *RootCommentModel, _ int) bool {
+		return item.IsItemComment()
+	})
+
+	return lo.GroupBy(itemComments, func(item *RootCommentModel) vo.Identifier {
+		return item.identifier
+	})
+}
+
+func (r RootCommentModelSlice) CountUnresolved() int {
+
+	return lo.CountBy(r.Threads(), func(item *RootCommentModel) bool {
+		return !item.resolved
+	})
+}
+
+// スケジュールまたはアイテム(識別子)に付けるコメント。返信は1階層のみ
+type RootCommentModel struct {
+	id           vo.CommentID
+	scheduleID   vo.ScheduleID
+	identifier   vo.Identifier
+	parentID     vo.CommentID
+	authorUserID vo.UserID
+	body         vo.CommentBody
+	resolved     bool
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func NewRootCommentModel(
+	id vo.CommentID,
+	scheduleID vo.ScheduleID,
+	identifier vo.Identifier,
+	parentID vo.CommentID,
+	authorUserID vo.UserID,
+	body vo.CommentBody,
+	resolved bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *RootCommentModel {
+
+	return &RootCommentModel{
+		id:           id,
+		scheduleID:   scheduleID,
+		identifier:   identifier,
+		parentID:     parentID,
+		authorUserID: authorUserID,
+		body:         body,
+		resolved:     resolved,
+		createdAt:    createdAt,
+		updatedAt:    updatedAt,
+	}
+}
+
+// identifierが未設定の場合はスケジュール全体へのコメントとなる
+func NewCreateRootCommentModel(
+	scheduleID vo.ScheduleID,
+	identifier vo.Identifier,
+	authorUserID vo.UserID,
+	body vo.CommentBody,
+) *RootCommentModel {
+
+	now := time.Now()
+
+	return &RootCommentModel{
+		id:           vo.COMMENT_ID_INITIAL,
+		scheduleID:   scheduleID,
+		identifier:   identifier,
+		parentID:     vo.COMMENT_ID_INITIAL,
+		authorUserID: authorUserID,
+		body:         body,
+		resolved:     false,
+		createdAt:    now,
+		updatedAt:    now,
+	}
+}
+
+func (r RootCommentModel) ID() vo.CommentID {
+	return r.id
+}
+
+func (r RootCommentModel) ScheduleID() vo.ScheduleID {
+	return r.scheduleID
+}
+
+func (r RootCommentModel) Identifier() vo.Identifier {
+	return r.identifier
+}
+
+func (r RootCommentModel) ParentID() vo.CommentID {
+	return r.parentID
+}
+
+func (r RootCommentModel) AuthorUserID() vo.UserID {
+	return r.authorUserID
+}
+
+func (r RootCommentModel) Body() vo.CommentBody {
+	return r.body
+}
+
+func (r RootCommentModel) IsResolved() bool {
+	return r.resolved
+}
+
+func (r RootCommentModel) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func (r RootCommentModel) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r RootCommentModel) IsReply() bool {
+	return !r.parentID.IsInitial()
+}
+
+func (r RootCommentModel) IsItemComment() bool {
+	return r.identifier != vo.IDENTIFIER_INITIAL
+}
+
+func (r RootCommentModel) IsAuthor(userID vo.UserID) bool {
+	return r.authorUserID == userID
+}
+
+// 返信は起点のコメントと同じスケジュール・アイテムに属する
+func (r RootCommentModel) Reply(authorUserID vo.UserID, body vo.CommentBody) (*RootCommentModel, error) {
+
+	if r.IsReply() {
+		return nil, log.WrapErrorWithStackTrace(errors.New("返信に対して返信することはできません"))
+	}
+
+	reply := NewCreateRootCommentModel(r.scheduleID, r.identifier, authorUserID, body)
+	reply.parentID = r.id
+
+	return reply, nil
+}
+
+func (r *RootCommentModel) Edit(editUserID vo.UserID, body vo.CommentBody) error {
+
+	if !r.IsAuthor(editUserID) {
+		return log.WrapErrorWithStackTrace(errors.New("コメントを編集できるのは投稿者のみです"))
+	}
+
+	r.body = body
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
+func (r *RootCommentModel) Resolve(resolved bool) error {
+
+	if r.IsReply() {
+		return log.WrapErrorWithStackTrace(errors.New("返信のコメントは解決状態を変更できません"))
+	}
+
+	r.resolved = resolved
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
+// 複製先のスケジュールに同じ内容のコメントを作成する。アイテムの識別子は複製後も変わらない
+func (r RootCommentModel) Duplicate(scheduleID vo.ScheduleID, parentID vo.CommentID) *RootCommentModel {
+
+	duplicateComment := &RootCommentModel{}
+	*duplicateComment = r
+	duplicateComment.id = vo.COMMENT_ID_INITIAL
+	duplicateComment.scheduleID = scheduleID
+	duplicateComment.parentID = parentID
+
+	return duplicateComment
+}
@@ -0,0 +1,89 @@
+package identity
+
+import (
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+// 外部のIDプロバイダー(OpenID Connect)のアカウントとユーザーの紐づけ
+// 発行者(issuer)とサブジェクト(sub)の組でアカウントを識別する
+type RootUserIdentityModel struct {
+	id          int
+	userID      vo.UserID
+	issuer      string
+	subject     string
+	email       string
+	lastLoginAt time.Time
+}
+
+func NewRootUserIdentityModel(
+	id int,
+	userID vo.UserID,
+	issuer string,
+	subject string,
+	email string,
+	lastLoginAt time.Time,
+) *RootUserIdentityModel {
+
+	return &RootUserIdentityModel{
+		id:          id,
+		userID:      userID,
+		issuer:      issuer,
+		subject:     subject,
+		email:       email,
+		lastLoginAt: lastLoginAt,
+	}
+}
+
+func NewCreateRootUserIdentityModel(
+	userID vo.UserID,
+	issuer string,
+	subject string,
+	email string,
+) *RootUserIdentityModel {
+
+	return &RootUserIdentityModel{
+		id:          0,
+		userID:      userID,
+		issuer:      issuer,
+		subject:     subject,
+		email:       email,
+		lastLoginAt: time.Now(),
+	}
+}
+
+func (r RootUserIdentityModel) ID() int {
+	return r.id
+}
+
+func (r RootUserIdentityModel) IsInitial() bool {
+	return r.id == 0
+}
+
+func (r RootUserIdentityModel) UserID() vo.UserID {
+	return r.userID
+}
+
+func (r RootUserIdentityModel) Issuer() string {
+	return r.issuer
+}
+
+func (r RootUserIdentityModel) Subject() string {
+	return r.subject
+}
+
+func (r RootUserIdentityModel) Email() string {
+	return r.email
+}
+
+func (r RootUserIdentityModel) LastLoginAt() time.Time {
+	return r.lastLoginAt
+}
+
+// ログインの記録。IDプロバイダー側で変更されたメールアドレスも反映する
+func (r *RootUserIdentityModel) RecordLogin(email string, now time.Time) {
+
+	r.email = email
+	r.lastLoginAt = now
+}
@@ -0,0 +1,79 @@
+package itemtype
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type RootRoomItemTypeModelSlice []*RootRoomItemTypeModel
+
+func (r RootRoomItemTypeModelSlice) IsUniq() bool {
+
+	return len(lo.UniqBy(r, func(item *RootRoomItemTypeModel) vo.RoomItemTag {
+		return item.itemTag
+	})) == len(r)
+}
+
+func (r RootRoomItemTypeModelSlice) FindByItemTag(itemTag vo.RoomItemTag) (*RootRoomItemTypeModel, bool) {
+
+	return lo.Find(r, func(item *RootRoomItemTypeModel) bool {
+		return item.itemTag == itemTag
+	})
+}
+
+// 講座・清掃は常に配置でき、それ以外は校舎に登録された種類のみ配置できる
+func (r RootRoomItemTypeModelSlice) IsPlaceable(itemTag vo.RoomItemTag) bool {
+
+	if !itemTag.IsCustom() {
+		return true
+	}
+
+	_, found := r.FindByItemTag(itemTag)
+	return found
+}
+
+// 校舎ごとに追加するアイテムの種類(行事・試験・会議・予約枠など)
+type RootRoomItemTypeModel struct {
+	campus  vo.Campus
+	itemTag vo.RoomItemTag
+	label   vo.RoomItemTypeLabel
+	color   vo.RoomItemTypeColor
+}
+
+func NewRootRoomItemTypeModel(
+	campus vo.Campus,
+	itemTag vo.RoomItemTag,
+	label vo.RoomItemTypeLabel,
+	color vo.RoomItemTypeColor,
+) (*RootRoomItemTypeModel, error) {
+
+	if !itemTag.IsCustom() {
+		return nil, log.WrapErrorWithStackTrace(errors.New("講座・清掃はアイテムの種類として登録できません"))
+	}
+
+	return &RootRoomItemTypeModel{
+		campus:  campus,
+		itemTag: itemTag,
+		label:   label,
+		color:   color,
+	}, nil
+}
+
+func (r RootRoomItemTypeModel) Campus() vo.Campus {
+	return r.campus
+}
+
+func (r RootRoomItemTypeModel) ItemTag() vo.RoomItemTag {
+	return r.itemTag
+}
+
+func (r RootRoomItemTypeModel) Label() vo.RoomItemTypeLabel {
+	return r.label
+}
+
+func (r RootRoomItemTypeModel) Color() vo.RoomItemTypeColor {
+	return r.color
+}
@@ -0,0 +1,104 @@
+package loginattempt
+
+import (
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+const (
+	// 保存できる最大の文字数。超えた分は切り捨てる
+	MAX_USER_NAME_LENGTH  = 64
+	MAX_IP_ADDRESS_LENGTH = 45
+	MAX_USER_AGENT_LENGTH = 512
+)
+
+// ログインの試行の記録。オーナーが不正なログインの確認に用いる
+type RootLoginAttemptModel struct {
+	id        int
+	userName  string
+	userID    vo.UserID
+	ipAddress string
+	userAgent string
+	result    vo.LoginAttemptResult
+	createdAt time.Time
+}
+
+func NewRootLoginAttemptModel(
+	id int,
+	userName string,
+	userID vo.UserID,
+	ipAddress string,
+	userAgent string,
+	result vo.LoginAttemptResult,
+	createdAt time.Time,
+) *RootLoginAttemptModel {
+
+	return &RootLoginAttemptModel{
+		id:        id,
+		userName:  userName,
+		userID:    userID,
+		ipAddress: ipAddress,
+		userAgent: userAgent,
+		result:    result,
+		createdAt: createdAt,
+	}
+}
+
+// 存在しないユーザー名での試行は userID に USER_ID_INITIAL を指定する
+func NewCreateRootLoginAttemptModel(
+	userName string,
+	userID vo.UserID,
+	ipAddress string,
+	userAgent string,
+	result vo.LoginAttemptResult,
+) *RootLoginAttemptModel {
+
+	return &RootLoginAttemptModel{
+		id:        0,
+		userName:  truncate(userName, MAX_USER_NAME_LENGTH),
+		userID:    userID,
+		ipAddress: truncate(ipAddress, MAX_IP_ADDRESS_LENGTH),
+		userAgent: truncate(userAgent, MAX_USER_AGENT_LENGTH),
+		result:    result,
+		createdAt: time.Now(),
+	}
+}
+
+func (r RootLoginAttemptModel) ID() int {
+	return r.id
+}
+
+func (r RootLoginAttemptModel) UserName() string {
+	return r.userName
+}
+
+func (r RootLoginAttemptModel) UserID() vo.UserID {
+	return r.userID
+}
+
+func (r RootLoginAttemptModel) IPAddress() string {
+	return r.ipAddress
+}
+
+func (r RootLoginAttemptModel) UserAgent() string {
+	return r.userAgent
+}
+
+func (r RootLoginAttemptModel) Result() vo.LoginAttemptResult {
+	return r.result
+}
+
+func (r RootLoginAttemptModel) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+func truncate(value string, length int) string {
+
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+
+	return string(runes[:length])
+}
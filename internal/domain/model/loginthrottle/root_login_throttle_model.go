@@ -0,0 +1,195 @@
+package loginthrottle
+
+import (
+	"strings"
+	"time"
+)
+
+type ThrottleType string
+
+const (
+	// ユーザー名ごとの制限。存在しないユーザー名も同様に制限する
+	THROTTLE_TYPE_ACCOUNT = ThrottleType("account")
+	// 接続元IPアドレスごとの制限
+	THROTTLE_TYPE_IP = ThrottleType("ip")
+
+	// 保存できるキーの最大の文字数
+	MAX_THROTTLE_KEY_LENGTH = 64
+)
+
+// 失敗回数に応じた制限の設定
+type policy struct {
+	// 待ち時間なしで失敗できる回数
+	freeFailures int
+	// 待ち時間の上限
+	maxDelay time.Duration
+	// ロックする失敗回数
+	lockFailures int
+	lockDuration time.Duration
+	// 最後の失敗からこの時間が経過すると失敗回数を数え直す
+	window time.Duration
+}
+
+var policies = map[ThrottleType]policy{
+	THROTTLE_TYPE_ACCOUNT: {
+		freeFailures: 3,
+		maxDelay:     30 * time.Second,
+		lockFailures: 10,
+		lockDuration: 15 * time.Minute,
+		window:       15 * time.Minute,
+	},
+	// 共有のネットワークからの利用を考慮して緩めにする
+	THROTTLE_TYPE_IP: {
+		freeFailures: 10,
+		maxDelay:     30 * time.Second,
+		lockFailures: 50,
+		lockDuration: 15 * time.Minute,
+		window:       15 * time.Minute,
+	},
+}
+
+// ログインの失敗回数と、次に試行できるまでの制限
+// 失敗が続くほど待ち時間を延ばし、一定回数で一時的にロックする
+type RootLoginThrottleModel struct {
+	throttleType ThrottleType
+	key          string
+	failureCount int
+	lastFailedAt time.Time
+	lockedUntil  time.Time
+}
+
+func NewRootLoginThrottleModel(
+	throttleType ThrottleType,
+	key string,
+	failureCount int,
+	lastFailedAt time.Time,
+	lockedUntil time.Time,
+) *RootLoginThrottleModel {
+
+	return &RootLoginThrottleModel{
+		throttleType: throttleType,
+		key:          key,
+		failureCount: failureCount,
+		lastFailedAt: lastFailedAt,
+		lockedUntil:  lockedUntil,
+	}
+}
+
+// 失敗回数が0回の制限。同時の失敗で回数を失わないよう、数える前に作成しておく
+func NewCreateRootLoginThrottleModel(throttleType ThrottleType, key string, now time.Time) *RootLoginThrottleModel {
+
+	return &RootLoginThrottleModel{
+		throttleType: throttleType,
+		key:          key,
+		lastFailedAt: now,
+	}
+}
+
+// ユーザー名の大文字小文字の違いで制限を回避できないようにする
+func AccountKey(userName string) string {
+
+	return truncate(strings.ToLower(strings.TrimSpace(userName)), MAX_THROTTLE_KEY_LENGTH)
+}
+
+func IPKey(ipAddress string) string {
+
+	return truncate(ipAddress, MAX_THROTTLE_KEY_LENGTH)
+}
+
+func (r RootLoginThrottleModel) ThrottleType() ThrottleType {
+	return r.throttleType
+}
+
+func (r RootLoginThrottleModel) Key() string {
+	return r.key
+}
+
+func (r RootLoginThrottleModel) FailureCount() int {
+	return r.failureCount
+}
+
+func (r RootLoginThrottleModel) LastFailedAt() time.Time {
+	return r.lastFailedAt
+}
+
+// ロックされていない場合はゼロ値
+func (r RootLoginThrottleModel) LockedUntil() time.Time {
+	return r.lockedUntil
+}
+
+func (r RootLoginThrottleModel) IsLockedAt(now time.Time) bool {
+	return now.Before(r.lockedUntil)
+}
+
+// 次に試行できる日時。制限されていない場合はゼロ値
+func (r RootLoginThrottleModel) BlockedUntil(now time.Time) time.Time {
+
+	if r.IsLockedAt(now) {
+		return r.lockedUntil
+	}
+
+	if r.isExpiredAt(now) {
+		return time.Time{}
+	}
+
+	next := r.lastFailedAt.Add(r.delay())
+	if now.Before(next) {
+		return next
+	}
+
+	return time.Time{}
+}
+
+// 失敗を記録する。ロックの期限や数え直しの期間が過ぎていれば1回目から数える
+func (r *RootLoginThrottleModel) RecordFailure(now time.Time) {
+
+	if r.isExpiredAt(now) {
+		r.failureCount = 0
+		r.lockedUntil = time.Time{}
+	}
+
+	r.failureCount++
+	r.lastFailedAt = now
+
+	policy := policies[r.throttleType]
+	if r.failureCount >= policy.lockFailures {
+		r.lockedUntil = now.Add(policy.lockDuration)
+	}
+}
+
+func (r RootLoginThrottleModel) isExpiredAt(now time.Time) bool {
+
+	if !r.lockedUntil.IsZero() {
+		return !now.Before(r.lockedUntil)
+	}
+
+	return now.Sub(r.lastFailedAt) >= policies[r.throttleType].window
+}
+
+// 失敗回数に応じて1秒から倍々に延ばす
+func (r RootLoginThrottleModel) delay() time.Duration {
+
+	policy := policies[r.throttleType]
+
+	over := r.failureCount - policy.freeFailures
+	if over <= 0 {
+		return 0
+	}
+
+	delay := time.Second
+	for i := 1; i < over && delay < policy.maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, policy.maxDelay)
+}
+
+func truncate(value string, length int) string {
+
+	runes := []rune(value)
+	if len(runes) <= length {
+		return value
+	}
+
+	return string(runes[:length])
+}
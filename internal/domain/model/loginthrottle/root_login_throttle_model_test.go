@@ -0,0 +1,154 @@
+package loginthrottle
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+// 同じ時刻に指定回数失敗した状態を作成する
+func newTestThrottle(throttleType ThrottleType, failures int) *RootLoginThrottleModel {
+
+	throttle := NewCreateRootLoginThrottleModel(throttleType, "editor@example.com", testNow)
+	for range failures {
+		throttle.RecordFailure(testNow)
+	}
+
+	return throttle
+}
+
+func TestBlockedUntil(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		throttleType ThrottleType
+		failures     int
+		wantDelay    time.Duration
+		wantLocked   bool
+	}{
+		{name: "失敗していない", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 0},
+		{name: "待ち時間なしで失敗できる回数まで", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 3},
+		{name: "超えた1回目は1秒", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 4, wantDelay: time.Second},
+		{name: "2回目は2秒", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 5, wantDelay: 2 * time.Second},
+		{name: "3回目は4秒", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 6, wantDelay: 4 * time.Second},
+		{name: "5回目は16秒", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 8, wantDelay: 16 * time.Second},
+		{name: "上限の30秒を超えない", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 9, wantDelay: 30 * time.Second},
+		{name: "ロックする回数で15分ロックする", throttleType: THROTTLE_TYPE_ACCOUNT, failures: 10, wantDelay: 15 * time.Minute, wantLocked: true},
+		{name: "IPアドレスは失敗できる回数が多い", throttleType: THROTTLE_TYPE_IP, failures: 10},
+		{name: "IPアドレスの超えた1回目は1秒", throttleType: THROTTLE_TYPE_IP, failures: 11, wantDelay: time.Second},
+		{name: "IPアドレスの上限は30秒", throttleType: THROTTLE_TYPE_IP, failures: 49, wantDelay: 30 * time.Second},
+		{name: "IPアドレスのロック", throttleType: THROTTLE_TYPE_IP, failures: 50, wantDelay: 15 * time.Minute, wantLocked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			throttle := newTestThrottle(tt.throttleType, tt.failures)
+
+			if throttle.FailureCount() != tt.failures {
+				t.Errorf("got failure count %d, want %d", throttle.FailureCount(), tt.failures)
+			}
+
+			if got := throttle.IsLockedAt(testNow); got != tt.wantLocked {
+				t.Errorf("got locked %t, want %t", got, tt.wantLocked)
+			}
+
+			want := time.Time{}
+			if tt.wantDelay > 0 {
+				want = testNow.Add(tt.wantDelay)
+			}
+
+			if got := throttle.BlockedUntil(testNow); !got.Equal(want) {
+				t.Errorf("got blocked until %v, want %v", got, want)
+			}
+
+			// 待ち時間が過ぎれば試行できる
+			if got := throttle.BlockedUntil(testNow.Add(tt.wantDelay)); !got.IsZero() {
+				t.Errorf("got blocked until %v after delay, want zero", got)
+			}
+		})
+	}
+}
+
+func TestRecordFailure(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		failures       int
+		failedAt       time.Time
+		wantCount      int
+		wantLocked     bool
+		wantLockExpiry time.Time
+	}{
+		{
+			name:      "数え直しの期間内は加算する",
+			failures:  5,
+			failedAt:  testNow.Add(14 * time.Minute),
+			wantCount: 6,
+		},
+		{
+			name:      "最後の失敗から期間が経過すると1回目から数える",
+			failures:  5,
+			failedAt:  testNow.Add(15 * time.Minute),
+			wantCount: 1,
+		},
+		{
+			name:           "ロック中の失敗はロックを延長する",
+			failures:       10,
+			failedAt:       testNow.Add(time.Minute),
+			wantCount:      11,
+			wantLocked:     true,
+			wantLockExpiry: testNow.Add(16 * time.Minute),
+		},
+		{
+			name:      "ロックの期限が過ぎると1回目から数える",
+			failures:  10,
+			failedAt:  testNow.Add(15 * time.Minute),
+			wantCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			throttle := newTestThrottle(THROTTLE_TYPE_ACCOUNT, tt.failures)
+			throttle.RecordFailure(tt.failedAt)
+
+			if throttle.FailureCount() != tt.wantCount {
+				t.Errorf("got failure count %d, want %d", throttle.FailureCount(), tt.wantCount)
+			}
+
+			if got := throttle.IsLockedAt(tt.failedAt); got != tt.wantLocked {
+				t.Errorf("got locked %t, want %t", got, tt.wantLocked)
+			}
+
+			if !throttle.LockedUntil().Equal(tt.wantLockExpiry) {
+				t.Errorf("got locked until %v, want %v", throttle.LockedUntil(), tt.wantLockExpiry)
+			}
+		})
+	}
+}
+
+func TestThrottleKey(t *testing.T) {
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "ユーザー名の大文字小文字と空白は区別しない", got: AccountKey(" Editor@Example.com "), want: "editor@example.com"},
+		{name: "ユーザー名は最大文字数で切り詰める", got: AccountKey(strings.Repeat("a", 70)), want: strings.Repeat("a", MAX_THROTTLE_KEY_LENGTH)},
+		{name: "IPアドレスはそのまま", got: IPKey("192.0.2.1"), want: "192.0.2.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if tt.got != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
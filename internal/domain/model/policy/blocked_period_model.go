@@ -0,0 +1,132 @@
+package policy
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type BlockedPeriodModelSlice []*BlockedPeriodModel
+
+// 指定した実施日のスケジュールに適用される時間帯を返す。実施日が未設定の場合は毎日の時間帯のみ
+func (r BlockedPeriodModelSlice) FilterByTargetDate(targetDate vo.ScheduleTargetDate) BlockedPeriodModelSlice {
+
+	return lo.Filter(r, func(item *BlockedPeriodModel, _ int) bool {
+		return item.appliesTo(targetDate)
+	})
+}
+
+// 指定した教室の時間範囲と重なる時間帯があるか
+func (r BlockedPeriodModelSlice) IsBlocked(roomIndex vo.RoomIndex, startMinutes int, endMinutes int) bool {
+
+	return lo.SomeBy(r, func(item *BlockedPeriodModel) bool {
+		return item.IsBlocked(roomIndex, startMinutes, endMinutes)
+	})
+}
+
+// 指定した教室の時間範囲と重なる最初の時間帯を返す
+func (r BlockedPeriodModelSlice) FindBlocking(roomIndex vo.RoomIndex, startMinutes int, endMinutes int) (*BlockedPeriodModel, bool) {
+
+	return lo.Find(r, func(item *BlockedPeriodModel) bool {
+		return item.IsBlocked(roomIndex, startMinutes, endMinutes)
+	})
+}
+
+// 講座を配置できない時間帯(昼休み・全体集会・設備点検など)
+type BlockedPeriodModel struct {
+	title      vo.BlockedPeriodTitle
+	roomIndex  vo.RoomIndex
+	recurrence vo.BlockedPeriodRecurrence
+	weekday    vo.Weekday
+	targetDate vo.ScheduleTargetDate
+	period     vo.ScheduleTime
+}
+
+// roomIndexにROOM_INDEX_ALLを指定した場合は校舎の全教室に適用する
+// weekdayは毎週、targetDateは日付指定の場合のみ使用する
+func NewBlockedPeriodModel(
+	title vo.BlockedPeriodTitle,
+	roomIndex vo.RoomIndex,
+	recurrence vo.BlockedPeriodRecurrence,
+	weekday vo.Weekday,
+	targetDate vo.ScheduleTargetDate,
+	period vo.ScheduleTime,
+) (*BlockedPeriodModel, error) {
+
+	if recurrence.IsWeekly() && weekday == vo.WEEKDAY_INVALID {
+		return nil, log.WrapErrorWithStackTrace(errors.New("毎週の利用不可時間帯には曜日を指定してください"))
+	}
+
+	if recurrence.IsDate() && targetDate.IsNone() {
+		return nil, log.WrapErrorWithStackTrace(errors.New("日付指定の利用不可時間帯には日付を指定してください"))
+	}
+
+	if !recurrence.IsWeekly() {
+		weekday = vo.WEEKDAY_INVALID
+	}
+
+	if !recurrence.IsDate() {
+		targetDate = vo.SCHEDULE_TARGET_DATE_NONE
+	}
+
+	return &BlockedPeriodModel{
+		title:      title,
+		roomIndex:  roomIndex,
+		recurrence: recurrence,
+		weekday:    weekday,
+		targetDate: targetDate,
+		period:     period,
+	}, nil
+}
+
+func (r BlockedPeriodModel) Title() vo.BlockedPeriodTitle {
+	return r.title
+}
+
+func (r BlockedPeriodModel) RoomIndex() vo.RoomIndex {
+	return r.roomIndex
+}
+
+func (r BlockedPeriodModel) IsAllRooms() bool {
+	return r.roomIndex == vo.ROOM_INDEX_ALL
+}
+
+func (r BlockedPeriodModel) Recurrence() vo.BlockedPeriodRecurrence {
+	return r.recurrence
+}
+
+func (r BlockedPeriodModel) Weekday() vo.Weekday {
+	return r.weekday
+}
+
+func (r BlockedPeriodModel) TargetDate() vo.ScheduleTargetDate {
+	return r.targetDate
+}
+
+func (r BlockedPeriodModel) Period() vo.ScheduleTime {
+	return r.period
+}
+
+func (r BlockedPeriodModel) appliesTo(targetDate vo.ScheduleTargetDate) bool {
+
+	switch r.recurrence {
+	case vo.BLOCKED_PERIOD_RECURRENCE_WEEKLY:
+		weekday, ok := targetDate.Weekday()
+		return ok && weekday == r.weekday
+	case vo.BLOCKED_PERIOD_RECURRENCE_DATE:
+		return !targetDate.IsNone() && targetDate.Value() == r.targetDate.Value()
+	default:
+		return true
+	}
+}
+
+func (r BlockedPeriodModel) IsBlocked(roomIndex vo.RoomIndex, startMinutes int, endMinutes int) bool {
+
+	if !r.IsAllRooms() && r.roomIndex != roomIndex {
+		return false
+	}
+
+	return r.period.StartTimeValueMinutes() < endMinutes && startMinutes < r.period.EndTimeValueMinutes()
+}
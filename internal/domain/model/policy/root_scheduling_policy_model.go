@@ -0,0 +1,212 @@
+package policy
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type OpeningHourModelSlice []*OpeningHourModel
+
+func (r OpeningHourModelSlice) IsUniq() bool {
+
+	return len(lo.UniqBy(r, func(item *OpeningHourModel) vo.Weekday {
+		return item.weekday
+	})) == len(r)
+}
+
+func (r OpeningHourModelSlice) findByWeekday(weekday vo.Weekday) (*OpeningHourModel, bool) {
+
+	return lo.Find(r, func(item *OpeningHourModel) bool {
+		return item.weekday == weekday
+	})
+}
+
+// 曜日ごとの開校時間。登録のない曜日は休校日として扱う
+type OpeningHourModel struct {
+	weekday vo.Weekday
+	hours   vo.ScheduleTime
+}
+
+func NewOpeningHourModel(
+	weekday vo.Weekday,
+	hours vo.ScheduleTime,
+) *OpeningHourModel {
+
+	return &OpeningHourModel{
+		weekday: weekday,
+		hours:   hours,
+	}
+}
+
+func (r OpeningHourModel) Weekday() vo.Weekday {
+	return r.weekday
+}
+
+func (r OpeningHourModel) Hours() vo.ScheduleTime {
+	return r.hours
+}
+
+func (r OpeningHourModel) contains(scheduleTime vo.ScheduleTime) bool {
+
+	return r.hours.StartTimeValueMinutes() <= scheduleTime.StartTimeValueMinutes() &&
+		scheduleTime.EndTimeValueMinutes() <= r.hours.EndTimeValueMinutes()
+}
+
+type RootSchedulingPolicyModel struct {
+	campus            vo.Campus
+	gridMinutes       vo.PolicyGridMinutes
+	minLessonDuration vo.LessonDuration
+	maxLessonDuration vo.LessonDuration
+	minGapMinutes     vo.PolicyGapMinutes
+	openingHours      OpeningHourModelSlice
+	blockedPeriods    BlockedPeriodModelSlice
+}
+
+func NewRootSchedulingPolicyModel(
+	campus vo.Campus,
+	gridMinutes vo.PolicyGridMinutes,
+	minLessonDuration vo.LessonDuration,
+	maxLessonDuration vo.LessonDuration,
+	minGapMinutes vo.PolicyGapMinutes,
+	openingHours OpeningHourModelSlice,
+	blockedPeriods BlockedPeriodModelSlice,
+) (*RootSchedulingPolicyModel, error) {
+
+	if minLessonDuration > maxLessonDuration {
+		return nil, log.WrapErrorWithStackTrace(log.Errorf("講座時間の最小値が最大値を超えています 最小:%d分, 最大:%d分", minLessonDuration.Value(), maxLessonDuration.Value()))
+	}
+
+	if !openingHours.IsUniq() {
+		return nil, log.WrapErrorWithStackTrace(errors.New("開校時間の曜日が重複しています"))
+	}
+
+	return &RootSchedulingPolicyModel{
+		campus:            campus,
+		gridMinutes:       gridMinutes,
+		minLessonDuration: minLessonDuration,
+		maxLessonDuration: maxLessonDuration,
+		minGapMinutes:     minGapMinutes,
+		openingHours:      openingHours,
+		blockedPeriods:    blockedPeriods,
+	}, nil
+}
+
+// ポリシー未登録の校舎に適用する既定値。従来の制約と同じ動作になる
+func NewDefaultSchedulingPolicyModel(campus vo.Campus) *RootSchedulingPolicyModel {
+
+	const default_max_lesson_duration = 60 * 5
+
+	return &RootSchedulingPolicyModel{
+		campus:            campus,
+		gridMinutes:       vo.POLICY_GRID_MINUTES_DEFAULT,
+		minLessonDuration: vo.LessonDuration(1),
+		maxLessonDuration: vo.LessonDuration(default_max_lesson_duration),
+		minGapMinutes:     vo.POLICY_GAP_MINUTES_NONE,
+		openingHours:      []*OpeningHourModel{},
+		blockedPeriods:    []*BlockedPeriodModel{},
+	}
+}
+
+func (r RootSchedulingPolicyModel) Campus() vo.Campus {
+	return r.campus
+}
+
+func (r RootSchedulingPolicyModel) GridMinutes() vo.PolicyGridMinutes {
+	return r.gridMinutes
+}
+
+func (r RootSchedulingPolicyModel) MinLessonDuration() vo.LessonDuration {
+	return r.minLessonDuration
+}
+
+func (r RootSchedulingPolicyModel) MaxLessonDuration() vo.LessonDuration {
+	return r.maxLessonDuration
+}
+
+func (r RootSchedulingPolicyModel) MinGapMinutes() vo.PolicyGapMinutes {
+	return r.minGapMinutes
+}
+
+func (r RootSchedulingPolicyModel) OpeningHours() OpeningHourModelSlice {
+	return r.openingHours
+}
+
+func (r RootSchedulingPolicyModel) BlockedPeriods() BlockedPeriodModelSlice {
+	return r.blockedPeriods
+}
+
+func (r *RootSchedulingPolicyModel) ReplaceBlockedPeriods(blockedPeriods BlockedPeriodModelSlice) {
+
+	r.blockedPeriods = blockedPeriods
+}
+
+func (r RootSchedulingPolicyModel) ValidateLessonDuration(duration vo.LessonDuration) error {
+
+	if duration < r.minLessonDuration {
+		return log.WrapErrorWithStackTrace(log.Errorf("講座時間が校舎の最小時間を下回っています 最小:%d分, 指定:%d分", r.minLessonDuration.Value(), duration.Value()))
+	}
+
+	if duration > r.maxLessonDuration {
+		return log.WrapErrorWithStackTrace(log.Errorf("講座時間が校舎の最大時間を超えています 最大:%d分, 指定:%d分", r.maxLessonDuration.Value(), duration.Value()))
+	}
+
+	return nil
+}
+
+func (r RootSchedulingPolicyModel) ValidateStartTime(startTime vo.ScheduleLessonTime) error {
+
+	if !r.gridMinutes.IsAligned(startTime.ValueMinutes()) {
+		hour, minutes := startTime.Value()
+		return log.WrapErrorWithStackTrace(log.Errorf("開始時刻が%d分刻みになっていません:%d時%d分", r.gridMinutes.Value(), hour, minutes))
+	}
+
+	return nil
+}
+
+func (r RootSchedulingPolicyModel) ValidateGap(prevEndTime vo.ScheduleLessonTime, nextStartTime vo.ScheduleLessonTime) error {
+
+	gap := nextStartTime.ValueMinutes() - prevEndTime.ValueMinutes()
+	if gap < r.minGapMinutes.Value() {
+		return log.WrapErrorWithStackTrace(log.Errorf("講座の間隔が%d分未満です 間隔:%d分", r.minGapMinutes.Value(), gap))
+	}
+
+	return nil
+}
+
+// スケジュール時間が刻みに乗っており開校時間に収まるかを検証する
+// 実施日が未設定の場合はいずれかの曜日の開校時間に収まればよい
+func (r RootSchedulingPolicyModel) ValidateScheduleTime(targetDate vo.ScheduleTargetDate, scheduleTime vo.ScheduleTime) error {
+
+	if !r.gridMinutes.IsAligned(scheduleTime.StartTimeValueMinutes()) || !r.gridMinutes.IsAligned(scheduleTime.EndTimeValueMinutes()) {
+		return log.WrapErrorWithStackTrace(log.Errorf("スケジュール時間が%d分刻みになっていません", r.gridMinutes.Value()))
+	}
+
+	// 開校時間が未登録の場合は終日開校
+	if len(r.openingHours) == 0 {
+		return nil
+	}
+
+	weekday, ok := targetDate.Weekday()
+	if !ok {
+		if !lo.SomeBy(r.openingHours, func(item *OpeningHourModel) bool {
+			return item.contains(scheduleTime)
+		}) {
+			return log.WrapErrorWithStackTrace(errors.New("スケジュール時間がいずれの曜日の開校時間にも収まりません"))
+		}
+		return nil
+	}
+
+	openingHour, found := r.openingHours.findByWeekday(weekday)
+	if !found {
+		return log.WrapErrorWithStackTrace(log.Errorf("実施日は休校日です:%s", targetDate.Value()))
+	}
+
+	if !openingHour.contains(scheduleTime) {
+		return log.WrapErrorWithStackTrace(log.Errorf("スケジュール時間が実施日の開校時間外です:%s", targetDate.Value()))
+	}
+
+	return nil
+}
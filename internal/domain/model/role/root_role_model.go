@@ -1,12 +1,16 @@
 package role
 
 import (
+	"errors"
 	"sort"
 
 	"github.com/samber/lo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
 
+var ErrRoleBuiltin = errors.New("既定のロールは変更できません")
+
 type RootRoleModelSlice []*RootRoleModel
 
 func (r RootRoleModelSlice) FindNameByKey(key vo.RoleKey) vo.RoleName {
@@ -23,6 +27,15 @@ func (r RootRoleModelSlice) FindNameByKey(key vo.RoleKey) vo.RoleName {
 
 }
 
+func (r RootRoleModelSlice) FindByKey(key vo.RoleKey) *RootRoleModel {
+
+	item, _ := lo.Find(r, func(item *RootRoleModel) bool {
+		return item.roleKey == key
+	})
+
+	return item
+}
+
 func (r RootRoleModelSlice) Sort() {
 
 	sort.Slice(r, func(i, j int) bool {
@@ -44,21 +57,42 @@ func (r RootRoleModelSlice) IsOwner(roleKey vo.RoleKey) bool {
 }
 
 type RootRoleModel struct {
-	id       vo.RoleID
-	roleKey  vo.RoleKey
-	roleName vo.RoleName
+	id          vo.RoleID
+	roleKey     vo.RoleKey
+	roleName    vo.RoleName
+	builtin     bool
+	permissions vo.Permissions
 }
 
 func NewRootRoleModel(
 	id vo.RoleID,
 	roleKey vo.RoleKey,
 	roleName vo.RoleName,
+	builtin bool,
+	permissions vo.Permissions,
 ) *RootRoleModel {
 
 	return &RootRoleModel{
-		id:       id,
-		roleKey:  roleKey,
-		roleName: roleName,
+		id:          id,
+		roleKey:     roleKey,
+		roleName:    roleName,
+		builtin:     builtin,
+		permissions: permissions,
+	}
+}
+
+func NewCreateRoleModel(
+	roleKey vo.RoleKey,
+	roleName vo.RoleName,
+	permissions vo.Permissions,
+) *RootRoleModel {
+
+	return &RootRoleModel{
+		id:          vo.ROLE_ID_INITIAL,
+		roleKey:     roleKey,
+		roleName:    roleName,
+		builtin:     false,
+		permissions: lo.Uniq(permissions),
 	}
 }
 
@@ -73,3 +107,29 @@ func (r RootRoleModel) RoleKey() vo.RoleKey {
 func (r RootRoleModel) RoleName() vo.RoleName {
 	return r.roleName
 }
+
+// オーナー・編集者・閲覧者の既定のロール
+func (r RootRoleModel) IsBuiltin() bool {
+	return r.builtin
+}
+
+func (r RootRoleModel) Permissions() vo.Permissions {
+	return r.permissions
+}
+
+func (r RootRoleModel) Allows(permission vo.Permission) bool {
+	return r.permissions.Has(permission)
+}
+
+// 追加したロールの名称と権限を変更する
+func (r *RootRoleModel) Change(roleName vo.RoleName, permissions vo.Permissions) error {
+
+	if r.builtin {
+		return log.WrapErrorWithStackTraceBadRequest(ErrRoleBuiltin)
+	}
+
+	r.roleName = roleName
+	r.permissions = lo.Uniq(permissions)
+
+	return nil
+}
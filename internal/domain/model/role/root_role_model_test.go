@@ -0,0 +1,71 @@
+package role
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestChangeRole(t *testing.T) {
+
+	tests := []struct {
+		name            string
+		roleData        *RootRoleModel
+		roleName        vo.RoleName
+		permissions     vo.Permissions
+		wantRoleName    vo.RoleName
+		wantPermissions vo.Permissions
+		wantErr         error
+	}{
+		{
+			name:            "追加したロールの名称と権限を変更する",
+			roleData:        NewCreateRoleModel(vo.RoleKey("scheduler"), vo.RoleName("時間割担当"), vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT}),
+			roleName:        vo.RoleName("時間割責任者"),
+			permissions:     vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT, vo.PERMISSION_SCHEDULE_MANAGE},
+			wantRoleName:    vo.RoleName("時間割責任者"),
+			wantPermissions: vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT, vo.PERMISSION_SCHEDULE_MANAGE},
+		},
+		{
+			name:            "重複した権限はまとめる",
+			roleData:        NewCreateRoleModel(vo.RoleKey("scheduler"), vo.RoleName("時間割担当"), vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT}),
+			roleName:        vo.RoleName("時間割担当"),
+			permissions:     vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT, vo.PERMISSION_SCHEDULE_COMMENT, vo.PERMISSION_SCHEDULE_EDIT},
+			wantRoleName:    vo.RoleName("時間割担当"),
+			wantPermissions: vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT, vo.PERMISSION_SCHEDULE_COMMENT},
+		},
+		{
+			name:            "既定のロールは変更できない",
+			roleData:        NewRootRoleModel(vo.RoleID(3), vo.ROLE_KEY_VIEWER, vo.RoleName("閲覧者"), true, vo.Permissions{vo.PERMISSION_SCHEDULE_PROPOSE}),
+			roleName:        vo.RoleName("閲覧者"),
+			permissions:     vo.Permissions{vo.PERMISSION_SCHEDULE_EDIT},
+			wantRoleName:    vo.RoleName("閲覧者"),
+			wantPermissions: vo.Permissions{vo.PERMISSION_SCHEDULE_PROPOSE},
+			wantErr:         ErrRoleBuiltin,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			err := tt.roleData.Change(tt.roleName, tt.permissions)
+
+			if tt.wantErr != nil {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.roleData.RoleName() != tt.wantRoleName {
+				t.Errorf("got role name %s, want %s", tt.roleData.RoleName(), tt.wantRoleName)
+			}
+
+			if !reflect.DeepEqual(tt.roleData.Permissions(), tt.wantPermissions) {
+				t.Errorf("got permissions %v, want %v", tt.roleData.Permissions(), tt.wantPermissions)
+			}
+		})
+	}
+}
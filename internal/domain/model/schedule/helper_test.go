@@ -0,0 +1,79 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+// テスト用に時刻を分で指定して配置済みのアイテムを作成する
+func newTestRoomItem(t *testing.T, identifier string, roomIndex int, startMinutes int, duration int, pinned bool) *ScheduleRoomItemModel {
+
+	t.Helper()
+
+	startTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes + duration)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewScheduleRoomItemModel(
+		vo.ROOM_ITEM_TAG_LESSON,
+		vo.LessonID(1),
+		vo.Identifier(identifier),
+		vo.ROOM_ITEM_TITLE_NONE,
+		vo.LessonDuration(duration),
+		startTime,
+		endTime,
+		vo.RoomIndex(roomIndex),
+		pinned,
+	)
+}
+
+// テスト用に時間帯と配置済みのアイテムを指定してスケジュールを作成する
+func newTestSchedule(t *testing.T, startHour int, endHour int, roomItems ...*ScheduleRoomItemModel) *RootScheduleModel {
+
+	t.Helper()
+
+	scheduleTime, err := vo.NewScheduleTime(startHour, 0, endHour, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+
+	return NewRootScheduleModel(
+		vo.ScheduleID(1),
+		vo.Campus("shibuya"),
+		vo.NewScheduleTitleInitialCreate(),
+		vo.SCHEDULE_TARGET_DATE_NONE,
+		vo.HistoryIndex(5),
+		vo.UserID(1),
+		vo.UserID(1),
+		ScheduleItemModelSlice{},
+		roomItems,
+		ScheduleItemGroupModelSlice{},
+		scheduleTime,
+		vo.SCHEDULE_STATUS_DRAFT,
+		vo.SCHEDULE_REVIEW_COMMENT_NONE,
+		ScheduleCollaboratorModelSlice{},
+		now,
+		now,
+	)
+}
+
+// 識別子ごとの開始時刻(分)
+func roomItemStartMinutes(roomItems ScheduleRoomItemModelSlice) map[string]int {
+
+	startMinutes := make(map[string]int, len(roomItems))
+	for _, roomItem := range roomItems {
+		startMinutes[roomItem.Identifier().Value()] = roomItem.StartTime().ValueMinutes()
+	}
+
+	return startMinutes
+}
@@ -3,9 +3,11 @@ package schedule
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
@@ -14,26 +16,40 @@ type RootScheduleModel struct {
 	id             vo.ScheduleID
 	campus         vo.Campus
 	title          vo.ScheduleTitle
+	targetDate     vo.ScheduleTargetDate
 	historyIndex   vo.HistoryIndex
 	createUser     vo.UserID
 	lastUpdateUser vo.UserID
 	items          ScheduleItemModelSlice
 	roomItems      ScheduleRoomItemModelSlice
+	itemGroups     ScheduleItemGroupModelSlice
 	scheduleTime   vo.ScheduleTime
+	status         vo.ScheduleStatus
+	reviewComment  vo.ScheduleReviewComment
+	collaborators  ScheduleCollaboratorModelSlice
 	createdAt      time.Time
 	updatedAt      time.Time
+
+	// 校舎の配置ポリシー。適用時点の配置を基準に変更箇所のみ検証する
+	policy         *policy.RootSchedulingPolicyModel
+	policyBaseline *policyBaseline
 }
 
 func NewRootScheduleModel(
 	id vo.ScheduleID,
 	campus vo.Campus,
 	title vo.ScheduleTitle,
+	targetDate vo.ScheduleTargetDate,
 	historyIndex vo.HistoryIndex,
 	createUser vo.UserID,
 	lastUpdateUser vo.UserID,
 	items ScheduleItemModelSlice,
 	roomItems ScheduleRoomItemModelSlice,
+	itemGroups ScheduleItemGroupModelSlice,
 	scheduleTime vo.ScheduleTime,
+	status vo.ScheduleStatus,
+	reviewComment vo.ScheduleReviewComment,
+	collaborators ScheduleCollaboratorModelSlice,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *RootScheduleModel {
@@ -42,12 +58,17 @@ func NewRootScheduleModel(
 		id:             id,
 		campus:         campus,
 		title:          title,
+		targetDate:     targetDate,
 		historyIndex:   historyIndex,
 		createUser:     createUser,
 		lastUpdateUser: lastUpdateUser,
 		items:          items,
 		roomItems:      roomItems,
+		itemGroups:     itemGroups,
 		scheduleTime:   scheduleTime,
+		status:         status,
+		reviewComment:  reviewComment,
+		collaborators:  collaborators,
 		createdAt:      createdAt,
 		updatedAt:      updatedAt,
 	}
@@ -56,6 +77,7 @@ func NewRootScheduleModel(
 func NewCreateRootScheduleModel(
 	campus vo.Campus,
 	createUser vo.UserID,
+	targetDate vo.ScheduleTargetDate,
 	scheduleTime vo.ScheduleTime,
 ) *RootScheduleModel {
 
@@ -65,12 +87,17 @@ func NewCreateRootScheduleModel(
 		id:             vo.NewCreateInitialScheduleID(),
 		campus:         campus,
 		title:          vo.NewScheduleTitleInitialCreate(),
+		targetDate:     targetDate,
 		historyIndex:   vo.HISTORY_INDEX_INITIAL,
 		createUser:     createUser,
 		lastUpdateUser: createUser,
 		items:          []*ScheduleItemModel{},
 		roomItems:      []*ScheduleRoomItemModel{},
+		itemGroups:     []*ScheduleItemGroupModel{},
 		scheduleTime:   scheduleTime,
+		status:         vo.SCHEDULE_STATUS_DRAFT,
+		reviewComment:  vo.SCHEDULE_REVIEW_COMMENT_NONE,
+		collaborators:  []*ScheduleCollaboratorModel{},
 		createdAt:      now,
 		updatedAt:      now,
 	}
@@ -88,6 +115,10 @@ func (r RootScheduleModel) Title() vo.ScheduleTitle {
 	return r.title
 }
 
+func (r RootScheduleModel) TargetDate() vo.ScheduleTargetDate {
+	return r.targetDate
+}
+
 func (r RootScheduleModel) HistoryIndex() vo.HistoryIndex {
 	return r.historyIndex
 }
@@ -108,6 +139,10 @@ func (r RootScheduleModel) RoomItems() ScheduleRoomItemModelSlice {
 	return r.roomItems
 }
 
+func (r RootScheduleModel) ItemGroups() ScheduleItemGroupModelSlice {
+	return r.itemGroups
+}
+
 // func (r RootScheduleModel) ScheduleLesson() []*ScheduleLesson {
 // 	return r.scheduleLesson
 // }
@@ -120,6 +155,17 @@ func (r RootScheduleModel) UpdatedAt() time.Time {
 	return r.updatedAt
 }
 
+// 未配置・配置済みのいずれかに指定した識別子のアイテムが存在するか
+func (r RootScheduleModel) HasItem(identifier vo.Identifier) bool {
+
+	if _, found := r.items.findByIdentifier(identifier); found {
+		return true
+	}
+
+	_, found := r.roomItems.findByIdentifier(identifier)
+	return found
+}
+
 func (r RootScheduleModel) FilterScheduleItemByLessonID(lessonID vo.LessonID) ScheduleItemModelSlice {
 
 	return r.items.filterByLessonID(lessonID)
@@ -130,18 +176,106 @@ func (r *RootScheduleModel) ChangeTitle(title vo.ScheduleTitle) {
 	r.title = title
 }
 
-func (r *RootScheduleModel) RoomItemMove(item *ScheduleRoomItemModel) error {
+func (r *RootScheduleModel) RoomItemMove(item *ScheduleRoomItemModel, mode vo.ItemMoveMode) error {
+
+	moveItems := []*ScheduleRoomItemModel{item}
+	if group, found := r.itemGroups.findByMemberIdentifier(item.identifier); found {
+
+		groupItems, err := r.groupRoomItems(group, item)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+		moveItems = groupItems
+	}
+
+	// グループは押し出さない場合、移動先の教室のアイテムと重ならないことを確認する
+	if len(moveItems) > 1 && !mode.IsPush() {
+
+		err := r.validateNotOverlapped(moveItems)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	err := r.placeRoomItems(moveItems)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
 
-	if !r.scheduleTime.IsWithinTimeRange(item.startTime) {
-		return log.WrapErrorWithStackTrace(errors.New("講座開始時刻がスケジュール時刻以より前です"))
+	if !mode.IsPush() {
+		return nil
 	}
 
-	if !r.scheduleTime.IsWithinTimeRange(item.endTime) {
-		return log.WrapErrorWithStackTrace(errors.New("講座終了時刻がスケジュール時刻より後です"))
+	moveIdentifiers := lo.Map(moveItems, func(item *ScheduleRoomItemModel, _ int) vo.Identifier {
+		return item.identifier
+	})
+
+	// グループが複数の教室にまたがる場合は、移動先のすべての教室で押し出す
+	pushedRoomItems := r.roomItems
+	for _, roomIndex := range ScheduleRoomItemModelSlice(moveItems).roomIndexes() {
+
+		pushedItems, err := r.roomItems.pushedItems(roomIndex, moveIdentifiers, r.scheduleTime, r.itemGroups, r.BlockedPeriods())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		pushedRoomItems = append(pushedRoomItems.removeByRoomIndex(roomIndex), pushedItems...)
 	}
 
-	removedItems := r.items.removeByIdentifier(item.identifier)
-	replacedRoomItems := r.roomItems.replaceItem(item)
+	r.roomItems = pushedRoomItems
+
+	return nil
+}
+
+// 配置するアイテムが、配置先の教室の他のアイテムと重ならないことを確認する
+func (r RootScheduleModel) validateNotOverlapped(items []*ScheduleRoomItemModel) error {
+
+	identifiers := lo.Map(items, func(item *ScheduleRoomItemModel, _ int) vo.Identifier {
+		return item.identifier
+	})
+
+	otherRoomItems := lo.Filter(r.roomItems, func(roomItem *ScheduleRoomItemModel, _ int) bool {
+		return !lo.Contains(identifiers, roomItem.identifier)
+	})
+
+	for _, item := range items {
+
+		isOverlapped := lo.SomeBy(otherRoomItems, func(roomItem *ScheduleRoomItemModel) bool {
+			return roomItem.roomIndex == item.roomIndex && roomItem.isOverlapped(item.startTime.ValueMinutes(), item.endTime.ValueMinutes())
+		})
+		if isOverlapped {
+			return log.WrapErrorWithStackTrace(log.Errorf("グループのアイテムが配置先の教室のアイテムと重なります:%s", item.identifier.Value()))
+		}
+	}
+
+	return nil
+}
+
+func (r *RootScheduleModel) placeRoomItems(items []*ScheduleRoomItemModel) error {
+
+	for _, item := range items {
+
+		if !r.scheduleTime.IsWithinTimeRange(item.startTime) {
+			return log.WrapErrorWithStackTrace(errors.New("講座開始時刻がスケジュール時刻以より前です"))
+		}
+
+		if !r.scheduleTime.IsWithinTimeRange(item.endTime) {
+			return log.WrapErrorWithStackTrace(errors.New("講座終了時刻がスケジュール時刻より後です"))
+		}
+	}
+
+	removedItems := r.items
+	replacedRoomItems := r.roomItems
+	for _, item := range items {
+
+		// 固定状態は配置済みアイテムから引き継ぐ
+		if roomItem, found := r.roomItems.findByIdentifier(item.identifier); found {
+			item.pinned = roomItem.pinned
+		}
+
+		removedItems = removedItems.removeByIdentifier(item.identifier)
+		replacedRoomItems = replacedRoomItems.replaceItem(item)
+	}
 
 	err := r.validateUniqueIdentifiers(removedItems, replacedRoomItems)
 	if err != nil {
@@ -154,19 +288,123 @@ func (r *RootScheduleModel) RoomItemMove(item *ScheduleRoomItemModel) error {
 	return nil
 }
 
-func (r *RootScheduleModel) ItemReturnList(item *ScheduleItemModel) error {
+// 基準アイテムの配置に合わせて、グループの全メンバーの配置を作成する
+// 配置済みのメンバーは基準アイテムとの教室の位置関係を保ち、未配置のメンバーは基準アイテムと同じ教室に配置する
+func (r RootScheduleModel) groupRoomItems(group *ScheduleItemGroupModel, baseItem *ScheduleRoomItemModel) ([]*ScheduleRoomItemModel, error) {
+
+	groupStartTime := baseItem.startTime.ValueMinutes() - group.offsetMinutes(baseItem.identifier)
+	if groupStartTime < r.scheduleTime.StartTimeValueMinutes() {
+		return nil, log.WrapErrorWithStackTrace(errors.New("グループの先頭の講座がスケジュール開始時刻より前になります"))
+	}
+
+	currentBaseItem, isBasePlaced := r.roomItems.findByIdentifier(baseItem.identifier)
+
+	groupItems := make([]*ScheduleRoomItemModel, 0, len(group.members))
+	for _, member := range group.members {
+
+		if member.identifier == baseItem.identifier {
+			groupItems = append(groupItems, baseItem)
+			continue
+		}
 
-	roomItem, found := r.roomItems.findByIdentifier(item.identifier)
+		itemTag, lessonID, title, duration, found := r.findItemAttributes(member.identifier)
+		if !found {
+			return nil, log.WrapErrorWithStackTrace(log.Errorf("グループのアイテムが見つかりません:%s", member.identifier.Value()))
+		}
+
+		startTime, err := vo.NewScheduleLessonTimeFromMinutes(groupStartTime + member.offsetMinutes.Value())
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		endTime, err := vo.NewScheduleLessonTimeFromMinutes(startTime.ValueMinutes() + duration.Value())
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		roomIndex := baseItem.roomIndex
+		if memberItem, found := r.roomItems.findByIdentifier(member.identifier); found && isBasePlaced {
+
+			roomIndex, err = vo.NewRoomIndex(baseItem.roomIndex.Value() + memberItem.roomIndex.Value() - currentBaseItem.roomIndex.Value())
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(log.Errorf("グループのアイテムの移動先の教室がありません:%s", member.identifier.Value()))
+			}
+		}
+
+		groupItems = append(groupItems, NewScheduleRoomItemModel(
+			itemTag,
+			lessonID,
+			member.identifier,
+			title,
+			duration,
+			startTime,
+			endTime,
+			roomIndex,
+			false,
+		))
+	}
+
+	return groupItems, nil
+}
+
+func (r RootScheduleModel) findItemAttributes(identifier vo.Identifier) (vo.RoomItemTag, vo.LessonID, vo.RoomItemTitle, vo.LessonDuration, bool) {
+
+	if roomItem, found := r.roomItems.findByIdentifier(identifier); found {
+		return roomItem.itemTag, roomItem.lessonID, roomItem.title, roomItem.duration, true
+	}
+
+	if item, found := r.items.findByIdentifier(identifier); found {
+		return vo.ROOM_ITEM_TAG_LESSON, item.lessonID, vo.ROOM_ITEM_TITLE_NONE, item.duration, true
+	}
+
+	return vo.ROOM_ITEM_TAG_INVALID, vo.LESSON_ID_INVALID, vo.ROOM_ITEM_TITLE_INVALID, vo.LESSON_DURATION_INVALID, false
+}
+
+func (r *RootScheduleModel) ItemReturnList(item *ScheduleItemModel, mode vo.ItemReturnMode) error {
+
+	returnRoomItem, found := r.roomItems.findByIdentifier(item.identifier)
 	if !found {
 		return log.WrapErrorWithStackTrace(log.Errorf("移動元アイテムが見つかりません"))
 	}
 
+	returnItems := []*ScheduleItemModel{item}
+	if group, found := r.itemGroups.findByMemberIdentifier(item.identifier); found {
+
+		for _, identifier := range group.memberIdentifiers() {
+
+			roomItem, found := r.roomItems.findByIdentifier(identifier)
+			if !found || identifier == item.identifier {
+				continue
+			}
+
+			returnItems = append(returnItems, NewScheduleItemModel(roomItem.lessonID, roomItem.identifier, roomItem.duration))
+		}
+	}
+
 	addItems := r.items
-	if roomItem.itemTag.IsLesson() {
-		addItems = r.items.addItem(item)
+	removedRoomItems := r.roomItems
+	removedStartMinutes, removedEndMinutes := returnRoomItem.startTime.ValueMinutes(), returnRoomItem.endTime.ValueMinutes()
+	for _, returnItem := range returnItems {
+
+		roomItem, _ := r.roomItems.findByIdentifier(returnItem.identifier)
+		if roomItem.itemTag.IsLesson() {
+			addItems = addItems.addItem(returnItem)
+		}
+
+		removedStartMinutes = min(removedStartMinutes, roomItem.startTime.ValueMinutes())
+		removedEndMinutes = max(removedEndMinutes, roomItem.endTime.ValueMinutes())
+		removedRoomItems = removedRoomItems.removeByIdentifier(returnItem.identifier)
 	}
 
-	removedRoomItems := r.roomItems.removeByIdentifier(item.identifier)
+	if mode.IsPull() {
+
+		pulledItems, err := removedRoomItems.pulledItems(returnRoomItem.roomIndex, removedStartMinutes, removedEndMinutes-removedStartMinutes, r.scheduleTime, r.itemGroups, r.BlockedPeriods())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		removedRoomItems = append(removedRoomItems.removeByRoomIndex(returnRoomItem.roomIndex), pulledItems...)
+	}
 
 	err := r.validateUniqueIdentifiers(addItems, removedRoomItems)
 	if err != nil {
@@ -206,33 +444,157 @@ func (r *RootScheduleModel) ModifySaving(historyIndex vo.HistoryIndex, lastUpdat
 	r.lastUpdateUser = lastUpdateUser
 }
 
-func (r *RootScheduleModel) RoomItemShift(roomIndex vo.RoomIndex) error {
+func (r *RootScheduleModel) RoomItemShift(roomIndexes []vo.RoomIndex, option *ScheduleItemShiftOption) error {
+
+	if len(roomIndexes) <= 0 {
+		return log.WrapErrorWithStackTrace(errors.New("シフト対象の教室が指定されていません"))
+	}
+
+	return r.shiftRooms(lo.Uniq(roomIndexes), option)
+}
+
+// アイテムが配置されているすべての教室をシフトする
+func (r *RootScheduleModel) RoomItemShiftAll(option *ScheduleItemShiftOption) error {
+
+	return r.shiftRooms(r.roomItems.roomIndexes(), option)
+}
+
+// いずれかの教室でシフトに失敗した場合は、どの教室も変更しない
+func (r *RootScheduleModel) shiftRooms(roomIndexes []vo.RoomIndex, option *ScheduleItemShiftOption) error {
+
+	shiftedRoomItems := r.roomItems
+	for _, roomIndex := range roomIndexes {
+
+		shiftItems, err := r.roomItems.shiftedItems(roomIndex, r.scheduleTime, r.itemGroups, r.BlockedPeriods(), option)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(fmt.Errorf("教室%d: %w", roomIndex.Value(), err))
+		}
+
+		shiftedRoomItems = append(shiftedRoomItems.removeByRoomIndex(roomIndex), shiftItems...)
+	}
+
+	r.roomItems = shiftedRoomItems
+
+	return nil
+}
+
+// 指定した教室で、開始時刻が範囲内(終了時刻は含まない)にあるアイテムの識別子を返す
+func (r RootScheduleModel) RoomItemIdentifiersInRange(roomIndexes []vo.RoomIndex, rangeStartTime vo.ScheduleLessonTime, rangeEndTime vo.ScheduleLessonTime) []vo.Identifier {
+
+	return lo.FilterMap(r.roomItems, func(item *ScheduleRoomItemModel, _ int) (vo.Identifier, bool) {
+
+		isInRange := rangeStartTime.ValueMinutes() <= item.startTime.ValueMinutes() && item.startTime.ValueMinutes() < rangeEndTime.ValueMinutes()
+		return item.identifier, isInRange && lo.Contains(roomIndexes, item.roomIndex)
+	})
+}
+
+// 指定したアイテム(グループのメンバーを含む)の時刻をまとめてずらす。いずれかが配置できない場合はどのアイテムも変更しない
+func (r *RootScheduleModel) RoomItemTimeShift(identifiers []vo.Identifier, shiftMinutes vo.ItemTimeShiftMinutes) error {
+
+	if len(identifiers) <= 0 {
+		return log.WrapErrorWithStackTrace(errors.New("時刻をずらすアイテムが指定されていません"))
+	}
+
+	shiftIdentifiers := lo.Uniq(lo.FlatMap(identifiers, func(identifier vo.Identifier, _ int) []vo.Identifier {
+		return r.itemGroups.linkedIdentifiers(identifier)
+	}))
+
+	shiftedRoomItems := make(ScheduleRoomItemModelSlice, 0, len(shiftIdentifiers))
+	for _, identifier := range shiftIdentifiers {
+
+		roomItem, found := r.roomItems.findByIdentifier(identifier)
+		if !found {
+			return log.WrapErrorWithStackTrace(log.Errorf("時刻をずらすアイテムが教室に配置されていません:%s", identifier.Value()))
+		}
+
+		startTime, err := vo.NewScheduleLessonTimeFromMinutes(roomItem.startTime.ValueMinutes() + shiftMinutes.Value())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		endTime, err := vo.NewScheduleLessonTimeFromMinutes(roomItem.endTime.ValueMinutes() + shiftMinutes.Value())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
 
-	shiftItems, err := r.roomItems.shiftedItems(roomIndex, r.scheduleTime)
+		shiftedItem := roomItem.duplicate()
+		shiftedItem.startTime = startTime
+		shiftedItem.endTime = endTime
+		shiftedRoomItems = append(shiftedRoomItems, shiftedItem)
+	}
+
+	err := r.validateWithinScheduleTime(shiftedRoomItems)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
 	}
 
-	r.roomItems = r.roomItems.removeByRoomIndex(roomIndex)
-	r.roomItems = append(r.roomItems, shiftItems...)
+	// ずらしたアイテム同士は相対位置が変わらないため、ずらしていないアイテムとの重なりのみ確認する
+	unshiftedRoomItems := lo.Reject(r.roomItems, func(item *ScheduleRoomItemModel, _ int) bool {
+		return lo.Contains(shiftIdentifiers, item.identifier)
+	})
+	for _, shiftedItem := range shiftedRoomItems {
+
+		isOverlapped := lo.SomeBy(unshiftedRoomItems, func(item *ScheduleRoomItemModel) bool {
+			return item.roomIndex == shiftedItem.roomIndex && item.isOverlapped(shiftedItem.startTime.ValueMinutes(), shiftedItem.endTime.ValueMinutes())
+		})
+		if isOverlapped {
+			return log.WrapErrorWithStackTrace(log.Errorf("ずらした時刻で他のアイテムと重なります:%s", shiftedItem.identifier.Value()))
+		}
+	}
+
+	r.roomItems = append(ScheduleRoomItemModelSlice(unshiftedRoomItems), shiftedRoomItems...)
 
 	return nil
 }
 
-func (r *RootScheduleModel) ItemDivide(lessonID vo.LessonID, initialLessonDuration vo.LessonDuration, identifier vo.Identifier, divideMinutes vo.ItemDivideMinutes) error {
+func (r RootScheduleModel) IsPinnedRoomItem(identifier vo.Identifier) bool {
+
+	return lo.SomeBy(r.itemGroups.linkedIdentifiers(identifier), func(linkedIdentifier vo.Identifier) bool {
+		roomItem, found := r.roomItems.findByIdentifier(linkedIdentifier)
+		return found && roomItem.pinned
+	})
+}
+
+func (r *RootScheduleModel) RoomItemPin(identifier vo.Identifier, pinned bool) error {
+
+	roomItem, found := r.roomItems.findByIdentifier(identifier)
+	if !found {
+		return log.WrapErrorWithStackTrace(errors.New("固定対象のアイテムは教室に配置されていません"))
+	}
+
+	pinnedItem := roomItem.duplicate()
+	pinnedItem.pinned = pinned
+
+	r.roomItems = r.roomItems.replaceItem(pinnedItem)
+
+	return nil
+}
+
+// 先頭の分割アイテムは元の識別子を引き継ぎ、以降の分割アイテムには新しい識別子を割り当てる
+func (r *RootScheduleModel) ItemDivide(lessonID vo.LessonID, initialLessonDuration vo.LessonDuration, identifier vo.Identifier, option *ScheduleItemDivideOption) error {
+
+	if _, found := r.itemGroups.findByMemberIdentifier(identifier); found {
+		return log.WrapErrorWithStackTrace(errors.New("グループに属する講座は分割できません"))
+	}
 
 	var divide = func(item *ScheduleItemModel) error {
 
-		divideDurationFrom, divideDurationTo, err := item.duration.Divide(divideMinutes)
+		durations, err := option.divide(item.duration)
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		divideFrom := NewScheduleItemModel(item.lessonID, identifier, divideDurationFrom)
-		divideTo := NewScheduleItemModel(item.lessonID, vo.NewIdentifierGenerate(), divideDurationTo)
-
 		items := r.items.removeByIdentifier(item.identifier)
-		r.items = append(items, divideFrom, divideTo)
+		for i, duration := range durations {
+
+			divideIdentifier := identifier
+			if i > 0 {
+				divideIdentifier = vo.NewIdentifierGenerate()
+			}
+
+			items = append(items, NewScheduleItemModel(item.lessonID, divideIdentifier, duration))
+		}
+		r.items = items
 
 		return nil
 	}
@@ -264,119 +626,273 @@ func (r *RootScheduleModel) ItemDivide(lessonID vo.LessonID, initialLessonDurati
 			return newDivideItem()
 		}
 
-		divideDurationFrom, divideDurationTo, err := roomItem.duration.Divide(divideMinutes)
+		err := r.divideRoomItem(roomItem, option)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
+// 配置済みアイテムを元の開始時刻から順に、休憩時間を空けて分割する
+func (r *RootScheduleModel) divideRoomItem(roomItem *ScheduleRoomItemModel, option *ScheduleItemDivideOption) error {
+
+	durations, err := option.divide(roomItem.duration)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	divideItems := make(ScheduleRoomItemModelSlice, 0, len(durations))
+	startMinutes := roomItem.startTime.ValueMinutes()
+	for i, duration := range durations {
+
+		divideIdentifier := roomItem.identifier
+		if i > 0 {
+			divideIdentifier = vo.NewIdentifierGenerate()
+		}
+
+		startTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes)
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		newEndTime, err := vo.NewScheduleLessonTimeFromMinutes(roomItem.startTime.ValueMinutes() + divideDurationFrom.Value())
+		endTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes + duration.Value())
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
-		divideFrom := NewScheduleRoomItemModel(
-			roomItem.itemTag,
-			roomItem.lessonID,
-			roomItem.identifier,
-			divideDurationFrom,
-			roomItem.startTime,
-			newEndTime,
-			roomItem.roomIndex,
-		)
 
-		divideTo := NewScheduleRoomItemModel(
+		divideItems = append(divideItems, NewScheduleRoomItemModel(
 			roomItem.itemTag,
 			roomItem.lessonID,
-			vo.NewIdentifierGenerate(),
-			divideDurationTo,
-			newEndTime,
-			roomItem.endTime,
+			divideIdentifier,
+			roomItem.title,
+			duration,
+			startTime,
+			endTime,
 			roomItem.roomIndex,
-		)
+			roomItem.pinned,
+		))
 
-		roomItems := r.roomItems.removeByIdentifier(roomItem.identifier)
-		r.roomItems = append(roomItems, divideFrom, divideTo)
+		startMinutes = endTime.ValueMinutes() + option.breakMinutes.Value()
 	}
 
-	return nil
-}
+	err = r.validateWithinScheduleTime(divideItems)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
 
-func (r *RootScheduleModel) ItemJoin(joinFromID vo.Identifier, joinToID vo.Identifier) error {
+	roomItems := r.roomItems.removeByIdentifier(roomItem.identifier)
 
-	joinFromItem, foundItemFrom := r.items.findByIdentifier(joinFromID)
-	joinToItem, foundItemTo := r.items.findByIdentifier(joinToID)
+	// 休憩時間を空けた場合は、後続のアイテムと重ならないことを確認する
+	if option.breakMinutes.Value() > 0 {
 
-	joinFromRoomItem, foundRoomItemFrom := r.roomItems.findByIdentifier(joinFromID)
-	joinToRoomItem, foundRoomItemTo := r.roomItems.findByIdentifier(joinToID)
+		for _, divideItem := range divideItems {
 
-	if foundItemFrom != foundItemTo || foundRoomItemFrom != foundRoomItemTo {
-		return log.WrapErrorWithStackTrace(errors.New("講座の結合は一覧または教室に配置している同士のみ可能です"))
+			isOverlapped := lo.SomeBy(roomItems.filterByRoomIndex(roomItem.roomIndex), func(item *ScheduleRoomItemModel) bool {
+				return item.isOverlapped(divideItem.startTime.ValueMinutes(), divideItem.endTime.ValueMinutes())
+			})
+			if isOverlapped {
+				return log.WrapErrorWithStackTrace(errors.New("分割したアイテムが他のアイテムと重なります"))
+			}
+		}
 	}
 
-	if !foundItemFrom && !foundRoomItemFrom {
-		return log.WrapErrorWithStackTrace(errors.New("結合対象の講座がみつかりません"))
+	r.roomItems = append(roomItems, divideItems...)
+
+	return nil
+}
+
+// 同じ講座のアイテムを1つに結合する。結合後のアイテムは先頭に指定した識別子を引き継ぐ
+// 先頭のアイテムが配置済みの場合はその位置に、未配置で他に配置済みのアイテムがある場合は最も早い位置に配置する
+func (r *RootScheduleModel) ItemJoin(identifiers []vo.Identifier) error {
+
+	if len(identifiers) < 2 {
+		return log.WrapErrorWithStackTrace(errors.New("結合には2つ以上のアイテムを指定してください"))
+	}
+
+	dupes := lo.FindDuplicates(identifiers)
+	if len(dupes) > 0 {
+		return log.WrapErrorWithStackTrace(fmt.Errorf("結合するアイテムが重複しています: %v", dupes))
 	}
 
-	if foundItemFrom {
+	joinDurationMinutes := 0
+	joinLessonID := vo.LESSON_ID_INVALID
+	joinRoomItems := ScheduleRoomItemModelSlice{}
+	for _, identifier := range identifiers {
+
+		if _, found := r.itemGroups.findByMemberIdentifier(identifier); found {
+			return log.WrapErrorWithStackTrace(errors.New("グループに属する講座は結合できません"))
+		}
+
+		_, lessonID, _, duration, found := r.findItemAttributes(identifier)
+		if !found {
+			return log.WrapErrorWithStackTrace(log.Errorf("結合対象の講座がみつかりません:%s", identifier.Value()))
+		}
 
-		if joinFromItem.lessonID != joinToItem.lessonID {
+		if joinLessonID != vo.LESSON_ID_INVALID && joinLessonID != lessonID {
 			return log.WrapErrorWithStackTrace(errors.New("結合は同じ講座のみできます"))
 		}
+		joinLessonID = lessonID
 
-		joinedItem := NewScheduleItemModel(
-			joinToItem.lessonID,
-			joinToItem.identifier,
-			joinToItem.duration.Add(joinFromItem.duration),
-		)
+		if roomItem, found := r.roomItems.findByIdentifier(identifier); found {
+			joinRoomItems = append(joinRoomItems, roomItem)
+		}
 
-		removedItems := r.items.removeByIdentifier(joinFromItem.identifier).removeByIdentifier(joinToItem.identifier)
-		r.items = append(removedItems, joinedItem)
+		joinDurationMinutes += duration.Value()
+	}
 
-	} else {
+	joinDuration, err := vo.NewLessonDuration(joinDurationMinutes)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
 
-		if joinFromRoomItem.lessonID != joinToRoomItem.lessonID {
-			return log.WrapErrorWithStackTrace(errors.New("結合は同じ講座のみできます"))
+	removedItems := r.items
+	removedRoomItems := r.roomItems
+	for _, identifier := range identifiers {
+		removedItems = removedItems.removeByIdentifier(identifier)
+		removedRoomItems = removedRoomItems.removeByIdentifier(identifier)
+	}
+
+	survivorIdentifier := identifiers[0]
+	if len(joinRoomItems) <= 0 {
+
+		r.items = append(removedItems, NewScheduleItemModel(joinLessonID, survivorIdentifier, joinDuration))
+		r.roomItems = removedRoomItems
+
+		return nil
+	}
+
+	anchorRoomItem, found := joinRoomItems.findByIdentifier(survivorIdentifier)
+	if !found {
+		anchorRoomItem = lo.MinBy(joinRoomItems, func(a, b *ScheduleRoomItemModel) bool {
+			if a.startTime.ValueMinutes() != b.startTime.ValueMinutes() {
+				return a.startTime.ValueMinutes() < b.startTime.ValueMinutes()
+			}
+			return a.roomIndex < b.roomIndex
+		})
+	}
+
+	// スケジュール終了時刻を超える場合は、終了時刻に揃うように開始時刻を前へずらす
+	joinStartMinutes := min(anchorRoomItem.startTime.ValueMinutes(), r.scheduleTime.EndTimeValueMinutes()-joinDuration.Value())
+
+	joinStartTime, err := vo.NewScheduleLessonTimeFromMinutes(joinStartMinutes)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	joinEndTime, err := vo.NewScheduleLessonTimeFromMinutes(joinStartMinutes + joinDuration.Value())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.scheduleTime.IsWithinTimeRange(joinStartTime) {
+		return log.WrapErrorWithStackTrace(errors.New("結合した講座は利用時間の範囲に収まりません"))
+	}
+
+	isOverlapped := lo.SomeBy(removedRoomItems.filterByRoomIndex(anchorRoomItem.roomIndex), func(item *ScheduleRoomItemModel) bool {
+		return item.isOverlapped(joinStartTime.ValueMinutes(), joinEndTime.ValueMinutes())
+	})
+	if isOverlapped {
+		return log.WrapErrorWithStackTrace(errors.New("結合した講座が他のアイテムと重なります"))
+	}
+
+	joinedItem := NewScheduleRoomItemModel(
+		anchorRoomItem.itemTag,
+		joinLessonID,
+		survivorIdentifier,
+		anchorRoomItem.title,
+		joinDuration,
+		joinStartTime,
+		joinEndTime,
+		anchorRoomItem.roomIndex,
+		anchorRoomItem.pinned,
+	)
+
+	r.items = removedItems
+	r.roomItems = append(removedRoomItems, joinedItem)
+
+	return nil
+}
+
+func (r *RootScheduleModel) ItemGroupCreate(groupIdentifier vo.Identifier, members []*ScheduleItemGroupMemberModel) error {
+
+	if len(members) < 2 {
+		return log.WrapErrorWithStackTrace(errors.New("グループには2つ以上のアイテムを指定してください"))
+	}
+
+	if _, found := r.itemGroups.findByGroupIdentifier(groupIdentifier); found {
+		return log.WrapErrorWithStackTrace(log.Errorf("グループ識別子が重複しています:%s", groupIdentifier.Value()))
+	}
+
+	group := NewScheduleItemGroupModel(groupIdentifier, members)
+
+	dupes := lo.FindDuplicates(group.memberIdentifiers())
+	if len(dupes) > 0 {
+		return log.WrapErrorWithStackTrace(fmt.Errorf("グループのアイテムが重複しています: %v", dupes))
+	}
+
+	if group.members[0].offsetMinutes.Value() != 0 {
+		return log.WrapErrorWithStackTrace(errors.New("グループの先頭のアイテムの開始位置は0分にしてください"))
+	}
+
+	prevEndMinutes := 0
+	var baseItem *ScheduleRoomItemModel
+	for _, member := range group.members {
+
+		if _, found := r.itemGroups.findByMemberIdentifier(member.identifier); found {
+			return log.WrapErrorWithStackTrace(log.Errorf("既に他のグループに属しているアイテムがあります:%s", member.identifier.Value()))
 		}
 
-		joinDuration := joinToRoomItem.duration.Add(joinFromRoomItem.duration)
-		joinEndTime, err := vo.NewScheduleLessonTimeFromMinutes(joinToRoomItem.startTime.ValueMinutes() + joinDuration.Value())
-		if err != nil {
-			return log.WrapErrorWithStackTrace(err)
+		_, _, _, duration, found := r.findItemAttributes(member.identifier)
+		if !found {
+			return log.WrapErrorWithStackTrace(log.Errorf("グループ対象のアイテムが見つかりません:%s", member.identifier.Value()))
 		}
 
-		joinStartTime := joinToRoomItem.startTime
-		if !r.scheduleTime.IsWithinTimeRange(joinEndTime) {
+		if member.offsetMinutes.Value() < prevEndMinutes {
+			return log.WrapErrorWithStackTrace(errors.New("グループ内のアイテムの時間が重なっています"))
+		}
+		prevEndMinutes = member.offsetMinutes.Value() + duration.Value()
 
-			_, scheduleEndTime := r.scheduleTime.Value()
-			diffEndTimeMinute := joinEndTime.ValueMinutes() - scheduleEndTime
-			joinStartTime, err = vo.NewScheduleLessonTimeFromMinutes(joinStartTime.ValueMinutes() - diffEndTimeMinute)
-			if err != nil {
-				return log.WrapErrorWithStackTrace(err)
-			}
+		if roomItem, found := r.roomItems.findByIdentifier(member.identifier); found && baseItem == nil {
+			baseItem = roomItem
+		}
+	}
 
-			joinEndTime, err = vo.NewScheduleLessonTimeFromMinutes(joinStartTime.ValueMinutes() - diffEndTimeMinute)
-			if err != nil {
-				return log.WrapErrorWithStackTrace(err)
-			}
+	itemGroups := append(slices.Clone(r.itemGroups), group)
+
+	// 配置済みのメンバーがいる場合は、そのメンバーの位置に合わせてグループ全体を配置する
+	if baseItem != nil {
+
+		groupItems, err := r.groupRoomItems(group, baseItem.duplicate())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
 		}
 
-		if !r.scheduleTime.IsWithinTimeRange(joinStartTime) {
-			return log.WrapErrorWithStackTrace(errors.New("結合した講座は利用時間の範囲に収まりません"))
+		err = r.validateNotOverlapped(groupItems)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
 		}
 
-		joinedItem := NewScheduleRoomItemModel(
-			joinToRoomItem.itemTag,
-			joinToRoomItem.lessonID,
-			joinToRoomItem.identifier,
-			joinDuration,
-			joinStartTime,
-			joinEndTime,
-			joinToRoomItem.roomIndex,
-		)
+		err = r.placeRoomItems(groupItems)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	r.itemGroups = itemGroups
+
+	return nil
+}
+
+func (r *RootScheduleModel) ItemGroupRelease(groupIdentifier vo.Identifier) error {
 
-		removedRoomsItems := r.roomItems.removeByIdentifier(joinFromRoomItem.identifier).removeByIdentifier(joinToRoomItem.identifier)
-		r.roomItems = append(removedRoomsItems, joinedItem)
+	if _, found := r.itemGroups.findByGroupIdentifier(groupIdentifier); !found {
+		return log.WrapErrorWithStackTrace(log.Errorf("指定したグループが見つかりません:%s", groupIdentifier.Value()))
 	}
 
+	r.itemGroups = r.itemGroups.removeByGroupIdentifier(groupIdentifier)
+
 	return nil
 }
 
@@ -400,6 +916,11 @@ func (r *RootScheduleModel) ChangeScheduleTime(newScheduleTime vo.ScheduleTime)
 	return nil
 }
 
+func (r *RootScheduleModel) ChangeTargetDate(targetDate vo.ScheduleTargetDate) {
+
+	r.targetDate = targetDate
+}
+
 func (r RootScheduleModel) Duplicate(duplicateUser vo.UserID) *RootScheduleModel {
 
 	duplicateSchedule := &RootScheduleModel{}
@@ -409,6 +930,10 @@ func (r RootScheduleModel) Duplicate(duplicateUser vo.UserID) *RootScheduleModel
 	duplicateSchedule.historyIndex = vo.HISTORY_INDEX_INITIAL
 	duplicateSchedule.createUser = duplicateUser
 	duplicateSchedule.lastUpdateUser = duplicateUser
+	duplicateSchedule.status = vo.SCHEDULE_STATUS_DRAFT
+	duplicateSchedule.reviewComment = vo.SCHEDULE_REVIEW_COMMENT_NONE
+	// 共同編集者は複製先に引き継がない
+	duplicateSchedule.collaborators = []*ScheduleCollaboratorModel{}
 
 	duplicateSchedule.items = lo.Map(r.items, func(item *ScheduleItemModel, _ int) *ScheduleItemModel {
 		return item.duplicate()
@@ -418,5 +943,9 @@ func (r RootScheduleModel) Duplicate(duplicateUser vo.UserID) *RootScheduleModel
 		return item.duplicate()
 	})
 
+	duplicateSchedule.itemGroups = lo.Map(r.itemGroups, func(group *ScheduleItemGroupModel, _ int) *ScheduleItemGroupModel {
+		return group.duplicate()
+	})
+
 	return duplicateSchedule
 }
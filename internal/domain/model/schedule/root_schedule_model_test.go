@@ -0,0 +1,197 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestRoomItemTimeShift(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		identifiers  []vo.Identifier
+		shiftMinutes vo.ItemTimeShiftMinutes
+		want         map[string]int
+		wantErr      bool
+	}{
+		{
+			name:         "指定したアイテムの時刻をずらす",
+			identifiers:  []vo.Identifier{"c"},
+			shiftMinutes: vo.ItemTimeShiftMinutes(30),
+			want:         map[string]int{"a": 540, "b": 600, "c": 690},
+		},
+		{
+			name:         "グループのアイテムはまとめてずらす",
+			identifiers:  []vo.Identifier{"b"},
+			shiftMinutes: vo.ItemTimeShiftMinutes(-30),
+			want:         map[string]int{"a": 510, "b": 570, "c": 660},
+		},
+		{
+			name:         "いずれかがスケジュール終了時刻を超える場合はどのアイテムもずらさない",
+			identifiers:  []vo.Identifier{"a", "c"},
+			shiftMinutes: vo.ItemTimeShiftMinutes(90),
+			wantErr:      true,
+		},
+		{
+			name:         "ずらしていないアイテムと重なる場合はどのアイテムもずらさない",
+			identifiers:  []vo.Identifier{"c"},
+			shiftMinutes: vo.ItemTimeShiftMinutes(-30),
+			wantErr:      true,
+		},
+		{
+			name:         "教室に配置されていないアイテムが含まれる場合はどのアイテムもずらさない",
+			identifiers:  []vo.Identifier{"c", "not_placed"},
+			shiftMinutes: vo.ItemTimeShiftMinutes(30),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// a と b はグループ、c は単独のアイテム
+			scheduleData := newTestSchedule(t, 8, 13,
+				newTestRoomItem(t, "a", 1, 540, 60, false),
+				newTestRoomItem(t, "b", 1, 600, 60, false),
+				newTestRoomItem(t, "c", 1, 660, 60, false),
+			)
+
+			err := scheduleData.ItemGroupCreate(vo.Identifier("group"), []*ScheduleItemGroupMemberModel{
+				NewScheduleItemGroupMemberModel(vo.Identifier("a"), vo.ItemGroupOffsetMinutes(0)),
+				NewScheduleItemGroupMemberModel(vo.Identifier("b"), vo.ItemGroupOffsetMinutes(60)),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			before := roomItemStartMinutes(scheduleData.RoomItems())
+
+			err = scheduleData.RoomItemTimeShift(tt.identifiers, tt.shiftMinutes)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, before) {
+					t.Errorf("room items changed on error: got %v, want %v", got, before)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoomItemMoveGroupAcrossRooms(t *testing.T) {
+
+	type placement struct {
+		roomIndex    int
+		startMinutes int
+	}
+
+	tests := []struct {
+		name     string
+		moveItem string
+		room     int
+		start    int
+		mode     vo.ItemMoveMode
+		shift    bool
+		want     map[string]placement
+		wantErr  bool
+	}{
+		{
+			name:     "メンバーの教室の位置関係を保って移動する",
+			moveItem: "a",
+			room:     2,
+			start:    600,
+			mode:     vo.ITEM_MOVE_MODE_EXACT,
+			want:     map[string]placement{"a": {2, 600}, "b": {3, 660}, "c": {2, 660}, "d": {3, 720}},
+		},
+		{
+			name:     "他のメンバーが移動先の教室のアイテムと重なる",
+			moveItem: "a",
+			room:     1,
+			start:    600,
+			mode:     vo.ITEM_MOVE_MODE_EXACT,
+			wantErr:  true,
+		},
+		{
+			name:     "押し出す場合は他のメンバーの教室でも押し出す",
+			moveItem: "a",
+			room:     1,
+			start:    600,
+			mode:     vo.ITEM_MOVE_MODE_PUSH,
+			want:     map[string]placement{"a": {1, 600}, "b": {2, 660}, "c": {2, 720}, "d": {3, 720}},
+		},
+		{
+			name:     "他のメンバーの移動先の教室がない",
+			moveItem: "b",
+			room:     1,
+			start:    600,
+			mode:     vo.ITEM_MOVE_MODE_EXACT,
+			wantErr:  true,
+		},
+		{
+			name:  "教室をシフトしても他の教室にメンバーがいるグループは動かさない",
+			shift: true,
+			want:  map[string]placement{"a": {1, 540}, "b": {2, 600}, "c": {2, 480}, "d": {3, 720}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			// a と b は教室をまたぐグループ
+			scheduleData := newTestSchedule(t, 8, 13,
+				newTestRoomItem(t, "a", 1, 540, 60, false),
+				newTestRoomItem(t, "b", 2, 600, 60, false),
+				newTestRoomItem(t, "c", 2, 660, 60, false),
+				newTestRoomItem(t, "d", 3, 720, 60, false),
+			)
+
+			err := scheduleData.ItemGroupCreate(vo.Identifier("group"), []*ScheduleItemGroupMemberModel{
+				NewScheduleItemGroupMemberModel(vo.Identifier("a"), vo.ItemGroupOffsetMinutes(0)),
+				NewScheduleItemGroupMemberModel(vo.Identifier("b"), vo.ItemGroupOffsetMinutes(60)),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.shift {
+				option := NewScheduleItemShiftOption(vo.ITEM_SHIFT_STRATEGY_PACK_START, vo.ItemShiftGapMinutes(0), vo.ITEM_SHIFT_GRID_MINUTES_DEFAULT)
+				err = scheduleData.RoomItemShift([]vo.RoomIndex{2}, option)
+			} else {
+				err = scheduleData.RoomItemMove(newTestRoomItem(t, tt.moveItem, tt.room, tt.start, 60, false), tt.mode)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := make(map[string]placement, len(scheduleData.RoomItems()))
+			for _, roomItem := range scheduleData.RoomItems() {
+				got[roomItem.Identifier().Value()] = placement{roomItem.RoomIndex().Value(), roomItem.StartTime().ValueMinutes()}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
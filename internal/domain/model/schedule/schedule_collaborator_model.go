@@ -0,0 +1,123 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleCollaboratorIsOwner = errors.New("スケジュールの所有者は共同編集者に設定できません")
+var ErrScheduleCollaboratorNotFound = errors.New("指定したユーザーは共同編集者ではありません")
+var ErrScheduleOwnerNotChanged = errors.New("既に所有者に設定されているユーザーです")
+
+type ScheduleCollaboratorModelSlice []*ScheduleCollaboratorModel
+
+// スケジュールごとに個別の権限を付与されたユーザー
+type ScheduleCollaboratorModel struct {
+	userID     vo.UserID
+	permission vo.CollaboratorPermission
+}
+
+func NewScheduleCollaboratorModel(
+	userID vo.UserID,
+	permission vo.CollaboratorPermission,
+) *ScheduleCollaboratorModel {
+
+	return &ScheduleCollaboratorModel{
+		userID:     userID,
+		permission: permission,
+	}
+}
+
+func (r ScheduleCollaboratorModel) UserID() vo.UserID {
+	return r.userID
+}
+
+func (r ScheduleCollaboratorModel) Permission() vo.CollaboratorPermission {
+	return r.permission
+}
+
+func (r ScheduleCollaboratorModelSlice) findByUserID(userID vo.UserID) (*ScheduleCollaboratorModel, bool) {
+
+	return lo.Find(r, func(item *ScheduleCollaboratorModel) bool {
+		return item.userID == userID
+	})
+}
+
+func (r RootScheduleModel) Collaborators() ScheduleCollaboratorModelSlice {
+	return r.collaborators
+}
+
+// スケジュールの所有者(作成者、または所有権を移譲されたユーザー)
+func (r RootScheduleModel) IsOwnedBy(userID vo.UserID) bool {
+	return r.createUser == userID
+}
+
+// 所有者、または編集権限を付与された共同編集者
+func (r RootScheduleModel) IsEditableCollaborator(userID vo.UserID) bool {
+
+	if r.IsOwnedBy(userID) {
+		return true
+	}
+
+	collaborator, found := r.collaborators.findByUserID(userID)
+	return found && collaborator.permission.IsEdit()
+}
+
+// 所有者、または権限の種類に関わらず共同編集者に含まれる
+func (r RootScheduleModel) IsViewableCollaborator(userID vo.UserID) bool {
+
+	if r.IsOwnedBy(userID) {
+		return true
+	}
+
+	_, found := r.collaborators.findByUserID(userID)
+	return found
+}
+
+// 共同編集者を追加する。既に追加済みの場合は権限を変更する
+func (r *RootScheduleModel) GrantCollaborator(userID vo.UserID, permission vo.CollaboratorPermission) error {
+
+	if r.IsOwnedBy(userID) {
+		return log.WrapErrorWithStackTrace(ErrScheduleCollaboratorIsOwner)
+	}
+
+	if collaborator, found := r.collaborators.findByUserID(userID); found {
+		collaborator.permission = permission
+		return nil
+	}
+
+	r.collaborators = append(r.collaborators, NewScheduleCollaboratorModel(userID, permission))
+	return nil
+}
+
+func (r *RootScheduleModel) RevokeCollaborator(userID vo.UserID) error {
+
+	if _, found := r.collaborators.findByUserID(userID); !found {
+		return log.WrapErrorWithStackTrace(ErrScheduleCollaboratorNotFound)
+	}
+
+	r.collaborators = lo.Reject(r.collaborators, func(item *ScheduleCollaboratorModel, _ int) bool {
+		return item.userID == userID
+	})
+	return nil
+}
+
+// 所有権を移譲する。移譲前の所有者は編集権限を持つ共同編集者として残す
+func (r *RootScheduleModel) TransferOwnership(newOwner vo.UserID) error {
+
+	if r.IsOwnedBy(newOwner) {
+		return log.WrapErrorWithStackTrace(ErrScheduleOwnerNotChanged)
+	}
+
+	previousOwner := r.createUser
+
+	r.collaborators = lo.Reject(r.collaborators, func(item *ScheduleCollaboratorModel, _ int) bool {
+		return item.userID == newOwner
+	})
+	r.createUser = newOwner
+
+	return r.GrantCollaborator(previousOwner, vo.COLLABORATOR_PERMISSION_EDIT)
+}
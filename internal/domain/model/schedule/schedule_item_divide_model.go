@@ -0,0 +1,67 @@
+package schedule
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// アイテム分割の方法と、配置済みアイテムを分割する場合の休憩時間
+type ScheduleItemDivideOption struct {
+	divideMinutes vo.ItemDivideMinutes
+	parts         vo.ItemDivideParts
+	lengths       []vo.ItemDivideMinutes
+	breakMinutes  vo.ItemDivideBreakMinutes
+}
+
+// 先頭から指定した時間で2つに分割する
+func NewScheduleItemDivideOptionByMinutes(divideMinutes vo.ItemDivideMinutes, breakMinutes vo.ItemDivideBreakMinutes) *ScheduleItemDivideOption {
+
+	return &ScheduleItemDivideOption{
+		divideMinutes: divideMinutes,
+		parts:         vo.ITEM_DIVIDE_PARTS_INVALID,
+		breakMinutes:  breakMinutes,
+	}
+}
+
+// 指定した数で均等に分割する
+func NewScheduleItemDivideOptionEqually(parts vo.ItemDivideParts, breakMinutes vo.ItemDivideBreakMinutes) *ScheduleItemDivideOption {
+
+	return &ScheduleItemDivideOption{
+		divideMinutes: vo.ITEM_DIVIDE_MINUTES_INVALID,
+		parts:         parts,
+		breakMinutes:  breakMinutes,
+	}
+}
+
+// 指定した時間の並びで分割する
+func NewScheduleItemDivideOptionByLengths(lengths []vo.ItemDivideMinutes, breakMinutes vo.ItemDivideBreakMinutes) *ScheduleItemDivideOption {
+
+	return &ScheduleItemDivideOption{
+		divideMinutes: vo.ITEM_DIVIDE_MINUTES_INVALID,
+		parts:         vo.ITEM_DIVIDE_PARTS_INVALID,
+		lengths:       lengths,
+		breakMinutes:  breakMinutes,
+	}
+}
+
+func (r ScheduleItemDivideOption) BreakMinutes() vo.ItemDivideBreakMinutes {
+	return r.breakMinutes
+}
+
+func (r ScheduleItemDivideOption) divide(duration vo.LessonDuration) ([]vo.LessonDuration, error) {
+
+	if len(r.lengths) > 0 {
+		return duration.DivideByLengths(r.lengths)
+	}
+
+	if r.parts != vo.ITEM_DIVIDE_PARTS_INVALID {
+		return duration.DivideEqually(r.parts)
+	}
+
+	divideDurationFrom, divideDurationTo, err := duration.Divide(r.divideMinutes)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return []vo.LessonDuration{divideDurationFrom, divideDurationTo}, nil
+}
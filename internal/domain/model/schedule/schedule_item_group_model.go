@@ -0,0 +1,135 @@
+package schedule
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type ScheduleItemGroupModelSlice []*ScheduleItemGroupModel
+
+func (r ScheduleItemGroupModelSlice) findByMemberIdentifier(identifier vo.Identifier) (*ScheduleItemGroupModel, bool) {
+
+	return lo.Find(r, func(group *ScheduleItemGroupModel) bool {
+		return group.hasMember(identifier)
+	})
+}
+
+func (r ScheduleItemGroupModelSlice) findByGroupIdentifier(groupIdentifier vo.Identifier) (*ScheduleItemGroupModel, bool) {
+
+	return lo.Find(r, func(group *ScheduleItemGroupModel) bool {
+		return group.groupIdentifier == groupIdentifier
+	})
+}
+
+func (r ScheduleItemGroupModelSlice) removeByGroupIdentifier(groupIdentifier vo.Identifier) ScheduleItemGroupModelSlice {
+
+	return lo.Filter(r, func(group *ScheduleItemGroupModel, _ int) bool {
+		return group.groupIdentifier != groupIdentifier
+	})
+}
+
+// 同時に移動するアイテム(グループのメンバー、またはグループに属さないアイテム単体)の識別子を返す
+func (r ScheduleItemGroupModelSlice) linkedIdentifiers(identifier vo.Identifier) []vo.Identifier {
+
+	group, found := r.findByMemberIdentifier(identifier)
+	if !found {
+		return []vo.Identifier{identifier}
+	}
+
+	return group.memberIdentifiers()
+}
+
+// 連動して移動するアイテムのグループ
+type ScheduleItemGroupModel struct {
+	groupIdentifier vo.Identifier
+	members         []*ScheduleItemGroupMemberModel
+}
+
+func NewScheduleItemGroupModel(
+	groupIdentifier vo.Identifier,
+	members []*ScheduleItemGroupMemberModel,
+) *ScheduleItemGroupModel {
+
+	sortedMembers := slices.Clone(members)
+	slices.SortStableFunc(sortedMembers, func(a, b *ScheduleItemGroupMemberModel) int {
+		return cmp.Compare(a.offsetMinutes.Value(), b.offsetMinutes.Value())
+	})
+
+	return &ScheduleItemGroupModel{
+		groupIdentifier: groupIdentifier,
+		members:         sortedMembers,
+	}
+}
+
+func (r ScheduleItemGroupModel) GroupIdentifier() vo.Identifier {
+	return r.groupIdentifier
+}
+
+func (r ScheduleItemGroupModel) Members() []*ScheduleItemGroupMemberModel {
+	return r.members
+}
+
+func (r ScheduleItemGroupModel) hasMember(identifier vo.Identifier) bool {
+
+	return lo.SomeBy(r.members, func(member *ScheduleItemGroupMemberModel) bool {
+		return member.identifier == identifier
+	})
+}
+
+func (r ScheduleItemGroupModel) memberIdentifiers() []vo.Identifier {
+
+	return lo.Map(r.members, func(member *ScheduleItemGroupMemberModel, _ int) vo.Identifier {
+		return member.identifier
+	})
+}
+
+func (r ScheduleItemGroupModel) offsetMinutes(identifier vo.Identifier) int {
+
+	member, _ := lo.Find(r.members, func(member *ScheduleItemGroupMemberModel) bool {
+		return member.identifier == identifier
+	})
+
+	if member == nil {
+		return 0
+	}
+
+	return member.offsetMinutes.Value()
+}
+
+func (r ScheduleItemGroupModel) duplicate() *ScheduleItemGroupModel {
+
+	return &ScheduleItemGroupModel{
+		groupIdentifier: r.groupIdentifier,
+		members: lo.Map(r.members, func(member *ScheduleItemGroupMemberModel, _ int) *ScheduleItemGroupMemberModel {
+			copy := *member
+			return &copy
+		}),
+	}
+}
+
+type ScheduleItemGroupMemberModel struct {
+	identifier    vo.Identifier
+	offsetMinutes vo.ItemGroupOffsetMinutes
+}
+
+func NewScheduleItemGroupMemberModel(
+	identifier vo.Identifier,
+	offsetMinutes vo.ItemGroupOffsetMinutes,
+) *ScheduleItemGroupMemberModel {
+
+	return &ScheduleItemGroupMemberModel{
+		identifier:    identifier,
+		offsetMinutes: offsetMinutes,
+	}
+}
+
+func (r ScheduleItemGroupMemberModel) Identifier() vo.Identifier {
+	return r.identifier
+}
+
+func (r ScheduleItemGroupMemberModel) OffsetMinutes() vo.ItemGroupOffsetMinutes {
+	return r.offsetMinutes
+}
@@ -0,0 +1,114 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type ScheduleItemOperationModelSlice []*ScheduleItemOperationModel
+
+// 変更提案に含まれるアイテムの操作。アイテムの種類や長さは適用先のスケジュールから引き継ぐ
+// 一覧へ戻す操作では教室・時刻は使用しない
+type ScheduleItemOperationModel struct {
+	operation  vo.ItemOperation
+	identifier vo.Identifier
+	roomIndex  vo.RoomIndex
+	startTime  vo.ScheduleLessonTime
+}
+
+func NewScheduleItemOperationModel(
+	operation vo.ItemOperation,
+	identifier vo.Identifier,
+	roomIndex vo.RoomIndex,
+	startTime vo.ScheduleLessonTime,
+) *ScheduleItemOperationModel {
+
+	return &ScheduleItemOperationModel{
+		operation:  operation,
+		identifier: identifier,
+		roomIndex:  roomIndex,
+		startTime:  startTime,
+	}
+}
+
+func (r ScheduleItemOperationModel) Operation() vo.ItemOperation {
+	return r.operation
+}
+
+func (r ScheduleItemOperationModel) Identifier() vo.Identifier {
+	return r.identifier
+}
+
+func (r ScheduleItemOperationModel) RoomIndex() vo.RoomIndex {
+	return r.roomIndex
+}
+
+func (r ScheduleItemOperationModel) StartTime() vo.ScheduleLessonTime {
+	return r.startTime
+}
+
+// 配置済みアイテムを返す。未配置の場合は false
+func (r RootScheduleModel) FindRoomItem(identifier vo.Identifier) (*ScheduleRoomItemModel, bool) {
+	return r.roomItems.findByIdentifier(identifier)
+}
+
+// 操作を順に適用する。途中で失敗した場合、このスケジュールは破棄すること
+func (r *RootScheduleModel) ApplyItemOperations(operations ScheduleItemOperationModelSlice) error {
+
+	for _, operation := range operations {
+
+		var err error
+		if operation.operation.IsMove() {
+			err = r.applyItemMove(operation)
+		} else {
+			err = r.applyItemReturn(operation)
+		}
+
+		if err != nil {
+			return log.WrapErrorWithStackTrace(log.Errorf("%s: %v", operation.identifier.Value(), err))
+		}
+	}
+
+	return nil
+}
+
+func (r *RootScheduleModel) applyItemMove(operation *ScheduleItemOperationModel) error {
+
+	if operation.roomIndex == vo.ROOM_INDEX_ALL {
+		return log.WrapErrorWithStackTrace(errors.New("配置先の教室が指定されていません"))
+	}
+
+	itemTag, lessonID, title, duration, found := r.findItemAttributes(operation.identifier)
+	if !found {
+		return log.WrapErrorWithStackTrace(errors.New("アイテムが見つかりません"))
+	}
+
+	endTime, err := vo.NewScheduleLessonTimeFromMinutes(operation.startTime.ValueMinutes() + duration.Value())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return r.RoomItemMove(NewScheduleRoomItemModel(
+		itemTag,
+		lessonID,
+		operation.identifier,
+		title,
+		duration,
+		operation.startTime,
+		endTime,
+		operation.roomIndex,
+		false,
+	), vo.ITEM_MOVE_MODE_EXACT)
+}
+
+func (r *RootScheduleModel) applyItemReturn(operation *ScheduleItemOperationModel) error {
+
+	roomItem, found := r.roomItems.findByIdentifier(operation.identifier)
+	if !found {
+		return log.WrapErrorWithStackTrace(errors.New("配置されていないアイテムです"))
+	}
+
+	return r.ItemReturnList(NewScheduleItemModel(roomItem.lessonID, roomItem.identifier, roomItem.duration), vo.ITEM_RETURN_MODE_KEEP)
+}
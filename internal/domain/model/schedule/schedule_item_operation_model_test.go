@@ -0,0 +1,125 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestApplyItemOperations(t *testing.T) {
+
+	type testOperation struct {
+		operation    vo.ItemOperation
+		identifier   string
+		roomIndex    vo.RoomIndex
+		startMinutes int
+	}
+
+	tests := []struct {
+		name       string
+		operations []testOperation
+		want       map[string]int
+		wantRooms  map[string]int
+		wantList   int
+		wantErr    bool
+	}{
+		{
+			name: "別の教室へ移動する",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_MOVE, identifier: "a", roomIndex: 2, startMinutes: 600},
+			},
+			want:      map[string]int{"a": 600, "b": 600},
+			wantRooms: map[string]int{"a": 2, "b": 1},
+		},
+		{
+			name: "操作を順に適用する",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_MOVE, identifier: "a", roomIndex: 1, startMinutes: 660},
+				{operation: vo.ITEM_OPERATION_RETURN, identifier: "b"},
+			},
+			want:      map[string]int{"a": 660},
+			wantRooms: map[string]int{"a": 1},
+			wantList:  1,
+		},
+		{
+			name: "スケジュールに存在しないアイテム",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_MOVE, identifier: "unknown", roomIndex: 1, startMinutes: 660},
+			},
+			wantErr: true,
+		},
+		{
+			name: "配置先の教室が指定されていない",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_MOVE, identifier: "a", roomIndex: vo.ROOM_INDEX_ALL, startMinutes: 660},
+			},
+			wantErr: true,
+		},
+		{
+			name: "スケジュール終了時刻を超える",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_MOVE, identifier: "a", roomIndex: 1, startMinutes: 750},
+			},
+			wantErr: true,
+		},
+		{
+			name: "一覧へ戻したアイテムを再度戻す",
+			operations: []testOperation{
+				{operation: vo.ITEM_OPERATION_RETURN, identifier: "b"},
+				{operation: vo.ITEM_OPERATION_RETURN, identifier: "b"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			scheduleData := newTestSchedule(t, 9, 13,
+				newTestRoomItem(t, "a", 1, 540, 60, false),
+				newTestRoomItem(t, "b", 1, 600, 60, false),
+			)
+
+			operations := make(ScheduleItemOperationModelSlice, 0, len(tt.operations))
+			for _, operation := range tt.operations {
+
+				startTime, err := vo.NewScheduleLessonTimeFromMinutes(operation.startMinutes)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				operations = append(operations, NewScheduleItemOperationModel(operation.operation, vo.Identifier(operation.identifier), operation.roomIndex, startTime))
+			}
+
+			err := scheduleData.ApplyItemOperations(operations)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			gotRooms := make(map[string]int, len(scheduleData.RoomItems()))
+			for _, roomItem := range scheduleData.RoomItems() {
+				gotRooms[roomItem.Identifier().Value()] = roomItem.RoomIndex().Value()
+			}
+			if !reflect.DeepEqual(gotRooms, tt.wantRooms) {
+				t.Errorf("got rooms %v, want %v", gotRooms, tt.wantRooms)
+			}
+
+			if got := len(scheduleData.Items()); got != tt.wantList {
+				t.Errorf("got %d list items, want %d", got, tt.wantList)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package schedule
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// ポリシー適用時点の配置。既存データの違反で編集できなくならないよう、変更のあった箇所だけを検証する
+type policyBaseline struct {
+	targetDate   vo.ScheduleTargetDate
+	scheduleTime vo.ScheduleTime
+	placements   map[vo.Identifier]policyPlacement
+}
+
+type policyPlacement struct {
+	placed       bool
+	roomIndex    vo.RoomIndex
+	startMinutes int
+	duration     vo.LessonDuration
+}
+
+func (r *RootScheduleModel) ApplySchedulingPolicy(schedulingPolicy *policy.RootSchedulingPolicyModel) {
+
+	r.policy = schedulingPolicy
+	r.policyBaseline = &policyBaseline{
+		targetDate:   r.targetDate,
+		scheduleTime: r.scheduleTime,
+		placements:   r.policyPlacements(),
+	}
+}
+
+// 実施日に適用される利用不可の時間帯。ポリシー未適用の場合は無し
+func (r RootScheduleModel) BlockedPeriods() policy.BlockedPeriodModelSlice {
+
+	if r.policy == nil {
+		return policy.BlockedPeriodModelSlice{}
+	}
+
+	return r.policy.BlockedPeriods().FilterByTargetDate(r.targetDate)
+}
+
+func (r RootScheduleModel) policyPlacements() map[vo.Identifier]policyPlacement {
+
+	placements := make(map[vo.Identifier]policyPlacement, len(r.items)+len(r.roomItems))
+
+	for _, item := range r.items {
+		placements[item.identifier] = policyPlacement{
+			placed:   false,
+			duration: item.duration,
+		}
+	}
+
+	for _, roomItem := range r.roomItems {
+		placements[roomItem.identifier] = policyPlacement{
+			placed:       true,
+			roomIndex:    roomItem.roomIndex,
+			startMinutes: roomItem.startTime.ValueMinutes(),
+			duration:     roomItem.duration,
+		}
+	}
+
+	return placements
+}
+
+func (r RootScheduleModel) isChangedFromPolicyBaseline(identifier vo.Identifier, current policyPlacement) bool {
+
+	before, found := r.policyBaseline.placements[identifier]
+	return !found || before != current
+}
+
+// 適用したポリシーに対して、適用後に変更された講座の時間・開始時刻・間隔・利用不可の時間帯とスケジュール時間を検証する
+func (r RootScheduleModel) ValidateSchedulingPolicy() error {
+
+	if r.policy == nil || r.policyBaseline == nil {
+		return nil
+	}
+
+	if r.targetDate != r.policyBaseline.targetDate || r.scheduleTime != r.policyBaseline.scheduleTime {
+		if err := r.policy.ValidateScheduleTime(r.targetDate, r.scheduleTime); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	blockedPeriods := r.BlockedPeriods()
+	currentPlacements := r.policyPlacements()
+	changed := func(identifier vo.Identifier) bool {
+		return r.isChangedFromPolicyBaseline(identifier, currentPlacements[identifier])
+	}
+
+	var errs error
+
+	for _, item := range r.items {
+		if changed(item.identifier) {
+			errs = errors.Join(errs, r.policy.ValidateLessonDuration(item.duration))
+		}
+	}
+
+	for _, roomItem := range r.roomItems {
+
+		if !changed(roomItem.identifier) {
+			continue
+		}
+
+		if roomItem.itemTag == vo.ROOM_ITEM_TAG_LESSON {
+
+			errs = errors.Join(errs, r.policy.ValidateLessonDuration(roomItem.duration))
+
+			blockedPeriod, blocked := blockedPeriods.FindBlocking(roomItem.roomIndex, roomItem.startTime.ValueMinutes(), roomItem.endTime.ValueMinutes())
+			if blocked {
+				errs = errors.Join(errs, log.Errorf("講座を利用不可の時間帯(%s)に配置することはできません", blockedPeriod.Title().Value()))
+			}
+		}
+
+		errs = errors.Join(errs, r.policy.ValidateStartTime(roomItem.startTime))
+	}
+
+	// 講座の間隔は教室ごとに前後の講座で検証する。清掃などの講座以外のアイテムは検証の対象外で、講座の間にあればその時間も間隔として数える
+	for _, roomIndex := range r.roomItems.roomIndexes() {
+
+		lessonItems := lo.Filter(r.roomItems.filterByRoomIndex(roomIndex), func(item *ScheduleRoomItemModel, _ int) bool {
+			return item.itemTag == vo.ROOM_ITEM_TAG_LESSON
+		})
+
+		slices.SortFunc(lessonItems, func(a, b *ScheduleRoomItemModel) int {
+			return a.startTime.ValueMinutes() - b.startTime.ValueMinutes()
+		})
+
+		for i := 1; i < len(lessonItems); i++ {
+
+			prev, next := lessonItems[i-1], lessonItems[i]
+			if !changed(prev.identifier) && !changed(next.identifier) {
+				continue
+			}
+
+			errs = errors.Join(errs, r.policy.ValidateGap(prev.endTime, next.startTime))
+		}
+	}
+
+	if errs != nil {
+		return log.WrapErrorWithStackTrace(log.Errorf("%v", errs.Error()))
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package schedule
+
+import (
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestValidateSchedulingPolicyGap(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		start   int
+		wantErr bool
+	}{
+		{
+			// a(9:00-10:00) と b の間隔は清掃(10:00-10:10)の時間を含めて15分
+			name:  "講座以外のアイテムを挟んでも講座同士の間隔を満たせばよい",
+			start: 615,
+		},
+		{
+			name:    "講座以外のアイテムの時間を含めても講座同士の間隔が足りない",
+			start:   610,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			cleaning := newTestRoomItem(t, "c", 1, 600, 10, false)
+			cleaning.itemTag = vo.ROOM_ITEM_TAG_CLEANING
+
+			scheduleData := newTestSchedule(t, 9, 12,
+				newTestRoomItem(t, "a", 1, 540, 60, false),
+				cleaning,
+				newTestRoomItem(t, "b", 1, 660, 60, false),
+			)
+
+			schedulingPolicy, err := policy.NewRootSchedulingPolicyModel(
+				vo.Campus("shibuya"),
+				vo.POLICY_GRID_MINUTES_DEFAULT,
+				vo.LessonDuration(1),
+				vo.LessonDuration(300),
+				vo.PolicyGapMinutes(15),
+				policy.OpeningHourModelSlice{},
+				policy.BlockedPeriodModelSlice{},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
+			err = scheduleData.RoomItemMove(newTestRoomItem(t, "b", 1, tt.start, 60, false), vo.ITEM_MOVE_MODE_EXACT)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = scheduleData.ValidateSchedulingPolicy()
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package schedule
 
 import (
 	"cmp"
+	"errors"
 	"slices"
 
 	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
@@ -48,7 +50,43 @@ func (r ScheduleRoomItemModelSlice) removeByRoomIndex(roomIndex vo.RoomIndex) Sc
 	})
 }
 
-func (r ScheduleRoomItemModelSlice) shiftedItems(roomIndex vo.RoomIndex, scheduleTime vo.ScheduleTime) (ScheduleRoomItemModelSlice, error) {
+func (r ScheduleRoomItemModelSlice) filterByRoomIndex(roomIndex vo.RoomIndex) ScheduleRoomItemModelSlice {
+
+	return lo.Filter(r, func(item *ScheduleRoomItemModel, _ int) bool {
+		return item.roomIndex == roomIndex
+	})
+}
+
+func (r ScheduleRoomItemModelSlice) filterByRoomIndexes(roomIndexes ...vo.RoomIndex) ScheduleRoomItemModelSlice {
+
+	return lo.Filter(r, func(item *ScheduleRoomItemModel, _ int) bool {
+		return lo.Contains(roomIndexes, item.roomIndex)
+	})
+}
+
+// アイテムが配置されている教室番号を昇順で返す
+func (r ScheduleRoomItemModelSlice) roomIndexes() []vo.RoomIndex {
+
+	roomIndexes := lo.Uniq(lo.Map(r, func(item *ScheduleRoomItemModel, _ int) vo.RoomIndex {
+		return item.roomIndex
+	}))
+	slices.Sort(roomIndexes)
+
+	return roomIndexes
+}
+
+// 指定したアイテムが複数の教室に配置されているか
+func (r ScheduleRoomItemModelSlice) spansRooms(identifiers []vo.Identifier) bool {
+
+	roomIndexes := lo.FilterMap(r, func(item *ScheduleRoomItemModel, _ int) (vo.RoomIndex, bool) {
+		return item.roomIndex, lo.Contains(identifiers, item.identifier)
+	})
+
+	return len(lo.Uniq(roomIndexes)) > 1
+}
+
+// 指定した教室のアイテムを開始時刻順に複製して返す
+func (r ScheduleRoomItemModelSlice) copiedSortedItems(roomIndex vo.RoomIndex) ScheduleRoomItemModelSlice {
 
 	roomItems := lo.Map(
 		lo.Filter(r, func(item *ScheduleRoomItemModel, _ int) bool {
@@ -64,76 +102,246 @@ func (r ScheduleRoomItemModelSlice) shiftedItems(roomIndex vo.RoomIndex, schedul
 		return cmp.Compare(a.startTime.ValueMinutes(), b.startTime.ValueMinutes())
 	})
 
-	var createLessonTime = func(minutes int) (vo.ScheduleLessonTime, error) {
+	return roomItems
+}
+
+func (r ScheduleRoomItemModelSlice) shiftedItems(roomIndex vo.RoomIndex, scheduleTime vo.ScheduleTime, itemGroups ScheduleItemGroupModelSlice, blockedPeriods policy.BlockedPeriodModelSlice, option *ScheduleItemShiftOption) (ScheduleRoomItemModelSlice, error) {
 
-		newStartTimeHour := minutes / 60
-		newStartTimeMinute := minutes % 60
-		return vo.NewScheduleLessonTime(newStartTimeHour, newStartTimeMinute)
+	roomItems := r.copiedSortedItems(roomIndex)
+	units := withBlockedUnits(newShiftUnits(roomItems, r, itemGroups), roomIndex, blockedPeriods)
 
+	err := shiftUnits(units, scheduleTime, option)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	return roomItems, nil
+}
+
+// 配置したアイテムと重なるアイテムを、連鎖的に後ろへ押し出す
+func (r ScheduleRoomItemModelSlice) pushedItems(roomIndex vo.RoomIndex, placedIdentifiers []vo.Identifier, scheduleTime vo.ScheduleTime, itemGroups ScheduleItemGroupModelSlice, blockedPeriods policy.BlockedPeriodModelSlice) (ScheduleRoomItemModelSlice, error) {
+
+	roomItems := r.copiedSortedItems(roomIndex)
+	units := withBlockedUnits(newShiftUnits(roomItems, r, itemGroups), roomIndex, blockedPeriods)
+
+	// 利用不可の時間帯は配置したアイテムと同じく押し出しの起点にする
+	placedUnits, movableUnits := lo.FilterReject(units, func(unit *shiftUnit, _ int) bool {
+		return unit.containsAny(placedIdentifiers) || unit.isBlocked()
+	})
+
 	scheduleEndTime := scheduleTime.EndTimeValueMinutes()
-	for index, roomItem := range roomItems {
+	settledUnits := placedUnits
+	for _, unit := range movableUnits {
+
+		start := unit.startMinutes()
+		for isMoved := true; isMoved; {
+
+			isMoved = false
+			for _, settledUnit := range settledUnits {
+				if settledUnit.isOverlapped(start, start+unit.spanMinutes()) {
+					start = settledUnit.endMinutes()
+					isMoved = true
+				}
+			}
+		}
 
-		if index <= 0 {
-			continue
+		if start != unit.startMinutes() {
+
+			if unit.isPinned() {
+				return nil, log.WrapErrorWithStackTrace(errors.New("固定されたアイテムは押し出せません"))
+			}
+
+			if start+unit.spanMinutes() > scheduleEndTime {
+				return nil, log.WrapErrorWithStackTrace(errors.New("押し出したアイテムがスケジュール終了時刻を超えます"))
+			}
+
+			err := unit.moveTo(start)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
 		}
 
-		start := roomItems[index-1].endTime.ValueMinutes()
-		end := start + roomItem.duration.Value()
+		settledUnits = append(settledUnits, unit)
+	}
+
+	return roomItems, nil
+}
+
+// 取り除いたアイテムの後続のアイテムを前へ詰める。固定アイテム以降は詰めない
+func (r ScheduleRoomItemModelSlice) pulledItems(roomIndex vo.RoomIndex, removedStartMinutes int, removedSpanMinutes int, scheduleTime vo.ScheduleTime, itemGroups ScheduleItemGroupModelSlice, blockedPeriods policy.BlockedPeriodModelSlice) (ScheduleRoomItemModelSlice, error) {
+
+	roomItems := r.copiedSortedItems(roomIndex)
+	units := withBlockedUnits(newShiftUnits(roomItems, r, itemGroups), roomIndex, blockedPeriods)
 
-		if end > scheduleEndTime {
+	prevEndTime := scheduleTime.StartTimeValueMinutes()
+	for _, unit := range units {
 
-			diff := end - scheduleEndTime
-			end -= diff
-			start -= diff
+		if unit.startMinutes() < removedStartMinutes {
+			prevEndTime = max(prevEndTime, unit.endMinutes())
+			continue
 		}
 
-		newStartTime, err := createLessonTime(start)
-		if err != nil {
-			return nil, log.WrapErrorWithStackTrace(err)
+		if unit.isPinned() {
+			break
 		}
 
-		newEndTime, err := createLessonTime(end)
+		err := unit.moveTo(max(prevEndTime, unit.startMinutes()-removedSpanMinutes))
 		if err != nil {
 			return nil, log.WrapErrorWithStackTrace(err)
 		}
 
-		roomItem.startTime = newStartTime
-		roomItem.endTime = newEndTime
+		prevEndTime = unit.endMinutes()
 	}
 
 	return roomItems, nil
 }
 
+// シフト時にまとめて移動するアイテムの単位(グループに属さないアイテムは単体で1つの単位)
+type shiftUnit struct {
+	items []*ScheduleRoomItemModel
+	// 利用不可の時間帯を表す単位。アイテムを持たず、固定アイテムと同じく動かさない
+	blockedPeriod *policy.BlockedPeriodModel
+	// 他の教室にもメンバーが配置されているグループ。教室ごとに動かすとグループ内の位置がずれるため動かさない
+	spansRooms bool
+}
+
+func newShiftUnits(sortedRoomItems []*ScheduleRoomItemModel, allRoomItems ScheduleRoomItemModelSlice, itemGroups ScheduleItemGroupModelSlice) []*shiftUnit {
+
+	units := []*shiftUnit{}
+	unitByGroup := map[vo.Identifier]*shiftUnit{}
+	for _, roomItem := range sortedRoomItems {
+
+		group, found := itemGroups.findByMemberIdentifier(roomItem.identifier)
+		if !found {
+			units = append(units, &shiftUnit{items: []*ScheduleRoomItemModel{roomItem}})
+			continue
+		}
+
+		unit, found := unitByGroup[group.groupIdentifier]
+		if !found {
+			unit = &shiftUnit{spansRooms: allRoomItems.spansRooms(group.memberIdentifiers())}
+			unitByGroup[group.groupIdentifier] = unit
+			units = append(units, unit)
+		}
+
+		unit.items = append(unit.items, roomItem)
+	}
+
+	return units
+}
+
+// 教室に適用される利用不可の時間帯を単位として加え、開始時刻順に並べ直す
+func withBlockedUnits(units []*shiftUnit, roomIndex vo.RoomIndex, blockedPeriods policy.BlockedPeriodModelSlice) []*shiftUnit {
+
+	for _, blockedPeriod := range blockedPeriods {
+		if blockedPeriod.IsAllRooms() || blockedPeriod.RoomIndex() == roomIndex {
+			units = append(units, &shiftUnit{blockedPeriod: blockedPeriod})
+		}
+	}
+
+	slices.SortStableFunc(units, func(a, b *shiftUnit) int {
+		return a.startMinutes() - b.startMinutes()
+	})
+
+	return units
+}
+
+func (r shiftUnit) isBlocked() bool {
+	return r.blockedPeriod != nil
+}
+
+func (r shiftUnit) startMinutes() int {
+
+	if r.isBlocked() {
+		return r.blockedPeriod.Period().StartTimeValueMinutes()
+	}
+
+	return lo.Min(lo.Map(r.items, func(item *ScheduleRoomItemModel, _ int) int {
+		return item.startTime.ValueMinutes()
+	}))
+}
+
+func (r shiftUnit) endMinutes() int {
+
+	if r.isBlocked() {
+		return r.blockedPeriod.Period().EndTimeValueMinutes()
+	}
+
+	return lo.Max(lo.Map(r.items, func(item *ScheduleRoomItemModel, _ int) int {
+		return item.startTime.ValueMinutes() + item.duration.Value()
+	}))
+}
+
+func (r shiftUnit) spanMinutes() int {
+	return r.endMinutes() - r.startMinutes()
+}
+
+func (r shiftUnit) containsAny(identifiers []vo.Identifier) bool {
+
+	return lo.SomeBy(r.items, func(item *ScheduleRoomItemModel) bool {
+		return lo.Contains(identifiers, item.identifier)
+	})
+}
+
+func (r shiftUnit) isPinned() bool {
+
+	return r.isBlocked() || r.spansRooms || lo.SomeBy(r.items, func(item *ScheduleRoomItemModel) bool {
+		return item.pinned
+	})
+}
+
+func (r shiftUnit) isOverlapped(startMinutes int, endMinutes int) bool {
+	return r.startMinutes() < endMinutes && startMinutes < r.endMinutes()
+}
+
+func (r shiftUnit) moveTo(startMinutes int) error {
+
+	diff := startMinutes - r.startMinutes()
+	for _, item := range r.items {
+
+		err := item.moveTo(item.startTime.ValueMinutes() + diff)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	return nil
+}
+
 type ScheduleRoomItemModel struct {
 	itemTag    vo.RoomItemTag
 	lessonID   vo.LessonID
 	identifier vo.Identifier
+	title      vo.RoomItemTitle
 	duration   vo.LessonDuration
 	startTime  vo.ScheduleLessonTime
 	endTime    vo.ScheduleLessonTime
 	roomIndex  vo.RoomIndex
+	pinned     bool
 }
 
 func NewScheduleRoomItemModel(
 	itemTag vo.RoomItemTag,
 	lessonID vo.LessonID,
 	identifier vo.Identifier,
+	title vo.RoomItemTitle,
 	duration vo.LessonDuration,
 	startTime vo.ScheduleLessonTime,
 	endTime vo.ScheduleLessonTime,
 	roomIndex vo.RoomIndex,
+	pinned bool,
 ) *ScheduleRoomItemModel {
 
 	return &ScheduleRoomItemModel{
 		itemTag:    itemTag,
 		lessonID:   lessonID,
 		identifier: identifier,
+		title:      title,
 		duration:   duration,
 		startTime:  startTime,
 		endTime:    endTime,
 		roomIndex:  roomIndex,
+		pinned:     pinned,
 	}
 }
 
@@ -149,6 +357,10 @@ func (r ScheduleRoomItemModel) Identifier() vo.Identifier {
 	return r.identifier
 }
 
+func (r ScheduleRoomItemModel) Title() vo.RoomItemTitle {
+	return r.title
+}
+
 func (r ScheduleRoomItemModel) Duration() vo.LessonDuration {
 	return r.duration
 }
@@ -165,14 +377,42 @@ func (r ScheduleRoomItemModel) RoomIndex() vo.RoomIndex {
 	return r.roomIndex
 }
 
+func (r ScheduleRoomItemModel) Pinned() bool {
+	return r.pinned
+}
+
+func (r ScheduleRoomItemModel) isOverlapped(startMinutes int, endMinutes int) bool {
+	return r.startTime.ValueMinutes() < endMinutes && startMinutes < r.endTime.ValueMinutes()
+}
+
+func (r *ScheduleRoomItemModel) moveTo(startMinutes int) error {
+
+	newStartTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	newEndTime, err := vo.NewScheduleLessonTimeFromMinutes(startMinutes + r.duration.Value())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.startTime = newStartTime
+	r.endTime = newEndTime
+
+	return nil
+}
+
 func (r ScheduleRoomItemModel) duplicate() *ScheduleRoomItemModel {
 	return &ScheduleRoomItemModel{
 		itemTag:    r.itemTag,
 		lessonID:   r.lessonID,
 		identifier: r.identifier,
+		title:      r.title,
 		duration:   r.duration,
 		startTime:  r.startTime,
 		endTime:    r.endTime,
 		roomIndex:  r.roomIndex,
+		pinned:     r.pinned,
 	}
 }
@@ -0,0 +1,341 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleItemShiftNoFreeSlot = errors.New("固定アイテム・利用不可の時間帯と重ならずにシフトできる空き時間がありません")
+
+// アイテムシフトの方法と、方法ごとに使用する設定値
+type ScheduleItemShiftOption struct {
+	strategy    vo.ItemShiftStrategy
+	gapMinutes  vo.ItemShiftGapMinutes
+	gridMinutes vo.ItemShiftGridMinutes
+}
+
+func NewScheduleItemShiftOption(
+	strategy vo.ItemShiftStrategy,
+	gapMinutes vo.ItemShiftGapMinutes,
+	gridMinutes vo.ItemShiftGridMinutes,
+) *ScheduleItemShiftOption {
+
+	return &ScheduleItemShiftOption{
+		strategy:    strategy,
+		gapMinutes:  gapMinutes,
+		gridMinutes: gridMinutes,
+	}
+}
+
+func (r ScheduleItemShiftOption) Strategy() vo.ItemShiftStrategy {
+	return r.strategy
+}
+
+func (r ScheduleItemShiftOption) GapMinutes() vo.ItemShiftGapMinutes {
+	return r.gapMinutes
+}
+
+func (r ScheduleItemShiftOption) GridMinutes() vo.ItemShiftGridMinutes {
+	return r.gridMinutes
+}
+
+// 開始時刻順に並んだシフト単位を、指定した方法で再配置する。固定アイテムを含む単位は動かさない
+func shiftUnits(units []*shiftUnit, scheduleTime vo.ScheduleTime, option *ScheduleItemShiftOption) error {
+
+	switch option.strategy {
+	case vo.ITEM_SHIFT_STRATEGY_PACK_START:
+		return packUnitsToStart(units, scheduleTime)
+	case vo.ITEM_SHIFT_STRATEGY_PACK_END:
+		return packUnitsToEnd(units, scheduleTime)
+	case vo.ITEM_SHIFT_STRATEGY_DISTRIBUTE:
+		return distributeUnits(units, scheduleTime)
+	case vo.ITEM_SHIFT_STRATEGY_FIXED_GAP:
+		return packUnitsWithGap(units, scheduleTime, option.gapMinutes.Value())
+	case vo.ITEM_SHIFT_STRATEGY_GRID:
+		return alignUnitsToGrid(units, scheduleTime, option.gridMinutes.Value())
+	default:
+		return packUnits(units, scheduleTime)
+	}
+}
+
+// 先頭のアイテムに後続のアイテムを詰める。終了時刻を超える場合は終了時刻に揃える
+func packUnits(units []*shiftUnit, scheduleTime vo.ScheduleTime) error {
+
+	pinnedUnits := filterPinnedUnits(units)
+	anchorIndex := firstItemUnitIndex(units)
+
+	scheduleEndTime := scheduleTime.EndTimeValueMinutes()
+	prevEndTime := 0
+	for index, unit := range units {
+
+		if index <= anchorIndex || unit.isPinned() {
+			prevEndTime = max(prevEndTime, unit.endMinutes())
+			continue
+		}
+
+		// 固定アイテムと重なる場合は固定アイテムの後ろに詰める
+		start := earliestFreeStart(prevEndTime, unit.spanMinutes(), 0, pinnedUnits, noAlign)
+		end := start + unit.spanMinutes()
+
+		// 終了時刻に揃えた位置が固定アイテムと重なる場合は、固定アイテムの手前に詰める
+		if end > scheduleEndTime {
+
+			start = latestFreeStart(scheduleEndTime-unit.spanMinutes(), unit.spanMinutes(), pinnedUnits)
+			end = start + unit.spanMinutes()
+
+			if start < scheduleTime.StartTimeValueMinutes() {
+				return log.WrapErrorWithStackTrace(ErrScheduleItemShiftNoFreeSlot)
+			}
+		}
+
+		err := unit.moveTo(start)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		prevEndTime = end
+	}
+
+	return nil
+}
+
+// スケジュール開始時刻から順に詰める
+func packUnitsToStart(units []*shiftUnit, scheduleTime vo.ScheduleTime) error {
+
+	pinnedUnits := filterPinnedUnits(units)
+
+	prevEndTime := scheduleTime.StartTimeValueMinutes()
+	for _, unit := range filterMovableUnits(units) {
+
+		start := earliestFreeStart(prevEndTime, unit.spanMinutes(), 0, pinnedUnits, noAlign)
+
+		err := moveUnitWithinSchedule(unit, start, scheduleTime)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		prevEndTime = unit.endMinutes()
+	}
+
+	return nil
+}
+
+// スケジュール終了時刻から逆順に詰める
+func packUnitsToEnd(units []*shiftUnit, scheduleTime vo.ScheduleTime) error {
+
+	pinnedUnits := filterPinnedUnits(units)
+	movableUnits := filterMovableUnits(units)
+
+	prevStartTime := scheduleTime.EndTimeValueMinutes()
+	for _, unit := range lo.Reverse(movableUnits) {
+
+		start := latestFreeStart(prevStartTime-unit.spanMinutes(), unit.spanMinutes(), pinnedUnits)
+		if start < scheduleTime.StartTimeValueMinutes() {
+			return log.WrapErrorWithStackTrace(ErrScheduleItemShiftNoFreeSlot)
+		}
+
+		err := moveUnitWithinSchedule(unit, start, scheduleTime)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		prevStartTime = unit.startMinutes()
+	}
+
+	return nil
+}
+
+// 先頭のアイテムを起点に、一定の間隔を空けて詰める
+func packUnitsWithGap(units []*shiftUnit, scheduleTime vo.ScheduleTime, gapMinutes int) error {
+
+	anchorIndex := firstItemUnitIndex(units)
+	if anchorIndex < 0 {
+		return nil
+	}
+
+	pinnedUnits := filterPinnedUnits(units)
+
+	nextStartTime := units[anchorIndex].startMinutes()
+	for _, unit := range filterMovableUnits(units) {
+
+		start := earliestFreeStart(nextStartTime, unit.spanMinutes(), gapMinutes, pinnedUnits, noAlign)
+
+		err := moveUnitWithinSchedule(unit, start, scheduleTime)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		nextStartTime = unit.endMinutes() + gapMinutes
+	}
+
+	return nil
+}
+
+// 開始時刻をスケジュール開始時刻からの刻みの最寄りに揃える。前のアイテムと重なる場合は次の刻みへずらす
+func alignUnitsToGrid(units []*shiftUnit, scheduleTime vo.ScheduleTime, gridMinutes int) error {
+
+	pinnedUnits := filterPinnedUnits(units)
+
+	scheduleStartTime := scheduleTime.StartTimeValueMinutes()
+	alignNearest := func(minutes int) int {
+		return scheduleStartTime + (minutes-scheduleStartTime+gridMinutes/2)/gridMinutes*gridMinutes
+	}
+	alignCeil := func(minutes int) int {
+		return scheduleStartTime + (minutes-scheduleStartTime+gridMinutes-1)/gridMinutes*gridMinutes
+	}
+
+	prevEndTime := scheduleStartTime
+	for _, unit := range filterMovableUnits(units) {
+
+		start := max(alignNearest(unit.startMinutes()), alignCeil(prevEndTime))
+		start = earliestFreeStart(start, unit.spanMinutes(), 0, pinnedUnits, alignCeil)
+
+		err := moveUnitWithinSchedule(unit, start, scheduleTime)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		prevEndTime = unit.endMinutes()
+	}
+
+	return nil
+}
+
+// 固定アイテムで区切られた区間ごとに、空き時間が均等になるように配置する
+func distributeUnits(units []*shiftUnit, scheduleTime vo.ScheduleTime) error {
+
+	pinnedUnits := filterPinnedUnits(units)
+
+	// 区間ごとに、区間内に配置するアイテムを元の並び順のまま振り分ける
+	segmentUnits := make([][]*shiftUnit, len(pinnedUnits)+1)
+	for _, unit := range filterMovableUnits(units) {
+
+		segmentIndex := lo.CountBy(pinnedUnits, func(pinnedUnit *shiftUnit) bool {
+			return pinnedUnit.startMinutes() <= unit.startMinutes()
+		})
+		segmentUnits[segmentIndex] = append(segmentUnits[segmentIndex], unit)
+	}
+
+	for segmentIndex, units := range segmentUnits {
+
+		if len(units) <= 0 {
+			continue
+		}
+
+		segmentStartTime := scheduleTime.StartTimeValueMinutes()
+		if segmentIndex > 0 {
+			segmentStartTime = lo.Max(lo.Map(pinnedUnits[:segmentIndex], func(pinnedUnit *shiftUnit, _ int) int {
+				return pinnedUnit.endMinutes()
+			}))
+		}
+
+		segmentEndTime := scheduleTime.EndTimeValueMinutes()
+		if segmentIndex < len(pinnedUnits) {
+			segmentEndTime = pinnedUnits[segmentIndex].startMinutes()
+		}
+
+		totalSpan := lo.SumBy(units, func(unit *shiftUnit) int {
+			return unit.spanMinutes()
+		})
+
+		freeMinutes := segmentEndTime - segmentStartTime - totalSpan
+		if freeMinutes < 0 {
+			return log.WrapErrorWithStackTrace(errors.New("均等に配置するための時間が足りません"))
+		}
+
+		gapMinutes := freeMinutes / (len(units) + 1)
+		start := segmentStartTime + gapMinutes
+		for _, unit := range units {
+
+			err := moveUnitWithinSchedule(unit, start, scheduleTime)
+			if err != nil {
+				return log.WrapErrorWithStackTrace(err)
+			}
+
+			start = unit.endMinutes() + gapMinutes
+		}
+	}
+
+	return nil
+}
+
+func filterPinnedUnits(units []*shiftUnit) []*shiftUnit {
+
+	return lo.Filter(units, func(unit *shiftUnit, _ int) bool {
+		return unit.isPinned()
+	})
+}
+
+func filterMovableUnits(units []*shiftUnit) []*shiftUnit {
+
+	return lo.Reject(units, func(unit *shiftUnit, _ int) bool {
+		return unit.isPinned()
+	})
+}
+
+// 起点にする先頭のアイテムの位置。利用不可の時間帯は起点にしない
+func firstItemUnitIndex(units []*shiftUnit) int {
+
+	_, index, found := lo.FindIndexOf(units, func(unit *shiftUnit) bool {
+		return !unit.isBlocked()
+	})
+	if !found {
+		return -1
+	}
+
+	return index
+}
+
+func noAlign(minutes int) int {
+	return minutes
+}
+
+// 固定アイテムと(間隔を含めて)重ならない、指定時刻以降で最も早い開始時刻を返す
+func earliestFreeStart(start int, spanMinutes int, gapMinutes int, pinnedUnits []*shiftUnit, align func(int) int) int {
+
+	for isMoved := true; isMoved; {
+
+		isMoved = false
+		for _, pinnedUnit := range pinnedUnits {
+			if pinnedUnit.isOverlapped(start-gapMinutes, start+spanMinutes+gapMinutes) {
+				start = align(pinnedUnit.endMinutes() + gapMinutes)
+				isMoved = true
+			}
+		}
+	}
+
+	return start
+}
+
+// 固定アイテムと重ならない、指定時刻以前で最も遅い開始時刻を返す
+func latestFreeStart(start int, spanMinutes int, pinnedUnits []*shiftUnit) int {
+
+	for isMoved := true; isMoved; {
+
+		isMoved = false
+		for _, pinnedUnit := range pinnedUnits {
+			if pinnedUnit.isOverlapped(start, start+spanMinutes) {
+				start = pinnedUnit.startMinutes() - spanMinutes
+				isMoved = true
+			}
+		}
+	}
+
+	return start
+}
+
+func moveUnitWithinSchedule(unit *shiftUnit, start int, scheduleTime vo.ScheduleTime) error {
+
+	if start < scheduleTime.StartTimeValueMinutes() {
+		return log.WrapErrorWithStackTrace(errors.New("シフトしたアイテムがスケジュール開始時刻より前になります"))
+	}
+
+	if start+unit.spanMinutes() > scheduleTime.EndTimeValueMinutes() {
+		return log.WrapErrorWithStackTrace(errors.New("シフトしたアイテムがスケジュール終了時刻を超えます"))
+	}
+
+	return unit.moveTo(start)
+}
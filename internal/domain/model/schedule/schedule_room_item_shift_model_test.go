@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestRoomItemShiftWithPinnedItems(t *testing.T) {
+
+	type testItem struct {
+		identifier   string
+		startMinutes int
+		duration     int
+		pinned       bool
+	}
+
+	tests := []struct {
+		name     string
+		strategy vo.ItemShiftStrategy
+		items    []testItem
+		want     map[string]int
+		wantErr  error
+	}{
+		{
+			name:     "固定アイテムは動かさずに後ろへ詰める",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 60},
+				{identifier: "pinned", startMinutes: 600, duration: 30, pinned: true},
+				{identifier: "c", startMinutes: 680, duration: 30},
+			},
+			want: map[string]int{"a": 540, "pinned": 600, "c": 630},
+		},
+		{
+			name:     "終了時刻に揃えた位置が固定アイテムと重なる場合は固定アイテムの手前に詰める",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 20},
+				{identifier: "pinned", startMinutes: 620, duration: 80, pinned: true},
+				{identifier: "c", startMinutes: 710, duration: 40},
+			},
+			want: map[string]int{"a": 540, "pinned": 620, "c": 580},
+		},
+		{
+			name:     "終了時刻に揃えても固定アイテムと重ならない空きがない",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 20},
+				{identifier: "pinned", startMinutes: 560, duration: 160, pinned: true},
+				{identifier: "c", startMinutes: 600, duration: 40},
+			},
+			wantErr: ErrScheduleItemShiftNoFreeSlot,
+		},
+		{
+			name:     "開始時刻から詰める場合は固定アイテムを避けて詰める",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK_START,
+			items: []testItem{
+				{identifier: "pinned", startMinutes: 540, duration: 30, pinned: true},
+				{identifier: "a", startMinutes: 600, duration: 60},
+				{identifier: "b", startMinutes: 690, duration: 30},
+			},
+			want: map[string]int{"pinned": 540, "a": 570, "b": 630},
+		},
+		{
+			name:     "終了時刻から詰める場合は固定アイテムの手前に詰める",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK_END,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 60},
+				{identifier: "pinned", startMinutes: 660, duration: 60, pinned: true},
+			},
+			want: map[string]int{"a": 600, "pinned": 660},
+		},
+		{
+			name:     "終了時刻から詰める場合に固定アイテムの手前に空きがない",
+			strategy: vo.ITEM_SHIFT_STRATEGY_PACK_END,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 60},
+				{identifier: "pinned", startMinutes: 570, duration: 150, pinned: true},
+			},
+			wantErr: ErrScheduleItemShiftNoFreeSlot,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			roomItems := make([]*ScheduleRoomItemModel, 0, len(tt.items))
+			before := make(map[string]int, len(tt.items))
+			for _, item := range tt.items {
+				roomItems = append(roomItems, newTestRoomItem(t, item.identifier, 1, item.startMinutes, item.duration, item.pinned))
+				before[item.identifier] = item.startMinutes
+			}
+
+			scheduleData := newTestSchedule(t, 9, 12, roomItems...)
+			option := NewScheduleItemShiftOption(tt.strategy, vo.ItemShiftGapMinutes(0), vo.ITEM_SHIFT_GRID_MINUTES_DEFAULT)
+
+			err := scheduleData.RoomItemShift([]vo.RoomIndex{1}, option)
+
+			if tt.wantErr != nil {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+				// 失敗した場合は配置を変更しない
+				if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, before) {
+					t.Errorf("room items changed on error: got %v, want %v", got, before)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// 2つの教室に配置されたアイテムを入れ替える
+func (r *RootScheduleModel) RoomSwap(roomIndexA vo.RoomIndex, roomIndexB vo.RoomIndex, visibleRoomIndexes []vo.RoomIndex) error {
+
+	if roomIndexA == roomIndexB {
+		return log.WrapErrorWithStackTrace(errors.New("入れ替える教室に同じ教室が指定されています"))
+	}
+
+	err := validateVisibleRooms(visibleRoomIndexes, roomIndexA, roomIndexB)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	swappedRoomItems := ScheduleRoomItemModelSlice(lo.Map(r.roomItems, func(item *ScheduleRoomItemModel, _ int) *ScheduleRoomItemModel {
+
+		swappedItem := item.duplicate()
+		switch item.roomIndex {
+		case roomIndexA:
+			swappedItem.roomIndex = roomIndexB
+		case roomIndexB:
+			swappedItem.roomIndex = roomIndexA
+		}
+
+		return swappedItem
+	}))
+
+	err = r.validateWithinScheduleTime(swappedRoomItems.filterByRoomIndexes(roomIndexA, roomIndexB))
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.roomItems = swappedRoomItems
+
+	return nil
+}
+
+// 教室に配置されたアイテムを、新しい識別子を割り当てて別の教室へ複製する
+func (r *RootScheduleModel) RoomCopy(fromRoomIndex vo.RoomIndex, toRoomIndex vo.RoomIndex, visibleRoomIndexes []vo.RoomIndex) error {
+
+	if fromRoomIndex == toRoomIndex {
+		return log.WrapErrorWithStackTrace(errors.New("複製元と複製先に同じ教室が指定されています"))
+	}
+
+	err := validateVisibleRooms(visibleRoomIndexes, fromRoomIndex, toRoomIndex)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	fromRoomItems := r.roomItems.filterByRoomIndex(fromRoomIndex)
+	if len(fromRoomItems) <= 0 {
+		return log.WrapErrorWithStackTrace(errors.New("複製元の教室にアイテムが配置されていません"))
+	}
+
+	toRoomItems := r.roomItems.filterByRoomIndex(toRoomIndex)
+
+	copiedIdentifiers := map[vo.Identifier]vo.Identifier{}
+	copiedRoomItems := make(ScheduleRoomItemModelSlice, 0, len(fromRoomItems))
+	for _, item := range fromRoomItems {
+
+		copiedItem := item.duplicate()
+		copiedItem.identifier = vo.NewIdentifierGenerate()
+		copiedItem.roomIndex = toRoomIndex
+
+		isOverlapped := lo.SomeBy(toRoomItems, func(roomItem *ScheduleRoomItemModel) bool {
+			return roomItem.isOverlapped(copiedItem.startTime.ValueMinutes(), copiedItem.endTime.ValueMinutes())
+		})
+		if isOverlapped {
+			return log.WrapErrorWithStackTrace(errors.New("複製先の教室のアイテムと時間が重なります"))
+		}
+
+		copiedIdentifiers[item.identifier] = copiedItem.identifier
+		copiedRoomItems = append(copiedRoomItems, copiedItem)
+	}
+
+	err = r.validateWithinScheduleTime(copiedRoomItems)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	// 複製元のグループは、複製したアイテムで新しいグループとして作成する
+	copiedGroups := lo.FilterMap(r.itemGroups, func(group *ScheduleItemGroupModel, _ int) (*ScheduleItemGroupModel, bool) {
+
+		if !lo.SomeBy(group.members, func(member *ScheduleItemGroupMemberModel) bool {
+			_, found := copiedIdentifiers[member.identifier]
+			return found
+		}) {
+			return nil, false
+		}
+
+		members := lo.FilterMap(group.members, func(member *ScheduleItemGroupMemberModel, _ int) (*ScheduleItemGroupMemberModel, bool) {
+			identifier, found := copiedIdentifiers[member.identifier]
+			return NewScheduleItemGroupMemberModel(identifier, member.offsetMinutes), found
+		})
+
+		return NewScheduleItemGroupModel(vo.NewIdentifierGenerate(), members), true
+	})
+
+	addedRoomItems := append(r.roomItems, copiedRoomItems...)
+	err = r.validateUniqueIdentifiers(r.items, addedRoomItems)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.roomItems = addedRoomItems
+	r.itemGroups = append(r.itemGroups, copiedGroups...)
+
+	return nil
+}
+
+// 教室に配置されたアイテムをすべて講座リストへ戻す
+func (r *RootScheduleModel) RoomReturnList(roomIndex vo.RoomIndex, visibleRoomIndexes []vo.RoomIndex) error {
+
+	err := validateVisibleRooms(visibleRoomIndexes, roomIndex)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return r.returnRoomItemsToList(r.roomItems.filterByRoomIndex(roomIndex))
+}
+
+// スケジュールに配置されたアイテムをすべて講座リストへ戻す
+func (r *RootScheduleModel) RoomReturnListAll() error {
+
+	return r.returnRoomItemsToList(r.roomItems)
+}
+
+func (r *RootScheduleModel) returnRoomItemsToList(returnRoomItems ScheduleRoomItemModelSlice) error {
+
+	addItems := r.items
+	removedRoomItems := r.roomItems
+	for _, roomItem := range returnRoomItems {
+
+		// 講座以外のアイテムはリストに戻さず削除する
+		if roomItem.itemTag.IsLesson() {
+			addItems = addItems.addItem(NewScheduleItemModel(roomItem.lessonID, roomItem.identifier, roomItem.duration))
+		}
+
+		removedRoomItems = removedRoomItems.removeByIdentifier(roomItem.identifier)
+	}
+
+	err := r.validateUniqueIdentifiers(addItems, removedRoomItems)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.items = addItems
+	r.roomItems = removedRoomItems
+
+	return nil
+}
+
+func (r RootScheduleModel) validateWithinScheduleTime(roomItems []*ScheduleRoomItemModel) error {
+
+	for _, item := range roomItems {
+
+		if !r.scheduleTime.IsWithinTimeRange(item.startTime) || !r.scheduleTime.IsWithinTimeRange(item.endTime) {
+			return log.WrapErrorWithStackTrace(errors.New("スケジュール時刻の範囲外に配置されるアイテムがあります"))
+		}
+	}
+
+	return nil
+}
+
+func validateVisibleRooms(visibleRoomIndexes []vo.RoomIndex, roomIndexes ...vo.RoomIndex) error {
+
+	for _, roomIndex := range roomIndexes {
+
+		if !lo.Contains(visibleRoomIndexes, roomIndex) {
+			return log.WrapErrorWithStackTrace(log.Errorf("指定した教室は表示されていません:%d", roomIndex.Value()))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package schedule
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type ScheduleSlotSuggestionModelSlice []*ScheduleSlotSuggestionModel
+
+// 収まりの良さ(前後の空き時間の少なさ・希望教室)を優先し、同じであれば開始時刻が早い順に並べる
+func (r ScheduleSlotSuggestionModelSlice) sortByRank() {
+
+	slices.SortStableFunc(r, func(a, b *ScheduleSlotSuggestionModel) int {
+
+		return cmp.Or(
+			cmp.Compare(a.gapCount, b.gapCount),
+			-cmp.Compare(lo.Ternary(a.isPreferredRoom, 1, 0), lo.Ternary(b.isPreferredRoom, 1, 0)),
+			cmp.Compare(a.startTime.ValueMinutes(), b.startTime.ValueMinutes()),
+			cmp.Compare(a.roomIndex.Value(), b.roomIndex.Value()),
+		)
+	})
+}
+
+// 未配置アイテムを配置できる空き枠
+type ScheduleSlotSuggestionModel struct {
+	roomIndex       vo.RoomIndex
+	startTime       vo.ScheduleLessonTime
+	endTime         vo.ScheduleLessonTime
+	gapCount        int
+	isPreferredRoom bool
+}
+
+func (r ScheduleSlotSuggestionModel) RoomIndex() vo.RoomIndex {
+	return r.roomIndex
+}
+
+func (r ScheduleSlotSuggestionModel) StartTime() vo.ScheduleLessonTime {
+	return r.startTime
+}
+
+func (r ScheduleSlotSuggestionModel) EndTime() vo.ScheduleLessonTime {
+	return r.endTime
+}
+
+// 配置した場合に前後にできる空き時間の数
+func (r ScheduleSlotSuggestionModel) GapCount() int {
+	return r.gapCount
+}
+
+func (r ScheduleSlotSuggestionModel) IsPreferredRoom() bool {
+	return r.isPreferredRoom
+}
+
+// 一覧にあるアイテムを配置できる空き枠(利用不可の時間帯を除く)を、空き時間が少ない順・希望教室・開始時刻が早い順に上位から返す
+func (r RootScheduleModel) SuggestSlots(
+	lessonID vo.LessonID,
+	initialLessonDuration vo.LessonDuration,
+	identifier vo.Identifier,
+	roomIndexes []vo.RoomIndex,
+	step vo.SuggestionStepMinutes,
+	preferredRoomIndex vo.RoomIndex,
+	limit vo.SuggestionLimit,
+) (ScheduleSlotSuggestionModelSlice, error) {
+
+	if _, found := r.roomItems.findByIdentifier(identifier); found {
+		return nil, log.WrapErrorWithStackTrace(log.Errorf("指定したアイテムは既に教室に配置されています:%s", identifier.Value()))
+	}
+
+	item, found := r.items.findByIdentifier(identifier)
+	if !found {
+		item = NewScheduleItemModel(lessonID, identifier, initialLessonDuration)
+	}
+
+	scheduleStartTime := r.scheduleTime.StartTimeValueMinutes()
+	scheduleEndTime := r.scheduleTime.EndTimeValueMinutes()
+	duration := item.duration.Value()
+	blockedPeriods := r.BlockedPeriods()
+
+	suggestions := ScheduleSlotSuggestionModelSlice{}
+	for _, roomIndex := range lo.Uniq(roomIndexes) {
+
+		roomItems := r.roomItems.filterByRoomIndex(roomIndex)
+
+		for start := scheduleStartTime; start+duration <= scheduleEndTime; start += step.Value() {
+
+			end := start + duration
+
+			isOverlapped := lo.SomeBy(roomItems, func(roomItem *ScheduleRoomItemModel) bool {
+				return roomItem.isOverlapped(start, end)
+			})
+			if isOverlapped || blockedPeriods.IsBlocked(roomIndex, start, end) {
+				continue
+			}
+
+			prevEndTime := lo.Max(append(
+				lo.FilterMap(roomItems, func(roomItem *ScheduleRoomItemModel, _ int) (int, bool) {
+					return roomItem.endTime.ValueMinutes(), roomItem.endTime.ValueMinutes() <= start
+				}),
+				scheduleStartTime,
+			))
+
+			nextStartTime := lo.Min(append(
+				lo.FilterMap(roomItems, func(roomItem *ScheduleRoomItemModel, _ int) (int, bool) {
+					return roomItem.startTime.ValueMinutes(), roomItem.startTime.ValueMinutes() >= end
+				}),
+				scheduleEndTime,
+			))
+
+			startTime, err := vo.NewScheduleLessonTimeFromMinutes(start)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
+
+			endTime, err := vo.NewScheduleLessonTimeFromMinutes(end)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
+
+			suggestions = append(suggestions, &ScheduleSlotSuggestionModel{
+				roomIndex:       roomIndex,
+				startTime:       startTime,
+				endTime:         endTime,
+				gapCount:        lo.Ternary(start > prevEndTime, 1, 0) + lo.Ternary(nextStartTime > end, 1, 0),
+				isPreferredRoom: roomIndex == preferredRoomIndex,
+			})
+		}
+	}
+
+	suggestions.sortByRank()
+
+	if len(suggestions) > limit.Value() {
+		suggestions = suggestions[:limit.Value()]
+	}
+
+	return suggestions, nil
+}
@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type testSlot struct {
+	roomIndex    int
+	startMinutes int
+	gapCount     int
+	preferred    bool
+}
+
+func TestSuggestSlots(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		roomItems func(t *testing.T) []*ScheduleRoomItemModel
+		preferred vo.RoomIndex
+		limit     vo.SuggestionLimit
+		want      []testSlot
+		wantErr   bool
+	}{
+		{
+			name: "前後の空き時間が少ない枠を開始時刻より優先する",
+			roomItems: func(t *testing.T) []*ScheduleRoomItemModel {
+				return []*ScheduleRoomItemModel{newTestRoomItem(t, "placed", 1, 600, 60, false)}
+			},
+			preferred: vo.RoomIndex(2),
+			limit:     vo.SUGGESTION_LIMIT_DEFAULT,
+			want: []testSlot{
+				{roomIndex: 1, startMinutes: 540, gapCount: 0},
+				{roomIndex: 1, startMinutes: 660, gapCount: 0},
+				{roomIndex: 2, startMinutes: 540, gapCount: 1, preferred: true},
+				{roomIndex: 2, startMinutes: 660, gapCount: 1, preferred: true},
+				{roomIndex: 2, startMinutes: 600, gapCount: 2, preferred: true},
+			},
+		},
+		{
+			name: "空き時間が同じ場合は希望教室を開始時刻より優先する",
+			roomItems: func(t *testing.T) []*ScheduleRoomItemModel {
+				return []*ScheduleRoomItemModel{}
+			},
+			preferred: vo.RoomIndex(2),
+			limit:     vo.SUGGESTION_LIMIT_DEFAULT,
+			want: []testSlot{
+				{roomIndex: 2, startMinutes: 540, gapCount: 1, preferred: true},
+				{roomIndex: 2, startMinutes: 660, gapCount: 1, preferred: true},
+				{roomIndex: 1, startMinutes: 540, gapCount: 1},
+				{roomIndex: 1, startMinutes: 660, gapCount: 1},
+				{roomIndex: 2, startMinutes: 600, gapCount: 2, preferred: true},
+				{roomIndex: 1, startMinutes: 600, gapCount: 2},
+			},
+		},
+		{
+			name: "件数の上限で打ち切る",
+			roomItems: func(t *testing.T) []*ScheduleRoomItemModel {
+				return []*ScheduleRoomItemModel{newTestRoomItem(t, "placed", 1, 600, 60, false)}
+			},
+			preferred: vo.ROOM_INDEX_INVALID,
+			limit:     vo.SuggestionLimit(2),
+			want: []testSlot{
+				{roomIndex: 1, startMinutes: 540, gapCount: 0},
+				{roomIndex: 1, startMinutes: 660, gapCount: 0},
+			},
+		},
+		{
+			name: "配置済みのアイテムは候補を返さない",
+			roomItems: func(t *testing.T) []*ScheduleRoomItemModel {
+				return []*ScheduleRoomItemModel{newTestRoomItem(t, "target", 1, 600, 60, false)}
+			},
+			preferred: vo.ROOM_INDEX_INVALID,
+			limit:     vo.SUGGESTION_LIMIT_DEFAULT,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			scheduleData := newTestSchedule(t, 9, 12, tt.roomItems(t)...)
+
+			suggestions, err := scheduleData.SuggestSlots(
+				vo.LessonID(1),
+				vo.LessonDuration(60),
+				vo.Identifier("target"),
+				[]vo.RoomIndex{1, 2},
+				vo.SuggestionStepMinutes(60),
+				tt.preferred,
+				tt.limit,
+			)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := lo.Map(suggestions, func(suggestion *ScheduleSlotSuggestionModel, _ int) testSlot {
+				return testSlot{
+					roomIndex:    suggestion.RoomIndex().Value(),
+					startMinutes: suggestion.StartTime().ValueMinutes(),
+					gapCount:     suggestion.GapCount(),
+					preferred:    suggestion.IsPreferredRoom(),
+				}
+			})
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
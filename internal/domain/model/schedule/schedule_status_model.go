@@ -0,0 +1,106 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleStatusTransition = errors.New("現在のステータスでは実行できない操作です")
+var ErrScheduleRejectReasonEmpty = errors.New("差し戻し理由が未設定です")
+
+func (r RootScheduleModel) Status() vo.ScheduleStatus {
+	return r.status
+}
+
+// 差し戻し時に設定された理由
+func (r RootScheduleModel) ReviewComment() vo.ScheduleReviewComment {
+	return r.reviewComment
+}
+
+// 公開済み・アーカイブ済みのスケジュールはアイテムの編集を受け付けない
+func (r RootScheduleModel) IsFrozen() bool {
+	return r.status.IsPublished() || r.status.IsArchived()
+}
+
+func (r *RootScheduleModel) ChangeStatus(action vo.ScheduleStatusAction, reason vo.ScheduleReviewComment) error {
+
+	switch action {
+	case vo.SCHEDULE_STATUS_ACTION_SUBMIT:
+		return r.submit()
+	case vo.SCHEDULE_STATUS_ACTION_APPROVE:
+		return r.approve()
+	case vo.SCHEDULE_STATUS_ACTION_REJECT:
+		return r.reject(reason)
+	case vo.SCHEDULE_STATUS_ACTION_PUBLISH:
+		return r.publish()
+	case vo.SCHEDULE_STATUS_ACTION_ARCHIVE:
+		return r.archive()
+	default:
+		return log.WrapErrorWithStackTrace(vo.ErrScheduleStatusActionInvalid)
+	}
+}
+
+// 作成中 → レビュー待ち
+func (r *RootScheduleModel) submit() error {
+
+	if !r.status.IsDraft() {
+		return log.WrapErrorWithStackTrace(ErrScheduleStatusTransition)
+	}
+
+	r.status = vo.SCHEDULE_STATUS_IN_REVIEW
+	r.reviewComment = vo.SCHEDULE_REVIEW_COMMENT_NONE
+	return nil
+}
+
+// レビュー待ち → 公開済み
+func (r *RootScheduleModel) approve() error {
+
+	if !r.status.IsInReview() {
+		return log.WrapErrorWithStackTrace(ErrScheduleStatusTransition)
+	}
+
+	r.status = vo.SCHEDULE_STATUS_PUBLISHED
+	r.reviewComment = vo.SCHEDULE_REVIEW_COMMENT_NONE
+	return nil
+}
+
+// レビュー待ち → 作成中。理由は再提出まで保持する
+func (r *RootScheduleModel) reject(reason vo.ScheduleReviewComment) error {
+
+	if !r.status.IsInReview() {
+		return log.WrapErrorWithStackTrace(ErrScheduleStatusTransition)
+	}
+
+	if reason.IsNone() {
+		return log.WrapErrorWithStackTrace(ErrScheduleRejectReasonEmpty)
+	}
+
+	r.status = vo.SCHEDULE_STATUS_DRAFT
+	r.reviewComment = reason
+	return nil
+}
+
+// 作成中・レビュー待ち → 公開済み
+func (r *RootScheduleModel) publish() error {
+
+	if !r.status.IsDraft() && !r.status.IsInReview() {
+		return log.WrapErrorWithStackTrace(ErrScheduleStatusTransition)
+	}
+
+	r.status = vo.SCHEDULE_STATUS_PUBLISHED
+	r.reviewComment = vo.SCHEDULE_REVIEW_COMMENT_NONE
+	return nil
+}
+
+// 公開済み → アーカイブ済み
+func (r *RootScheduleModel) archive() error {
+
+	if !r.status.IsPublished() {
+		return log.WrapErrorWithStackTrace(ErrScheduleStatusTransition)
+	}
+
+	r.status = vo.SCHEDULE_STATUS_ARCHIVED
+	return nil
+}
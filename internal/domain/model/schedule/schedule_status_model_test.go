@@ -0,0 +1,131 @@
+package schedule
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestChangeStatus(t *testing.T) {
+
+	const reason = vo.ScheduleReviewComment("教室の割り当てを見直してください")
+
+	tests := []struct {
+		name              string
+		before            []vo.ScheduleStatusAction
+		action            vo.ScheduleStatusAction
+		reason            vo.ScheduleReviewComment
+		wantStatus        vo.ScheduleStatus
+		wantReviewComment vo.ScheduleReviewComment
+		wantFrozen        bool
+		wantErr           error
+	}{
+		{
+			name:       "作成中からレビュー待ちへ提出する",
+			action:     vo.SCHEDULE_STATUS_ACTION_SUBMIT,
+			wantStatus: vo.SCHEDULE_STATUS_IN_REVIEW,
+		},
+		{
+			name:       "レビュー待ちを承認して公開する",
+			before:     []vo.ScheduleStatusAction{vo.SCHEDULE_STATUS_ACTION_SUBMIT},
+			action:     vo.SCHEDULE_STATUS_ACTION_APPROVE,
+			wantStatus: vo.SCHEDULE_STATUS_PUBLISHED,
+			wantFrozen: true,
+		},
+		{
+			name:              "レビュー待ちを理由を付けて差し戻す",
+			before:            []vo.ScheduleStatusAction{vo.SCHEDULE_STATUS_ACTION_SUBMIT},
+			action:            vo.SCHEDULE_STATUS_ACTION_REJECT,
+			reason:            reason,
+			wantStatus:        vo.SCHEDULE_STATUS_DRAFT,
+			wantReviewComment: reason,
+		},
+		{
+			name:       "差し戻しの理由がない",
+			before:     []vo.ScheduleStatusAction{vo.SCHEDULE_STATUS_ACTION_SUBMIT},
+			action:     vo.SCHEDULE_STATUS_ACTION_REJECT,
+			reason:     vo.SCHEDULE_REVIEW_COMMENT_NONE,
+			wantStatus: vo.SCHEDULE_STATUS_IN_REVIEW,
+			wantErr:    ErrScheduleRejectReasonEmpty,
+		},
+		{
+			name:       "作成中から直接公開する",
+			action:     vo.SCHEDULE_STATUS_ACTION_PUBLISH,
+			wantStatus: vo.SCHEDULE_STATUS_PUBLISHED,
+			wantFrozen: true,
+		},
+		{
+			name:       "公開済みをアーカイブする",
+			before:     []vo.ScheduleStatusAction{vo.SCHEDULE_STATUS_ACTION_PUBLISH},
+			action:     vo.SCHEDULE_STATUS_ACTION_ARCHIVE,
+			wantStatus: vo.SCHEDULE_STATUS_ARCHIVED,
+			wantFrozen: true,
+		},
+		{
+			name:       "作成中は承認できない",
+			action:     vo.SCHEDULE_STATUS_ACTION_APPROVE,
+			wantStatus: vo.SCHEDULE_STATUS_DRAFT,
+			wantErr:    ErrScheduleStatusTransition,
+		},
+		{
+			name:       "作成中はアーカイブできない",
+			action:     vo.SCHEDULE_STATUS_ACTION_ARCHIVE,
+			wantStatus: vo.SCHEDULE_STATUS_DRAFT,
+			wantErr:    ErrScheduleStatusTransition,
+		},
+		{
+			name:       "公開済みは再提出できない",
+			before:     []vo.ScheduleStatusAction{vo.SCHEDULE_STATUS_ACTION_PUBLISH},
+			action:     vo.SCHEDULE_STATUS_ACTION_SUBMIT,
+			wantStatus: vo.SCHEDULE_STATUS_PUBLISHED,
+			wantFrozen: true,
+			wantErr:    ErrScheduleStatusTransition,
+		},
+		{
+			name: "アーカイブ済みは公開できない",
+			before: []vo.ScheduleStatusAction{
+				vo.SCHEDULE_STATUS_ACTION_PUBLISH,
+				vo.SCHEDULE_STATUS_ACTION_ARCHIVE,
+			},
+			action:     vo.SCHEDULE_STATUS_ACTION_PUBLISH,
+			wantStatus: vo.SCHEDULE_STATUS_ARCHIVED,
+			wantFrozen: true,
+			wantErr:    ErrScheduleStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			scheduleData := newTestSchedule(t, 9, 12)
+			for _, action := range tt.before {
+				if err := scheduleData.ChangeStatus(action, vo.SCHEDULE_REVIEW_COMMENT_NONE); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := scheduleData.ChangeStatus(tt.action, tt.reason)
+
+			if tt.wantErr != nil {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if scheduleData.Status() != tt.wantStatus {
+				t.Errorf("got status %s, want %s", scheduleData.Status(), tt.wantStatus)
+			}
+
+			if scheduleData.ReviewComment() != tt.wantReviewComment {
+				t.Errorf("got review comment %q, want %q", scheduleData.ReviewComment(), tt.wantReviewComment)
+			}
+
+			if scheduleData.IsFrozen() != tt.wantFrozen {
+				t.Errorf("got frozen %t, want %t", scheduleData.IsFrozen(), tt.wantFrozen)
+			}
+		})
+	}
+}
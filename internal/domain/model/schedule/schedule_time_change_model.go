@@ -0,0 +1,151 @@
+package schedule
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// スケジュール時間を変更し、配置済みの講座を指定した方法で新しい時間に合わせる
+func (r *RootScheduleModel) AdjustScheduleTime(newScheduleTime vo.ScheduleTime, mode vo.ScheduleTimeChangeMode) error {
+
+	var adjustedRoomItems ScheduleRoomItemModelSlice
+	var err error
+	switch mode {
+	case vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE:
+		adjustedRoomItems, err = r.translatedRoomItems(newScheduleTime)
+	case vo.SCHEDULE_TIME_CHANGE_MODE_SCALE:
+		adjustedRoomItems, err = r.scaledRoomItems(newScheduleTime)
+	case vo.SCHEDULE_TIME_CHANGE_MODE_RETURN_LIST:
+		err = r.returnOutOfRangeRoomItems(newScheduleTime)
+		adjustedRoomItems = r.roomItems
+	default:
+		return log.WrapErrorWithStackTrace(vo.ErrScheduleTimeChangeModeInvalid)
+	}
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	r.roomItems = adjustedRoomItems
+	r.scheduleTime = newScheduleTime
+
+	return nil
+}
+
+// 開始時刻の差分だけ講座をずらす。固定アイテムは動かさず、ずらした講座と重なる場合は変更しない
+func (r RootScheduleModel) translatedRoomItems(newScheduleTime vo.ScheduleTime) (ScheduleRoomItemModelSlice, error) {
+
+	diffMinutes := newScheduleTime.StartTimeValueMinutes() - r.scheduleTime.StartTimeValueMinutes()
+
+	translatedRoomItems := ScheduleRoomItemModelSlice{}
+	for _, roomIndex := range r.roomItems.roomIndexes() {
+
+		roomItems := r.roomItems.copiedSortedItems(roomIndex)
+		units := newShiftUnits(roomItems, r.roomItems, r.itemGroups)
+
+		pinnedUnits := filterPinnedUnits(units)
+		err := validatePinnedUnitsWithin(pinnedUnits, newScheduleTime)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		for _, unit := range filterMovableUnits(units) {
+
+			start := unit.startMinutes() + diffMinutes
+			if start < newScheduleTime.StartTimeValueMinutes() || start+unit.spanMinutes() > newScheduleTime.EndTimeValueMinutes() {
+				return nil, log.WrapErrorWithStackTrace(errors.New("開始時刻に合わせてずらすと新しいスケジュール時間に収まらない講座があります"))
+			}
+
+			isOverlapped := lo.SomeBy(pinnedUnits, func(pinnedUnit *shiftUnit) bool {
+				return pinnedUnit.isOverlapped(start, start+unit.spanMinutes())
+			})
+			if isOverlapped {
+				return nil, log.WrapErrorWithStackTrace(errors.New("開始時刻に合わせてずらすと固定されたアイテムと重なる講座があります"))
+			}
+
+			err := unit.moveTo(start)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
+		}
+
+		translatedRoomItems = append(translatedRoomItems, roomItems...)
+	}
+
+	return translatedRoomItems, nil
+}
+
+// スケジュール開始時刻からの位置を時間の長さの比率で伸縮する。講座時間とグループ内の位置は変えず、重なる場合は後ろへ詰める
+// 固定アイテムは動かさず、他の講座は固定アイテムを避けて配置する
+func (r RootScheduleModel) scaledRoomItems(newScheduleTime vo.ScheduleTime) (ScheduleRoomItemModelSlice, error) {
+
+	oldStartMinutes := r.scheduleTime.StartTimeValueMinutes()
+	oldSpanMinutes := r.scheduleTime.EndTimeValueMinutes() - oldStartMinutes
+	newStartMinutes := newScheduleTime.StartTimeValueMinutes()
+	newSpanMinutes := newScheduleTime.EndTimeValueMinutes() - newStartMinutes
+
+	scaledRoomItems := ScheduleRoomItemModelSlice{}
+	for _, roomIndex := range r.roomItems.roomIndexes() {
+
+		roomItems := r.roomItems.copiedSortedItems(roomIndex)
+		units := newShiftUnits(roomItems, r.roomItems, r.itemGroups)
+
+		pinnedUnits := filterPinnedUnits(units)
+		err := validatePinnedUnitsWithin(pinnedUnits, newScheduleTime)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		prevEndMinutes := newStartMinutes
+		for _, unit := range filterMovableUnits(units) {
+
+			scaledStartMinutes := newStartMinutes + (unit.startMinutes()-oldStartMinutes)*newSpanMinutes/oldSpanMinutes
+			start := earliestFreeStart(max(scaledStartMinutes, prevEndMinutes), unit.spanMinutes(), 0, pinnedUnits, noAlign)
+
+			err := moveUnitWithinSchedule(unit, start, newScheduleTime)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
+
+			prevEndMinutes = unit.endMinutes()
+		}
+
+		scaledRoomItems = append(scaledRoomItems, roomItems...)
+	}
+
+	return scaledRoomItems, nil
+}
+
+// 動かさない固定アイテムが新しいスケジュール時間に収まることを確認する
+func validatePinnedUnitsWithin(pinnedUnits []*shiftUnit, newScheduleTime vo.ScheduleTime) error {
+
+	isOutOfRange := lo.SomeBy(pinnedUnits, func(unit *shiftUnit) bool {
+		return unit.startMinutes() < newScheduleTime.StartTimeValueMinutes() || unit.endMinutes() > newScheduleTime.EndTimeValueMinutes()
+	})
+	if isOutOfRange {
+		return log.WrapErrorWithStackTrace(errors.New("固定されたアイテムが新しいスケジュール時間に収まりません"))
+	}
+
+	return nil
+}
+
+// 新しいスケジュール時間に収まらない講座をリストへ戻す。グループのメンバーが範囲外となる場合はグループごと戻す
+func (r *RootScheduleModel) returnOutOfRangeRoomItems(newScheduleTime vo.ScheduleTime) error {
+
+	outOfRangeIdentifiers := lo.FilterMap(r.roomItems, func(item *ScheduleRoomItemModel, _ int) (vo.Identifier, bool) {
+		return item.identifier, !newScheduleTime.IsWithinTimeRange(item.startTime) || !newScheduleTime.IsWithinTimeRange(item.endTime)
+	})
+
+	returnIdentifiers := lo.Uniq(lo.FlatMap(outOfRangeIdentifiers, func(identifier vo.Identifier, _ int) []vo.Identifier {
+		return r.itemGroups.linkedIdentifiers(identifier)
+	}))
+
+	returnRoomItems := lo.Filter(r.roomItems, func(item *ScheduleRoomItemModel, _ int) bool {
+		return lo.Contains(returnIdentifiers, item.identifier)
+	})
+
+	return r.returnRoomItemsToList(returnRoomItems)
+}
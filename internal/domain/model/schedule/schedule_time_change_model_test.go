@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+func TestAdjustScheduleTime(t *testing.T) {
+
+	type testItem struct {
+		identifier   string
+		startMinutes int
+		duration     int
+		pinned       bool
+	}
+
+	tests := []struct {
+		name         string
+		mode         vo.ScheduleTimeChangeMode
+		items        []testItem
+		newStartHour int
+		newEndHour   int
+		want         map[string]int
+		wantList     int
+		wantErr      bool
+	}{
+		{
+			name: "縮めた比率で開始位置を切り捨てて配置する",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_SCALE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 600, duration: 30},
+				{identifier: "b", startMinutes: 650, duration: 20},
+			},
+			newStartHour: 9,
+			newEndHour:   11,
+			// a: 540+60*120/180=580、b: 540+110*120/180=613.3 の切り捨て
+			want: map[string]int{"a": 580, "b": 613},
+		},
+		{
+			name: "伸ばした比率で開始位置を切り捨てて配置する",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_SCALE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 550, duration: 30},
+			},
+			newStartHour: 9,
+			newEndHour:   13,
+			// 540+10*240/180=553.3 の切り捨て
+			want: map[string]int{"a": 553},
+		},
+		{
+			name: "縮めて前のアイテムと重なる場合は後ろへ詰める",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_SCALE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 60},
+				{identifier: "b", startMinutes: 600, duration: 60},
+			},
+			newStartHour: 9,
+			newEndHour:   11,
+			want:         map[string]int{"a": 540, "b": 600},
+		},
+		{
+			name: "縮めた時間に収まらない",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_SCALE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 180},
+			},
+			newStartHour: 9,
+			newEndHour:   11,
+			wantErr:      true,
+		},
+		{
+			name: "伸縮しても固定アイテムは動かさず、重なる講座は後ろへ詰める",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_SCALE,
+			items: []testItem{
+				{identifier: "p", startMinutes: 570, duration: 30, pinned: true},
+				{identifier: "a", startMinutes: 615, duration: 30},
+			},
+			newStartHour: 9,
+			newEndHour:   11,
+			// a: 540+75*120/180=590 は固定アイテムと重なるため、その後ろへ詰める
+			want: map[string]int{"a": 600, "p": 570},
+		},
+		{
+			name: "開始時刻の差分だけずらす",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 600, duration: 60},
+			},
+			newStartHour: 10,
+			newEndHour:   13,
+			want:         map[string]int{"a": 660},
+		},
+		{
+			name: "24時を終了時刻としてずらす",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 660, duration: 60},
+			},
+			newStartHour: 21,
+			newEndHour:   24,
+			want:         map[string]int{"a": 1380},
+		},
+		{
+			name: "ずらしても固定アイテムは動かさない",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE,
+			items: []testItem{
+				{identifier: "a", startMinutes: 600, duration: 60},
+				{identifier: "p", startMinutes: 660, duration: 30, pinned: true},
+			},
+			newStartHour: 8,
+			newEndHour:   12,
+			want:         map[string]int{"a": 540, "p": 660},
+		},
+		{
+			name: "ずらすと固定アイテムと重なる",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE,
+			items: []testItem{
+				{identifier: "p", startMinutes: 600, duration: 60, pinned: true},
+				{identifier: "a", startMinutes: 690, duration: 30},
+			},
+			newStartHour: 8,
+			newEndHour:   12,
+			wantErr:      true,
+		},
+		{
+			name: "固定アイテムが新しい時間に収まらない",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_TRANSLATE,
+			items: []testItem{
+				{identifier: "p", startMinutes: 660, duration: 60, pinned: true},
+			},
+			newStartHour: 8,
+			newEndHour:   11,
+			wantErr:      true,
+		},
+		{
+			name: "範囲外のアイテムをリストへ戻す",
+			mode: vo.SCHEDULE_TIME_CHANGE_MODE_RETURN_LIST,
+			items: []testItem{
+				{identifier: "a", startMinutes: 540, duration: 60},
+				{identifier: "b", startMinutes: 660, duration: 60},
+			},
+			newStartHour: 9,
+			newEndHour:   11,
+			want:         map[string]int{"a": 540},
+			wantList:     1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			roomItems := make([]*ScheduleRoomItemModel, 0, len(tt.items))
+			for _, item := range tt.items {
+				roomItems = append(roomItems, newTestRoomItem(t, item.identifier, 1, item.startMinutes, item.duration, item.pinned))
+			}
+
+			scheduleData := newTestSchedule(t, 9, 12, roomItems...)
+
+			newScheduleTime, err := vo.NewScheduleTime(tt.newStartHour, 0, tt.newEndHour, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = scheduleData.AdjustScheduleTime(newScheduleTime, tt.mode)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := roomItemStartMinutes(scheduleData.RoomItems()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+
+			if got := len(scheduleData.Items()); got != tt.wantList {
+				t.Errorf("got %d list items, want %d", got, tt.wantList)
+			}
+
+			if scheduleData.ScheduleTime() != newScheduleTime {
+				t.Errorf("got schedule time %v, want %v", scheduleData.ScheduleTime(), newScheduleTime)
+			}
+
+			// スケジュール時間は履歴ごとに保持するため、通常の編集と同じく次の履歴になる
+			scheduleData.ModifyEditing(scheduleData.HistoryIndex(), vo.UserID(1))
+			if want := vo.HistoryIndex(5).Next(); scheduleData.HistoryIndex() != want {
+				t.Errorf("got history index %d, want %d", scheduleData.HistoryIndex(), want)
+			}
+		})
+	}
+}
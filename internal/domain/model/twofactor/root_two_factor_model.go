@@ -0,0 +1,287 @@
+package twofactor
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/totp"
+)
+
+const (
+	// 認証アプリに表示される発行者名
+	TOTP_ISSUER = "LessonLink"
+	// 登録完了時に発行するリカバリーコードの数
+	RECOVERY_CODE_COUNT = 10
+	// リカバリーコードの文字数(区切りの"-"を除く)
+	RECOVERY_CODE_LENGTH = 10
+)
+
+// ログイン時の2段階認証(TOTP)の設定
+// 登録を開始するとシークレットを保持し、認証コードを確認できた時点で有効になる
+type RootTwoFactorModel struct {
+	id            int
+	userID        vo.UserID
+	secret        string
+	enabled       bool
+	required      bool
+	lastUsedStep  int64
+	recoveryCodes []*RecoveryCodeModel
+}
+
+// リカバリーコード。コード自体はハッシュ化した値のみ保持する
+type RecoveryCodeModel struct {
+	codeHash string
+	used     bool
+}
+
+func NewRecoveryCodeModel(codeHash string, used bool) *RecoveryCodeModel {
+
+	return &RecoveryCodeModel{
+		codeHash: codeHash,
+		used:     used,
+	}
+}
+
+func (r RecoveryCodeModel) CodeHash() string {
+	return r.codeHash
+}
+
+func (r RecoveryCodeModel) IsUsed() bool {
+	return r.used
+}
+
+func NewRootTwoFactorModel(
+	id int,
+	userID vo.UserID,
+	secret string,
+	enabled bool,
+	required bool,
+	lastUsedStep int64,
+	recoveryCodes []*RecoveryCodeModel,
+) *RootTwoFactorModel {
+
+	return &RootTwoFactorModel{
+		id:            id,
+		userID:        userID,
+		secret:        secret,
+		enabled:       enabled,
+		required:      required,
+		lastUsedStep:  lastUsedStep,
+		recoveryCodes: recoveryCodes,
+	}
+}
+
+// 未設定のユーザーの初期状態
+func NewCreateRootTwoFactorModel(userID vo.UserID) *RootTwoFactorModel {
+
+	return &RootTwoFactorModel{
+		id:            0,
+		userID:        userID,
+		recoveryCodes: []*RecoveryCodeModel{},
+	}
+}
+
+func (r RootTwoFactorModel) ID() int {
+	return r.id
+}
+
+func (r RootTwoFactorModel) IsInitial() bool {
+	return r.id == 0
+}
+
+func (r RootTwoFactorModel) UserID() vo.UserID {
+	return r.userID
+}
+
+func (r RootTwoFactorModel) Secret() string {
+	return r.secret
+}
+
+func (r RootTwoFactorModel) IsEnabled() bool {
+	return r.enabled
+}
+
+// オーナーによって2段階認証の利用が必須とされているか
+func (r RootTwoFactorModel) IsRequired() bool {
+	return r.required
+}
+
+func (r RootTwoFactorModel) LastUsedStep() int64 {
+	return r.lastUsedStep
+}
+
+func (r RootTwoFactorModel) RecoveryCodes() []*RecoveryCodeModel {
+	return r.recoveryCodes
+}
+
+// ログイン時に認証コードの入力が必要か
+func (r RootTwoFactorModel) IsLoginChallengeRequired() bool {
+	return r.enabled || r.required
+}
+
+func (r RootTwoFactorModel) RemainingRecoveryCodeCount() int {
+
+	return lo.CountBy(r.recoveryCodes, func(item *RecoveryCodeModel) bool {
+		return !item.used
+	})
+}
+
+// 登録を開始し、認証アプリに登録するシークレットを発行する
+// 確認が完了するまでは何度でもやり直せる
+func (r *RootTwoFactorModel) StartEnrollment() (string, error) {
+
+	if r.enabled {
+		return "", log.WrapErrorWithStackTraceConflict(log.Errorf("2段階認証は既に有効です"))
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	r.secret = secret
+
+	return secret, nil
+}
+
+// 認証アプリに表示されたコードで登録を完了し、リカバリーコードを発行する
+// 平文のリカバリーコードは登録完了時にのみ返す
+func (r *RootTwoFactorModel) ConfirmEnrollment(code string, now time.Time) ([]string, error) {
+
+	if r.enabled {
+		return nil, log.WrapErrorWithStackTraceConflict(log.Errorf("2段階認証は既に有効です"))
+	}
+
+	if r.secret == "" {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("2段階認証の登録が開始されていません"))
+	}
+
+	step, ok := totp.Verify(r.secret, normalizeCode(code), now)
+	if !ok {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("認証コードが正しくありません"))
+	}
+
+	codes, recoveryCodes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	r.enabled = true
+	r.lastUsedStep = step
+	r.recoveryCodes = recoveryCodes
+
+	return codes, nil
+}
+
+// 認証アプリのコード、または未使用のリカバリーコードで認証する
+// 同じコードの再利用を防ぐため、利用したステップとリカバリーコードを記録する
+func (r *RootTwoFactorModel) Verify(code string, now time.Time) bool {
+
+	if !r.enabled {
+		return false
+	}
+
+	code = normalizeCode(code)
+
+	if len(code) == totp.DIGITS {
+
+		step, ok := totp.Verify(r.secret, code, now)
+		if !ok || step <= r.lastUsedStep {
+			return false
+		}
+
+		r.lastUsedStep = step
+
+		return true
+	}
+
+	codeHash := hash.HashToken(code)
+	recoveryCode, ok := lo.Find(r.recoveryCodes, func(item *RecoveryCodeModel) bool {
+		return !item.used && item.codeHash == codeHash
+	})
+	if !ok {
+		return false
+	}
+
+	recoveryCode.used = true
+
+	return true
+}
+
+// 本人による無効化。必須とされている場合は無効化できない
+func (r *RootTwoFactorModel) Disable(code string, now time.Time) error {
+
+	if !r.enabled {
+		return log.WrapErrorWithStackTraceBadRequest(log.Errorf("2段階認証は有効になっていません"))
+	}
+
+	if r.required {
+		return log.WrapErrorWithStackTraceForbidden(log.Errorf("2段階認証の利用が必須とされているため無効化できません"))
+	}
+
+	if !r.Verify(code, now) {
+		return log.WrapErrorWithStackTraceBadRequest(log.Errorf("認証コードが正しくありません"))
+	}
+
+	r.Reset()
+
+	return nil
+}
+
+// 端末の紛失時などにオーナーが設定を初期化する
+// 必須の設定は残すため、次回ログイン時に再登録が求められる
+func (r *RootTwoFactorModel) Reset() {
+
+	r.secret = ""
+	r.enabled = false
+	r.lastUsedStep = 0
+	r.recoveryCodes = []*RecoveryCodeModel{}
+}
+
+func (r *RootTwoFactorModel) SetRequired(required bool) {
+	r.required = required
+}
+
+func generateRecoveryCodes() ([]string, []*RecoveryCodeModel, error) {
+
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	codes := make([]string, 0, RECOVERY_CODE_COUNT)
+	recoveryCodes := make([]*RecoveryCodeModel, 0, RECOVERY_CODE_COUNT)
+
+	for len(codes) < RECOVERY_CODE_COUNT {
+
+		random := make([]byte, RECOVERY_CODE_LENGTH)
+		if _, err := rand.Read(random); err != nil {
+			return nil, nil, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+		code := strings.ToLower(encoding.EncodeToString(random))[:RECOVERY_CODE_LENGTH]
+		codeHash := hash.HashToken(code)
+
+		// 重複したコードは保存できないため作り直す
+		if lo.ContainsBy(recoveryCodes, func(item *RecoveryCodeModel) bool { return item.codeHash == codeHash }) {
+			continue
+		}
+
+		half := RECOVERY_CODE_LENGTH / 2
+		codes = append(codes, code[:half]+"-"+code[half:])
+		recoveryCodes = append(recoveryCodes, NewRecoveryCodeModel(codeHash, false))
+	}
+
+	return codes, recoveryCodes, nil
+}
+
+// 入力時の区切り文字や空白、大文字小文字の違いを吸収する
+func normalizeCode(code string) string {
+
+	code = strings.ToLower(code)
+
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
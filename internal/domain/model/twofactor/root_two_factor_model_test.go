@@ -0,0 +1,267 @@
+package twofactor
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+)
+
+// RFC 6238 付録Bのシークレットと、その認証コード
+const (
+	testSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	// T=1111111109 (ステップ37037036)
+	testCode = "081804"
+	// T=1111111111 (ステップ37037037)
+	testNextCode     = "050471"
+	testStep         = int64(37037036)
+	testRecoveryCode = "abcde12345"
+)
+
+var testNow = time.Unix(1111111109, 0)
+
+func newTestTwoFactor(enabled bool, required bool, lastUsedStep int64) *RootTwoFactorModel {
+
+	return NewRootTwoFactorModel(
+		1,
+		vo.UserID(2),
+		testSecret,
+		enabled,
+		required,
+		lastUsedStep,
+		[]*RecoveryCodeModel{NewRecoveryCodeModel(hash.HashToken(testRecoveryCode), false)},
+	)
+}
+
+func TestVerifyTwoFactor(t *testing.T) {
+
+	tests := []struct {
+		name             string
+		enabled          bool
+		lastUsedStep     int64
+		code             string
+		now              time.Time
+		want             bool
+		wantLastUsedStep int64
+	}{
+		{
+			name:             "認証アプリのコードで認証する",
+			enabled:          true,
+			code:             testCode,
+			now:              testNow,
+			want:             true,
+			wantLastUsedStep: testStep,
+		},
+		{
+			name:             "区切りや空白を含むコード",
+			enabled:          true,
+			code:             "081 804",
+			now:              testNow,
+			want:             true,
+			wantLastUsedStep: testStep,
+		},
+		{
+			name:             "利用済みのステップのコードは再利用できない",
+			enabled:          true,
+			lastUsedStep:     testStep,
+			code:             testCode,
+			now:              testNow,
+			wantLastUsedStep: testStep,
+		},
+		{
+			name:             "前回より前のステップのコードは利用できない",
+			enabled:          true,
+			lastUsedStep:     testStep + 1,
+			code:             testCode,
+			now:              testNow.Add(30 * time.Second),
+			wantLastUsedStep: testStep + 1,
+		},
+		{
+			name:             "前回より後のステップのコードは利用できる",
+			enabled:          true,
+			lastUsedStep:     testStep,
+			code:             testNextCode,
+			now:              testNow,
+			want:             true,
+			wantLastUsedStep: testStep + 1,
+		},
+		{
+			name:             "誤ったコード",
+			enabled:          true,
+			code:             "000000",
+			now:              testNow,
+			wantLastUsedStep: 0,
+		},
+		{
+			name:             "有効になっていない",
+			code:             testCode,
+			now:              testNow,
+			wantLastUsedStep: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			twoFactor := newTestTwoFactor(tt.enabled, false, tt.lastUsedStep)
+
+			if got := twoFactor.Verify(tt.code, tt.now); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+
+			if twoFactor.LastUsedStep() != tt.wantLastUsedStep {
+				t.Errorf("got last used step %d, want %d", twoFactor.LastUsedStep(), tt.wantLastUsedStep)
+			}
+		})
+	}
+}
+
+func TestVerifyRecoveryCode(t *testing.T) {
+
+	twoFactor := newTestTwoFactor(true, false, 0)
+
+	// 入力時の区切り文字や大文字小文字の違いは吸収する
+	if !twoFactor.Verify("ABCDE-12345", testNow) {
+		t.Fatal("expected recovery code to be verified")
+	}
+
+	if got := twoFactor.RemainingRecoveryCodeCount(); got != 0 {
+		t.Errorf("got %d remaining recovery codes, want 0", got)
+	}
+
+	// 利用済みのリカバリーコードは再利用できない
+	if twoFactor.Verify(testRecoveryCode, testNow) {
+		t.Error("expected used recovery code to be rejected")
+	}
+}
+
+func TestConfirmEnrollment(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		twoFactor   *RootTwoFactorModel
+		code        string
+		wantEnabled bool
+		wantErr     bool
+	}{
+		{
+			name:        "認証コードで登録を完了する",
+			twoFactor:   newTestTwoFactor(false, false, 0),
+			code:        testCode,
+			wantEnabled: true,
+		},
+		{
+			name:      "誤った認証コード",
+			twoFactor: newTestTwoFactor(false, false, 0),
+			code:      "000000",
+			wantErr:   true,
+		},
+		{
+			name:        "既に有効",
+			twoFactor:   newTestTwoFactor(true, false, 0),
+			code:        testCode,
+			wantEnabled: true,
+			wantErr:     true,
+		},
+		{
+			name:      "登録を開始していない",
+			twoFactor: NewCreateRootTwoFactorModel(vo.UserID(2)),
+			code:      testCode,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			codes, err := tt.twoFactor.ConfirmEnrollment(tt.code, testNow)
+
+			if tt.twoFactor.IsEnabled() != tt.wantEnabled {
+				t.Errorf("got enabled %t, want %t", tt.twoFactor.IsEnabled(), tt.wantEnabled)
+			}
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(codes) != RECOVERY_CODE_COUNT || tt.twoFactor.RemainingRecoveryCodeCount() != RECOVERY_CODE_COUNT {
+				t.Fatalf("got %d recovery codes, want %d", len(codes), RECOVERY_CODE_COUNT)
+			}
+
+			// 登録に用いたコードはログインで再利用できない
+			if tt.twoFactor.Verify(tt.code, testNow) {
+				t.Error("expected confirmed code to be rejected")
+			}
+
+			// 発行したリカバリーコードで認証できる
+			if !tt.twoFactor.Verify(strings.ToUpper(codes[0]), testNow) {
+				t.Error("expected issued recovery code to be verified")
+			}
+		})
+	}
+}
+
+func TestDisableTwoFactor(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		twoFactor   *RootTwoFactorModel
+		code        string
+		wantEnabled bool
+		wantErr     bool
+	}{
+		{
+			name:      "認証コードを確認して無効化する",
+			twoFactor: newTestTwoFactor(true, false, 0),
+			code:      testCode,
+		},
+		{
+			name:        "必須とされている",
+			twoFactor:   newTestTwoFactor(true, true, 0),
+			code:        testCode,
+			wantEnabled: true,
+			wantErr:     true,
+		},
+		{
+			name:        "誤った認証コード",
+			twoFactor:   newTestTwoFactor(true, false, 0),
+			code:        "000000",
+			wantEnabled: true,
+			wantErr:     true,
+		},
+		{
+			name:      "有効になっていない",
+			twoFactor: newTestTwoFactor(false, false, 0),
+			code:      testCode,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			err := tt.twoFactor.Disable(tt.code, testNow)
+
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %t", err, tt.wantErr)
+			}
+
+			if tt.twoFactor.IsEnabled() != tt.wantEnabled {
+				t.Errorf("got enabled %t, want %t", tt.twoFactor.IsEnabled(), tt.wantEnabled)
+			}
+
+			if !tt.wantErr && tt.twoFactor.Secret() != "" {
+				t.Error("expected secret to be cleared")
+			}
+		})
+	}
+}
@@ -39,6 +39,8 @@ type RootUserModel struct {
 	userName    vo.UserName
 	password    vo.UserPassword
 	displayName vo.UserDisplayName
+	permissions vo.Permissions
+	campusRoles UserCampusRoleModelSlice
 }
 
 func NewRootUserModel(
@@ -47,6 +49,8 @@ func NewRootUserModel(
 	userName vo.UserName,
 	password vo.UserPassword,
 	displayName vo.UserDisplayName,
+	permissions vo.Permissions,
+	campusRoles UserCampusRoleModelSlice,
 ) *RootUserModel {
 
 	return &RootUserModel{
@@ -55,6 +59,8 @@ func NewRootUserModel(
 		userName:    userName,
 		password:    password,
 		displayName: displayName,
+		permissions: permissions,
+		campusRoles: campusRoles,
 	}
 }
 
@@ -71,6 +77,8 @@ func NewCreateUserModel(
 		userName:    userName,
 		password:    password,
 		displayName: displayName,
+		permissions: vo.Permissions{},
+		campusRoles: UserCampusRoleModelSlice{},
 	}
 }
 
@@ -84,6 +92,12 @@ func (r RootUserModel) RoleKey() vo.RoleKey {
 	return r.roleKey
 }
 
+// ユーザー全体のロールに割り当てられた権限
+func (r RootUserModel) Permissions() vo.Permissions {
+
+	return r.permissions
+}
+
 func (r RootUserModel) UserName() vo.UserName {
 
 	return r.userName
@@ -111,6 +125,18 @@ func (r RootUserModel) AuthenticatePassword(_password vo.UserPassword) bool {
 	return result
 }
 
+// 存在しないユーザーの検証に用いる固定のハッシュ。HashPasswordと同じパラメータで生成している
+const dummyPasswordHash = "$argon2id$v=19$m=65536,t=1,p=4$g3udlYD2q/7pbFkXAn+8zw$S3IWvybgY7vl74Ae0sbSCJ63pFppgYql3t4WPBT+a1I"
+
+// 存在しないユーザー名でもパスワードの誤りと同じ時間がかかるよう、固定のハッシュで検証する
+// 応答時間からユーザー名の存在を推測されないようにするためで、結果は常に失敗とする
+func AuthenticateDummyPassword(_password vo.UserPassword) bool {
+
+	_, _ = hash.VerifyPassword(_password.Value(), dummyPasswordHash)
+
+	return false
+}
+
 func (r RootUserModel) IsEnableDelete(deleteFromUserID vo.UserID) bool {
 
 	return r.id == deleteFromUserID
@@ -121,16 +147,16 @@ func (r *RootUserModel) UpdateUser(
 	newPassword vo.UserPassword,
 	newDisplayName vo.UserDisplayName,
 	updateFromUserID vo.UserID,
-	updateFromUserRoleKey vo.RoleKey,
+	allowsManagingUsers bool,
 ) error {
 
-	// 自分の情報以外はオーナーでないと変更できない
-	if (r.id != updateFromUserID) && !(updateFromUserRoleKey.IsOwner()) {
+	// 自分の情報以外はユーザー管理の権限がないと変更できない
+	if (r.id != updateFromUserID) && !allowsManagingUsers {
 		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
-	// 新しいロールをセット ロールが変更された場合はオーナー以外は設定できない
-	if (r.roleKey != newRoleKey) && (!updateFromUserRoleKey.IsOwner()) {
+	// 新しいロールをセット ロールが変更された場合はユーザー管理の権限がないと設定できない
+	if (r.roleKey != newRoleKey) && !allowsManagingUsers {
 
 		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrUserCampusRoleDuplicated = errors.New("同じキャンパスのロールが重複しています")
+
+type UserCampusRoleModelSlice []*UserCampusRoleModel
+
+// キャンパスごとに割り当てられたロール
+type UserCampusRoleModel struct {
+	campus      vo.Campus
+	roleKey     vo.RoleKey
+	permissions vo.Permissions
+}
+
+func NewUserCampusRoleModel(
+	campus vo.Campus,
+	roleKey vo.RoleKey,
+	permissions vo.Permissions,
+) *UserCampusRoleModel {
+
+	return &UserCampusRoleModel{
+		campus:      campus,
+		roleKey:     roleKey,
+		permissions: permissions,
+	}
+}
+
+func (r UserCampusRoleModel) Campus() vo.Campus {
+	return r.campus
+}
+
+func (r UserCampusRoleModel) RoleKey() vo.RoleKey {
+	return r.roleKey
+}
+
+func (r UserCampusRoleModel) Permissions() vo.Permissions {
+	return r.permissions
+}
+
+func (r UserCampusRoleModelSlice) findByCampus(campus vo.Campus) (*UserCampusRoleModel, bool) {
+
+	return lo.Find(r, func(item *UserCampusRoleModel) bool {
+		return item.campus == campus
+	})
+}
+
+func (r RootUserModel) CampusRoles() UserCampusRoleModelSlice {
+	return r.campusRoles
+}
+
+// 指定キャンパスに割り当てられたロール
+func (r RootUserModel) CampusRoleAt(campus vo.Campus) (*UserCampusRoleModel, bool) {
+	return r.campusRoles.findByCampus(campus)
+}
+
+// 指定キャンパスで有効なロール 割り当てがなければユーザー全体のロールを用いる
+func (r RootUserModel) RoleKeyAt(campus vo.Campus) vo.RoleKey {
+
+	campusRole, found := r.CampusRoleAt(campus)
+	if !found {
+		return r.roleKey
+	}
+
+	return campusRole.roleKey
+}
+
+// 指定キャンパスで有効なロールに割り当てられた権限
+func (r RootUserModel) PermissionsAt(campus vo.Campus) vo.Permissions {
+
+	campusRole, found := r.CampusRoleAt(campus)
+	if !found {
+		return r.permissions
+	}
+
+	return campusRole.permissions
+}
+
+func (r RootUserModel) AllowsAt(campus vo.Campus, permission vo.Permission) bool {
+	return r.PermissionsAt(campus).Has(permission)
+}
+
+func (r *RootUserModel) ChangeCampusRoles(campusRoles UserCampusRoleModelSlice) error {
+
+	uniq := lo.UniqBy(campusRoles, func(item *UserCampusRoleModel) vo.Campus {
+		return item.campus
+	})
+	if len(uniq) != len(campusRoles) {
+		return log.WrapErrorWithStackTraceBadRequest(ErrUserCampusRoleDuplicated)
+	}
+
+	r.campusRoles = campusRoles
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+var (
+	testEditorPermissions = vo.Permissions{vo.PERMISSION_SCHEDULE_CREATE, vo.PERMISSION_SCHEDULE_EDIT}
+	testViewerPermissions = vo.Permissions{}
+	testOwnerPermissions  = vo.Permissions{vo.PERMISSION_SCHEDULE_CREATE, vo.PERMISSION_SCHEDULE_EDIT, vo.PERMISSION_USER_MANAGE}
+)
+
+func TestRoleAt(t *testing.T) {
+
+	userData := NewRootUserModel(
+		vo.UserID(2),
+		vo.ROLE_KEY_EDITOR,
+		vo.UserName("editor@example.com"),
+		vo.UserPassword(""),
+		vo.UserDisplayName("編集者"),
+		testEditorPermissions,
+		UserCampusRoleModelSlice{
+			NewUserCampusRoleModel(vo.Campus("shibuya"), vo.ROLE_KEY_OWNER, testOwnerPermissions),
+			NewUserCampusRoleModel(vo.Campus("ikebukuro"), vo.ROLE_KEY_VIEWER, testViewerPermissions),
+		},
+	)
+
+	tests := []struct {
+		name         string
+		campus       vo.Campus
+		wantRoleKey  vo.RoleKey
+		wantAssigned bool
+		wantEdit     bool
+		wantManage   bool
+	}{
+		{
+			name:         "オーナーを割り当てたキャンパス",
+			campus:       vo.Campus("shibuya"),
+			wantRoleKey:  vo.ROLE_KEY_OWNER,
+			wantAssigned: true,
+			wantEdit:     true,
+			wantManage:   true,
+		},
+		{
+			name:         "閲覧者を割り当てたキャンパス",
+			campus:       vo.Campus("ikebukuro"),
+			wantRoleKey:  vo.ROLE_KEY_VIEWER,
+			wantAssigned: true,
+		},
+		{
+			name:        "割り当てがないキャンパスはユーザー全体のロール",
+			campus:      vo.Campus("shinjuku"),
+			wantRoleKey: vo.ROLE_KEY_EDITOR,
+			wantEdit:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if got := userData.RoleKeyAt(tt.campus); got != tt.wantRoleKey {
+				t.Errorf("got role %s, want %s", got, tt.wantRoleKey)
+			}
+
+			if _, got := userData.CampusRoleAt(tt.campus); got != tt.wantAssigned {
+				t.Errorf("got assigned %t, want %t", got, tt.wantAssigned)
+			}
+
+			if got := userData.AllowsAt(tt.campus, vo.PERMISSION_SCHEDULE_EDIT); got != tt.wantEdit {
+				t.Errorf("got schedule.edit %t, want %t", got, tt.wantEdit)
+			}
+
+			if got := userData.AllowsAt(tt.campus, vo.PERMISSION_USER_MANAGE); got != tt.wantManage {
+				t.Errorf("got user.manage %t, want %t", got, tt.wantManage)
+			}
+		})
+	}
+}
+
+func TestChangeCampusRoles(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		campusRoles UserCampusRoleModelSlice
+		wantCount   int
+		wantErr     error
+	}{
+		{
+			name: "キャンパスごとに割り当てる",
+			campusRoles: UserCampusRoleModelSlice{
+				NewUserCampusRoleModel(vo.Campus("shibuya"), vo.ROLE_KEY_OWNER, testOwnerPermissions),
+				NewUserCampusRoleModel(vo.Campus("ikebukuro"), vo.ROLE_KEY_VIEWER, testViewerPermissions),
+			},
+			wantCount: 2,
+		},
+		{
+			name:        "割り当てを全て外す",
+			campusRoles: UserCampusRoleModelSlice{},
+			wantCount:   0,
+		},
+		{
+			name: "同じキャンパスが重複している",
+			campusRoles: UserCampusRoleModelSlice{
+				NewUserCampusRoleModel(vo.Campus("ikebukuro"), vo.ROLE_KEY_OWNER, testOwnerPermissions),
+				NewUserCampusRoleModel(vo.Campus("ikebukuro"), vo.ROLE_KEY_VIEWER, testViewerPermissions),
+			},
+			wantCount: 1,
+			wantErr:   ErrUserCampusRoleDuplicated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			userData := NewRootUserModel(
+				vo.UserID(2),
+				vo.ROLE_KEY_EDITOR,
+				vo.UserName("editor@example.com"),
+				vo.UserPassword(""),
+				vo.UserDisplayName("編集者"),
+				testEditorPermissions,
+				UserCampusRoleModelSlice{
+					NewUserCampusRoleModel(vo.Campus("shinjuku"), vo.ROLE_KEY_VIEWER, testViewerPermissions),
+				},
+			)
+
+			err := userData.ChangeCampusRoles(tt.campusRoles)
+
+			if tt.wantErr != nil {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr.Error()) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			// 失敗した場合は元の割り当てが保持される
+			if got := len(userData.CampusRoles()); got != tt.wantCount {
+				t.Errorf("got %d campus roles, want %d", got, tt.wantCount)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/apitoken"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type APITokenRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *apitoken.RootAPITokenModel) (vo.APITokenID, error)
+	Delete(ctx context.Context, tx *sql.Tx, tokenID vo.APITokenID) error
+	FindByID(ctx context.Context, tokenID vo.APITokenID) (*apitoken.RootAPITokenModel, error)
+	FindByUserID(ctx context.Context, userID vo.UserID) (apitoken.RootAPITokenModelSlice, error)
+	FindByTokenHash(ctx context.Context, tokenHash string) (*apitoken.RootAPITokenModel, error)
+}
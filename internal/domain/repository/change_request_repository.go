@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/changerequest"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type ChangeRequestRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *changerequest.RootChangeRequestModel) (vo.ChangeRequestID, error)
+	FindByID(ctx context.Context, changeRequestID vo.ChangeRequestID) (*changerequest.RootChangeRequestModel, error)
+	FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (changerequest.RootChangeRequestModelSlice, error)
+}
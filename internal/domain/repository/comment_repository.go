@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/comment"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type CommentRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *comment.RootCommentModel) (vo.CommentID, error)
+	Delete(ctx context.Context, tx *sql.Tx, commentID vo.CommentID) error
+	FindByID(ctx context.Context, commentID vo.CommentID) (*comment.RootCommentModel, error)
+	FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (comment.RootCommentModelSlice, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type DayBundleRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *bundle.RootDayBundleModel) (vo.DayBundleID, error)
+	Delete(ctx context.Context, tx *sql.Tx, dayBundleID vo.DayBundleID) error
+	FindByID(ctx context.Context, dayBundleID vo.DayBundleID) (*bundle.RootDayBundleModel, error)
+	FindByCampus(ctx context.Context, campus vo.Campus) (bundle.RootDayBundleModelSlice, error)
+	FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (*bundle.RootDayBundleModel, error)
+}
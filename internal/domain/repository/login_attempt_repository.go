@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type LoginAttemptRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *loginattempt.RootLoginAttemptModel) error
+	// ユーザーとしての試行と、ユーザー名を指定した試行を新しい順に取得する
+	FindRecentByUser(ctx context.Context, userID vo.UserID, userName string, limit int) ([]*loginattempt.RootLoginAttemptModel, error)
+}
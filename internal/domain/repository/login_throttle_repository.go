@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+)
+
+type LoginThrottleRepository interface {
+	// 既に存在する場合は何もしない
+	CreateIfNotExists(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error
+	Save(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error
+	Delete(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) error
+	FindByKey(ctx context.Context, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error)
+	FindByKeyWithLock(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error)
+}
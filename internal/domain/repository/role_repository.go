@@ -2,10 +2,15 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/role"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 )
 
 type RoleRepository interface {
 	FindAll(ctx context.Context) (role.RootRoleModelSlice, error)
+	FindByRoleKey(ctx context.Context, roleKey vo.RoleKey) (*role.RootRoleModel, error)
+	// ロールの名称と権限の割り当てを保存する
+	Save(ctx context.Context, tx *sql.Tx, role *role.RootRoleModel) error
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/itemtype"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type RoomItemTypeRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, campus vo.Campus, slice itemtype.RootRoomItemTypeModelSlice) error
+	FindByCampus(ctx context.Context, campus vo.Campus) (itemtype.RootRoomItemTypeModelSlice, error)
+}
@@ -10,9 +10,12 @@ import (
 
 type ScheduleRepository interface {
 	Save(ctx context.Context, tx *sql.Tx, rootModel *schedule.RootScheduleModel) (vo.ScheduleID, error)
+	SaveAccessControl(ctx context.Context, tx *sql.Tx, rootModel *schedule.RootScheduleModel) error
 	Delete(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, deleteUserID vo.UserID) error
 	FindByIDWithLock(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID) (*schedule.RootScheduleModel, error)
 	FindByIDWithLockHistoryIndex(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex) (*schedule.RootScheduleModel, error)
 	FindByIDWithHistoryIndex(ctx context.Context, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex) (*schedule.RootScheduleModel, error)
 	FindByID(ctx context.Context, scheduleID vo.ScheduleID) (*schedule.RootScheduleModel, error)
+	// 所有者・共同編集者の変更用。アイテムは読み込まない
+	FindAccessControlByUserIDWithLock(ctx context.Context, tx *sql.Tx, userID vo.UserID) ([]*schedule.RootScheduleModel, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type SchedulingPolicyRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *policy.RootSchedulingPolicyModel) error
+	FindByCampus(ctx context.Context, campus vo.Campus) (*policy.RootSchedulingPolicyModel, error)
+}
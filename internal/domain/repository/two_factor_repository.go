@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/twofactor"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type TwoFactorRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *twofactor.RootTwoFactorModel) error
+	FindByUserID(ctx context.Context, userID vo.UserID) (*twofactor.RootTwoFactorModel, error)
+	FindByUserIDWithLock(ctx context.Context, tx *sql.Tx, userID vo.UserID) (*twofactor.RootTwoFactorModel, error)
+}
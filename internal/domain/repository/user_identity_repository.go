@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/identity"
+)
+
+type UserIdentityRepository interface {
+	Save(ctx context.Context, tx *sql.Tx, model *identity.RootUserIdentityModel) error
+	FindByIssuerAndSubject(ctx context.Context, issuer string, subject string) (*identity.RootUserIdentityModel, error)
+}
@@ -12,6 +12,13 @@ type UserRepository interface {
 	FindAll(ctx context.Context) (user.RootUserModelSlice, error)
 	FindByUserName(ctx context.Context, userName string) (*user.RootUserModel, error)
 	FindByUserID(ctx context.Context, userId vo.UserID) (*user.RootUserModel, error)
+	// 無効化・削除済みのユーザーも含めて取得する
+	FindByUserIDWithStatus(ctx context.Context, userId vo.UserID) (*user.RootUserModel, vo.UserStatus, error)
 	Save(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, userID vo.UserID) error
+	// 外部のIDプロバイダーから自動作成したユーザーを登録し、採番したIDを返す
+	SaveProvisioned(ctx context.Context, tx *sql.Tx, user *user.RootUserModel) (vo.UserID, error)
+	// キャンパスごとのロール割り当てを置き換える
+	SaveCampusRoles(ctx context.Context, tx *sql.Tx, user *user.RootUserModel) error
 	Delete(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, deleteFromUserID vo.UserID) error
+	ChangeStatus(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, status vo.UserStatus, changeFromUserID vo.UserID) error
 }
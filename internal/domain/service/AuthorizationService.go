@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	// ユーザーに割り当てられたロールの権限で操作を認可する
+	// ロールや権限の変更がログイン中のユーザーにも反映されるよう、セッションのロールではなく最新のユーザー情報で判定する
+	IAuthorizationService interface {
+		// ユーザー全体のロールの権限で判定する
+		Allows(ctx context.Context, user vo.UserID, permission vo.Permission) (bool, error)
+		// 権限がない場合は Forbidden のエラーを返す
+		Authorize(ctx context.Context, user vo.UserID, permission vo.Permission) error
+		// キャンパスで有効なロールの権限で判定する。権限がない場合は Forbidden のエラーを返す
+		AuthorizeAt(ctx context.Context, user vo.UserID, campus vo.Campus, permission vo.Permission) error
+		// キャンパスで有効なロールに割り当てられた権限
+		PermissionsAt(ctx context.Context, user vo.UserID, campus vo.Campus) (vo.Permissions, error)
+	}
+
+	AuthorizationService struct {
+		repositoryUser repository.UserRepository
+	}
+)
+
+func NewAuthorizationService(
+	repositoryUser repository.UserRepository,
+) IAuthorizationService {
+	return &AuthorizationService{
+		repositoryUser: repositoryUser,
+	}
+}
+
+func (r AuthorizationService) Allows(ctx context.Context, user vo.UserID, permission vo.Permission) (bool, error) {
+
+	userData, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return false, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 無効化・削除されたユーザーには権限を与えない
+	if userData == nil {
+		return false, nil
+	}
+
+	return userData.Permissions().Has(permission), nil
+}
+
+func (r AuthorizationService) Authorize(ctx context.Context, user vo.UserID, permission vo.Permission) error {
+
+	allowed, err := r.Allows(ctx, user, permission)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !allowed {
+		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	return nil
+}
+
+func (r AuthorizationService) AuthorizeAt(ctx context.Context, user vo.UserID, campus vo.Campus, permission vo.Permission) error {
+
+	permissions, err := r.PermissionsAt(ctx, user, campus)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !permissions.Has(permission) {
+		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	return nil
+}
+
+func (r AuthorizationService) PermissionsAt(ctx context.Context, user vo.UserID, campus vo.Campus) (vo.Permissions, error) {
+
+	userData, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if userData == nil {
+		return vo.Permissions{}, nil
+	}
+
+	return userData.PermissionsAt(campus), nil
+}
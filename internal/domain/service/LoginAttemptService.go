@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	// ログインの試行を記録し、ユーザー名と接続元IPアドレスごとの失敗回数で試行を制限する
+	ILoginAttemptService interface {
+		// 制限中の場合は TooManyRequests のエラーを返す。パスワードの検証より前に呼び出す
+		Check(ctx context.Context, userName string, ipAddress string, now time.Time) error
+		// 制限中で認証を行わなかった試行や、2段階認証を待っている試行を記録する
+		Record(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error
+		RecordFailure(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error
+		// ログインが完了したユーザー名の失敗回数を消去する
+		RecordSuccess(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error
+	}
+
+	LoginAttemptService struct {
+		repositoryLoginAttempt  repository.LoginAttemptRepository
+		repositoryLoginThrottle repository.LoginThrottleRepository
+	}
+)
+
+func NewLoginAttemptService(
+	repositoryLoginAttempt repository.LoginAttemptRepository,
+	repositoryLoginThrottle repository.LoginThrottleRepository,
+) ILoginAttemptService {
+	return &LoginAttemptService{
+		repositoryLoginAttempt:  repositoryLoginAttempt,
+		repositoryLoginThrottle: repositoryLoginThrottle,
+	}
+}
+
+func (r LoginAttemptService) Check(ctx context.Context, userName string, ipAddress string, now time.Time) error {
+
+	account, err := r.repositoryLoginThrottle.FindByKey(ctx, loginthrottle.THROTTLE_TYPE_ACCOUNT, loginthrottle.AccountKey(userName))
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if account != nil && account.IsLockedAt(now) {
+		return log.WrapErrorWithStackTraceTooManyRequests(
+			log.Errorf("ログインの失敗が続いたためアカウントがロックされています。%d分後に再試行するか管理者に連絡してください", minutesUntil(now, account.LockedUntil())),
+		)
+	}
+
+	ip, err := r.repositoryLoginThrottle.FindByKey(ctx, loginthrottle.THROTTLE_TYPE_IP, loginthrottle.IPKey(ipAddress))
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	var blockedUntil time.Time
+	for _, throttle := range []*loginthrottle.RootLoginThrottleModel{account, ip} {
+
+		if throttle == nil {
+			continue
+		}
+
+		if until := throttle.BlockedUntil(now); until.After(blockedUntil) {
+			blockedUntil = until
+		}
+	}
+
+	if !blockedUntil.IsZero() {
+		return log.WrapErrorWithStackTraceTooManyRequests(
+			log.Errorf("ログインの試行回数が多すぎます。%d秒後に再試行してください", int(math.Ceil(blockedUntil.Sub(now).Seconds()))),
+		)
+	}
+
+	return nil
+}
+
+func (r LoginAttemptService) Record(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error {
+
+	if err := r.repositoryLoginAttempt.Save(ctx, tx, attempt); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (r LoginAttemptService) RecordFailure(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error {
+
+	// デッドロックを避けるため、常にユーザー名、IPアドレスの順にロックする
+	throttles := []*loginthrottle.RootLoginThrottleModel{
+		loginthrottle.NewCreateRootLoginThrottleModel(loginthrottle.THROTTLE_TYPE_ACCOUNT, loginthrottle.AccountKey(attempt.UserName()), attempt.CreatedAt()),
+		loginthrottle.NewCreateRootLoginThrottleModel(loginthrottle.THROTTLE_TYPE_IP, loginthrottle.IPKey(attempt.IPAddress()), attempt.CreatedAt()),
+	}
+
+	for _, initial := range throttles {
+
+		// 同時の失敗で回数を上書きしないよう、行を作成してからロックした値に加算する
+		if err := r.repositoryLoginThrottle.CreateIfNotExists(ctx, tx, initial); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		throttle, err := r.repositoryLoginThrottle.FindByKeyWithLock(ctx, tx, initial.ThrottleType(), initial.Key())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if throttle == nil {
+			return log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ログインの失敗回数を取得できませんでした"))
+		}
+
+		throttle.RecordFailure(attempt.CreatedAt())
+
+		if err := r.repositoryLoginThrottle.Save(ctx, tx, throttle); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	return r.Record(ctx, tx, attempt)
+}
+
+func (r LoginAttemptService) RecordSuccess(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error {
+
+	// 共有のネットワークでの攻撃を見逃さないよう、IPアドレスの失敗回数は残す
+	err := r.repositoryLoginThrottle.Delete(ctx, tx, loginthrottle.THROTTLE_TYPE_ACCOUNT, loginthrottle.AccountKey(attempt.UserName()))
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return r.Record(ctx, tx, attempt)
+}
+
+func minutesUntil(now time.Time, until time.Time) int {
+
+	return int(math.Ceil(until.Sub(now).Minutes()))
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// 種別とキーで保持するだけの制限のリポジトリ
+type fakeLoginThrottleRepository struct {
+	throttles map[string]*loginthrottle.RootLoginThrottleModel
+}
+
+func newFakeLoginThrottleRepository(throttles ...*loginthrottle.RootLoginThrottleModel) *fakeLoginThrottleRepository {
+
+	repository := &fakeLoginThrottleRepository{throttles: map[string]*loginthrottle.RootLoginThrottleModel{}}
+	for _, throttle := range throttles {
+		repository.throttles[string(throttle.ThrottleType())+":"+throttle.Key()] = throttle
+	}
+
+	return repository
+}
+
+func (r *fakeLoginThrottleRepository) CreateIfNotExists(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error {
+	return nil
+}
+
+func (r *fakeLoginThrottleRepository) Save(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error {
+	return nil
+}
+
+func (r *fakeLoginThrottleRepository) Delete(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) error {
+	return nil
+}
+
+func (r *fakeLoginThrottleRepository) FindByKey(ctx context.Context, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error) {
+	return r.throttles[string(throttleType)+":"+key], nil
+}
+
+func (r *fakeLoginThrottleRepository) FindByKeyWithLock(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error) {
+	return r.FindByKey(ctx, throttleType, key)
+}
+
+func TestLoginAttemptCheck(t *testing.T) {
+
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	const userName = "editor@example.com"
+	const ipAddress = "192.0.2.1"
+
+	throttle := func(throttleType loginthrottle.ThrottleType, key string, failures int) *loginthrottle.RootLoginThrottleModel {
+		return loginthrottle.NewRootLoginThrottleModel(throttleType, key, failures, now, time.Time{})
+	}
+
+	tests := []struct {
+		name       string
+		throttles  []*loginthrottle.RootLoginThrottleModel
+		userName   string
+		wantStatus int
+	}{
+		{
+			name:     "失敗の記録がない",
+			userName: userName,
+		},
+		{
+			name:      "待ち時間なしで失敗できる回数まで",
+			throttles: []*loginthrottle.RootLoginThrottleModel{throttle(loginthrottle.THROTTLE_TYPE_ACCOUNT, userName, 3)},
+			userName:  userName,
+		},
+		{
+			name:       "ユーザー名の失敗回数による待ち時間",
+			throttles:  []*loginthrottle.RootLoginThrottleModel{throttle(loginthrottle.THROTTLE_TYPE_ACCOUNT, userName, 4)},
+			userName:   userName,
+			wantStatus: log.TOO_MANY_REQUESTS,
+		},
+		{
+			name:       "ユーザー名の大文字小文字を変えても制限される",
+			throttles:  []*loginthrottle.RootLoginThrottleModel{throttle(loginthrottle.THROTTLE_TYPE_ACCOUNT, userName, 4)},
+			userName:   "Editor@Example.com",
+			wantStatus: log.TOO_MANY_REQUESTS,
+		},
+		{
+			name:       "接続元IPアドレスの失敗回数による待ち時間",
+			throttles:  []*loginthrottle.RootLoginThrottleModel{throttle(loginthrottle.THROTTLE_TYPE_IP, ipAddress, 11)},
+			userName:   "viewer@example.com",
+			wantStatus: log.TOO_MANY_REQUESTS,
+		},
+		{
+			name: "ロックされたアカウント",
+			throttles: []*loginthrottle.RootLoginThrottleModel{
+				loginthrottle.NewRootLoginThrottleModel(loginthrottle.THROTTLE_TYPE_ACCOUNT, userName, 10, now.Add(-time.Minute), now.Add(14*time.Minute)),
+			},
+			userName:   userName,
+			wantStatus: log.TOO_MANY_REQUESTS,
+		},
+		{
+			name: "ロックの期限が過ぎたアカウント",
+			throttles: []*loginthrottle.RootLoginThrottleModel{
+				loginthrottle.NewRootLoginThrottleModel(loginthrottle.THROTTLE_TYPE_ACCOUNT, userName, 10, now.Add(-15*time.Minute), now),
+			},
+			userName: userName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			service := NewLoginAttemptService(nil, newFakeLoginThrottleRepository(tt.throttles...))
+
+			err := service.Check(context.Background(), tt.userName, ipAddress, now)
+
+			status := 0
+			var logErr *log.Error
+			if errors.As(err, &logErr) {
+				status = logErr.StatusCode
+			}
+
+			if status != tt.wantStatus {
+				t.Errorf("got status %d, want %d (%v)", status, tt.wantStatus, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleCollisionService interface {
+		LoadMembers(
+			ctx context.Context,
+			dayBundle *bundle.RootDayBundleModel,
+		) ([]*schedule.RootScheduleModel, error)
+		FindCollisions(
+			schedules []*schedule.RootScheduleModel,
+		) bundle.RoomCollisionModelSlice
+		FindCollisionsWith(
+			target *schedule.RootScheduleModel,
+			others []*schedule.RootScheduleModel,
+		) bundle.RoomCollisionModelSlice
+	}
+
+	ScheduleCollisionService struct {
+		repositorySchedule repository.ScheduleRepository
+	}
+)
+
+func NewScheduleCollisionService(
+	repositorySchedule repository.ScheduleRepository,
+) IScheduleCollisionService {
+	return &ScheduleCollisionService{
+		repositorySchedule: repositorySchedule,
+	}
+}
+
+// まとまりに含まれる各スケジュールの最新の履歴を取得する
+func (r ScheduleCollisionService) LoadMembers(
+	ctx context.Context,
+	dayBundle *bundle.RootDayBundleModel,
+) ([]*schedule.RootScheduleModel, error) {
+
+	schedules := make([]*schedule.RootScheduleModel, 0, len(dayBundle.ScheduleIDs()))
+	for _, scheduleID := range dayBundle.ScheduleIDs() {
+
+		scheduleData, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		if scheduleData == nil {
+			return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		schedules = append(schedules, scheduleData)
+	}
+
+	return schedules, nil
+}
+
+// 全スケジュールの組み合わせについて教室の衝突を検出する
+func (r ScheduleCollisionService) FindCollisions(
+	schedules []*schedule.RootScheduleModel,
+) bundle.RoomCollisionModelSlice {
+
+	collisions := bundle.RoomCollisionModelSlice{}
+	for index, target := range schedules {
+		collisions = append(collisions, r.FindCollisionsWith(target, schedules[index+1:])...)
+	}
+
+	return collisions
+}
+
+// targetと他のスケジュールとの衝突を検出する。target自身は比較対象から除く
+func (r ScheduleCollisionService) FindCollisionsWith(
+	target *schedule.RootScheduleModel,
+	others []*schedule.RootScheduleModel,
+) bundle.RoomCollisionModelSlice {
+
+	collisions := bundle.RoomCollisionModelSlice{}
+	for _, other := range others {
+
+		if other.ID() == target.ID() {
+			continue
+		}
+
+		for _, item := range target.RoomItems() {
+			for _, otherItem := range other.RoomItems() {
+
+				if item.RoomIndex() != otherItem.RoomIndex() {
+					continue
+				}
+
+				// 重なっている時間帯は遅い方の開始から早い方の終了まで
+				startTime := lo.Ternary(item.StartTime().ValueMinutes() > otherItem.StartTime().ValueMinutes(), item.StartTime(), otherItem.StartTime())
+				endTime := lo.Ternary(item.EndTime().ValueMinutes() < otherItem.EndTime().ValueMinutes(), item.EndTime(), otherItem.EndTime())
+				if startTime.ValueMinutes() >= endTime.ValueMinutes() {
+					continue
+				}
+
+				collisions = append(collisions, bundle.NewRoomCollisionModel(
+					item.RoomIndex(),
+					startTime,
+					endTime,
+					target.ID(),
+					item.Identifier(),
+					other.ID(),
+					otherItem.Identifier(),
+				))
+			}
+		}
+	}
+
+	return collisions
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 )
 
 type (
@@ -11,8 +12,21 @@ type (
 			sheduleData *schedule.RootScheduleModel,
 			editUserID *user.RootUserModel,
 		) bool
+		AllowsDeletingBy(
+			sheduleData *schedule.RootScheduleModel,
+			deleteUser *user.RootUserModel,
+		) bool
+		AllowsReviewingBy(
+			sheduleData *schedule.RootScheduleModel,
+			reviewUser *user.RootUserModel,
+		) bool
+		AllowsManagingAccessBy(
+			sheduleData *schedule.RootScheduleModel,
+			manageUser *user.RootUserModel,
+		) bool
 	}
 
+	// 存在しないスケジュール・ユーザーに対してはいずれの操作も許可しない
 	ScheduleEditPermissionService struct{}
 )
 
@@ -25,14 +39,97 @@ func (r ScheduleEditPermissionService) AllowsEditingBy(
 	editUser *user.RootUserModel,
 ) bool {
 
-	if editUser.RoleKey().IsViewer() {
+	if sheduleData == nil || editUser == nil {
 		return false
 	}
 
-	if editUser.RoleKey().IsEditor() && sheduleData.CreateUser() != editUser.ID() {
+	campus := sheduleData.Campus()
+
+	// 全スケジュールの管理権限がない場合は、所有者または編集権限を付与された共同編集者のみ
+	if !editUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_MANAGE) {
+
+		if !editUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_EDIT) {
+			return false
+		}
+
+		if !sheduleData.IsEditableCollaborator(editUser.ID()) {
+			return false
+		}
+	}
+
+	// 公開済み・アーカイブ済みは編集不可
+	if sheduleData.IsFrozen() {
 		return false
 	}
 
 	return true
 
 }
+
+func (r ScheduleEditPermissionService) AllowsDeletingBy(
+	sheduleData *schedule.RootScheduleModel,
+	deleteUser *user.RootUserModel,
+) bool {
+
+	if sheduleData == nil || deleteUser == nil {
+		return false
+	}
+
+	allowsManaging := deleteUser.AllowsAt(sheduleData.Campus(), vo.PERMISSION_SCHEDULE_MANAGE)
+
+	// アーカイブ済みは管理権限があるユーザーのみ削除できる
+	if sheduleData.Status().IsArchived() {
+		return allowsManaging
+	}
+
+	// 共同編集者は削除できない
+	if !allowsManaging && !sheduleData.IsOwnedBy(deleteUser.ID()) {
+		return false
+	}
+
+	return r.AllowsEditingBy(sheduleData, deleteUser)
+}
+
+// 変更提案の承認・却下。公開済みのスケジュールは却下のみ行える
+func (r ScheduleEditPermissionService) AllowsReviewingBy(
+	sheduleData *schedule.RootScheduleModel,
+	reviewUser *user.RootUserModel,
+) bool {
+
+	if sheduleData == nil || reviewUser == nil {
+		return false
+	}
+
+	if sheduleData.Status().IsArchived() {
+		return false
+	}
+
+	if reviewUser.AllowsAt(sheduleData.Campus(), vo.PERMISSION_SCHEDULE_REVIEW) {
+		return true
+	}
+
+	return r.AllowsEditingBy(sheduleData, reviewUser)
+}
+
+// 共同編集者の設定・所有権の移譲。スケジュールの所有者と管理権限があるユーザーのみ
+func (r ScheduleEditPermissionService) AllowsManagingAccessBy(
+	sheduleData *schedule.RootScheduleModel,
+	manageUser *user.RootUserModel,
+) bool {
+
+	if sheduleData == nil || manageUser == nil {
+		return false
+	}
+
+	campus := sheduleData.Campus()
+
+	if manageUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_MANAGE) {
+		return true
+	}
+
+	if !manageUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_EDIT) {
+		return false
+	}
+
+	return sheduleData.IsOwnedBy(manageUser.ID())
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+)
+
+func TestScheduleEditPermissionServiceNilSchedule(t *testing.T) {
+
+	service := NewScheduleEditPermissionService()
+
+	tests := []struct {
+		name   string
+		allows func(*schedule.RootScheduleModel, *user.RootUserModel) bool
+	}{
+		{name: "編集", allows: service.AllowsEditingBy},
+		{name: "削除", allows: service.AllowsDeletingBy},
+		{name: "承認", allows: service.AllowsReviewingBy},
+		{name: "共同編集者の管理", allows: service.AllowsManagingAccessBy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if tt.allows(nil, nil) {
+				t.Error("存在しないスケジュールの操作が許可されています")
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+)
+
+type (
+	IScheduleStatusPermissionService interface {
+		AllowsChangingBy(
+			sheduleData *schedule.RootScheduleModel,
+			action vo.ScheduleStatusAction,
+			changeUser *user.RootUserModel,
+		) bool
+		AllowsViewingBy(
+			status vo.ScheduleStatus,
+			permissions vo.Permissions,
+		) bool
+		AllowsViewingScheduleBy(
+			sheduleData *schedule.RootScheduleModel,
+			viewUser vo.UserID,
+			permissions vo.Permissions,
+		) bool
+	}
+
+	ScheduleStatusPermissionService struct{}
+)
+
+func NewScheduleStatusPermissionService() IScheduleStatusPermissionService {
+	return &ScheduleStatusPermissionService{}
+}
+
+func (r ScheduleStatusPermissionService) AllowsChangingBy(
+	sheduleData *schedule.RootScheduleModel,
+	action vo.ScheduleStatusAction,
+	changeUser *user.RootUserModel,
+) bool {
+
+	campus := sheduleData.Campus()
+
+	if changeUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_REVIEW) {
+		return true
+	}
+
+	// レビュー依頼は所有者・編集権限を持つ共同編集者も可能。承認・差し戻し・公開・アーカイブはレビュー権限が必要
+	if !action.IsSubmit() {
+		return false
+	}
+
+	if changeUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_MANAGE) {
+		return true
+	}
+
+	return changeUser.AllowsAt(campus, vo.PERMISSION_SCHEDULE_EDIT) && sheduleData.IsEditableCollaborator(changeUser.ID())
+}
+
+func (r ScheduleStatusPermissionService) AllowsViewingBy(
+	status vo.ScheduleStatus,
+	permissions vo.Permissions,
+) bool {
+
+	// 公開前のスケジュールを閲覧する権限がなければ公開済みのスケジュールのみ見せる
+	if !permissions.Has(vo.PERMISSION_SCHEDULE_VIEW_DRAFT) {
+		return status.IsPublished()
+	}
+
+	return true
+}
+
+// 共同編集者として登録されていれば、ステータスに関わらず閲覧できる
+func (r ScheduleStatusPermissionService) AllowsViewingScheduleBy(
+	sheduleData *schedule.RootScheduleModel,
+	viewUser vo.UserID,
+	permissions vo.Permissions,
+) bool {
+
+	if sheduleData.IsViewableCollaborator(viewUser) {
+		return true
+	}
+
+	return r.AllowsViewingBy(sheduleData.Status(), permissions)
+}
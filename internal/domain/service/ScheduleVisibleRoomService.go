@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/room"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleVisibleRoomService interface {
+		VisibleRoomIndexes(
+			ctx context.Context,
+			scheduleData *schedule.RootScheduleModel,
+		) ([]vo.RoomIndex, error)
+	}
+
+	ScheduleVisibleRoomService struct {
+		repositoryRoom                  repository.RoomRepository
+		repositoryScheduleInvisibleRoom repository.ScheduleInvisibleRoomRepository
+	}
+)
+
+func NewScheduleVisibleRoomService(
+	repositoryRoom repository.RoomRepository,
+	repositoryScheduleInvisibleRoom repository.ScheduleInvisibleRoomRepository,
+) IScheduleVisibleRoomService {
+	return &ScheduleVisibleRoomService{
+		repositoryRoom:                  repositoryRoom,
+		repositoryScheduleInvisibleRoom: repositoryScheduleInvisibleRoom,
+	}
+}
+
+// 校舎の教室のうち、スケジュールで非表示にしていない教室の番号を返す
+func (r ScheduleVisibleRoomService) VisibleRoomIndexes(
+	ctx context.Context,
+	scheduleData *schedule.RootScheduleModel,
+) ([]vo.RoomIndex, error) {
+
+	rooms, err := r.repositoryRoom.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	invisibleRooms, err := r.repositoryScheduleInvisibleRoom.FindBySheduleID(ctx, scheduleData.ID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return lo.FilterMap(rooms, func(item *room.RootRoomModel, _ int) (vo.RoomIndex, bool) {
+		return item.RoomIndex(), !invisibleRooms.IsInvisible(item.RoomIndex())
+	}), nil
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrAPITokenIDUnderMin = errors.New("APIトークンIDは0以上を設定してください")
+
+type APITokenID int
+
+const (
+	API_TOKEN_ID_INVALID = APITokenID(-1)
+	API_TOKEN_ID_INITIAL = APITokenID(0)
+)
+
+func NewAPITokenID(id int) (APITokenID, error) {
+
+	if id < 0 {
+		return API_TOKEN_ID_INVALID, log.WrapErrorWithStackTraceBadRequest(ErrAPITokenIDUnderMin)
+	}
+
+	return APITokenID(id), nil
+}
+
+func (r APITokenID) Value() int {
+
+	return int(r)
+}
+
+func (r APITokenID) IsInitial() bool {
+
+	return r == API_TOKEN_ID_INITIAL
+}
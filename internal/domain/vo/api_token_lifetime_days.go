@@ -0,0 +1,37 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrAPITokenLifetimeDaysOutOfRange = errors.New("APIトークンの有効日数が範囲外です")
+
+// APIトークンの有効日数。無期限のトークンは発行しない
+type APITokenLifetimeDays int
+
+const (
+	API_TOKEN_LIFETIME_DAYS_INVALID = APITokenLifetimeDays(-1)
+)
+
+func NewAPITokenLifetimeDays(days int) (APITokenLifetimeDays, error) {
+
+	const MIN_API_TOKEN_LIFETIME_DAYS = 1
+	const MAX_API_TOKEN_LIFETIME_DAYS = 365
+	if days < MIN_API_TOKEN_LIFETIME_DAYS || days > MAX_API_TOKEN_LIFETIME_DAYS {
+		return API_TOKEN_LIFETIME_DAYS_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最小:%d日 最大:%d日", ErrAPITokenLifetimeDaysOutOfRange, MIN_API_TOKEN_LIFETIME_DAYS, MAX_API_TOKEN_LIFETIME_DAYS))
+	}
+
+	return APITokenLifetimeDays(days), nil
+}
+
+func (r APITokenLifetimeDays) Value() int {
+	return int(r)
+}
+
+func (r APITokenLifetimeDays) ExpiresAt(from time.Time) time.Time {
+	return from.AddDate(0, 0, int(r))
+}
@@ -0,0 +1,39 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrAPITokenNameEmpty = errors.New("APIトークン名が未設定です")
+var ErrAPITokenNameLengthOver = errors.New("APIトークン名に設定できる最大数を超えています")
+
+type APITokenName string
+
+const (
+	API_TOKEN_NAME_INVALID = APITokenName("invalid")
+)
+
+func NewAPITokenName(name string) (APITokenName, error) {
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return API_TOKEN_NAME_INVALID, log.WrapErrorWithStackTrace(ErrAPITokenNameEmpty)
+	}
+
+	const MAX_API_TOKEN_NAME_LENGTH = 64
+	if utf8.RuneCountInString(name) > MAX_API_TOKEN_NAME_LENGTH {
+		return API_TOKEN_NAME_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrAPITokenNameLengthOver, MAX_API_TOKEN_NAME_LENGTH))
+	}
+
+	return APITokenName(name), nil
+}
+
+func (r APITokenName) Value() string {
+
+	return string(r)
+}
@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrAPITokenScopeInvalid = errors.New("APIトークンのスコープが不正です")
+
+type APITokenScope string
+
+const (
+	API_TOKEN_SCOPE_INVALID = APITokenScope("invalid")
+	// 参照系(GET)のリクエストのみ可能
+	API_TOKEN_SCOPE_READ = APITokenScope("read")
+	// 更新系を含む全てのリクエストが可能
+	API_TOKEN_SCOPE_WRITE = APITokenScope("write")
+)
+
+func NewAPITokenScope(scope string) (APITokenScope, error) {
+
+	apiTokenScope := APITokenScope(strings.TrimSpace(scope))
+
+	switch apiTokenScope {
+	case API_TOKEN_SCOPE_READ, API_TOKEN_SCOPE_WRITE:
+		return apiTokenScope, nil
+	default:
+		return API_TOKEN_SCOPE_INVALID, log.WrapErrorWithStackTrace(ErrAPITokenScopeInvalid)
+	}
+}
+
+func (r APITokenScope) Value() string {
+	return string(r)
+}
+
+func (r APITokenScope) IsReadOnly() bool {
+	return r == API_TOKEN_SCOPE_READ
+}
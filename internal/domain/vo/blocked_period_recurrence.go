@@ -0,0 +1,48 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrBlockedPeriodRecurrenceInvalid = errors.New("利用不可時間帯の繰り返し指定が不正です")
+
+type BlockedPeriodRecurrence string
+
+const (
+	BLOCKED_PERIOD_RECURRENCE_INVALID = BlockedPeriodRecurrence("invalid")
+	// 毎日
+	BLOCKED_PERIOD_RECURRENCE_DAILY = BlockedPeriodRecurrence("daily")
+	// 毎週指定した曜日
+	BLOCKED_PERIOD_RECURRENCE_WEEKLY = BlockedPeriodRecurrence("weekly")
+	// 指定した日付のみ
+	BLOCKED_PERIOD_RECURRENCE_DATE = BlockedPeriodRecurrence("date")
+)
+
+func NewBlockedPeriodRecurrence(recurrence string) (BlockedPeriodRecurrence, error) {
+
+	switch strings.TrimSpace(recurrence) {
+	case "", "daily":
+		return BLOCKED_PERIOD_RECURRENCE_DAILY, nil
+	case "weekly":
+		return BLOCKED_PERIOD_RECURRENCE_WEEKLY, nil
+	case "date":
+		return BLOCKED_PERIOD_RECURRENCE_DATE, nil
+	default:
+		return BLOCKED_PERIOD_RECURRENCE_INVALID, log.WrapErrorWithStackTrace(ErrBlockedPeriodRecurrenceInvalid)
+	}
+}
+
+func (r BlockedPeriodRecurrence) Value() string {
+	return string(r)
+}
+
+func (r BlockedPeriodRecurrence) IsWeekly() bool {
+	return r == BLOCKED_PERIOD_RECURRENCE_WEEKLY
+}
+
+func (r BlockedPeriodRecurrence) IsDate() bool {
+	return r == BLOCKED_PERIOD_RECURRENCE_DATE
+}
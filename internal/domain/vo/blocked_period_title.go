@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrBlockedPeriodTitleEmpty = errors.New("利用不可時間帯の名称が未設定です")
+var ErrBlockedPeriodTitleLengthOver = errors.New("利用不可時間帯の名称に設定できる最大数を超えています")
+
+type BlockedPeriodTitle string
+
+const (
+	BLOCKED_PERIOD_TITLE_INVALID = BlockedPeriodTitle("invalid")
+)
+
+func NewBlockedPeriodTitle(title string) (BlockedPeriodTitle, error) {
+
+	title = strings.TrimSpace(title)
+	if len(title) == 0 {
+		return BLOCKED_PERIOD_TITLE_INVALID, log.WrapErrorWithStackTrace(ErrBlockedPeriodTitleEmpty)
+	}
+
+	const NAME_MAX_LENGTH = 32
+	if utf8.RuneCountInString(title) > NAME_MAX_LENGTH {
+		return BLOCKED_PERIOD_TITLE_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrBlockedPeriodTitleLengthOver, NAME_MAX_LENGTH))
+	}
+
+	return BlockedPeriodTitle(title), nil
+}
+
+func (r BlockedPeriodTitle) Value() string {
+	return string(r)
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrChangeRequestIDUnderMin = errors.New("変更提案IDは0以上を設定してください")
+
+type ChangeRequestID int
+
+const (
+	CHANGE_REQUEST_ID_INVALID = ChangeRequestID(-1)
+	CHANGE_REQUEST_ID_INITIAL = ChangeRequestID(0)
+)
+
+func NewChangeRequestID(id int) (ChangeRequestID, error) {
+
+	if id < 0 {
+		return CHANGE_REQUEST_ID_INVALID, log.WrapErrorWithStackTraceBadRequest(ErrChangeRequestIDUnderMin)
+	}
+
+	return ChangeRequestID(id), nil
+}
+
+func (r ChangeRequestID) Value() int {
+
+	return int(r)
+}
+
+func (r ChangeRequestID) IsInitial() bool {
+
+	return r == CHANGE_REQUEST_ID_INITIAL
+}
@@ -0,0 +1,42 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrChangeRequestStatusInvalid = errors.New("変更提案のステータスが不正です")
+
+type ChangeRequestStatus string
+
+const (
+	CHANGE_REQUEST_STATUS_INVALID = ChangeRequestStatus("invalid")
+	// レビュー待ち
+	CHANGE_REQUEST_STATUS_PENDING = ChangeRequestStatus("pending")
+	// 承認済み(スケジュールに反映済み)
+	CHANGE_REQUEST_STATUS_APPROVED = ChangeRequestStatus("approved")
+	// 却下
+	CHANGE_REQUEST_STATUS_REJECTED = ChangeRequestStatus("rejected")
+)
+
+func NewChangeRequestStatus(status string) (ChangeRequestStatus, error) {
+
+	changeRequestStatus := ChangeRequestStatus(strings.TrimSpace(status))
+
+	switch changeRequestStatus {
+	case CHANGE_REQUEST_STATUS_PENDING, CHANGE_REQUEST_STATUS_APPROVED, CHANGE_REQUEST_STATUS_REJECTED:
+		return changeRequestStatus, nil
+	default:
+		return CHANGE_REQUEST_STATUS_INVALID, log.WrapErrorWithStackTrace(ErrChangeRequestStatusInvalid)
+	}
+}
+
+func (r ChangeRequestStatus) Value() string {
+	return string(r)
+}
+
+func (r ChangeRequestStatus) IsPending() bool {
+	return r == CHANGE_REQUEST_STATUS_PENDING
+}
@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrCollaboratorPermissionInvalid = errors.New("共同編集者の権限が不正です")
+
+type CollaboratorPermission string
+
+const (
+	COLLABORATOR_PERMISSION_INVALID = CollaboratorPermission("invalid")
+	// スケジュールの編集が可能
+	COLLABORATOR_PERMISSION_EDIT = CollaboratorPermission("edit")
+	// ステータスに関わらずスケジュールの閲覧が可能
+	COLLABORATOR_PERMISSION_VIEW = CollaboratorPermission("view")
+)
+
+func NewCollaboratorPermission(permission string) (CollaboratorPermission, error) {
+
+	collaboratorPermission := CollaboratorPermission(strings.TrimSpace(permission))
+
+	switch collaboratorPermission {
+	case COLLABORATOR_PERMISSION_EDIT, COLLABORATOR_PERMISSION_VIEW:
+		return collaboratorPermission, nil
+	default:
+		return COLLABORATOR_PERMISSION_INVALID, log.WrapErrorWithStackTrace(ErrCollaboratorPermissionInvalid)
+	}
+}
+
+func (r CollaboratorPermission) Value() string {
+	return string(r)
+}
+
+func (r CollaboratorPermission) IsEdit() bool {
+	return r == COLLABORATOR_PERMISSION_EDIT
+}
@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrCommentBodyEmpty = errors.New("コメントの本文が未設定です")
+var ErrCommentBodyLengthOver = errors.New("コメントの本文に設定できる最大数を超えています")
+
+type CommentBody string
+
+const (
+	COMMENT_BODY_INVALID = CommentBody("invalid")
+)
+
+func NewCommentBody(body string) (CommentBody, error) {
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return COMMENT_BODY_INVALID, log.WrapErrorWithStackTrace(ErrCommentBodyEmpty)
+	}
+
+	const BODY_MAX_LENGTH = 1000
+	if utf8.RuneCountInString(body) > BODY_MAX_LENGTH {
+		return COMMENT_BODY_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrCommentBodyLengthOver, BODY_MAX_LENGTH))
+	}
+
+	return CommentBody(body), nil
+}
+
+func (r CommentBody) Value() string {
+	return string(r)
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrCommentIDUnderMin = errors.New("コメントIDは0以上を設定してください")
+
+type CommentID int
+
+const (
+	COMMENT_ID_INVALID = CommentID(-1)
+	COMMENT_ID_INITIAL = CommentID(0)
+)
+
+func NewCommentID(id int) (CommentID, error) {
+
+	if id < 0 {
+		return COMMENT_ID_INVALID, log.WrapErrorWithStackTraceBadRequest(ErrCommentIDUnderMin)
+	}
+
+	return CommentID(id), nil
+}
+
+func (r CommentID) Value() int {
+
+	return int(r)
+}
+
+func (r CommentID) IsInitial() bool {
+
+	return r == COMMENT_ID_INITIAL
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrDayBundleIDUnderMin = errors.New("同日スケジュールのまとまりのIDは0以上を設定してください")
+
+type DayBundleID int
+
+const (
+	DAY_BUNDLE_ID_INVALID = DayBundleID(-1)
+	DAY_BUNDLE_ID_INITIAL = DayBundleID(0)
+)
+
+func NewDayBundleID(id int) (DayBundleID, error) {
+
+	if id < 0 {
+		return DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceBadRequest(ErrDayBundleIDUnderMin)
+	}
+
+	return DayBundleID(id), nil
+}
+
+func (r DayBundleID) Value() int {
+
+	return int(r)
+}
+
+func (r DayBundleID) IsInitial() bool {
+
+	return r == DAY_BUNDLE_ID_INITIAL
+}
@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrDayBundleTitleEmpty = errors.New("同日スケジュールのまとまりの名称が未設定です")
+var ErrDayBundleTitleLengthOver = errors.New("同日スケジュールのまとまりの名称に設定できる最大数を超えています")
+
+type DayBundleTitle string
+
+const (
+	DAY_BUNDLE_TITLE_INVALID = DayBundleTitle("invalid")
+)
+
+func NewDayBundleTitle(title string) (DayBundleTitle, error) {
+
+	title = strings.TrimSpace(title)
+	if len(title) == 0 {
+		return DAY_BUNDLE_TITLE_INVALID, log.WrapErrorWithStackTrace(ErrDayBundleTitleEmpty)
+	}
+
+	const NAME_MAX_LENGTH = 64
+	if utf8.RuneCountInString(title) > NAME_MAX_LENGTH {
+		return DAY_BUNDLE_TITLE_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrDayBundleTitleLengthOver, NAME_MAX_LENGTH))
+	}
+
+	return DayBundleTitle(title), nil
+}
+
+func (r DayBundleTitle) Value() string {
+	return string(r)
+}
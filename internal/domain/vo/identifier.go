@@ -27,6 +27,16 @@ func NewIdentifier(id string) (Identifier, error) {
 	return Identifier(key), nil
 }
 
+// 未設定を許容する。スケジュール全体を対象とする場合に使用する
+func NewIdentifierOrInitial(id string) (Identifier, error) {
+
+	if strings.TrimSpace(id) == "" {
+		return IDENTIFIER_INITIAL, nil
+	}
+
+	return NewIdentifier(id)
+}
+
 func NewIdentifierGenerate() Identifier {
 
 	return Identifier(uuid.New().String())
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemDivideBreakMinutesUnderMin = errors.New("分割後の休憩時間は0分以上を設定する必要があります")
+var ErrItemDivideBreakMinutesOverMax = errors.New("分割後の休憩時間に設定できる時間を超えています")
+
+type ItemDivideBreakMinutes int
+
+const (
+	ITEM_DIVIDE_BREAK_MINUTES_INVALID = ItemDivideBreakMinutes(-1)
+	ITEM_DIVIDE_BREAK_MINUTES_NONE    = ItemDivideBreakMinutes(0)
+)
+
+func NewItemDivideBreakMinutes(minutes int) (ItemDivideBreakMinutes, error) {
+
+	if minutes < 0 {
+		return ITEM_DIVIDE_BREAK_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrItemDivideBreakMinutesUnderMin)
+	}
+
+	const max_break_minutes = 60 * 2
+	if minutes > max_break_minutes {
+		return ITEM_DIVIDE_BREAK_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemDivideBreakMinutesOverMax, max_break_minutes))
+	}
+
+	return ItemDivideBreakMinutes(minutes), nil
+}
+
+func (r ItemDivideBreakMinutes) Value() int {
+	return int(r)
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemDividePartsUnderMin = errors.New("分割数は2以上を設定する必要があります")
+var ErrItemDividePartsOverMax = errors.New("分割数に設定できる数を超えています")
+
+type ItemDivideParts int
+
+const (
+	ITEM_DIVIDE_PARTS_INVALID = ItemDivideParts(-1)
+)
+
+func NewItemDivideParts(parts int) (ItemDivideParts, error) {
+
+	if parts < 2 {
+		return ITEM_DIVIDE_PARTS_INVALID, log.WrapErrorWithStackTrace(ErrItemDividePartsUnderMin)
+	}
+
+	const max_divide_parts = 12
+	if parts > max_divide_parts {
+		return ITEM_DIVIDE_PARTS_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d", ErrItemDividePartsOverMax, max_divide_parts))
+	}
+
+	return ItemDivideParts(parts), nil
+}
+
+func (r ItemDivideParts) Value() int {
+	return int(r)
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemGroupOffsetMinutesUnderMin = errors.New("グループ内の開始位置は0分以上を設定する必要があります")
+var ErrItemGroupOffsetMinutesOverMax = errors.New("グループ内の開始位置に設定できる時間を超えています")
+
+type ItemGroupOffsetMinutes int
+
+const (
+	ITEM_GROUP_OFFSET_MINUTES_INVALID = ItemGroupOffsetMinutes(-1)
+)
+
+func NewItemGroupOffsetMinutes(minutes int) (ItemGroupOffsetMinutes, error) {
+
+	if minutes < 0 {
+		return ITEM_GROUP_OFFSET_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrItemGroupOffsetMinutesUnderMin)
+	}
+
+	const max_offset_minutes = 60 * 24
+	if minutes > max_offset_minutes {
+		return ITEM_GROUP_OFFSET_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemGroupOffsetMinutesOverMax, max_offset_minutes))
+	}
+
+	return ItemGroupOffsetMinutes(minutes), nil
+}
+
+func (r ItemGroupOffsetMinutes) Value() int {
+	return int(r)
+}
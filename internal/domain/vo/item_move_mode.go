@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemMoveModeInvalid = errors.New("移動モードが不正です")
+
+type ItemMoveMode string
+
+const (
+	ITEM_MOVE_MODE_INVALID = ItemMoveMode("invalid")
+	// 指定した時刻にそのまま配置する
+	ITEM_MOVE_MODE_EXACT = ItemMoveMode("exact")
+	// 重なった後続のアイテムを後ろへ押し出して配置する
+	ITEM_MOVE_MODE_PUSH = ItemMoveMode("push")
+)
+
+func NewItemMoveMode(mode string) (ItemMoveMode, error) {
+
+	switch strings.TrimSpace(mode) {
+	case "", "exact":
+		return ITEM_MOVE_MODE_EXACT, nil
+	case "push":
+		return ITEM_MOVE_MODE_PUSH, nil
+	default:
+		return ITEM_MOVE_MODE_INVALID, log.WrapErrorWithStackTrace(ErrItemMoveModeInvalid)
+	}
+}
+
+func (r ItemMoveMode) Value() string {
+	return string(r)
+}
+
+func (r ItemMoveMode) IsPush() bool {
+	return r == ITEM_MOVE_MODE_PUSH
+}
@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemOperationInvalid = errors.New("アイテムの操作が不正です")
+
+type ItemOperation string
+
+const (
+	ITEM_OPERATION_INVALID = ItemOperation("invalid")
+	// 教室・時刻を指定して配置する
+	ITEM_OPERATION_MOVE = ItemOperation("move")
+	// 未配置の一覧へ戻す
+	ITEM_OPERATION_RETURN = ItemOperation("return")
+)
+
+func NewItemOperation(operation string) (ItemOperation, error) {
+
+	itemOperation := ItemOperation(strings.TrimSpace(operation))
+
+	switch itemOperation {
+	case ITEM_OPERATION_MOVE, ITEM_OPERATION_RETURN:
+		return itemOperation, nil
+	default:
+		return ITEM_OPERATION_INVALID, log.WrapErrorWithStackTrace(ErrItemOperationInvalid)
+	}
+}
+
+func (r ItemOperation) Value() string {
+	return string(r)
+}
+
+func (r ItemOperation) IsMove() bool {
+	return r == ITEM_OPERATION_MOVE
+}
@@ -0,0 +1,40 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemReturnModeInvalid = errors.New("一覧へ戻す際のモードが不正です")
+
+type ItemReturnMode string
+
+const (
+	ITEM_RETURN_MODE_INVALID = ItemReturnMode("invalid")
+	// 後続のアイテムはそのままにする
+	ITEM_RETURN_MODE_KEEP = ItemReturnMode("keep")
+	// 空いた時間を後続のアイテムで詰める
+	ITEM_RETURN_MODE_PULL = ItemReturnMode("pull")
+)
+
+func NewItemReturnMode(mode string) (ItemReturnMode, error) {
+
+	switch strings.TrimSpace(mode) {
+	case "", "keep":
+		return ITEM_RETURN_MODE_KEEP, nil
+	case "pull":
+		return ITEM_RETURN_MODE_PULL, nil
+	default:
+		return ITEM_RETURN_MODE_INVALID, log.WrapErrorWithStackTrace(ErrItemReturnModeInvalid)
+	}
+}
+
+func (r ItemReturnMode) Value() string {
+	return string(r)
+}
+
+func (r ItemReturnMode) IsPull() bool {
+	return r == ITEM_RETURN_MODE_PULL
+}
@@ -0,0 +1,35 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemShiftGapMinutesUnderMin = errors.New("アイテムの間隔は0分以上を設定する必要があります")
+var ErrItemShiftGapMinutesOverMax = errors.New("アイテムの間隔に設定できる時間を超えています")
+
+type ItemShiftGapMinutes int
+
+const (
+	ITEM_SHIFT_GAP_MINUTES_INVALID = ItemShiftGapMinutes(-1)
+)
+
+func NewItemShiftGapMinutes(minutes int) (ItemShiftGapMinutes, error) {
+
+	if minutes < 0 {
+		return ITEM_SHIFT_GAP_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrItemShiftGapMinutesUnderMin)
+	}
+
+	const max_gap_minutes = 60 * 3
+	if minutes > max_gap_minutes {
+		return ITEM_SHIFT_GAP_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemShiftGapMinutesOverMax, max_gap_minutes))
+	}
+
+	return ItemShiftGapMinutes(minutes), nil
+}
+
+func (r ItemShiftGapMinutes) Value() int {
+	return int(r)
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemShiftGridMinutesUnderMin = errors.New("開始時刻の刻みは1分以上を設定する必要があります")
+var ErrItemShiftGridMinutesOverMax = errors.New("開始時刻の刻みに設定できる時間を超えています")
+
+type ItemShiftGridMinutes int
+
+const (
+	ITEM_SHIFT_GRID_MINUTES_INVALID = ItemShiftGridMinutes(-1)
+	ITEM_SHIFT_GRID_MINUTES_DEFAULT = ItemShiftGridMinutes(5)
+)
+
+func NewItemShiftGridMinutes(minutes int) (ItemShiftGridMinutes, error) {
+
+	if minutes < 1 {
+		return ITEM_SHIFT_GRID_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrItemShiftGridMinutesUnderMin)
+	}
+
+	const max_grid_minutes = 60
+	if minutes > max_grid_minutes {
+		return ITEM_SHIFT_GRID_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemShiftGridMinutesOverMax, max_grid_minutes))
+	}
+
+	return ItemShiftGridMinutes(minutes), nil
+}
+
+func (r ItemShiftGridMinutes) Value() int {
+	return int(r)
+}
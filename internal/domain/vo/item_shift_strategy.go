@@ -0,0 +1,52 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemShiftStrategyInvalid = errors.New("シフト方法が不正です")
+
+type ItemShiftStrategy string
+
+const (
+	ITEM_SHIFT_STRATEGY_INVALID = ItemShiftStrategy("invalid")
+	// 先頭のアイテムに後続のアイテムを詰める
+	ITEM_SHIFT_STRATEGY_PACK = ItemShiftStrategy("pack")
+	// スケジュール開始時刻から詰める
+	ITEM_SHIFT_STRATEGY_PACK_START = ItemShiftStrategy("pack_start")
+	// スケジュール終了時刻に向けて詰める
+	ITEM_SHIFT_STRATEGY_PACK_END = ItemShiftStrategy("pack_end")
+	// 空き時間が均等になるように配置する
+	ITEM_SHIFT_STRATEGY_DISTRIBUTE = ItemShiftStrategy("distribute")
+	// 先頭のアイテムから一定の間隔を空けて詰める
+	ITEM_SHIFT_STRATEGY_FIXED_GAP = ItemShiftStrategy("fixed_gap")
+	// 開始時刻を指定した刻みに揃える
+	ITEM_SHIFT_STRATEGY_GRID = ItemShiftStrategy("grid")
+)
+
+func NewItemShiftStrategy(strategy string) (ItemShiftStrategy, error) {
+
+	switch strings.TrimSpace(strategy) {
+	case "", "pack":
+		return ITEM_SHIFT_STRATEGY_PACK, nil
+	case "pack_start":
+		return ITEM_SHIFT_STRATEGY_PACK_START, nil
+	case "pack_end":
+		return ITEM_SHIFT_STRATEGY_PACK_END, nil
+	case "distribute":
+		return ITEM_SHIFT_STRATEGY_DISTRIBUTE, nil
+	case "fixed_gap":
+		return ITEM_SHIFT_STRATEGY_FIXED_GAP, nil
+	case "grid":
+		return ITEM_SHIFT_STRATEGY_GRID, nil
+	default:
+		return ITEM_SHIFT_STRATEGY_INVALID, log.WrapErrorWithStackTrace(ErrItemShiftStrategyInvalid)
+	}
+}
+
+func (r ItemShiftStrategy) Value() string {
+	return string(r)
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrItemTimeShiftMinutesZero = errors.New("ずらす時間には0分以外を設定する必要があります")
+var ErrItemTimeShiftMinutesOverMax = errors.New("ずらす時間に設定できる時間を超えています")
+
+// アイテムの開始時刻をずらす分数。負の値は前へずらす
+type ItemTimeShiftMinutes int
+
+const (
+	ITEM_TIME_SHIFT_MINUTES_INVALID = ItemTimeShiftMinutes(0)
+)
+
+func NewItemTimeShiftMinutes(minutes int) (ItemTimeShiftMinutes, error) {
+
+	if minutes == 0 {
+		return ITEM_TIME_SHIFT_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrItemTimeShiftMinutesZero)
+	}
+
+	const max_shift_minutes = 60 * 24
+	if minutes < -max_shift_minutes || minutes > max_shift_minutes {
+		return ITEM_TIME_SHIFT_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemTimeShiftMinutesOverMax, max_shift_minutes))
+	}
+
+	return ItemTimeShiftMinutes(minutes), nil
+}
+
+func (r ItemTimeShiftMinutes) Value() int {
+	return int(r)
+}
@@ -23,7 +23,8 @@ func NewLessonDuration(duration int) (LessonDuration, error) {
 		return LESSON_DURATION_INVALID, log.WrapErrorWithStackTrace(ErrItemLessonDurationUnderMin)
 	}
 
-	const max_lesson_time_minutes = 60 * 5
+	// 校舎ごとの上限は配置ポリシーで設定するため、ここでは1日の長さを上限とする
+	const max_lesson_time_minutes = 60 * 24
 	if duration > max_lesson_time_minutes {
 		return LESSON_DURATION_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrItemLessonDurationOverMax, max_lesson_time_minutes))
 	}
@@ -58,3 +59,46 @@ func (r LessonDuration) Divide(inputDivideMinutes ItemDivideMinutes) (LessonDura
 
 	return LessonDuration(durationDivideFrom), LessonDuration(durationDivideTo), nil
 }
+
+// 講座時間を均等に分割する。割り切れない分は先頭から1分ずつ割り当てる
+func (r LessonDuration) DivideEqually(inputParts ItemDivideParts) ([]LessonDuration, error) {
+
+	duration := r.Value()
+	parts := inputParts.Value()
+
+	if parts > duration {
+		return nil, log.WrapErrorWithStackTrace(ErrItemLessonDurationRangeOver)
+	}
+
+	durations := make([]LessonDuration, parts)
+	for i := range durations {
+
+		durations[i] = LessonDuration(duration / parts)
+		if i < duration%parts {
+			durations[i]++
+		}
+	}
+
+	return durations, nil
+}
+
+// 講座時間を指定した時間の並びで分割する。時間の合計は講座時間と一致する必要がある
+func (r LessonDuration) DivideByLengths(inputLengths []ItemDivideMinutes) ([]LessonDuration, error) {
+
+	if len(inputLengths) < 2 {
+		return nil, log.WrapErrorWithStackTrace(ErrItemDividePartsUnderMin)
+	}
+
+	total := 0
+	durations := make([]LessonDuration, len(inputLengths))
+	for i, length := range inputLengths {
+		durations[i] = LessonDuration(length.Value())
+		total += length.Value()
+	}
+
+	if total != r.Value() {
+		return nil, log.WrapErrorWithStackTrace(fmt.Errorf("%w 分割時間の合計:%d分, 講座時間:%d分", ErrItemLessonDurationRangeOver, total, r.Value()))
+	}
+
+	return durations, nil
+}
@@ -0,0 +1,44 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrLoginAttemptResultInvalid = errors.New("ログイン試行の結果が不正です")
+
+type LoginAttemptResult string
+
+const (
+	LOGIN_ATTEMPT_RESULT_INVALID = LoginAttemptResult("invalid")
+	LOGIN_ATTEMPT_RESULT_SUCCESS = LoginAttemptResult("success")
+	// ユーザー名またはパスワードの誤り
+	LOGIN_ATTEMPT_RESULT_FAILURE = LoginAttemptResult("failure")
+	// 試行回数の制限により認証を行わなかった
+	LOGIN_ATTEMPT_RESULT_BLOCKED = LoginAttemptResult("blocked")
+	// パスワード認証に成功し、2段階認証を待っている
+	LOGIN_ATTEMPT_RESULT_TWO_FACTOR_PENDING = LoginAttemptResult("two_factor_pending")
+	// 2段階認証の認証コードの誤り
+	LOGIN_ATTEMPT_RESULT_TWO_FACTOR_FAILURE = LoginAttemptResult("two_factor_failure")
+)
+
+func NewLoginAttemptResult(result string) (LoginAttemptResult, error) {
+
+	loginAttemptResult := LoginAttemptResult(result)
+
+	switch loginAttemptResult {
+	case LOGIN_ATTEMPT_RESULT_SUCCESS,
+		LOGIN_ATTEMPT_RESULT_FAILURE,
+		LOGIN_ATTEMPT_RESULT_BLOCKED,
+		LOGIN_ATTEMPT_RESULT_TWO_FACTOR_PENDING,
+		LOGIN_ATTEMPT_RESULT_TWO_FACTOR_FAILURE:
+		return loginAttemptResult, nil
+	default:
+		return LOGIN_ATTEMPT_RESULT_INVALID, log.WrapErrorWithStackTrace(ErrLoginAttemptResultInvalid)
+	}
+}
+
+func (r LoginAttemptResult) Value() string {
+	return string(r)
+}
@@ -0,0 +1,80 @@
+package vo
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrPermissionEmpty = errors.New("権限が未設定です")
+var ErrPermissionInvalid = errors.New("権限の識別子が不正です")
+
+type Permission string
+
+const (
+	PERMISSION_INVALID = Permission("invalid")
+)
+
+const (
+	// 公開前(下書き・レビュー中など)のスケジュールの閲覧
+	PERMISSION_SCHEDULE_VIEW_DRAFT = Permission("schedule.view_draft")
+	PERMISSION_SCHEDULE_CREATE     = Permission("schedule.create")
+	// 所有・共同編集しているスケジュールの編集
+	PERMISSION_SCHEDULE_EDIT = Permission("schedule.edit")
+	// 所有者に関わらず全てのスケジュールの編集・削除・共同編集者の設定
+	PERMISSION_SCHEDULE_MANAGE = Permission("schedule.manage")
+	// 承認・差し戻し・公開・アーカイブ
+	PERMISSION_SCHEDULE_REVIEW  = Permission("schedule.review")
+	PERMISSION_SCHEDULE_COMMENT = Permission("schedule.comment")
+	// 閲覧できるスケジュールへの変更提案
+	PERMISSION_SCHEDULE_PROPOSE = Permission("schedule.propose")
+	PERMISSION_BUNDLE_EDIT      = Permission("bundle.edit")
+	PERMISSION_LESSON_EDIT      = Permission("lesson.edit")
+	PERMISSION_ROOM_EDIT        = Permission("room.edit")
+	PERMISSION_CAMPUS_EDIT      = Permission("campus.edit")
+	PERMISSION_USER_MANAGE      = Permission("user.manage")
+	PERMISSION_ROLE_MANAGE      = Permission("role.manage")
+)
+
+var validPermissions = []Permission{
+	PERMISSION_SCHEDULE_VIEW_DRAFT,
+	PERMISSION_SCHEDULE_CREATE,
+	PERMISSION_SCHEDULE_EDIT,
+	PERMISSION_SCHEDULE_MANAGE,
+	PERMISSION_SCHEDULE_REVIEW,
+	PERMISSION_SCHEDULE_COMMENT,
+	PERMISSION_SCHEDULE_PROPOSE,
+	PERMISSION_BUNDLE_EDIT,
+	PERMISSION_LESSON_EDIT,
+	PERMISSION_ROOM_EDIT,
+	PERMISSION_CAMPUS_EDIT,
+	PERMISSION_USER_MANAGE,
+	PERMISSION_ROLE_MANAGE,
+}
+
+func NewPermission(key string) (Permission, error) {
+
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return PERMISSION_INVALID, log.WrapErrorWithStackTrace(ErrPermissionEmpty)
+	}
+
+	permission := Permission(key)
+	if !slices.Contains(validPermissions, permission) {
+		return PERMISSION_INVALID, log.WrapErrorWithStackTrace(ErrPermissionInvalid)
+	}
+
+	return permission, nil
+}
+
+func (r Permission) Value() string {
+	return string(r)
+}
+
+type Permissions []Permission
+
+func (r Permissions) Has(permission Permission) bool {
+	return slices.Contains(r, permission)
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrPolicyGapMinutesUnderMin = errors.New("講座間の最小間隔は0分以上を設定する必要があります")
+var ErrPolicyGapMinutesOverMax = errors.New("講座間の最小間隔に設定できる時間を超えています")
+
+type PolicyGapMinutes int
+
+const (
+	POLICY_GAP_MINUTES_INVALID = PolicyGapMinutes(-1)
+	POLICY_GAP_MINUTES_NONE    = PolicyGapMinutes(0)
+)
+
+func NewPolicyGapMinutes(minutes int) (PolicyGapMinutes, error) {
+
+	if minutes < 0 {
+		return POLICY_GAP_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrPolicyGapMinutesUnderMin)
+	}
+
+	const max_gap_minutes = 120
+	if minutes > max_gap_minutes {
+		return POLICY_GAP_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrPolicyGapMinutesOverMax, max_gap_minutes))
+	}
+
+	return PolicyGapMinutes(minutes), nil
+}
+
+func (r PolicyGapMinutes) Value() int {
+	return int(r)
+}
@@ -0,0 +1,46 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrPolicyGridMinutesUnderMin = errors.New("時刻の刻みは1分以上を設定する必要があります")
+var ErrPolicyGridMinutesOverMax = errors.New("時刻の刻みに設定できる時間を超えています")
+var ErrPolicyGridMinutesNotDivisor = errors.New("時刻の刻みは60分を割り切れる値を設定してください")
+
+type PolicyGridMinutes int
+
+const (
+	POLICY_GRID_MINUTES_INVALID = PolicyGridMinutes(-1)
+	POLICY_GRID_MINUTES_DEFAULT = PolicyGridMinutes(1)
+)
+
+func NewPolicyGridMinutes(minutes int) (PolicyGridMinutes, error) {
+
+	if minutes < 1 {
+		return POLICY_GRID_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrPolicyGridMinutesUnderMin)
+	}
+
+	const max_grid_minutes = 60
+	if minutes > max_grid_minutes {
+		return POLICY_GRID_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrPolicyGridMinutesOverMax, max_grid_minutes))
+	}
+
+	// 毎時0分が必ず刻みに乗るようにする
+	if max_grid_minutes%minutes != 0 {
+		return POLICY_GRID_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 指定:%d分", ErrPolicyGridMinutesNotDivisor, minutes))
+	}
+
+	return PolicyGridMinutes(minutes), nil
+}
+
+func (r PolicyGridMinutes) Value() int {
+	return int(r)
+}
+
+func (r PolicyGridMinutes) IsAligned(minutes int) bool {
+	return minutes%int(r) == 0
+}
@@ -27,3 +27,7 @@ func NewRoleID(id int) (RoleID, error) {
 func (r RoleID) IsValid() bool {
 	return r > ROLE_ID_INITIAL
 }
+
+func (r RoleID) Value() int {
+	return int(r)
+}
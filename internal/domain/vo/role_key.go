@@ -2,7 +2,7 @@ package vo
 
 import (
 	"errors"
-	"slices"
+	"regexp"
 	"strings"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
@@ -24,11 +24,8 @@ const (
 	ROLE_KEY_VIEWER = RoleKey("viewer")
 )
 
-var validRoleKeys = []RoleKey{
-	ROLE_KEY_OWNER,
-	ROLE_KEY_EDITOR,
-	ROLE_KEY_VIEWER,
-}
+// 追加するロールは英小文字で始まる英小文字・数字・アンダースコア(16文字以内)
+var customRoleKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,15}$`)
 
 func NewRoleKey(key string) (RoleKey, error) {
 
@@ -46,9 +43,15 @@ func NewRoleKey(key string) (RoleKey, error) {
 		return roleKey, nil
 	case ROLE_KEY_VIEWER:
 		return roleKey, nil
-	default:
+	case ROLE_KEY_NONE, ROLE_KEY_INVALID:
+		return ROLE_KEY_INVALID, log.WrapErrorWithStackTrace(ErrRoleKeyInvalid)
+	}
+
+	if !customRoleKeyPattern.MatchString(key) {
 		return ROLE_KEY_INVALID, log.WrapErrorWithStackTrace(ErrRoleKeyInvalid)
 	}
+
+	return roleKey, nil
 }
 
 func (r RoleKey) Value() string {
@@ -57,17 +60,9 @@ func (r RoleKey) Value() string {
 }
 
 func (r RoleKey) IsValid() bool {
-	return slices.Contains(validRoleKeys, r)
-}
-
-func (r RoleKey) IsOwner() bool {
-	return r == ROLE_KEY_OWNER
-}
-
-func (r RoleKey) IsEditor() bool {
-	return r == ROLE_KEY_EDITOR
-}
+	if r == ROLE_KEY_NONE || r == ROLE_KEY_INVALID {
+		return false
+	}
 
-func (r RoleKey) IsViewer() bool {
-	return r == ROLE_KEY_VIEWER
+	return customRoleKeyPattern.MatchString(string(r))
 }
@@ -3,11 +3,13 @@ package vo
 import (
 	"errors"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
 
 var ErrRoleNameEmpty = errors.New("権限名称が未設定です")
+var ErrRoleNameTooLong = errors.New("権限名称が長すぎます")
 
 type RoleName string
 
@@ -23,6 +25,11 @@ func NewRoleName(name string) (RoleName, error) {
 		return ROLE_NAME_INVALID, log.WrapErrorWithStackTrace(ErrRoleNameEmpty)
 	}
 
+	const MAX_LENGTH = 16
+	if utf8.RuneCountInString(name) > MAX_LENGTH {
+		return ROLE_NAME_INVALID, log.WrapErrorWithStackTrace(ErrRoleNameTooLong)
+	}
+
 	return RoleName(name), nil
 }
 
@@ -12,6 +12,8 @@ type RoomIndex int
 
 const (
 	ROOM_INDEX_INVALID = RoomIndex(-1)
+	// 教室を限定しない(全教室)
+	ROOM_INDEX_ALL = RoomIndex(0)
 )
 
 func NewRoomIndex(index int) (RoomIndex, error) {
@@ -23,6 +25,16 @@ func NewRoomIndex(index int) (RoomIndex, error) {
 	return RoomIndex(index), nil
 }
 
+// 利用不可の時間帯などで、0を全教室として受け付ける
+func NewRoomIndexOrAll(index int) (RoomIndex, error) {
+
+	if index == ROOM_INDEX_ALL.Value() {
+		return ROOM_INDEX_ALL, nil
+	}
+
+	return NewRoomIndex(index)
+}
+
 func (r RoomIndex) Value() int {
 
 	return int(r)
@@ -2,6 +2,7 @@ package vo
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
@@ -12,6 +13,9 @@ var ErrRoomItemTagInvalid = errors.New("アイテムの種類が不正です")
 
 type RoomItemTag string
 
+// 校舎ごとに追加するアイテムの種類は英小文字で始まる英小文字・数字・アンダースコア(32文字以内)
+var customRoomItemTagPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
 const (
 	ROOM_ITEM_TAG_INVALID  = RoomItemTag("invalid")
 	ROOM_ITEM_TAG_LESSON   = RoomItemTag("lesson")
@@ -30,9 +34,15 @@ func NewRoomItemTag(itemTag string) (RoomItemTag, error) {
 		return ROOM_ITEM_TAG_LESSON, nil
 	case "cleaning":
 		return ROOM_ITEM_TAG_CLEANING, nil
-	default:
+	case ROOM_ITEM_TAG_INVALID.Value():
+		return ROOM_ITEM_TAG_INVALID, log.WrapErrorWithStackTrace(ErrRoomItemTagInvalid)
+	}
+
+	if !customRoomItemTagPattern.MatchString(itemTag) {
 		return ROOM_ITEM_TAG_INVALID, log.WrapErrorWithStackTrace(ErrRoomItemTagInvalid)
 	}
+
+	return RoomItemTag(itemTag), nil
 }
 
 func (r RoomItemTag) Value() string {
@@ -46,3 +56,8 @@ func (r RoomItemTag) IsLesson() bool {
 func (r RoomItemTag) IsCleaning() bool {
 	return r == ROOM_ITEM_TAG_CLEANING
 }
+
+// 校舎ごとに追加した種類(講座・清掃以外)
+func (r RoomItemTag) IsCustom() bool {
+	return r != ROOM_ITEM_TAG_LESSON && r != ROOM_ITEM_TAG_CLEANING && r != ROOM_ITEM_TAG_INVALID
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrRoomItemTitleLengthOver = errors.New("アイテムの名称に設定できる最大数を超えています")
+
+// 行事・試験などのアイテムに付ける任意の名称
+type RoomItemTitle string
+
+const (
+	ROOM_ITEM_TITLE_NONE    = RoomItemTitle("")
+	ROOM_ITEM_TITLE_INVALID = RoomItemTitle("invalid")
+)
+
+func NewRoomItemTitle(title string) (RoomItemTitle, error) {
+
+	title = strings.TrimSpace(title)
+
+	const NAME_MAX_LENGTH = 64
+	if utf8.RuneCountInString(title) > NAME_MAX_LENGTH {
+		return ROOM_ITEM_TITLE_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrRoomItemTitleLengthOver, NAME_MAX_LENGTH))
+	}
+
+	return RoomItemTitle(title), nil
+}
+
+func (r RoomItemTitle) Value() string {
+	return string(r)
+}
@@ -0,0 +1,33 @@
+package vo
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrRoomItemTypeColorInvalid = errors.New("アイテムの種類の表示色は#RRGGBB形式で指定してください")
+
+var roomItemTypeColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+type RoomItemTypeColor string
+
+const (
+	ROOM_ITEM_TYPE_COLOR_INVALID = RoomItemTypeColor("invalid")
+)
+
+func NewRoomItemTypeColor(color string) (RoomItemTypeColor, error) {
+
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !roomItemTypeColorPattern.MatchString(color) {
+		return ROOM_ITEM_TYPE_COLOR_INVALID, log.WrapErrorWithStackTrace(ErrRoomItemTypeColorInvalid)
+	}
+
+	return RoomItemTypeColor(color), nil
+}
+
+func (r RoomItemTypeColor) Value() string {
+	return string(r)
+}
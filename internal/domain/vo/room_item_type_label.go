@@ -0,0 +1,38 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrRoomItemTypeLabelEmpty = errors.New("アイテムの種類の表示名が未設定です")
+var ErrRoomItemTypeLabelLengthOver = errors.New("アイテムの種類の表示名に設定できる最大数を超えています")
+
+type RoomItemTypeLabel string
+
+const (
+	ROOM_ITEM_TYPE_LABEL_INVALID = RoomItemTypeLabel("invalid")
+)
+
+func NewRoomItemTypeLabel(label string) (RoomItemTypeLabel, error) {
+
+	label = strings.TrimSpace(label)
+	if len(label) == 0 {
+		return ROOM_ITEM_TYPE_LABEL_INVALID, log.WrapErrorWithStackTrace(ErrRoomItemTypeLabelEmpty)
+	}
+
+	const NAME_MAX_LENGTH = 32
+	if utf8.RuneCountInString(label) > NAME_MAX_LENGTH {
+		return ROOM_ITEM_TYPE_LABEL_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrRoomItemTypeLabelLengthOver, NAME_MAX_LENGTH))
+	}
+
+	return RoomItemTypeLabel(label), nil
+}
+
+func (r RoomItemTypeLabel) Value() string {
+	return string(r)
+}
@@ -7,7 +7,8 @@ import (
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
 
-var ErrScheduleLessonTimeInvalidHour = errors.New("時間は0時～23時で設定してください")
+var ErrScheduleLessonTimeInvalidHour = errors.New("時間は0時～24時で設定してください")
+var ErrScheduleLessonTimeInvalidEndOfDay = errors.New("24時を設定する場合は0分を設定してください")
 var ErrScheduleLessonTimeInvalidMinutes = errors.New("分は0分～59分で設定してください")
 var ErrScheduleLessonTimeMinUnderMinutes = errors.New("分は0以上である必要があります")
 var ErrScheduleLessonTimeMaxOverMinutes = errors.New("分に設定できる最大値を超えています")
@@ -19,7 +20,7 @@ type ScheduleLessonTime struct {
 
 func NewScheduleLessonTime(hour int, minutes int) (ScheduleLessonTime, error) {
 
-	if hour < 0 || hour > 23 {
+	if hour < 0 || hour > 24 {
 		return ScheduleLessonTime{}, log.WrapErrorWithStackTrace(ErrScheduleLessonTimeInvalidHour)
 	}
 
@@ -27,6 +28,11 @@ func NewScheduleLessonTime(hour int, minutes int) (ScheduleLessonTime, error) {
 		return ScheduleLessonTime{}, log.WrapErrorWithStackTrace(ErrScheduleLessonTimeInvalidMinutes)
 	}
 
+	// 1日の終わりとして24時0分まで設定できる
+	if hour == 24 && minutes != 0 {
+		return ScheduleLessonTime{}, log.WrapErrorWithStackTrace(ErrScheduleLessonTimeInvalidEndOfDay)
+	}
+
 	return ScheduleLessonTime{scheduleItemTimeHour: hour, scheduleItemTimeMinutes: minutes}, nil
 }
 
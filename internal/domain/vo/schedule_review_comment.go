@@ -0,0 +1,39 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleReviewCommentLengthOver = errors.New("差し戻し理由に設定できる最大数を超えています")
+
+type ScheduleReviewComment string
+
+const (
+	SCHEDULE_REVIEW_COMMENT_INVALID = ScheduleReviewComment("invalid")
+	SCHEDULE_REVIEW_COMMENT_NONE    = ScheduleReviewComment("")
+)
+
+func NewScheduleReviewComment(comment string) (ScheduleReviewComment, error) {
+
+	comment = strings.TrimSpace(comment)
+
+	const COMMENT_MAX_LENGTH = 256
+	if utf8.RuneCountInString(comment) > COMMENT_MAX_LENGTH {
+		return SCHEDULE_REVIEW_COMMENT_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d文字", ErrScheduleReviewCommentLengthOver, COMMENT_MAX_LENGTH))
+	}
+
+	return ScheduleReviewComment(comment), nil
+}
+
+func (r ScheduleReviewComment) Value() string {
+	return string(r)
+}
+
+func (r ScheduleReviewComment) IsNone() bool {
+	return r == SCHEDULE_REVIEW_COMMENT_NONE
+}
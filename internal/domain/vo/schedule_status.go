@@ -0,0 +1,65 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleStatusEmpty = errors.New("スケジュールのステータスが未設定です")
+var ErrScheduleStatusInvalid = errors.New("スケジュールのステータスが不正です")
+
+type ScheduleStatus string
+
+const (
+	SCHEDULE_STATUS_INVALID = ScheduleStatus("invalid")
+)
+
+const (
+	// 作成中
+	SCHEDULE_STATUS_DRAFT = ScheduleStatus("draft")
+	// レビュー待ち
+	SCHEDULE_STATUS_IN_REVIEW = ScheduleStatus("in_review")
+	// 公開済み
+	SCHEDULE_STATUS_PUBLISHED = ScheduleStatus("published")
+	// アーカイブ済み
+	SCHEDULE_STATUS_ARCHIVED = ScheduleStatus("archived")
+)
+
+func NewScheduleStatus(status string) (ScheduleStatus, error) {
+
+	status = strings.TrimSpace(status)
+	if len(status) == 0 {
+		return SCHEDULE_STATUS_INVALID, log.WrapErrorWithStackTrace(ErrScheduleStatusEmpty)
+	}
+
+	scheduleStatus := ScheduleStatus(status)
+
+	switch scheduleStatus {
+	case SCHEDULE_STATUS_DRAFT, SCHEDULE_STATUS_IN_REVIEW, SCHEDULE_STATUS_PUBLISHED, SCHEDULE_STATUS_ARCHIVED:
+		return scheduleStatus, nil
+	default:
+		return SCHEDULE_STATUS_INVALID, log.WrapErrorWithStackTrace(ErrScheduleStatusInvalid)
+	}
+}
+
+func (r ScheduleStatus) Value() string {
+	return string(r)
+}
+
+func (r ScheduleStatus) IsDraft() bool {
+	return r == SCHEDULE_STATUS_DRAFT
+}
+
+func (r ScheduleStatus) IsInReview() bool {
+	return r == SCHEDULE_STATUS_IN_REVIEW
+}
+
+func (r ScheduleStatus) IsPublished() bool {
+	return r == SCHEDULE_STATUS_PUBLISHED
+}
+
+func (r ScheduleStatus) IsArchived() bool {
+	return r == SCHEDULE_STATUS_ARCHIVED
+}
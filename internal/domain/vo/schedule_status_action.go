@@ -0,0 +1,50 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleStatusActionInvalid = errors.New("ステータスの操作が不正です")
+
+type ScheduleStatusAction string
+
+const (
+	SCHEDULE_STATUS_ACTION_INVALID = ScheduleStatusAction("invalid")
+	// レビュー依頼
+	SCHEDULE_STATUS_ACTION_SUBMIT = ScheduleStatusAction("submit")
+	// 承認して公開
+	SCHEDULE_STATUS_ACTION_APPROVE = ScheduleStatusAction("approve")
+	// 差し戻し
+	SCHEDULE_STATUS_ACTION_REJECT = ScheduleStatusAction("reject")
+	// レビューを経ずに公開
+	SCHEDULE_STATUS_ACTION_PUBLISH = ScheduleStatusAction("publish")
+	// アーカイブ
+	SCHEDULE_STATUS_ACTION_ARCHIVE = ScheduleStatusAction("archive")
+)
+
+func NewScheduleStatusAction(action string) (ScheduleStatusAction, error) {
+
+	statusAction := ScheduleStatusAction(strings.TrimSpace(action))
+
+	switch statusAction {
+	case SCHEDULE_STATUS_ACTION_SUBMIT,
+		SCHEDULE_STATUS_ACTION_APPROVE,
+		SCHEDULE_STATUS_ACTION_REJECT,
+		SCHEDULE_STATUS_ACTION_PUBLISH,
+		SCHEDULE_STATUS_ACTION_ARCHIVE:
+		return statusAction, nil
+	default:
+		return SCHEDULE_STATUS_ACTION_INVALID, log.WrapErrorWithStackTrace(ErrScheduleStatusActionInvalid)
+	}
+}
+
+func (r ScheduleStatusAction) Value() string {
+	return string(r)
+}
+
+func (r ScheduleStatusAction) IsSubmit() bool {
+	return r == SCHEDULE_STATUS_ACTION_SUBMIT
+}
@@ -0,0 +1,72 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleTargetDateInvalid = errors.New("実施日はYYYY-MM-DD形式で設定してください")
+
+// スケジュールの実施日。未設定の場合は曜日を問わないスケジュールとして扱う
+type ScheduleTargetDate struct {
+	date  time.Time
+	valid bool
+}
+
+const SCHEDULE_TARGET_DATE_LAYOUT = "2006-01-02"
+
+var SCHEDULE_TARGET_DATE_NONE = ScheduleTargetDate{}
+
+func NewScheduleTargetDate(date string) (ScheduleTargetDate, error) {
+
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return SCHEDULE_TARGET_DATE_NONE, nil
+	}
+
+	parsed, err := time.ParseInLocation(SCHEDULE_TARGET_DATE_LAYOUT, date, time.Local)
+	if err != nil {
+		return SCHEDULE_TARGET_DATE_NONE, log.WrapErrorWithStackTrace(fmt.Errorf("%w 指定:%s", ErrScheduleTargetDateInvalid, date))
+	}
+
+	return ScheduleTargetDate{date: parsed, valid: true}, nil
+}
+
+func NewScheduleTargetDateFromTime(date time.Time) ScheduleTargetDate {
+
+	return ScheduleTargetDate{
+		date:  time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local),
+		valid: true,
+	}
+}
+
+func (r ScheduleTargetDate) IsNone() bool {
+	return !r.valid
+}
+
+// 未設定の場合は空文字を返す
+func (r ScheduleTargetDate) Value() string {
+
+	if !r.valid {
+		return ""
+	}
+
+	return r.date.Format(SCHEDULE_TARGET_DATE_LAYOUT)
+}
+
+func (r ScheduleTargetDate) Time() (time.Time, bool) {
+	return r.date, r.valid
+}
+
+func (r ScheduleTargetDate) Weekday() (Weekday, bool) {
+
+	if !r.valid {
+		return WEEKDAY_INVALID, false
+	}
+
+	return NewWeekdayFromTime(r.date.Weekday()), true
+}
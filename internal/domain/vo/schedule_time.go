@@ -8,27 +8,39 @@ import (
 )
 
 var ErrScheduleTimeInvalidStartHour = errors.New("スケジュール開始時間は0時～23時で設定してください")
-var ErrScheduleTimeInvalidEndHour = errors.New("スケジュール終了時間は0時～23時で設定してください")
+var ErrScheduleTimeInvalidEndHour = errors.New("スケジュール終了時間は0時～24時で設定してください")
+var ErrScheduleTimeInvalidMinutes = errors.New("スケジュール時間の分は0分～59分で設定してください")
+var ErrScheduleTimeInvalidEndOfDay = errors.New("スケジュール終了時間を24時にする場合は0分を設定してください")
 var ErrScheduleTimeInvalidIntegrity = errors.New("スケジュール開始時間は終了時間前である必要があります")
 
 type ScheduleTime struct {
+	// 0時からの経過分
 	scheduleStartTime int
 	scheduleEndTime   int
 }
 
-func NewScheduleTime(startTime int, endTime int) (ScheduleTime, error) {
+func NewScheduleTime(startHour int, startMinutes int, endHour int, endMinutes int) (ScheduleTime, error) {
 
-	if startTime < 0 || startTime > 23 {
+	if startHour < 0 || startHour > 23 {
 		return ScheduleTime{}, log.WrapErrorWithStackTrace(ErrScheduleTimeInvalidStartHour)
 	}
 
-	if endTime < 0 || endTime > 23 {
+	if endHour < 0 || endHour > 24 {
 		return ScheduleTime{}, log.WrapErrorWithStackTrace(ErrScheduleTimeInvalidEndHour)
 	}
 
-	if startTime >= endTime {
-		return ScheduleTime{}, log.WrapErrorWithStackTrace(fmt.Errorf("%w 開始:%d時, 終了:%d時", ErrScheduleTimeInvalidIntegrity, startTime, endTime))
+	if startMinutes < 0 || startMinutes > 59 || endMinutes < 0 || endMinutes > 59 {
+		return ScheduleTime{}, log.WrapErrorWithStackTrace(ErrScheduleTimeInvalidMinutes)
+	}
 
+	if endHour == 24 && endMinutes != 0 {
+		return ScheduleTime{}, log.WrapErrorWithStackTrace(ErrScheduleTimeInvalidEndOfDay)
+	}
+
+	startTime := startHour*60 + startMinutes
+	endTime := endHour*60 + endMinutes
+	if startTime >= endTime {
+		return ScheduleTime{}, log.WrapErrorWithStackTrace(fmt.Errorf("%w 開始:%d時%d分, 終了:%d時%d分", ErrScheduleTimeInvalidIntegrity, startHour, startMinutes, endHour, endMinutes))
 	}
 
 	return ScheduleTime{scheduleStartTime: startTime, scheduleEndTime: endTime}, nil
@@ -36,15 +48,25 @@ func NewScheduleTime(startTime int, endTime int) (ScheduleTime, error) {
 
 func (r ScheduleTime) IsWithinTimeRange(time ScheduleLessonTime) bool {
 
-	hour, minutes := time.Value()
-	return r.scheduleStartTime <= time.scheduleItemTimeHour && (r.scheduleEndTime > hour || (r.scheduleEndTime == hour && minutes == 0))
+	return r.scheduleStartTime <= time.ValueMinutes() && time.ValueMinutes() <= r.scheduleEndTime
 }
 
-func (r ScheduleTime) Value() (int, int) {
+// 開始時刻の時と分を返す
+func (r ScheduleTime) StartTimeValue() (int, int) {
+
+	return r.scheduleStartTime / 60, r.scheduleStartTime % 60
+}
+
+// 終了時刻の時と分を返す。24時は(24, 0)を返す
+func (r ScheduleTime) EndTimeValue() (int, int) {
+
+	return r.scheduleEndTime / 60, r.scheduleEndTime % 60
+}
 
-	return r.scheduleStartTime, r.scheduleEndTime
+func (r ScheduleTime) StartTimeValueMinutes() int {
+	return r.scheduleStartTime
 }
 
 func (r ScheduleTime) EndTimeValueMinutes() int {
-	return r.scheduleEndTime * 60
+	return r.scheduleEndTime
 }
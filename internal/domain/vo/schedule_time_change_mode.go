@@ -0,0 +1,48 @@
+package vo
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrScheduleTimeChangeModeInvalid = errors.New("スケジュール時間変更モードが不正です")
+
+type ScheduleTimeChangeMode string
+
+const (
+	SCHEDULE_TIME_CHANGE_MODE_INVALID = ScheduleTimeChangeMode("invalid")
+	// 範囲外の講座がある場合は変更せず、履歴を初期化する
+	SCHEDULE_TIME_CHANGE_MODE_STRICT = ScheduleTimeChangeMode("strict")
+	// 開始時刻の差分だけ講座をずらす
+	SCHEDULE_TIME_CHANGE_MODE_TRANSLATE = ScheduleTimeChangeMode("translate")
+	// 時間の長さの比率で講座の開始位置を伸縮する
+	SCHEDULE_TIME_CHANGE_MODE_SCALE = ScheduleTimeChangeMode("scale")
+	// 範囲外となる講座をリストに戻す
+	SCHEDULE_TIME_CHANGE_MODE_RETURN_LIST = ScheduleTimeChangeMode("return_list")
+)
+
+func NewScheduleTimeChangeMode(mode string) (ScheduleTimeChangeMode, error) {
+
+	switch strings.TrimSpace(mode) {
+	case "", "strict":
+		return SCHEDULE_TIME_CHANGE_MODE_STRICT, nil
+	case "translate":
+		return SCHEDULE_TIME_CHANGE_MODE_TRANSLATE, nil
+	case "scale":
+		return SCHEDULE_TIME_CHANGE_MODE_SCALE, nil
+	case "return_list":
+		return SCHEDULE_TIME_CHANGE_MODE_RETURN_LIST, nil
+	default:
+		return SCHEDULE_TIME_CHANGE_MODE_INVALID, log.WrapErrorWithStackTrace(ErrScheduleTimeChangeModeInvalid)
+	}
+}
+
+func (r ScheduleTimeChangeMode) Value() string {
+	return string(r)
+}
+
+func (r ScheduleTimeChangeMode) IsStrict() bool {
+	return r == SCHEDULE_TIME_CHANGE_MODE_STRICT
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrSuggestionLimitUnderMin = errors.New("候補の件数は1件以上を設定する必要があります")
+var ErrSuggestionLimitOverMax = errors.New("候補の件数に設定できる件数を超えています")
+
+type SuggestionLimit int
+
+const (
+	SUGGESTION_LIMIT_INVALID = SuggestionLimit(-1)
+	SUGGESTION_LIMIT_DEFAULT = SuggestionLimit(20)
+)
+
+func NewSuggestionLimit(limit int) (SuggestionLimit, error) {
+
+	if limit < 1 {
+		return SUGGESTION_LIMIT_INVALID, log.WrapErrorWithStackTrace(ErrSuggestionLimitUnderMin)
+	}
+
+	const max_limit = 100
+	if limit > max_limit {
+		return SUGGESTION_LIMIT_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d件", ErrSuggestionLimitOverMax, max_limit))
+	}
+
+	return SuggestionLimit(limit), nil
+}
+
+func (r SuggestionLimit) Value() int {
+	return int(r)
+}
@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrSuggestionStepMinutesUnderMin = errors.New("候補の刻み時間は1分以上を設定する必要があります")
+var ErrSuggestionStepMinutesOverMax = errors.New("候補の刻み時間に設定できる時間を超えています")
+
+type SuggestionStepMinutes int
+
+const (
+	SUGGESTION_STEP_MINUTES_INVALID = SuggestionStepMinutes(-1)
+	SUGGESTION_STEP_MINUTES_DEFAULT = SuggestionStepMinutes(5)
+)
+
+func NewSuggestionStepMinutes(minutes int) (SuggestionStepMinutes, error) {
+
+	if minutes < 1 {
+		return SUGGESTION_STEP_MINUTES_INVALID, log.WrapErrorWithStackTrace(ErrSuggestionStepMinutesUnderMin)
+	}
+
+	const max_step_minutes = 60
+	if minutes > max_step_minutes {
+		return SUGGESTION_STEP_MINUTES_INVALID, log.WrapErrorWithStackTrace(fmt.Errorf("%w 最大:%d分", ErrSuggestionStepMinutesOverMax, max_step_minutes))
+	}
+
+	return SuggestionStepMinutes(minutes), nil
+}
+
+func (r SuggestionStepMinutes) Value() int {
+	return int(r)
+}
@@ -0,0 +1,49 @@
+package vo
+
+import (
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrUserStatusInvalid = errors.New("ユーザーの状態が不正です")
+
+type UserStatus string
+
+const (
+	USER_STATUS_INVALID = UserStatus("invalid")
+	// ログイン可能
+	USER_STATUS_ACTIVE = UserStatus("active")
+	// 一時的に無効化。復元できる
+	USER_STATUS_DEACTIVATED = UserStatus("deactivated")
+	// 削除済み
+	USER_STATUS_DELETED = UserStatus("deleted")
+)
+
+func NewUserStatus(status string) (UserStatus, error) {
+
+	userStatus := UserStatus(status)
+
+	switch userStatus {
+	case USER_STATUS_ACTIVE, USER_STATUS_DEACTIVATED, USER_STATUS_DELETED:
+		return userStatus, nil
+	default:
+		return USER_STATUS_INVALID, log.WrapErrorWithStackTrace(ErrUserStatusInvalid)
+	}
+}
+
+func (r UserStatus) Value() string {
+	return string(r)
+}
+
+func (r UserStatus) IsActive() bool {
+	return r == USER_STATUS_ACTIVE
+}
+
+func (r UserStatus) IsDeactivated() bool {
+	return r == USER_STATUS_DEACTIVATED
+}
+
+func (r UserStatus) IsDeleted() bool {
+	return r == USER_STATUS_DELETED
+}
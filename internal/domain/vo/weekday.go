@@ -0,0 +1,33 @@
+package vo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+var ErrWeekdayInvalid = errors.New("曜日は0(日曜)～6(土曜)で設定してください")
+
+type Weekday int
+
+const (
+	WEEKDAY_INVALID = Weekday(-1)
+)
+
+func NewWeekday(weekday int) (Weekday, error) {
+
+	if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+		return WEEKDAY_INVALID, log.WrapErrorWithStackTrace(ErrWeekdayInvalid)
+	}
+
+	return Weekday(weekday), nil
+}
+
+func NewWeekdayFromTime(weekday time.Weekday) Weekday {
+	return Weekday(weekday)
+}
+
+func (r Weekday) Value() int {
+	return int(r)
+}
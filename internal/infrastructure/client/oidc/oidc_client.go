@@ -0,0 +1,378 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/client"
+)
+
+// IDトークンの有効期限の検証で許容する時計のずれ
+const CLOCK_SKEW = time.Minute
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+type idTokenClaims struct {
+	Issuer        string          `json:"iss"`
+	Subject       string          `json:"sub"`
+	Audience      json.RawMessage `json:"aud"`
+	AuthorizedBy  string          `json:"azp"`
+	ExpiresAt     int64           `json:"exp"`
+	Nonce         string          `json:"nonce"`
+	Email         string          `json:"email"`
+	EmailVerified json.RawMessage `json:"email_verified"`
+	Name          string          `json:"name"`
+}
+
+// OpenID Connect の認可コードフローのクライアント
+// ディスカバリーと署名鍵は初回利用時に取得してキャッシュする
+type OIDCClient struct {
+	env        configs.EnvConfig
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+func NewOIDCClient(env configs.EnvConfig) client.OIDCClient {
+	return &OIDCClient{
+		env:        env,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		keys:       map[string]*rsa.PublicKey{},
+	}
+}
+
+func (c *OIDCClient) AuthorizationURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+
+	discovery, err := c.getDiscovery(ctx)
+	if err != nil {
+		return "", log.WrapErrorWithStackTrace(err)
+	}
+
+	authorizationURL, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	query := authorizationURL.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", c.env.OIDCClientID)
+	query.Set("redirect_uri", c.env.OIDCRedirectURL)
+	query.Set("scope", strings.Join(c.env.OIDCScopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationURL.RawQuery = query.Encode()
+
+	return authorizationURL.String(), nil
+}
+
+func (c *OIDCClient) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*client.OIDCClaims, error) {
+
+	discovery, err := c.getDiscovery(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.env.OIDCRedirectURL)
+	form.Set("client_id", c.env.OIDCClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	// 機密クライアントの場合はBasic認証でクライアントを認証する
+	if c.env.OIDCClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(c.env.OIDCClientID), url.QueryEscape(c.env.OIDCClientSecret))
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+	defer res.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	if res.StatusCode != http.StatusOK || token.Error != "" {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("認可コードをトークンと交換できません:%d %s %s", res.StatusCode, token.Error, token.ErrorDescription))
+	}
+
+	claims, err := c.verifyIDToken(ctx, discovery, token.IDToken, nonce)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return claims, nil
+}
+
+// IDトークンの署名とクレームを検証する。署名はRS256のみ受け付ける
+func (c *OIDCClient) verifyIDToken(ctx context.Context, discovery *discoveryDocument, rawIDToken string, nonce string) (*client.OIDCClaims, error) {
+
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの形式が不正です"))
+	}
+
+	var header idTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(err)
+	}
+
+	if header.Alg != "RS256" {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの署名方式に対応していません:%s", header.Alg))
+	}
+
+	key, err := c.getKey(ctx, discovery, header.Kid)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの署名が不正です:%v", err))
+	}
+
+	var claims idTokenClaims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(err)
+	}
+
+	if claims.Issuer != discovery.Issuer {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの発行者が不正です:%s", claims.Issuer))
+	}
+
+	audiences, err := parseAudience(claims.Audience)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(err)
+	}
+
+	if !slices.Contains(audiences, c.env.OIDCClientID) {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの対象者が不正です"))
+	}
+
+	if len(audiences) > 1 && claims.AuthorizedBy != c.env.OIDCClientID {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの認可先が不正です"))
+	}
+
+	if time.Now().After(time.Unix(claims.ExpiresAt, 0).Add(CLOCK_SKEW)) {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの有効期限が切れています"))
+	}
+
+	if claims.Nonce != nonce {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンのnonceが一致しません"))
+	}
+
+	if claims.Subject == "" {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンにsubjectがありません"))
+	}
+
+	return &client.OIDCClaims{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: parseBool(claims.EmailVerified),
+		Name:          claims.Name,
+	}, nil
+}
+
+func (c *OIDCClient) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	issuer := strings.TrimSuffix(c.env.OIDCIssuerURL, "/")
+
+	var discovery discoveryDocument
+	if err := c.getJSON(ctx, issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 設定した発行者と異なるドキュメントは信用しない
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ディスカバリーの発行者が設定と一致しません:%s", discovery.Issuer))
+	}
+
+	c.discovery = &discovery
+
+	return c.discovery, nil
+}
+
+// 未知の鍵IDの場合は鍵のローテーションを考慮して再取得する
+func (c *OIDCClient) getKey(ctx context.Context, discovery *discoveryDocument, kid string) (*rsa.PublicKey, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(ctx, discovery.JwksURI, &jwks); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range jwks.Keys {
+
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			continue
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	c.keys = keys
+
+	key, ok := c.keys[kid]
+	if !ok {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDトークンの署名鍵が見つかりません:%s", kid))
+	}
+
+	return key, nil
+}
+
+func (c *OIDCClient) getJSON(ctx context.Context, endpoint string, v any) error {
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return log.WrapErrorWithStackTraceInternalServerError(log.Errorf("IDプロバイダーへのリクエストに失敗しました:%s %d", endpoint, res.StatusCode))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := json.Unmarshal(decoded, v); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+// audは文字列または文字列の配列
+func parseAudience(raw json.RawMessage) ([]string, error) {
+
+	var audience string
+	if err := json.Unmarshal(raw, &audience); err == nil {
+		return []string{audience}, nil
+	}
+
+	var audiences []string
+	if err := json.Unmarshal(raw, &audiences); err != nil {
+		return nil, log.WrapErrorWithStackTrace(log.Errorf("IDトークンの対象者の形式が不正です"))
+	}
+
+	return audiences, nil
+}
+
+// email_verifiedを文字列で返すIDプロバイダーもあるため両方を受け付ける
+func parseBool(raw json.RawMessage) bool {
+
+	var value bool
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text == "true"
+	}
+
+	return false
+}
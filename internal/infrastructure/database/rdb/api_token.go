@@ -0,0 +1,168 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/apitoken"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type APIToken struct {
+	c *sql.DB
+}
+
+func NewAPITokenRepository(c IMySQL) repository.APITokenRepository {
+	return &APIToken{c: c.GetConn()}
+}
+
+func (f *APIToken) Save(ctx context.Context, tx *sql.Tx, model *apitoken.RootAPITokenModel) (vo.APITokenID, error) {
+
+	record := f.toDTO(model)
+
+	if model.ID().IsInitial() {
+
+		if err := record.Insert(ctx, tx, boil.Infer()); err != nil {
+			return vo.API_TOKEN_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+	} else {
+
+		// 発行後に変更できるのは最終利用日時のみ
+		_, err := record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLUserAPITokenColumns.LastUsedAt,
+		))
+		if err != nil {
+			return vo.API_TOKEN_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	tokenID, err := vo.NewAPITokenID(record.ID)
+	if err != nil {
+		return vo.API_TOKEN_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return tokenID, nil
+}
+
+func (f *APIToken) Delete(ctx context.Context, tx *sql.Tx, tokenID vo.APITokenID) error {
+
+	_, err := dto.TBLUserAPITokens(
+		dto.TBLUserAPITokenWhere.ID.EQ(tokenID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *APIToken) FindByID(ctx context.Context, tokenID vo.APITokenID) (*apitoken.RootAPITokenModel, error) {
+
+	return f.findOne(ctx, dto.TBLUserAPITokenWhere.ID.EQ(tokenID.Value()))
+}
+
+func (f *APIToken) FindByTokenHash(ctx context.Context, tokenHash string) (*apitoken.RootAPITokenModel, error) {
+
+	return f.findOne(ctx, dto.TBLUserAPITokenWhere.TokenHash.EQ(tokenHash))
+}
+
+func (f *APIToken) FindByUserID(ctx context.Context, userID vo.UserID) (apitoken.RootAPITokenModelSlice, error) {
+
+	records, err := dto.TBLUserAPITokens(
+		dto.TBLUserAPITokenWhere.UserID.EQ(userID.Value()),
+		qm.OrderBy(dto.TBLUserAPITokenColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make(apitoken.RootAPITokenModelSlice, 0, len(records))
+	for _, record := range records {
+
+		model, err := f.toModel(record)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func (f *APIToken) findOne(ctx context.Context, mods ...qm.QueryMod) (*apitoken.RootAPITokenModel, error) {
+
+	record, err := dto.TBLUserAPITokens(mods...).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	model, err := f.toModel(record)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return model, nil
+}
+
+func (f *APIToken) toModel(record *dto.TBLUserAPIToken) (*apitoken.RootAPITokenModel, error) {
+
+	id, err := vo.NewAPITokenID(record.ID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	userID, err := vo.NewUserID(record.UserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	name, err := vo.NewAPITokenName(record.TokenName)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	scope, err := vo.NewAPITokenScope(record.Scope)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return apitoken.NewRootAPITokenModel(
+		id,
+		userID,
+		name,
+		record.TokenPrefix,
+		record.TokenHash,
+		scope,
+		record.ExpiresAt,
+		record.LastUsedAt.Time,
+		record.CreatedAt,
+	), nil
+}
+
+func (f *APIToken) toDTO(model *apitoken.RootAPITokenModel) *dto.TBLUserAPIToken {
+
+	return &dto.TBLUserAPIToken{
+		ID:          model.ID().Value(),
+		UserID:      model.UserID().Value(),
+		TokenName:   model.Name().Value(),
+		TokenPrefix: model.TokenPrefix(),
+		TokenHash:   model.TokenHash(),
+		Scope:       model.Scope().Value(),
+		ExpiresAt:   model.ExpiresAt(),
+		LastUsedAt:  null.NewTime(model.LastUsedAt(), !model.LastUsedAt().IsZero()),
+		CreatedAt:   model.CreatedAt(),
+	}
+}
@@ -0,0 +1,202 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/changerequest"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type ChangeRequest struct {
+	c *sql.DB
+}
+
+func NewChangeRequestRepository(c IMySQL) repository.ChangeRequestRepository {
+	return &ChangeRequest{c: c.GetConn()}
+}
+
+// 提案された操作は作成時のみ登録し、以降はレビュー結果のみを更新する
+func (f *ChangeRequest) Save(ctx context.Context, tx *sql.Tx, model *changerequest.RootChangeRequestModel) (vo.ChangeRequestID, error) {
+
+	record := f.toDTO(model)
+
+	if model.ID().IsInitial() {
+
+		if err := record.Insert(ctx, tx, boil.Infer()); err != nil {
+			return vo.CHANGE_REQUEST_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+		for _, operation := range model.Operations() {
+
+			startTimeHour, startTimeMinutes := operation.StartTime().Value()
+
+			operationRecord := &dto.TBLScheduleChangeRequestOperation{
+				ChangeRequestID:  record.ID,
+				Operation:        operation.Operation().Value(),
+				Identifier:       operation.Identifier().Value(),
+				RoomIndex:        operation.RoomIndex().Value(),
+				StartTimeHour:    startTimeHour,
+				StartTimeMinutes: startTimeMinutes,
+			}
+
+			if err := operationRecord.Insert(ctx, tx, boil.Infer()); err != nil {
+				return vo.CHANGE_REQUEST_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+			}
+		}
+
+	} else {
+
+		_, err := record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLScheduleChangeRequestColumns.Status,
+			dto.TBLScheduleChangeRequestColumns.ReviewUserID,
+			dto.TBLScheduleChangeRequestColumns.ReviewComment,
+			dto.TBLScheduleChangeRequestColumns.AppliedHistoryIndex,
+			dto.TBLScheduleChangeRequestColumns.UpdatedAt,
+		))
+		if err != nil {
+			return vo.CHANGE_REQUEST_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	changeRequestID, err := vo.NewChangeRequestID(record.ID)
+	if err != nil {
+		return vo.CHANGE_REQUEST_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return changeRequestID, nil
+}
+
+func (f *ChangeRequest) FindByID(ctx context.Context, changeRequestID vo.ChangeRequestID) (*changerequest.RootChangeRequestModel, error) {
+
+	record, err := dto.TBLScheduleChangeRequests(
+		dto.TBLScheduleChangeRequestWhere.ID.EQ(changeRequestID.Value()),
+		qm.Load(dto.TBLScheduleChangeRequestRels.ChangeRequestTBLScheduleChangeRequestOperations, qm.OrderBy(dto.TBLScheduleChangeRequestOperationColumns.ID)),
+	).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	model, err := f.toModel(record)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return model, nil
+}
+
+func (f *ChangeRequest) FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (changerequest.RootChangeRequestModelSlice, error) {
+
+	records, err := dto.TBLScheduleChangeRequests(
+		dto.TBLScheduleChangeRequestWhere.ScheduleID.EQ(scheduleID.Value()),
+		qm.Load(dto.TBLScheduleChangeRequestRels.ChangeRequestTBLScheduleChangeRequestOperations, qm.OrderBy(dto.TBLScheduleChangeRequestOperationColumns.ID)),
+		qm.OrderBy(dto.TBLScheduleChangeRequestColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make(changerequest.RootChangeRequestModelSlice, 0, len(records))
+	for _, record := range records {
+
+		model, err := f.toModel(record)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func (f *ChangeRequest) toModel(record *dto.TBLScheduleChangeRequest) (*changerequest.RootChangeRequestModel, error) {
+
+	var id vo.ChangeRequestID
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var requestUserID vo.UserID
+	var comment vo.CommentBody
+	var status vo.ChangeRequestStatus
+	var reviewUserID vo.UserID
+	var reviewComment vo.ScheduleReviewComment
+	var appliedHistoryIndex vo.HistoryIndex
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&id, vo.NewChangeRequestID, record.ID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, record.ScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, record.HistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&requestUserID, vo.NewUserID, record.RequestUserID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&comment, vo.NewCommentBody, record.Comment))
+	errs = errors.Join(errs, vo.SetVOConstructor(&status, vo.NewChangeRequestStatus, record.Status))
+	errs = errors.Join(errs, vo.SetVOConstructor(&reviewUserID, vo.NewUserID, record.ReviewUserID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&reviewComment, vo.NewScheduleReviewComment, record.ReviewComment))
+	errs = errors.Join(errs, vo.SetVOConstructor(&appliedHistoryIndex, vo.NewHistoryIndex, record.AppliedHistoryIndex))
+
+	operations := schedule.ScheduleItemOperationModelSlice{}
+	if record.R != nil {
+		for _, operationRecord := range record.R.ChangeRequestTBLScheduleChangeRequestOperations {
+
+			var operation vo.ItemOperation
+			var identifier vo.Identifier
+			var roomIndex vo.RoomIndex
+
+			errs = errors.Join(errs, vo.SetVOConstructor(&operation, vo.NewItemOperation, operationRecord.Operation))
+			errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, operationRecord.Identifier))
+			errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndexOrAll, operationRecord.RoomIndex))
+
+			startTime, err := vo.NewScheduleLessonTime(operationRecord.StartTimeHour, operationRecord.StartTimeMinutes)
+			errs = errors.Join(errs, err)
+
+			operations = append(operations, schedule.NewScheduleItemOperationModel(operation, identifier, roomIndex, startTime))
+		}
+	}
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+	}
+
+	return changerequest.NewRootChangeRequestModel(
+		id,
+		scheduleID,
+		historyIndex,
+		requestUserID,
+		comment,
+		operations,
+		status,
+		reviewUserID,
+		reviewComment,
+		appliedHistoryIndex,
+		record.CreatedAt,
+		record.UpdatedAt,
+	), nil
+}
+
+func (f *ChangeRequest) toDTO(model *changerequest.RootChangeRequestModel) *dto.TBLScheduleChangeRequest {
+
+	return &dto.TBLScheduleChangeRequest{
+		ID:                  model.ID().Value(),
+		ScheduleID:          model.ScheduleID().Value(),
+		HistoryIndex:        model.HistoryIndex().Value(),
+		RequestUserID:       model.RequestUserID().Value(),
+		Comment:             model.Comment().Value(),
+		Status:              model.Status().Value(),
+		ReviewUserID:        model.ReviewUserID().Value(),
+		ReviewComment:       model.ReviewComment().Value(),
+		AppliedHistoryIndex: model.AppliedHistoryIndex().Value(),
+		CreatedAt:           model.CreatedAt(),
+		UpdatedAt:           model.UpdatedAt(),
+	}
+}
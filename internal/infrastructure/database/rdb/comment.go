@@ -0,0 +1,179 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/comment"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type Comment struct {
+	c *sql.DB
+}
+
+func NewCommentRepository(c IMySQL) repository.CommentRepository {
+	return &Comment{c: c.GetConn()}
+}
+
+func (f *Comment) Save(ctx context.Context, tx *sql.Tx, model *comment.RootCommentModel) (vo.CommentID, error) {
+
+	record := f.toDTO(model)
+
+	if model.ID().IsInitial() {
+
+		if err := record.Insert(ctx, tx, boil.Infer()); err != nil {
+			return vo.COMMENT_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+	} else {
+
+		_, err := record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLScheduleCommentColumns.Body,
+			dto.TBLScheduleCommentColumns.Resolved,
+			dto.TBLScheduleCommentColumns.UpdatedAt,
+		))
+		if err != nil {
+			return vo.COMMENT_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	commentID, err := vo.NewCommentID(record.ID)
+	if err != nil {
+		return vo.COMMENT_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return commentID, nil
+}
+
+// 起点のコメントを削除した場合は返信もまとめて削除する
+func (f *Comment) Delete(ctx context.Context, tx *sql.Tx, commentID vo.CommentID) error {
+
+	_, err := dto.TBLScheduleComments(
+		dto.TBLScheduleCommentWhere.ParentID.EQ(commentID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.TBLScheduleComments(
+		dto.TBLScheduleCommentWhere.ID.EQ(commentID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *Comment) FindByID(ctx context.Context, commentID vo.CommentID) (*comment.RootCommentModel, error) {
+
+	record, err := dto.TBLScheduleComments(
+		dto.TBLScheduleCommentWhere.ID.EQ(commentID.Value()),
+	).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	model, err := f.toModel(record)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return model, nil
+}
+
+func (f *Comment) FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (comment.RootCommentModelSlice, error) {
+
+	records, err := dto.TBLScheduleComments(
+		dto.TBLScheduleCommentWhere.ScheduleID.EQ(scheduleID.Value()),
+		qm.OrderBy(dto.TBLScheduleCommentColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make(comment.RootCommentModelSlice, 0, len(records))
+	for _, record := range records {
+
+		model, err := f.toModel(record)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func (f *Comment) toModel(record *dto.TBLScheduleComment) (*comment.RootCommentModel, error) {
+
+	id, err := vo.NewCommentID(record.ID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	scheduleID, err := vo.NewScheduleID(record.ScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	identifier, err := vo.NewIdentifierOrInitial(record.Identifier)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	parentID, err := vo.NewCommentID(record.ParentID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	authorUserID, err := vo.NewUserID(record.AuthorUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	body, err := vo.NewCommentBody(record.Body)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return comment.NewRootCommentModel(
+		id,
+		scheduleID,
+		identifier,
+		parentID,
+		authorUserID,
+		body,
+		record.Resolved == RESOLVED,
+		record.CreatedAt,
+		record.UpdatedAt,
+	), nil
+}
+
+func (f *Comment) toDTO(model *comment.RootCommentModel) *dto.TBLScheduleComment {
+
+	return &dto.TBLScheduleComment{
+		ID:           model.ID().Value(),
+		ScheduleID:   model.ScheduleID().Value(),
+		Identifier:   model.Identifier().Value(),
+		ParentID:     model.ParentID().Value(),
+		AuthorUserID: model.AuthorUserID().Value(),
+		Body:         model.Body().Value(),
+		Resolved:     lo.Ternary(model.IsResolved(), RESOLVED, UN_RESOLVED),
+		CreatedAt:    model.CreatedAt(),
+		UpdatedAt:    model.UpdatedAt(),
+	}
+}
@@ -3,3 +3,10 @@ package rdb
 const ID_INITIAL = 0
 const ACTIVE = 0
 const IN_ACTIVE = 1
+const DEACTIVATED = 2
+const UN_PINNED = 0
+const PINNED = 1
+const UN_RESOLVED = 0
+const RESOLVED = 1
+const UN_REFUSE_COLLISION = 0
+const REFUSE_COLLISION = 1
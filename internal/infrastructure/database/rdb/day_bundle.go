@@ -0,0 +1,209 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type DayBundle struct {
+	c *sql.DB
+}
+
+func NewDayBundleRepository(c IMySQL) repository.DayBundleRepository {
+	return &DayBundle{c: c.GetConn()}
+}
+
+func (f *DayBundle) Save(ctx context.Context, tx *sql.Tx, model *bundle.RootDayBundleModel) (vo.DayBundleID, error) {
+
+	record := f.toDTO(model)
+
+	if model.ID().IsInitial() {
+
+		if err := record.Insert(ctx, tx, boil.Infer()); err != nil {
+			return vo.DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+	} else {
+
+		_, err := record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLScheduleDayBundleColumns.Title,
+			dto.TBLScheduleDayBundleColumns.RefuseCollision,
+		))
+		if err != nil {
+			return vo.DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	// 所属するスケジュールは入れ替える
+	_, err := dto.TBLScheduleDayBundleMembers(
+		dto.TBLScheduleDayBundleMemberWhere.BundleID.EQ(record.ID),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return vo.DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, scheduleID := range model.ScheduleIDs() {
+
+		member := &dto.TBLScheduleDayBundleMember{
+			BundleID:   record.ID,
+			ScheduleID: scheduleID.Value(),
+		}
+
+		if err := member.Insert(ctx, tx, boil.Infer()); err != nil {
+			return vo.DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	dayBundleID, err := vo.NewDayBundleID(record.ID)
+	if err != nil {
+		return vo.DAY_BUNDLE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return dayBundleID, nil
+}
+
+func (f *DayBundle) Delete(ctx context.Context, tx *sql.Tx, dayBundleID vo.DayBundleID) error {
+
+	_, err := dto.TBLScheduleDayBundleMembers(
+		dto.TBLScheduleDayBundleMemberWhere.BundleID.EQ(dayBundleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.TBLScheduleDayBundles(
+		dto.TBLScheduleDayBundleWhere.ID.EQ(dayBundleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *DayBundle) FindByID(ctx context.Context, dayBundleID vo.DayBundleID) (*bundle.RootDayBundleModel, error) {
+
+	record, err := dto.TBLScheduleDayBundles(
+		dto.TBLScheduleDayBundleWhere.ID.EQ(dayBundleID.Value()),
+		qm.Load(dto.TBLScheduleDayBundleRels.BundleTBLScheduleDayBundleMembers, qm.OrderBy(dto.TBLScheduleDayBundleMemberColumns.ID)),
+	).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	model, err := f.toModel(record)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return model, nil
+}
+
+func (f *DayBundle) FindByCampus(ctx context.Context, campus vo.Campus) (bundle.RootDayBundleModelSlice, error) {
+
+	records, err := dto.TBLScheduleDayBundles(
+		dto.TBLScheduleDayBundleWhere.Campus.EQ(campus.Value()),
+		qm.Load(dto.TBLScheduleDayBundleRels.BundleTBLScheduleDayBundleMembers, qm.OrderBy(dto.TBLScheduleDayBundleMemberColumns.ID)),
+		qm.OrderBy(dto.TBLScheduleDayBundleColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make(bundle.RootDayBundleModelSlice, 0, len(records))
+	for _, record := range records {
+
+		model, err := f.toModel(record)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func (f *DayBundle) FindByScheduleID(ctx context.Context, scheduleID vo.ScheduleID) (*bundle.RootDayBundleModel, error) {
+
+	member, err := dto.TBLScheduleDayBundleMembers(
+		dto.TBLScheduleDayBundleMemberWhere.ScheduleID.EQ(scheduleID.Value()),
+	).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	dayBundleID, err := vo.NewDayBundleID(member.BundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return f.FindByID(ctx, dayBundleID)
+}
+
+func (f *DayBundle) toModel(record *dto.TBLScheduleDayBundle) (*bundle.RootDayBundleModel, error) {
+
+	var id vo.DayBundleID
+	var campus vo.Campus
+	var title vo.DayBundleTitle
+	var createUser vo.UserID
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&id, vo.NewDayBundleID, record.ID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, record.Campus))
+	errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewDayBundleTitle, record.Title))
+	errs = errors.Join(errs, vo.SetVOConstructor(&createUser, vo.NewUserID, record.CreateUser))
+
+	scheduleIDs := []vo.ScheduleID{}
+	if record.R != nil {
+		for _, member := range record.R.BundleTBLScheduleDayBundleMembers {
+
+			var scheduleID vo.ScheduleID
+			errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, member.ScheduleID))
+			scheduleIDs = append(scheduleIDs, scheduleID)
+		}
+	}
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+	}
+
+	return bundle.NewRootDayBundleModel(
+		id,
+		campus,
+		title,
+		scheduleIDs,
+		record.RefuseCollision == REFUSE_COLLISION,
+		createUser,
+	), nil
+}
+
+func (f *DayBundle) toDTO(model *bundle.RootDayBundleModel) *dto.TBLScheduleDayBundle {
+
+	return &dto.TBLScheduleDayBundle{
+		ID:              model.ID().Value(),
+		Campus:          model.Campus().Value(),
+		Title:           model.Title().Value(),
+		RefuseCollision: lo.Ternary(model.RefuseCollision(), REFUSE_COLLISION, UN_REFUSE_COLLISION),
+		CreateUser:      model.CreateUser().Value(),
+	}
+}
@@ -4,25 +4,67 @@
 package dto
 
 var TableNames = struct {
-	DataCampuses              string
-	DataLessons               string
-	DataRoles                 string
-	DataRooms                 string
-	SysSessions               string
-	TBLScheduleInvisibleRooms string
-	TBLScheduleItems          string
-	TBLScheduleRoomItems      string
-	TBLSchedules              string
-	TBLUsers                  string
+	DataCampusBlockedPeriods           string
+	DataCampusOpeningHours             string
+	DataCampusRoomItemTypes            string
+	DataCampusSchedulingPolicies       string
+	DataCampuses                       string
+	DataLessons                        string
+	DataPermissions                    string
+	DataRolePermissions                string
+	DataRoles                          string
+	DataRooms                          string
+	SysSessions                        string
+	TBLLoginAttempts                   string
+	TBLLoginThrottles                  string
+	TBLScheduleChangeRequestOperations string
+	TBLScheduleChangeRequests          string
+	TBLScheduleCollaborators           string
+	TBLScheduleComments                string
+	TBLScheduleDayBundleMembers        string
+	TBLScheduleDayBundles              string
+	TBLScheduleHistories               string
+	TBLScheduleInvisibleRooms          string
+	TBLScheduleItemGroups              string
+	TBLScheduleItems                   string
+	TBLScheduleRoomItems               string
+	TBLSchedules                       string
+	TBLUserAPITokens                   string
+	TBLUserCampusRoles                 string
+	TBLUserIdentities                  string
+	TBLUserRecoveryCodes               string
+	TBLUserTwoFactors                  string
+	TBLUsers                           string
 }{
-	DataCampuses:              "data_campuses",
-	DataLessons:               "data_lessons",
-	DataRoles:                 "data_roles",
-	DataRooms:                 "data_rooms",
-	SysSessions:               "sys_sessions",
-	TBLScheduleInvisibleRooms: "tbl_schedule_invisible_rooms",
-	TBLScheduleItems:          "tbl_schedule_items",
-	TBLScheduleRoomItems:      "tbl_schedule_room_items",
-	TBLSchedules:              "tbl_schedules",
-	TBLUsers:                  "tbl_users",
+	DataCampusBlockedPeriods:           "data_campus_blocked_periods",
+	DataCampusOpeningHours:             "data_campus_opening_hours",
+	DataCampusRoomItemTypes:            "data_campus_room_item_types",
+	DataCampusSchedulingPolicies:       "data_campus_scheduling_policies",
+	DataCampuses:                       "data_campuses",
+	DataLessons:                        "data_lessons",
+	DataPermissions:                    "data_permissions",
+	DataRolePermissions:                "data_role_permissions",
+	DataRoles:                          "data_roles",
+	DataRooms:                          "data_rooms",
+	SysSessions:                        "sys_sessions",
+	TBLLoginAttempts:                   "tbl_login_attempts",
+	TBLLoginThrottles:                  "tbl_login_throttles",
+	TBLScheduleChangeRequestOperations: "tbl_schedule_change_request_operations",
+	TBLScheduleChangeRequests:          "tbl_schedule_change_requests",
+	TBLScheduleCollaborators:           "tbl_schedule_collaborators",
+	TBLScheduleComments:                "tbl_schedule_comments",
+	TBLScheduleDayBundleMembers:        "tbl_schedule_day_bundle_members",
+	TBLScheduleDayBundles:              "tbl_schedule_day_bundles",
+	TBLScheduleHistories:               "tbl_schedule_histories",
+	TBLScheduleInvisibleRooms:          "tbl_schedule_invisible_rooms",
+	TBLScheduleItemGroups:              "tbl_schedule_item_groups",
+	TBLScheduleItems:                   "tbl_schedule_items",
+	TBLScheduleRoomItems:               "tbl_schedule_room_items",
+	TBLSchedules:                       "tbl_schedules",
+	TBLUserAPITokens:                   "tbl_user_api_tokens",
+	TBLUserCampusRoles:                 "tbl_user_campus_roles",
+	TBLUserIdentities:                  "tbl_user_identities",
+	TBLUserRecoveryCodes:               "tbl_user_recovery_codes",
+	TBLUserTwoFactors:                  "tbl_user_two_factors",
+	TBLUsers:                           "tbl_users",
 }
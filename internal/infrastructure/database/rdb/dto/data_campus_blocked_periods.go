@@ -0,0 +1,1386 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataCampusBlockedPeriod is an object representing the database table.
+type DataCampusBlockedPeriod struct {
+	ID               int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus           string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	Title            string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	RoomIndex        int       `boil:"room_index" json:"room_index" toml:"room_index" yaml:"room_index"`
+	Recurrence       string    `boil:"recurrence" json:"recurrence" toml:"recurrence" yaml:"recurrence"`
+	Weekday          null.Int  `boil:"weekday" json:"weekday,omitempty" toml:"weekday" yaml:"weekday,omitempty"`
+	TargetDate       null.Time `boil:"target_date" json:"target_date,omitempty" toml:"target_date" yaml:"target_date,omitempty"`
+	StartTimeHour    int       `boil:"start_time_hour" json:"start_time_hour" toml:"start_time_hour" yaml:"start_time_hour"`
+	StartTimeMinutes int       `boil:"start_time_minutes" json:"start_time_minutes" toml:"start_time_minutes" yaml:"start_time_minutes"`
+	EndTimeHour      int       `boil:"end_time_hour" json:"end_time_hour" toml:"end_time_hour" yaml:"end_time_hour"`
+	EndTimeMinutes   int       `boil:"end_time_minutes" json:"end_time_minutes" toml:"end_time_minutes" yaml:"end_time_minutes"`
+	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataCampusBlockedPeriodR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataCampusBlockedPeriodL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataCampusBlockedPeriodColumns = struct {
+	ID               string
+	Campus           string
+	Title            string
+	RoomIndex        string
+	Recurrence       string
+	Weekday          string
+	TargetDate       string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "id",
+	Campus:           "campus",
+	Title:            "title",
+	RoomIndex:        "room_index",
+	Recurrence:       "recurrence",
+	Weekday:          "weekday",
+	TargetDate:       "target_date",
+	StartTimeHour:    "start_time_hour",
+	StartTimeMinutes: "start_time_minutes",
+	EndTimeHour:      "end_time_hour",
+	EndTimeMinutes:   "end_time_minutes",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var DataCampusBlockedPeriodTableColumns = struct {
+	ID               string
+	Campus           string
+	Title            string
+	RoomIndex        string
+	Recurrence       string
+	Weekday          string
+	TargetDate       string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "data_campus_blocked_periods.id",
+	Campus:           "data_campus_blocked_periods.campus",
+	Title:            "data_campus_blocked_periods.title",
+	RoomIndex:        "data_campus_blocked_periods.room_index",
+	Recurrence:       "data_campus_blocked_periods.recurrence",
+	Weekday:          "data_campus_blocked_periods.weekday",
+	TargetDate:       "data_campus_blocked_periods.target_date",
+	StartTimeHour:    "data_campus_blocked_periods.start_time_hour",
+	StartTimeMinutes: "data_campus_blocked_periods.start_time_minutes",
+	EndTimeHour:      "data_campus_blocked_periods.end_time_hour",
+	EndTimeMinutes:   "data_campus_blocked_periods.end_time_minutes",
+	CreatedAt:        "data_campus_blocked_periods.created_at",
+	UpdatedAt:        "data_campus_blocked_periods.updated_at",
+}
+
+// Generated where
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperstring struct{ field string }
+
+func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
+func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
+func (w whereHelperstring) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var DataCampusBlockedPeriodWhere = struct {
+	ID               whereHelperint
+	Campus           whereHelperstring
+	Title            whereHelperstring
+	RoomIndex        whereHelperint
+	Recurrence       whereHelperstring
+	Weekday          whereHelpernull_Int
+	TargetDate       whereHelpernull_Time
+	StartTimeHour    whereHelperint
+	StartTimeMinutes whereHelperint
+	EndTimeHour      whereHelperint
+	EndTimeMinutes   whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+}{
+	ID:               whereHelperint{field: "`data_campus_blocked_periods`.`id`"},
+	Campus:           whereHelperstring{field: "`data_campus_blocked_periods`.`campus`"},
+	Title:            whereHelperstring{field: "`data_campus_blocked_periods`.`title`"},
+	RoomIndex:        whereHelperint{field: "`data_campus_blocked_periods`.`room_index`"},
+	Recurrence:       whereHelperstring{field: "`data_campus_blocked_periods`.`recurrence`"},
+	Weekday:          whereHelpernull_Int{field: "`data_campus_blocked_periods`.`weekday`"},
+	TargetDate:       whereHelpernull_Time{field: "`data_campus_blocked_periods`.`target_date`"},
+	StartTimeHour:    whereHelperint{field: "`data_campus_blocked_periods`.`start_time_hour`"},
+	StartTimeMinutes: whereHelperint{field: "`data_campus_blocked_periods`.`start_time_minutes`"},
+	EndTimeHour:      whereHelperint{field: "`data_campus_blocked_periods`.`end_time_hour`"},
+	EndTimeMinutes:   whereHelperint{field: "`data_campus_blocked_periods`.`end_time_minutes`"},
+	CreatedAt:        whereHelpertime_Time{field: "`data_campus_blocked_periods`.`created_at`"},
+	UpdatedAt:        whereHelpertime_Time{field: "`data_campus_blocked_periods`.`updated_at`"},
+}
+
+// DataCampusBlockedPeriodRels is where relationship names are stored.
+var DataCampusBlockedPeriodRels = struct {
+	CampusDataCampuse string
+}{
+	CampusDataCampuse: "CampusDataCampuse",
+}
+
+// dataCampusBlockedPeriodR is where relationships are stored.
+type dataCampusBlockedPeriodR struct {
+	CampusDataCampuse *DataCampuse `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataCampusBlockedPeriodR) NewStruct() *dataCampusBlockedPeriodR {
+	return &dataCampusBlockedPeriodR{}
+}
+
+func (o *DataCampusBlockedPeriod) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *dataCampusBlockedPeriodR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+// dataCampusBlockedPeriodL is where Load methods for each relationship are stored.
+type dataCampusBlockedPeriodL struct{}
+
+var (
+	dataCampusBlockedPeriodAllColumns            = []string{"id", "campus", "title", "room_index", "recurrence", "weekday", "target_date", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "created_at", "updated_at"}
+	dataCampusBlockedPeriodColumnsWithoutDefault = []string{"campus", "title", "weekday", "target_date", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes"}
+	dataCampusBlockedPeriodColumnsWithDefault    = []string{"id", "room_index", "recurrence", "created_at", "updated_at"}
+	dataCampusBlockedPeriodPrimaryKeyColumns     = []string{"id"}
+	dataCampusBlockedPeriodGeneratedColumns      = []string{}
+)
+
+type (
+	// DataCampusBlockedPeriodSlice is an alias for a slice of pointers to DataCampusBlockedPeriod.
+	// This should almost always be used instead of []DataCampusBlockedPeriod.
+	DataCampusBlockedPeriodSlice []*DataCampusBlockedPeriod
+	// DataCampusBlockedPeriodHook is the signature for custom DataCampusBlockedPeriod hook methods
+	DataCampusBlockedPeriodHook func(context.Context, boil.ContextExecutor, *DataCampusBlockedPeriod) error
+
+	dataCampusBlockedPeriodQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataCampusBlockedPeriodType                 = reflect.TypeOf(&DataCampusBlockedPeriod{})
+	dataCampusBlockedPeriodMapping              = queries.MakeStructMapping(dataCampusBlockedPeriodType)
+	dataCampusBlockedPeriodPrimaryKeyMapping, _ = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, dataCampusBlockedPeriodPrimaryKeyColumns)
+	dataCampusBlockedPeriodInsertCacheMut       sync.RWMutex
+	dataCampusBlockedPeriodInsertCache          = make(map[string]insertCache)
+	dataCampusBlockedPeriodUpdateCacheMut       sync.RWMutex
+	dataCampusBlockedPeriodUpdateCache          = make(map[string]updateCache)
+	dataCampusBlockedPeriodUpsertCacheMut       sync.RWMutex
+	dataCampusBlockedPeriodUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataCampusBlockedPeriodAfterSelectMu sync.Mutex
+var dataCampusBlockedPeriodAfterSelectHooks []DataCampusBlockedPeriodHook
+
+var dataCampusBlockedPeriodBeforeInsertMu sync.Mutex
+var dataCampusBlockedPeriodBeforeInsertHooks []DataCampusBlockedPeriodHook
+var dataCampusBlockedPeriodAfterInsertMu sync.Mutex
+var dataCampusBlockedPeriodAfterInsertHooks []DataCampusBlockedPeriodHook
+
+var dataCampusBlockedPeriodBeforeUpdateMu sync.Mutex
+var dataCampusBlockedPeriodBeforeUpdateHooks []DataCampusBlockedPeriodHook
+var dataCampusBlockedPeriodAfterUpdateMu sync.Mutex
+var dataCampusBlockedPeriodAfterUpdateHooks []DataCampusBlockedPeriodHook
+
+var dataCampusBlockedPeriodBeforeDeleteMu sync.Mutex
+var dataCampusBlockedPeriodBeforeDeleteHooks []DataCampusBlockedPeriodHook
+var dataCampusBlockedPeriodAfterDeleteMu sync.Mutex
+var dataCampusBlockedPeriodAfterDeleteHooks []DataCampusBlockedPeriodHook
+
+var dataCampusBlockedPeriodBeforeUpsertMu sync.Mutex
+var dataCampusBlockedPeriodBeforeUpsertHooks []DataCampusBlockedPeriodHook
+var dataCampusBlockedPeriodAfterUpsertMu sync.Mutex
+var dataCampusBlockedPeriodAfterUpsertHooks []DataCampusBlockedPeriodHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataCampusBlockedPeriod) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataCampusBlockedPeriod) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataCampusBlockedPeriod) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataCampusBlockedPeriod) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataCampusBlockedPeriod) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataCampusBlockedPeriod) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataCampusBlockedPeriod) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataCampusBlockedPeriod) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataCampusBlockedPeriod) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusBlockedPeriodAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataCampusBlockedPeriodHook registers your hook function for all future operations.
+func AddDataCampusBlockedPeriodHook(hookPoint boil.HookPoint, dataCampusBlockedPeriodHook DataCampusBlockedPeriodHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataCampusBlockedPeriodAfterSelectMu.Lock()
+		dataCampusBlockedPeriodAfterSelectHooks = append(dataCampusBlockedPeriodAfterSelectHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataCampusBlockedPeriodBeforeInsertMu.Lock()
+		dataCampusBlockedPeriodBeforeInsertHooks = append(dataCampusBlockedPeriodBeforeInsertHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataCampusBlockedPeriodAfterInsertMu.Lock()
+		dataCampusBlockedPeriodAfterInsertHooks = append(dataCampusBlockedPeriodAfterInsertHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataCampusBlockedPeriodBeforeUpdateMu.Lock()
+		dataCampusBlockedPeriodBeforeUpdateHooks = append(dataCampusBlockedPeriodBeforeUpdateHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataCampusBlockedPeriodAfterUpdateMu.Lock()
+		dataCampusBlockedPeriodAfterUpdateHooks = append(dataCampusBlockedPeriodAfterUpdateHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataCampusBlockedPeriodBeforeDeleteMu.Lock()
+		dataCampusBlockedPeriodBeforeDeleteHooks = append(dataCampusBlockedPeriodBeforeDeleteHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataCampusBlockedPeriodAfterDeleteMu.Lock()
+		dataCampusBlockedPeriodAfterDeleteHooks = append(dataCampusBlockedPeriodAfterDeleteHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataCampusBlockedPeriodBeforeUpsertMu.Lock()
+		dataCampusBlockedPeriodBeforeUpsertHooks = append(dataCampusBlockedPeriodBeforeUpsertHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataCampusBlockedPeriodAfterUpsertMu.Lock()
+		dataCampusBlockedPeriodAfterUpsertHooks = append(dataCampusBlockedPeriodAfterUpsertHooks, dataCampusBlockedPeriodHook)
+		dataCampusBlockedPeriodAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataCampusBlockedPeriod record from the query.
+func (q dataCampusBlockedPeriodQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataCampusBlockedPeriod, error) {
+	o := &DataCampusBlockedPeriod{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_campus_blocked_periods")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataCampusBlockedPeriod records from the query.
+func (q dataCampusBlockedPeriodQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataCampusBlockedPeriodSlice, error) {
+	var o []*DataCampusBlockedPeriod
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataCampusBlockedPeriod slice")
+	}
+
+	if len(dataCampusBlockedPeriodAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataCampusBlockedPeriod records in the query.
+func (q dataCampusBlockedPeriodQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_campus_blocked_periods rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataCampusBlockedPeriodQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_campus_blocked_periods exists")
+	}
+
+	return count > 0, nil
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *DataCampusBlockedPeriod) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataCampusBlockedPeriodL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampusBlockedPeriod interface{}, mods queries.Applicator) error {
+	var slice []*DataCampusBlockedPeriod
+	var object *DataCampusBlockedPeriod
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampusBlockedPeriod.(*DataCampusBlockedPeriod)
+		if !ok {
+			object = new(DataCampusBlockedPeriod)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampusBlockedPeriod)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampusBlockedPeriod))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampusBlockedPeriod.(*[]*DataCampusBlockedPeriod)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampusBlockedPeriod)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampusBlockedPeriod))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampusBlockedPeriodR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampusBlockedPeriodR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusDataCampusBlockedPeriods = append(foreign.R.CampusDataCampusBlockedPeriods, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusDataCampusBlockedPeriods = append(foreign.R.CampusDataCampusBlockedPeriods, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the dataCampusBlockedPeriod to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusDataCampusBlockedPeriods.
+func (o *DataCampusBlockedPeriod) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_campus_blocked_periods` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, dataCampusBlockedPeriodPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &dataCampusBlockedPeriodR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusDataCampusBlockedPeriods: DataCampusBlockedPeriodSlice{o},
+		}
+	} else {
+		related.R.CampusDataCampusBlockedPeriods = append(related.R.CampusDataCampusBlockedPeriods, o)
+	}
+
+	return nil
+}
+
+// DataCampusBlockedPeriods retrieves all the records using an executor.
+func DataCampusBlockedPeriods(mods ...qm.QueryMod) dataCampusBlockedPeriodQuery {
+	mods = append(mods, qm.From("`data_campus_blocked_periods`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_campus_blocked_periods`.*"})
+	}
+
+	return dataCampusBlockedPeriodQuery{q}
+}
+
+// FindDataCampusBlockedPeriod retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataCampusBlockedPeriod(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataCampusBlockedPeriod, error) {
+	dataCampusBlockedPeriodObj := &DataCampusBlockedPeriod{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_campus_blocked_periods` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataCampusBlockedPeriodObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_campus_blocked_periods")
+	}
+
+	if err = dataCampusBlockedPeriodObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataCampusBlockedPeriodObj, err
+	}
+
+	return dataCampusBlockedPeriodObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataCampusBlockedPeriod) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_blocked_periods provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusBlockedPeriodColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataCampusBlockedPeriodInsertCacheMut.RLock()
+	cache, cached := dataCampusBlockedPeriodInsertCache[key]
+	dataCampusBlockedPeriodInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataCampusBlockedPeriodAllColumns,
+			dataCampusBlockedPeriodColumnsWithDefault,
+			dataCampusBlockedPeriodColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_campus_blocked_periods` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_campus_blocked_periods` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_campus_blocked_periods` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataCampusBlockedPeriodPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_campus_blocked_periods")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusBlockedPeriodMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_blocked_periods")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusBlockedPeriodInsertCacheMut.Lock()
+		dataCampusBlockedPeriodInsertCache[key] = cache
+		dataCampusBlockedPeriodInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataCampusBlockedPeriod.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataCampusBlockedPeriod) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataCampusBlockedPeriodUpdateCacheMut.RLock()
+	cache, cached := dataCampusBlockedPeriodUpdateCache[key]
+	dataCampusBlockedPeriodUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataCampusBlockedPeriodAllColumns,
+			dataCampusBlockedPeriodPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_campus_blocked_periods, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_campus_blocked_periods` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataCampusBlockedPeriodPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, append(wl, dataCampusBlockedPeriodPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_campus_blocked_periods row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_campus_blocked_periods")
+	}
+
+	if !cached {
+		dataCampusBlockedPeriodUpdateCacheMut.Lock()
+		dataCampusBlockedPeriodUpdateCache[key] = cache
+		dataCampusBlockedPeriodUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataCampusBlockedPeriodQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_campus_blocked_periods")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_campus_blocked_periods")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataCampusBlockedPeriodSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusBlockedPeriodPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_campus_blocked_periods` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusBlockedPeriodPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataCampusBlockedPeriod slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataCampusBlockedPeriod")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataCampusBlockedPeriodUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataCampusBlockedPeriod) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_blocked_periods provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusBlockedPeriodColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataCampusBlockedPeriodUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataCampusBlockedPeriodUpsertCacheMut.RLock()
+	cache, cached := dataCampusBlockedPeriodUpsertCache[key]
+	dataCampusBlockedPeriodUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataCampusBlockedPeriodAllColumns,
+			dataCampusBlockedPeriodColumnsWithDefault,
+			dataCampusBlockedPeriodColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataCampusBlockedPeriodAllColumns,
+			dataCampusBlockedPeriodPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_campus_blocked_periods, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataCampusBlockedPeriodAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_campus_blocked_periods`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_campus_blocked_periods` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_campus_blocked_periods")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusBlockedPeriodMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataCampusBlockedPeriodType, dataCampusBlockedPeriodMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_campus_blocked_periods")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_blocked_periods")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusBlockedPeriodUpsertCacheMut.Lock()
+		dataCampusBlockedPeriodUpsertCache[key] = cache
+		dataCampusBlockedPeriodUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataCampusBlockedPeriod record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataCampusBlockedPeriod) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataCampusBlockedPeriod provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataCampusBlockedPeriodPrimaryKeyMapping)
+	sql := "DELETE FROM `data_campus_blocked_periods` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_campus_blocked_periods")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_campus_blocked_periods")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataCampusBlockedPeriodQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataCampusBlockedPeriodQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_campus_blocked_periods")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_blocked_periods")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataCampusBlockedPeriodSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataCampusBlockedPeriodBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusBlockedPeriodPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_campus_blocked_periods` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusBlockedPeriodPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataCampusBlockedPeriod slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_blocked_periods")
+	}
+
+	if len(dataCampusBlockedPeriodAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataCampusBlockedPeriod) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataCampusBlockedPeriod(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataCampusBlockedPeriodSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataCampusBlockedPeriodSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusBlockedPeriodPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_campus_blocked_periods`.* FROM `data_campus_blocked_periods` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusBlockedPeriodPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataCampusBlockedPeriodSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataCampusBlockedPeriodExists checks if the DataCampusBlockedPeriod row exists.
+func DataCampusBlockedPeriodExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_campus_blocked_periods` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_campus_blocked_periods exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataCampusBlockedPeriod row exists.
+func (o *DataCampusBlockedPeriod) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataCampusBlockedPeriodExists(ctx, exec, o.ID)
+}
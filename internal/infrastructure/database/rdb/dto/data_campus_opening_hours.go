@@ -0,0 +1,1226 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataCampusOpeningHour is an object representing the database table.
+type DataCampusOpeningHour struct {
+	ID               int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus           string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	Weekday          int       `boil:"weekday" json:"weekday" toml:"weekday" yaml:"weekday"`
+	OpenTimeHour     int       `boil:"open_time_hour" json:"open_time_hour" toml:"open_time_hour" yaml:"open_time_hour"`
+	OpenTimeMinutes  int       `boil:"open_time_minutes" json:"open_time_minutes" toml:"open_time_minutes" yaml:"open_time_minutes"`
+	CloseTimeHour    int       `boil:"close_time_hour" json:"close_time_hour" toml:"close_time_hour" yaml:"close_time_hour"`
+	CloseTimeMinutes int       `boil:"close_time_minutes" json:"close_time_minutes" toml:"close_time_minutes" yaml:"close_time_minutes"`
+	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataCampusOpeningHourR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataCampusOpeningHourL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataCampusOpeningHourColumns = struct {
+	ID               string
+	Campus           string
+	Weekday          string
+	OpenTimeHour     string
+	OpenTimeMinutes  string
+	CloseTimeHour    string
+	CloseTimeMinutes string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "id",
+	Campus:           "campus",
+	Weekday:          "weekday",
+	OpenTimeHour:     "open_time_hour",
+	OpenTimeMinutes:  "open_time_minutes",
+	CloseTimeHour:    "close_time_hour",
+	CloseTimeMinutes: "close_time_minutes",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
+}
+
+var DataCampusOpeningHourTableColumns = struct {
+	ID               string
+	Campus           string
+	Weekday          string
+	OpenTimeHour     string
+	OpenTimeMinutes  string
+	CloseTimeHour    string
+	CloseTimeMinutes string
+	CreatedAt        string
+	UpdatedAt        string
+}{
+	ID:               "data_campus_opening_hours.id",
+	Campus:           "data_campus_opening_hours.campus",
+	Weekday:          "data_campus_opening_hours.weekday",
+	OpenTimeHour:     "data_campus_opening_hours.open_time_hour",
+	OpenTimeMinutes:  "data_campus_opening_hours.open_time_minutes",
+	CloseTimeHour:    "data_campus_opening_hours.close_time_hour",
+	CloseTimeMinutes: "data_campus_opening_hours.close_time_minutes",
+	CreatedAt:        "data_campus_opening_hours.created_at",
+	UpdatedAt:        "data_campus_opening_hours.updated_at",
+}
+
+// Generated where
+
+var DataCampusOpeningHourWhere = struct {
+	ID               whereHelperint
+	Campus           whereHelperstring
+	Weekday          whereHelperint
+	OpenTimeHour     whereHelperint
+	OpenTimeMinutes  whereHelperint
+	CloseTimeHour    whereHelperint
+	CloseTimeMinutes whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
+}{
+	ID:               whereHelperint{field: "`data_campus_opening_hours`.`id`"},
+	Campus:           whereHelperstring{field: "`data_campus_opening_hours`.`campus`"},
+	Weekday:          whereHelperint{field: "`data_campus_opening_hours`.`weekday`"},
+	OpenTimeHour:     whereHelperint{field: "`data_campus_opening_hours`.`open_time_hour`"},
+	OpenTimeMinutes:  whereHelperint{field: "`data_campus_opening_hours`.`open_time_minutes`"},
+	CloseTimeHour:    whereHelperint{field: "`data_campus_opening_hours`.`close_time_hour`"},
+	CloseTimeMinutes: whereHelperint{field: "`data_campus_opening_hours`.`close_time_minutes`"},
+	CreatedAt:        whereHelpertime_Time{field: "`data_campus_opening_hours`.`created_at`"},
+	UpdatedAt:        whereHelpertime_Time{field: "`data_campus_opening_hours`.`updated_at`"},
+}
+
+// DataCampusOpeningHourRels is where relationship names are stored.
+var DataCampusOpeningHourRels = struct {
+	CampusDataCampuse string
+}{
+	CampusDataCampuse: "CampusDataCampuse",
+}
+
+// dataCampusOpeningHourR is where relationships are stored.
+type dataCampusOpeningHourR struct {
+	CampusDataCampuse *DataCampuse `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataCampusOpeningHourR) NewStruct() *dataCampusOpeningHourR {
+	return &dataCampusOpeningHourR{}
+}
+
+func (o *DataCampusOpeningHour) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *dataCampusOpeningHourR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+// dataCampusOpeningHourL is where Load methods for each relationship are stored.
+type dataCampusOpeningHourL struct{}
+
+var (
+	dataCampusOpeningHourAllColumns            = []string{"id", "campus", "weekday", "open_time_hour", "open_time_minutes", "close_time_hour", "close_time_minutes", "created_at", "updated_at"}
+	dataCampusOpeningHourColumnsWithoutDefault = []string{"campus", "weekday", "open_time_hour", "open_time_minutes", "close_time_hour", "close_time_minutes"}
+	dataCampusOpeningHourColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	dataCampusOpeningHourPrimaryKeyColumns     = []string{"id"}
+	dataCampusOpeningHourGeneratedColumns      = []string{}
+)
+
+type (
+	// DataCampusOpeningHourSlice is an alias for a slice of pointers to DataCampusOpeningHour.
+	// This should almost always be used instead of []DataCampusOpeningHour.
+	DataCampusOpeningHourSlice []*DataCampusOpeningHour
+	// DataCampusOpeningHourHook is the signature for custom DataCampusOpeningHour hook methods
+	DataCampusOpeningHourHook func(context.Context, boil.ContextExecutor, *DataCampusOpeningHour) error
+
+	dataCampusOpeningHourQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataCampusOpeningHourType                 = reflect.TypeOf(&DataCampusOpeningHour{})
+	dataCampusOpeningHourMapping              = queries.MakeStructMapping(dataCampusOpeningHourType)
+	dataCampusOpeningHourPrimaryKeyMapping, _ = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, dataCampusOpeningHourPrimaryKeyColumns)
+	dataCampusOpeningHourInsertCacheMut       sync.RWMutex
+	dataCampusOpeningHourInsertCache          = make(map[string]insertCache)
+	dataCampusOpeningHourUpdateCacheMut       sync.RWMutex
+	dataCampusOpeningHourUpdateCache          = make(map[string]updateCache)
+	dataCampusOpeningHourUpsertCacheMut       sync.RWMutex
+	dataCampusOpeningHourUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataCampusOpeningHourAfterSelectMu sync.Mutex
+var dataCampusOpeningHourAfterSelectHooks []DataCampusOpeningHourHook
+
+var dataCampusOpeningHourBeforeInsertMu sync.Mutex
+var dataCampusOpeningHourBeforeInsertHooks []DataCampusOpeningHourHook
+var dataCampusOpeningHourAfterInsertMu sync.Mutex
+var dataCampusOpeningHourAfterInsertHooks []DataCampusOpeningHourHook
+
+var dataCampusOpeningHourBeforeUpdateMu sync.Mutex
+var dataCampusOpeningHourBeforeUpdateHooks []DataCampusOpeningHourHook
+var dataCampusOpeningHourAfterUpdateMu sync.Mutex
+var dataCampusOpeningHourAfterUpdateHooks []DataCampusOpeningHourHook
+
+var dataCampusOpeningHourBeforeDeleteMu sync.Mutex
+var dataCampusOpeningHourBeforeDeleteHooks []DataCampusOpeningHourHook
+var dataCampusOpeningHourAfterDeleteMu sync.Mutex
+var dataCampusOpeningHourAfterDeleteHooks []DataCampusOpeningHourHook
+
+var dataCampusOpeningHourBeforeUpsertMu sync.Mutex
+var dataCampusOpeningHourBeforeUpsertHooks []DataCampusOpeningHourHook
+var dataCampusOpeningHourAfterUpsertMu sync.Mutex
+var dataCampusOpeningHourAfterUpsertHooks []DataCampusOpeningHourHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataCampusOpeningHour) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataCampusOpeningHour) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataCampusOpeningHour) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataCampusOpeningHour) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataCampusOpeningHour) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataCampusOpeningHour) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataCampusOpeningHour) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataCampusOpeningHour) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataCampusOpeningHour) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusOpeningHourAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataCampusOpeningHourHook registers your hook function for all future operations.
+func AddDataCampusOpeningHourHook(hookPoint boil.HookPoint, dataCampusOpeningHourHook DataCampusOpeningHourHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataCampusOpeningHourAfterSelectMu.Lock()
+		dataCampusOpeningHourAfterSelectHooks = append(dataCampusOpeningHourAfterSelectHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataCampusOpeningHourBeforeInsertMu.Lock()
+		dataCampusOpeningHourBeforeInsertHooks = append(dataCampusOpeningHourBeforeInsertHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataCampusOpeningHourAfterInsertMu.Lock()
+		dataCampusOpeningHourAfterInsertHooks = append(dataCampusOpeningHourAfterInsertHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataCampusOpeningHourBeforeUpdateMu.Lock()
+		dataCampusOpeningHourBeforeUpdateHooks = append(dataCampusOpeningHourBeforeUpdateHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataCampusOpeningHourAfterUpdateMu.Lock()
+		dataCampusOpeningHourAfterUpdateHooks = append(dataCampusOpeningHourAfterUpdateHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataCampusOpeningHourBeforeDeleteMu.Lock()
+		dataCampusOpeningHourBeforeDeleteHooks = append(dataCampusOpeningHourBeforeDeleteHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataCampusOpeningHourAfterDeleteMu.Lock()
+		dataCampusOpeningHourAfterDeleteHooks = append(dataCampusOpeningHourAfterDeleteHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataCampusOpeningHourBeforeUpsertMu.Lock()
+		dataCampusOpeningHourBeforeUpsertHooks = append(dataCampusOpeningHourBeforeUpsertHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataCampusOpeningHourAfterUpsertMu.Lock()
+		dataCampusOpeningHourAfterUpsertHooks = append(dataCampusOpeningHourAfterUpsertHooks, dataCampusOpeningHourHook)
+		dataCampusOpeningHourAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataCampusOpeningHour record from the query.
+func (q dataCampusOpeningHourQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataCampusOpeningHour, error) {
+	o := &DataCampusOpeningHour{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_campus_opening_hours")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataCampusOpeningHour records from the query.
+func (q dataCampusOpeningHourQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataCampusOpeningHourSlice, error) {
+	var o []*DataCampusOpeningHour
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataCampusOpeningHour slice")
+	}
+
+	if len(dataCampusOpeningHourAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataCampusOpeningHour records in the query.
+func (q dataCampusOpeningHourQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_campus_opening_hours rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataCampusOpeningHourQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_campus_opening_hours exists")
+	}
+
+	return count > 0, nil
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *DataCampusOpeningHour) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataCampusOpeningHourL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampusOpeningHour interface{}, mods queries.Applicator) error {
+	var slice []*DataCampusOpeningHour
+	var object *DataCampusOpeningHour
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampusOpeningHour.(*DataCampusOpeningHour)
+		if !ok {
+			object = new(DataCampusOpeningHour)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampusOpeningHour)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampusOpeningHour))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampusOpeningHour.(*[]*DataCampusOpeningHour)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampusOpeningHour)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampusOpeningHour))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampusOpeningHourR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampusOpeningHourR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusDataCampusOpeningHours = append(foreign.R.CampusDataCampusOpeningHours, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusDataCampusOpeningHours = append(foreign.R.CampusDataCampusOpeningHours, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the dataCampusOpeningHour to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusDataCampusOpeningHours.
+func (o *DataCampusOpeningHour) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_campus_opening_hours` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, dataCampusOpeningHourPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &dataCampusOpeningHourR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusDataCampusOpeningHours: DataCampusOpeningHourSlice{o},
+		}
+	} else {
+		related.R.CampusDataCampusOpeningHours = append(related.R.CampusDataCampusOpeningHours, o)
+	}
+
+	return nil
+}
+
+// DataCampusOpeningHours retrieves all the records using an executor.
+func DataCampusOpeningHours(mods ...qm.QueryMod) dataCampusOpeningHourQuery {
+	mods = append(mods, qm.From("`data_campus_opening_hours`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_campus_opening_hours`.*"})
+	}
+
+	return dataCampusOpeningHourQuery{q}
+}
+
+// FindDataCampusOpeningHour retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataCampusOpeningHour(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataCampusOpeningHour, error) {
+	dataCampusOpeningHourObj := &DataCampusOpeningHour{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_campus_opening_hours` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataCampusOpeningHourObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_campus_opening_hours")
+	}
+
+	if err = dataCampusOpeningHourObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataCampusOpeningHourObj, err
+	}
+
+	return dataCampusOpeningHourObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataCampusOpeningHour) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_opening_hours provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusOpeningHourColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataCampusOpeningHourInsertCacheMut.RLock()
+	cache, cached := dataCampusOpeningHourInsertCache[key]
+	dataCampusOpeningHourInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataCampusOpeningHourAllColumns,
+			dataCampusOpeningHourColumnsWithDefault,
+			dataCampusOpeningHourColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_campus_opening_hours` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_campus_opening_hours` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_campus_opening_hours` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataCampusOpeningHourPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_campus_opening_hours")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusOpeningHourMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_opening_hours")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusOpeningHourInsertCacheMut.Lock()
+		dataCampusOpeningHourInsertCache[key] = cache
+		dataCampusOpeningHourInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataCampusOpeningHour.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataCampusOpeningHour) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataCampusOpeningHourUpdateCacheMut.RLock()
+	cache, cached := dataCampusOpeningHourUpdateCache[key]
+	dataCampusOpeningHourUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataCampusOpeningHourAllColumns,
+			dataCampusOpeningHourPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_campus_opening_hours, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_campus_opening_hours` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataCampusOpeningHourPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, append(wl, dataCampusOpeningHourPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_campus_opening_hours row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_campus_opening_hours")
+	}
+
+	if !cached {
+		dataCampusOpeningHourUpdateCacheMut.Lock()
+		dataCampusOpeningHourUpdateCache[key] = cache
+		dataCampusOpeningHourUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataCampusOpeningHourQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_campus_opening_hours")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_campus_opening_hours")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataCampusOpeningHourSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusOpeningHourPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_campus_opening_hours` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusOpeningHourPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataCampusOpeningHour slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataCampusOpeningHour")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataCampusOpeningHourUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataCampusOpeningHour) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_opening_hours provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusOpeningHourColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataCampusOpeningHourUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataCampusOpeningHourUpsertCacheMut.RLock()
+	cache, cached := dataCampusOpeningHourUpsertCache[key]
+	dataCampusOpeningHourUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataCampusOpeningHourAllColumns,
+			dataCampusOpeningHourColumnsWithDefault,
+			dataCampusOpeningHourColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataCampusOpeningHourAllColumns,
+			dataCampusOpeningHourPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_campus_opening_hours, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataCampusOpeningHourAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_campus_opening_hours`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_campus_opening_hours` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_campus_opening_hours")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusOpeningHourMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataCampusOpeningHourType, dataCampusOpeningHourMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_campus_opening_hours")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_opening_hours")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusOpeningHourUpsertCacheMut.Lock()
+		dataCampusOpeningHourUpsertCache[key] = cache
+		dataCampusOpeningHourUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataCampusOpeningHour record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataCampusOpeningHour) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataCampusOpeningHour provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataCampusOpeningHourPrimaryKeyMapping)
+	sql := "DELETE FROM `data_campus_opening_hours` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_campus_opening_hours")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_campus_opening_hours")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataCampusOpeningHourQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataCampusOpeningHourQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_campus_opening_hours")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_opening_hours")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataCampusOpeningHourSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataCampusOpeningHourBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusOpeningHourPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_campus_opening_hours` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusOpeningHourPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataCampusOpeningHour slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_opening_hours")
+	}
+
+	if len(dataCampusOpeningHourAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataCampusOpeningHour) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataCampusOpeningHour(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataCampusOpeningHourSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataCampusOpeningHourSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusOpeningHourPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_campus_opening_hours`.* FROM `data_campus_opening_hours` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusOpeningHourPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataCampusOpeningHourSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataCampusOpeningHourExists checks if the DataCampusOpeningHour row exists.
+func DataCampusOpeningHourExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_campus_opening_hours` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_campus_opening_hours exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataCampusOpeningHour row exists.
+func (o *DataCampusOpeningHour) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataCampusOpeningHourExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1212 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataCampusRoomItemType is an object representing the database table.
+type DataCampusRoomItemType struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus    string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	ItemTag   string    `boil:"item_tag" json:"item_tag" toml:"item_tag" yaml:"item_tag"`
+	Label     string    `boil:"label" json:"label" toml:"label" yaml:"label"`
+	Color     string    `boil:"color" json:"color" toml:"color" yaml:"color"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataCampusRoomItemTypeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataCampusRoomItemTypeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataCampusRoomItemTypeColumns = struct {
+	ID        string
+	Campus    string
+	ItemTag   string
+	Label     string
+	Color     string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Campus:    "campus",
+	ItemTag:   "item_tag",
+	Label:     "label",
+	Color:     "color",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var DataCampusRoomItemTypeTableColumns = struct {
+	ID        string
+	Campus    string
+	ItemTag   string
+	Label     string
+	Color     string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "data_campus_room_item_types.id",
+	Campus:    "data_campus_room_item_types.campus",
+	ItemTag:   "data_campus_room_item_types.item_tag",
+	Label:     "data_campus_room_item_types.label",
+	Color:     "data_campus_room_item_types.color",
+	CreatedAt: "data_campus_room_item_types.created_at",
+	UpdatedAt: "data_campus_room_item_types.updated_at",
+}
+
+// Generated where
+
+var DataCampusRoomItemTypeWhere = struct {
+	ID        whereHelperint
+	Campus    whereHelperstring
+	ItemTag   whereHelperstring
+	Label     whereHelperstring
+	Color     whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`data_campus_room_item_types`.`id`"},
+	Campus:    whereHelperstring{field: "`data_campus_room_item_types`.`campus`"},
+	ItemTag:   whereHelperstring{field: "`data_campus_room_item_types`.`item_tag`"},
+	Label:     whereHelperstring{field: "`data_campus_room_item_types`.`label`"},
+	Color:     whereHelperstring{field: "`data_campus_room_item_types`.`color`"},
+	CreatedAt: whereHelpertime_Time{field: "`data_campus_room_item_types`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`data_campus_room_item_types`.`updated_at`"},
+}
+
+// DataCampusRoomItemTypeRels is where relationship names are stored.
+var DataCampusRoomItemTypeRels = struct {
+	CampusDataCampuse string
+}{
+	CampusDataCampuse: "CampusDataCampuse",
+}
+
+// dataCampusRoomItemTypeR is where relationships are stored.
+type dataCampusRoomItemTypeR struct {
+	CampusDataCampuse *DataCampuse `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataCampusRoomItemTypeR) NewStruct() *dataCampusRoomItemTypeR {
+	return &dataCampusRoomItemTypeR{}
+}
+
+func (o *DataCampusRoomItemType) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *dataCampusRoomItemTypeR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+// dataCampusRoomItemTypeL is where Load methods for each relationship are stored.
+type dataCampusRoomItemTypeL struct{}
+
+var (
+	dataCampusRoomItemTypeAllColumns            = []string{"id", "campus", "item_tag", "label", "color", "created_at", "updated_at"}
+	dataCampusRoomItemTypeColumnsWithoutDefault = []string{"campus", "item_tag", "label", "color"}
+	dataCampusRoomItemTypeColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	dataCampusRoomItemTypePrimaryKeyColumns     = []string{"id"}
+	dataCampusRoomItemTypeGeneratedColumns      = []string{}
+)
+
+type (
+	// DataCampusRoomItemTypeSlice is an alias for a slice of pointers to DataCampusRoomItemType.
+	// This should almost always be used instead of []DataCampusRoomItemType.
+	DataCampusRoomItemTypeSlice []*DataCampusRoomItemType
+	// DataCampusRoomItemTypeHook is the signature for custom DataCampusRoomItemType hook methods
+	DataCampusRoomItemTypeHook func(context.Context, boil.ContextExecutor, *DataCampusRoomItemType) error
+
+	dataCampusRoomItemTypeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataCampusRoomItemTypeType                 = reflect.TypeOf(&DataCampusRoomItemType{})
+	dataCampusRoomItemTypeMapping              = queries.MakeStructMapping(dataCampusRoomItemTypeType)
+	dataCampusRoomItemTypePrimaryKeyMapping, _ = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, dataCampusRoomItemTypePrimaryKeyColumns)
+	dataCampusRoomItemTypeInsertCacheMut       sync.RWMutex
+	dataCampusRoomItemTypeInsertCache          = make(map[string]insertCache)
+	dataCampusRoomItemTypeUpdateCacheMut       sync.RWMutex
+	dataCampusRoomItemTypeUpdateCache          = make(map[string]updateCache)
+	dataCampusRoomItemTypeUpsertCacheMut       sync.RWMutex
+	dataCampusRoomItemTypeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataCampusRoomItemTypeAfterSelectMu sync.Mutex
+var dataCampusRoomItemTypeAfterSelectHooks []DataCampusRoomItemTypeHook
+
+var dataCampusRoomItemTypeBeforeInsertMu sync.Mutex
+var dataCampusRoomItemTypeBeforeInsertHooks []DataCampusRoomItemTypeHook
+var dataCampusRoomItemTypeAfterInsertMu sync.Mutex
+var dataCampusRoomItemTypeAfterInsertHooks []DataCampusRoomItemTypeHook
+
+var dataCampusRoomItemTypeBeforeUpdateMu sync.Mutex
+var dataCampusRoomItemTypeBeforeUpdateHooks []DataCampusRoomItemTypeHook
+var dataCampusRoomItemTypeAfterUpdateMu sync.Mutex
+var dataCampusRoomItemTypeAfterUpdateHooks []DataCampusRoomItemTypeHook
+
+var dataCampusRoomItemTypeBeforeDeleteMu sync.Mutex
+var dataCampusRoomItemTypeBeforeDeleteHooks []DataCampusRoomItemTypeHook
+var dataCampusRoomItemTypeAfterDeleteMu sync.Mutex
+var dataCampusRoomItemTypeAfterDeleteHooks []DataCampusRoomItemTypeHook
+
+var dataCampusRoomItemTypeBeforeUpsertMu sync.Mutex
+var dataCampusRoomItemTypeBeforeUpsertHooks []DataCampusRoomItemTypeHook
+var dataCampusRoomItemTypeAfterUpsertMu sync.Mutex
+var dataCampusRoomItemTypeAfterUpsertHooks []DataCampusRoomItemTypeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataCampusRoomItemType) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataCampusRoomItemType) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataCampusRoomItemType) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataCampusRoomItemType) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataCampusRoomItemType) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataCampusRoomItemType) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataCampusRoomItemType) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataCampusRoomItemType) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataCampusRoomItemType) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusRoomItemTypeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataCampusRoomItemTypeHook registers your hook function for all future operations.
+func AddDataCampusRoomItemTypeHook(hookPoint boil.HookPoint, dataCampusRoomItemTypeHook DataCampusRoomItemTypeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataCampusRoomItemTypeAfterSelectMu.Lock()
+		dataCampusRoomItemTypeAfterSelectHooks = append(dataCampusRoomItemTypeAfterSelectHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataCampusRoomItemTypeBeforeInsertMu.Lock()
+		dataCampusRoomItemTypeBeforeInsertHooks = append(dataCampusRoomItemTypeBeforeInsertHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataCampusRoomItemTypeAfterInsertMu.Lock()
+		dataCampusRoomItemTypeAfterInsertHooks = append(dataCampusRoomItemTypeAfterInsertHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataCampusRoomItemTypeBeforeUpdateMu.Lock()
+		dataCampusRoomItemTypeBeforeUpdateHooks = append(dataCampusRoomItemTypeBeforeUpdateHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataCampusRoomItemTypeAfterUpdateMu.Lock()
+		dataCampusRoomItemTypeAfterUpdateHooks = append(dataCampusRoomItemTypeAfterUpdateHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataCampusRoomItemTypeBeforeDeleteMu.Lock()
+		dataCampusRoomItemTypeBeforeDeleteHooks = append(dataCampusRoomItemTypeBeforeDeleteHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataCampusRoomItemTypeAfterDeleteMu.Lock()
+		dataCampusRoomItemTypeAfterDeleteHooks = append(dataCampusRoomItemTypeAfterDeleteHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataCampusRoomItemTypeBeforeUpsertMu.Lock()
+		dataCampusRoomItemTypeBeforeUpsertHooks = append(dataCampusRoomItemTypeBeforeUpsertHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataCampusRoomItemTypeAfterUpsertMu.Lock()
+		dataCampusRoomItemTypeAfterUpsertHooks = append(dataCampusRoomItemTypeAfterUpsertHooks, dataCampusRoomItemTypeHook)
+		dataCampusRoomItemTypeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataCampusRoomItemType record from the query.
+func (q dataCampusRoomItemTypeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataCampusRoomItemType, error) {
+	o := &DataCampusRoomItemType{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_campus_room_item_types")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataCampusRoomItemType records from the query.
+func (q dataCampusRoomItemTypeQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataCampusRoomItemTypeSlice, error) {
+	var o []*DataCampusRoomItemType
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataCampusRoomItemType slice")
+	}
+
+	if len(dataCampusRoomItemTypeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataCampusRoomItemType records in the query.
+func (q dataCampusRoomItemTypeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_campus_room_item_types rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataCampusRoomItemTypeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_campus_room_item_types exists")
+	}
+
+	return count > 0, nil
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *DataCampusRoomItemType) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataCampusRoomItemTypeL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampusRoomItemType interface{}, mods queries.Applicator) error {
+	var slice []*DataCampusRoomItemType
+	var object *DataCampusRoomItemType
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampusRoomItemType.(*DataCampusRoomItemType)
+		if !ok {
+			object = new(DataCampusRoomItemType)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampusRoomItemType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampusRoomItemType))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampusRoomItemType.(*[]*DataCampusRoomItemType)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampusRoomItemType)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampusRoomItemType))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampusRoomItemTypeR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampusRoomItemTypeR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusDataCampusRoomItemTypes = append(foreign.R.CampusDataCampusRoomItemTypes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusDataCampusRoomItemTypes = append(foreign.R.CampusDataCampusRoomItemTypes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the dataCampusRoomItemType to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusDataCampusRoomItemTypes.
+func (o *DataCampusRoomItemType) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_campus_room_item_types` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, dataCampusRoomItemTypePrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &dataCampusRoomItemTypeR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusDataCampusRoomItemTypes: DataCampusRoomItemTypeSlice{o},
+		}
+	} else {
+		related.R.CampusDataCampusRoomItemTypes = append(related.R.CampusDataCampusRoomItemTypes, o)
+	}
+
+	return nil
+}
+
+// DataCampusRoomItemTypes retrieves all the records using an executor.
+func DataCampusRoomItemTypes(mods ...qm.QueryMod) dataCampusRoomItemTypeQuery {
+	mods = append(mods, qm.From("`data_campus_room_item_types`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_campus_room_item_types`.*"})
+	}
+
+	return dataCampusRoomItemTypeQuery{q}
+}
+
+// FindDataCampusRoomItemType retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataCampusRoomItemType(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataCampusRoomItemType, error) {
+	dataCampusRoomItemTypeObj := &DataCampusRoomItemType{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_campus_room_item_types` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataCampusRoomItemTypeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_campus_room_item_types")
+	}
+
+	if err = dataCampusRoomItemTypeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataCampusRoomItemTypeObj, err
+	}
+
+	return dataCampusRoomItemTypeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataCampusRoomItemType) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_room_item_types provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusRoomItemTypeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataCampusRoomItemTypeInsertCacheMut.RLock()
+	cache, cached := dataCampusRoomItemTypeInsertCache[key]
+	dataCampusRoomItemTypeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataCampusRoomItemTypeAllColumns,
+			dataCampusRoomItemTypeColumnsWithDefault,
+			dataCampusRoomItemTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_campus_room_item_types` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_campus_room_item_types` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_campus_room_item_types` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataCampusRoomItemTypePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_campus_room_item_types")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusRoomItemTypeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_room_item_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusRoomItemTypeInsertCacheMut.Lock()
+		dataCampusRoomItemTypeInsertCache[key] = cache
+		dataCampusRoomItemTypeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataCampusRoomItemType.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataCampusRoomItemType) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataCampusRoomItemTypeUpdateCacheMut.RLock()
+	cache, cached := dataCampusRoomItemTypeUpdateCache[key]
+	dataCampusRoomItemTypeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataCampusRoomItemTypeAllColumns,
+			dataCampusRoomItemTypePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_campus_room_item_types, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_campus_room_item_types` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataCampusRoomItemTypePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, append(wl, dataCampusRoomItemTypePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_campus_room_item_types row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_campus_room_item_types")
+	}
+
+	if !cached {
+		dataCampusRoomItemTypeUpdateCacheMut.Lock()
+		dataCampusRoomItemTypeUpdateCache[key] = cache
+		dataCampusRoomItemTypeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataCampusRoomItemTypeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_campus_room_item_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_campus_room_item_types")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataCampusRoomItemTypeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusRoomItemTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_campus_room_item_types` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusRoomItemTypePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataCampusRoomItemType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataCampusRoomItemType")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataCampusRoomItemTypeUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataCampusRoomItemType) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_room_item_types provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusRoomItemTypeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataCampusRoomItemTypeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataCampusRoomItemTypeUpsertCacheMut.RLock()
+	cache, cached := dataCampusRoomItemTypeUpsertCache[key]
+	dataCampusRoomItemTypeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataCampusRoomItemTypeAllColumns,
+			dataCampusRoomItemTypeColumnsWithDefault,
+			dataCampusRoomItemTypeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataCampusRoomItemTypeAllColumns,
+			dataCampusRoomItemTypePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_campus_room_item_types, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataCampusRoomItemTypeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_campus_room_item_types`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_campus_room_item_types` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_campus_room_item_types")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusRoomItemTypeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataCampusRoomItemTypeType, dataCampusRoomItemTypeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_campus_room_item_types")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_room_item_types")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusRoomItemTypeUpsertCacheMut.Lock()
+		dataCampusRoomItemTypeUpsertCache[key] = cache
+		dataCampusRoomItemTypeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataCampusRoomItemType record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataCampusRoomItemType) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataCampusRoomItemType provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataCampusRoomItemTypePrimaryKeyMapping)
+	sql := "DELETE FROM `data_campus_room_item_types` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_campus_room_item_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_campus_room_item_types")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataCampusRoomItemTypeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataCampusRoomItemTypeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_campus_room_item_types")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_room_item_types")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataCampusRoomItemTypeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataCampusRoomItemTypeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusRoomItemTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_campus_room_item_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusRoomItemTypePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataCampusRoomItemType slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_room_item_types")
+	}
+
+	if len(dataCampusRoomItemTypeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataCampusRoomItemType) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataCampusRoomItemType(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataCampusRoomItemTypeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataCampusRoomItemTypeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusRoomItemTypePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_campus_room_item_types`.* FROM `data_campus_room_item_types` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusRoomItemTypePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataCampusRoomItemTypeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataCampusRoomItemTypeExists checks if the DataCampusRoomItemType row exists.
+func DataCampusRoomItemTypeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_campus_room_item_types` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_campus_room_item_types exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataCampusRoomItemType row exists.
+func (o *DataCampusRoomItemType) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataCampusRoomItemTypeExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1220 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataCampusSchedulingPolicy is an object representing the database table.
+type DataCampusSchedulingPolicy struct {
+	ID                int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus            string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	GridMinutes       int       `boil:"grid_minutes" json:"grid_minutes" toml:"grid_minutes" yaml:"grid_minutes"`
+	MinLessonDuration int       `boil:"min_lesson_duration" json:"min_lesson_duration" toml:"min_lesson_duration" yaml:"min_lesson_duration"`
+	MaxLessonDuration int       `boil:"max_lesson_duration" json:"max_lesson_duration" toml:"max_lesson_duration" yaml:"max_lesson_duration"`
+	MinGapMinutes     int       `boil:"min_gap_minutes" json:"min_gap_minutes" toml:"min_gap_minutes" yaml:"min_gap_minutes"`
+	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataCampusSchedulingPolicyR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataCampusSchedulingPolicyL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataCampusSchedulingPolicyColumns = struct {
+	ID                string
+	Campus            string
+	GridMinutes       string
+	MinLessonDuration string
+	MaxLessonDuration string
+	MinGapMinutes     string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "id",
+	Campus:            "campus",
+	GridMinutes:       "grid_minutes",
+	MinLessonDuration: "min_lesson_duration",
+	MaxLessonDuration: "max_lesson_duration",
+	MinGapMinutes:     "min_gap_minutes",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+}
+
+var DataCampusSchedulingPolicyTableColumns = struct {
+	ID                string
+	Campus            string
+	GridMinutes       string
+	MinLessonDuration string
+	MaxLessonDuration string
+	MinGapMinutes     string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "data_campus_scheduling_policies.id",
+	Campus:            "data_campus_scheduling_policies.campus",
+	GridMinutes:       "data_campus_scheduling_policies.grid_minutes",
+	MinLessonDuration: "data_campus_scheduling_policies.min_lesson_duration",
+	MaxLessonDuration: "data_campus_scheduling_policies.max_lesson_duration",
+	MinGapMinutes:     "data_campus_scheduling_policies.min_gap_minutes",
+	CreatedAt:         "data_campus_scheduling_policies.created_at",
+	UpdatedAt:         "data_campus_scheduling_policies.updated_at",
+}
+
+// Generated where
+
+var DataCampusSchedulingPolicyWhere = struct {
+	ID                whereHelperint
+	Campus            whereHelperstring
+	GridMinutes       whereHelperint
+	MinLessonDuration whereHelperint
+	MaxLessonDuration whereHelperint
+	MinGapMinutes     whereHelperint
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperint{field: "`data_campus_scheduling_policies`.`id`"},
+	Campus:            whereHelperstring{field: "`data_campus_scheduling_policies`.`campus`"},
+	GridMinutes:       whereHelperint{field: "`data_campus_scheduling_policies`.`grid_minutes`"},
+	MinLessonDuration: whereHelperint{field: "`data_campus_scheduling_policies`.`min_lesson_duration`"},
+	MaxLessonDuration: whereHelperint{field: "`data_campus_scheduling_policies`.`max_lesson_duration`"},
+	MinGapMinutes:     whereHelperint{field: "`data_campus_scheduling_policies`.`min_gap_minutes`"},
+	CreatedAt:         whereHelpertime_Time{field: "`data_campus_scheduling_policies`.`created_at`"},
+	UpdatedAt:         whereHelpertime_Time{field: "`data_campus_scheduling_policies`.`updated_at`"},
+}
+
+// DataCampusSchedulingPolicyRels is where relationship names are stored.
+var DataCampusSchedulingPolicyRels = struct {
+	CampusDataCampuse string
+}{
+	CampusDataCampuse: "CampusDataCampuse",
+}
+
+// dataCampusSchedulingPolicyR is where relationships are stored.
+type dataCampusSchedulingPolicyR struct {
+	CampusDataCampuse *DataCampuse `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataCampusSchedulingPolicyR) NewStruct() *dataCampusSchedulingPolicyR {
+	return &dataCampusSchedulingPolicyR{}
+}
+
+func (o *DataCampusSchedulingPolicy) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *dataCampusSchedulingPolicyR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+// dataCampusSchedulingPolicyL is where Load methods for each relationship are stored.
+type dataCampusSchedulingPolicyL struct{}
+
+var (
+	dataCampusSchedulingPolicyAllColumns            = []string{"id", "campus", "grid_minutes", "min_lesson_duration", "max_lesson_duration", "min_gap_minutes", "created_at", "updated_at"}
+	dataCampusSchedulingPolicyColumnsWithoutDefault = []string{"campus"}
+	dataCampusSchedulingPolicyColumnsWithDefault    = []string{"id", "grid_minutes", "min_lesson_duration", "max_lesson_duration", "min_gap_minutes", "created_at", "updated_at"}
+	dataCampusSchedulingPolicyPrimaryKeyColumns     = []string{"id"}
+	dataCampusSchedulingPolicyGeneratedColumns      = []string{}
+)
+
+type (
+	// DataCampusSchedulingPolicySlice is an alias for a slice of pointers to DataCampusSchedulingPolicy.
+	// This should almost always be used instead of []DataCampusSchedulingPolicy.
+	DataCampusSchedulingPolicySlice []*DataCampusSchedulingPolicy
+	// DataCampusSchedulingPolicyHook is the signature for custom DataCampusSchedulingPolicy hook methods
+	DataCampusSchedulingPolicyHook func(context.Context, boil.ContextExecutor, *DataCampusSchedulingPolicy) error
+
+	dataCampusSchedulingPolicyQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataCampusSchedulingPolicyType                 = reflect.TypeOf(&DataCampusSchedulingPolicy{})
+	dataCampusSchedulingPolicyMapping              = queries.MakeStructMapping(dataCampusSchedulingPolicyType)
+	dataCampusSchedulingPolicyPrimaryKeyMapping, _ = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, dataCampusSchedulingPolicyPrimaryKeyColumns)
+	dataCampusSchedulingPolicyInsertCacheMut       sync.RWMutex
+	dataCampusSchedulingPolicyInsertCache          = make(map[string]insertCache)
+	dataCampusSchedulingPolicyUpdateCacheMut       sync.RWMutex
+	dataCampusSchedulingPolicyUpdateCache          = make(map[string]updateCache)
+	dataCampusSchedulingPolicyUpsertCacheMut       sync.RWMutex
+	dataCampusSchedulingPolicyUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataCampusSchedulingPolicyAfterSelectMu sync.Mutex
+var dataCampusSchedulingPolicyAfterSelectHooks []DataCampusSchedulingPolicyHook
+
+var dataCampusSchedulingPolicyBeforeInsertMu sync.Mutex
+var dataCampusSchedulingPolicyBeforeInsertHooks []DataCampusSchedulingPolicyHook
+var dataCampusSchedulingPolicyAfterInsertMu sync.Mutex
+var dataCampusSchedulingPolicyAfterInsertHooks []DataCampusSchedulingPolicyHook
+
+var dataCampusSchedulingPolicyBeforeUpdateMu sync.Mutex
+var dataCampusSchedulingPolicyBeforeUpdateHooks []DataCampusSchedulingPolicyHook
+var dataCampusSchedulingPolicyAfterUpdateMu sync.Mutex
+var dataCampusSchedulingPolicyAfterUpdateHooks []DataCampusSchedulingPolicyHook
+
+var dataCampusSchedulingPolicyBeforeDeleteMu sync.Mutex
+var dataCampusSchedulingPolicyBeforeDeleteHooks []DataCampusSchedulingPolicyHook
+var dataCampusSchedulingPolicyAfterDeleteMu sync.Mutex
+var dataCampusSchedulingPolicyAfterDeleteHooks []DataCampusSchedulingPolicyHook
+
+var dataCampusSchedulingPolicyBeforeUpsertMu sync.Mutex
+var dataCampusSchedulingPolicyBeforeUpsertHooks []DataCampusSchedulingPolicyHook
+var dataCampusSchedulingPolicyAfterUpsertMu sync.Mutex
+var dataCampusSchedulingPolicyAfterUpsertHooks []DataCampusSchedulingPolicyHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataCampusSchedulingPolicy) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataCampusSchedulingPolicy) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataCampusSchedulingPolicy) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataCampusSchedulingPolicy) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataCampusSchedulingPolicy) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataCampusSchedulingPolicy) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataCampusSchedulingPolicy) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataCampusSchedulingPolicy) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataCampusSchedulingPolicy) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataCampusSchedulingPolicyAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataCampusSchedulingPolicyHook registers your hook function for all future operations.
+func AddDataCampusSchedulingPolicyHook(hookPoint boil.HookPoint, dataCampusSchedulingPolicyHook DataCampusSchedulingPolicyHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataCampusSchedulingPolicyAfterSelectMu.Lock()
+		dataCampusSchedulingPolicyAfterSelectHooks = append(dataCampusSchedulingPolicyAfterSelectHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataCampusSchedulingPolicyBeforeInsertMu.Lock()
+		dataCampusSchedulingPolicyBeforeInsertHooks = append(dataCampusSchedulingPolicyBeforeInsertHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataCampusSchedulingPolicyAfterInsertMu.Lock()
+		dataCampusSchedulingPolicyAfterInsertHooks = append(dataCampusSchedulingPolicyAfterInsertHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataCampusSchedulingPolicyBeforeUpdateMu.Lock()
+		dataCampusSchedulingPolicyBeforeUpdateHooks = append(dataCampusSchedulingPolicyBeforeUpdateHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataCampusSchedulingPolicyAfterUpdateMu.Lock()
+		dataCampusSchedulingPolicyAfterUpdateHooks = append(dataCampusSchedulingPolicyAfterUpdateHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataCampusSchedulingPolicyBeforeDeleteMu.Lock()
+		dataCampusSchedulingPolicyBeforeDeleteHooks = append(dataCampusSchedulingPolicyBeforeDeleteHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataCampusSchedulingPolicyAfterDeleteMu.Lock()
+		dataCampusSchedulingPolicyAfterDeleteHooks = append(dataCampusSchedulingPolicyAfterDeleteHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataCampusSchedulingPolicyBeforeUpsertMu.Lock()
+		dataCampusSchedulingPolicyBeforeUpsertHooks = append(dataCampusSchedulingPolicyBeforeUpsertHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataCampusSchedulingPolicyAfterUpsertMu.Lock()
+		dataCampusSchedulingPolicyAfterUpsertHooks = append(dataCampusSchedulingPolicyAfterUpsertHooks, dataCampusSchedulingPolicyHook)
+		dataCampusSchedulingPolicyAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataCampusSchedulingPolicy record from the query.
+func (q dataCampusSchedulingPolicyQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataCampusSchedulingPolicy, error) {
+	o := &DataCampusSchedulingPolicy{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_campus_scheduling_policies")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataCampusSchedulingPolicy records from the query.
+func (q dataCampusSchedulingPolicyQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataCampusSchedulingPolicySlice, error) {
+	var o []*DataCampusSchedulingPolicy
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataCampusSchedulingPolicy slice")
+	}
+
+	if len(dataCampusSchedulingPolicyAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataCampusSchedulingPolicy records in the query.
+func (q dataCampusSchedulingPolicyQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_campus_scheduling_policies rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataCampusSchedulingPolicyQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_campus_scheduling_policies exists")
+	}
+
+	return count > 0, nil
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *DataCampusSchedulingPolicy) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataCampusSchedulingPolicyL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampusSchedulingPolicy interface{}, mods queries.Applicator) error {
+	var slice []*DataCampusSchedulingPolicy
+	var object *DataCampusSchedulingPolicy
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampusSchedulingPolicy.(*DataCampusSchedulingPolicy)
+		if !ok {
+			object = new(DataCampusSchedulingPolicy)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampusSchedulingPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampusSchedulingPolicy))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampusSchedulingPolicy.(*[]*DataCampusSchedulingPolicy)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampusSchedulingPolicy)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampusSchedulingPolicy))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampusSchedulingPolicyR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampusSchedulingPolicyR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusDataCampusSchedulingPolicy = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusDataCampusSchedulingPolicy = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the dataCampusSchedulingPolicy to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusDataCampusSchedulingPolicy.
+func (o *DataCampusSchedulingPolicy) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_campus_scheduling_policies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, dataCampusSchedulingPolicyPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &dataCampusSchedulingPolicyR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusDataCampusSchedulingPolicy: o,
+		}
+	} else {
+		related.R.CampusDataCampusSchedulingPolicy = o
+	}
+
+	return nil
+}
+
+// DataCampusSchedulingPolicies retrieves all the records using an executor.
+func DataCampusSchedulingPolicies(mods ...qm.QueryMod) dataCampusSchedulingPolicyQuery {
+	mods = append(mods, qm.From("`data_campus_scheduling_policies`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_campus_scheduling_policies`.*"})
+	}
+
+	return dataCampusSchedulingPolicyQuery{q}
+}
+
+// FindDataCampusSchedulingPolicy retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataCampusSchedulingPolicy(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataCampusSchedulingPolicy, error) {
+	dataCampusSchedulingPolicyObj := &DataCampusSchedulingPolicy{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_campus_scheduling_policies` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataCampusSchedulingPolicyObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_campus_scheduling_policies")
+	}
+
+	if err = dataCampusSchedulingPolicyObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataCampusSchedulingPolicyObj, err
+	}
+
+	return dataCampusSchedulingPolicyObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataCampusSchedulingPolicy) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_scheduling_policies provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusSchedulingPolicyColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataCampusSchedulingPolicyInsertCacheMut.RLock()
+	cache, cached := dataCampusSchedulingPolicyInsertCache[key]
+	dataCampusSchedulingPolicyInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataCampusSchedulingPolicyAllColumns,
+			dataCampusSchedulingPolicyColumnsWithDefault,
+			dataCampusSchedulingPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_campus_scheduling_policies` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_campus_scheduling_policies` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_campus_scheduling_policies` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataCampusSchedulingPolicyPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_campus_scheduling_policies")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusSchedulingPolicyMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_scheduling_policies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusSchedulingPolicyInsertCacheMut.Lock()
+		dataCampusSchedulingPolicyInsertCache[key] = cache
+		dataCampusSchedulingPolicyInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataCampusSchedulingPolicy.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataCampusSchedulingPolicy) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataCampusSchedulingPolicyUpdateCacheMut.RLock()
+	cache, cached := dataCampusSchedulingPolicyUpdateCache[key]
+	dataCampusSchedulingPolicyUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataCampusSchedulingPolicyAllColumns,
+			dataCampusSchedulingPolicyPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_campus_scheduling_policies, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_campus_scheduling_policies` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataCampusSchedulingPolicyPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, append(wl, dataCampusSchedulingPolicyPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_campus_scheduling_policies row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_campus_scheduling_policies")
+	}
+
+	if !cached {
+		dataCampusSchedulingPolicyUpdateCacheMut.Lock()
+		dataCampusSchedulingPolicyUpdateCache[key] = cache
+		dataCampusSchedulingPolicyUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataCampusSchedulingPolicyQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_campus_scheduling_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_campus_scheduling_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataCampusSchedulingPolicySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusSchedulingPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_campus_scheduling_policies` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusSchedulingPolicyPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataCampusSchedulingPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataCampusSchedulingPolicy")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataCampusSchedulingPolicyUniqueColumns = []string{
+	"id",
+	"campus",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataCampusSchedulingPolicy) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_campus_scheduling_policies provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataCampusSchedulingPolicyColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataCampusSchedulingPolicyUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataCampusSchedulingPolicyUpsertCacheMut.RLock()
+	cache, cached := dataCampusSchedulingPolicyUpsertCache[key]
+	dataCampusSchedulingPolicyUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataCampusSchedulingPolicyAllColumns,
+			dataCampusSchedulingPolicyColumnsWithDefault,
+			dataCampusSchedulingPolicyColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataCampusSchedulingPolicyAllColumns,
+			dataCampusSchedulingPolicyPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_campus_scheduling_policies, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataCampusSchedulingPolicyAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_campus_scheduling_policies`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_campus_scheduling_policies` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_campus_scheduling_policies")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataCampusSchedulingPolicyMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataCampusSchedulingPolicyType, dataCampusSchedulingPolicyMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_campus_scheduling_policies")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_campus_scheduling_policies")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataCampusSchedulingPolicyUpsertCacheMut.Lock()
+		dataCampusSchedulingPolicyUpsertCache[key] = cache
+		dataCampusSchedulingPolicyUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataCampusSchedulingPolicy record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataCampusSchedulingPolicy) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataCampusSchedulingPolicy provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataCampusSchedulingPolicyPrimaryKeyMapping)
+	sql := "DELETE FROM `data_campus_scheduling_policies` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_campus_scheduling_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_campus_scheduling_policies")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataCampusSchedulingPolicyQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataCampusSchedulingPolicyQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_campus_scheduling_policies")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_scheduling_policies")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataCampusSchedulingPolicySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataCampusSchedulingPolicyBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusSchedulingPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_campus_scheduling_policies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusSchedulingPolicyPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataCampusSchedulingPolicy slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_campus_scheduling_policies")
+	}
+
+	if len(dataCampusSchedulingPolicyAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataCampusSchedulingPolicy) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataCampusSchedulingPolicy(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataCampusSchedulingPolicySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataCampusSchedulingPolicySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataCampusSchedulingPolicyPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_campus_scheduling_policies`.* FROM `data_campus_scheduling_policies` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataCampusSchedulingPolicyPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataCampusSchedulingPolicySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataCampusSchedulingPolicyExists checks if the DataCampusSchedulingPolicy row exists.
+func DataCampusSchedulingPolicyExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_campus_scheduling_policies` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_campus_scheduling_policies exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataCampusSchedulingPolicy row exists.
+func (o *DataCampusSchedulingPolicy) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataCampusSchedulingPolicyExists(ctx, exec, o.ID)
+}
@@ -68,75 +68,6 @@ var DataCampuseTableColumns = struct {
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod   { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod  { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var DataCampuseWhere = struct {
 	ID         whereHelperint
 	Campus     whereHelperstring
@@ -155,20 +86,38 @@ var DataCampuseWhere = struct {
 
 // DataCampuseRels is where relationship names are stored.
 var DataCampuseRels = struct {
-	CampusDataLessons  string
-	CampusDataRooms    string
-	CampusTBLSchedules string
+	CampusDataCampusSchedulingPolicy string
+	CampusDataCampusBlockedPeriods   string
+	CampusDataCampusOpeningHours     string
+	CampusDataCampusRoomItemTypes    string
+	CampusDataLessons                string
+	CampusDataRooms                  string
+	CampusTBLScheduleDayBundles      string
+	CampusTBLSchedules               string
+	CampusTBLUserCampusRoles         string
 }{
-	CampusDataLessons:  "CampusDataLessons",
-	CampusDataRooms:    "CampusDataRooms",
-	CampusTBLSchedules: "CampusTBLSchedules",
+	CampusDataCampusSchedulingPolicy: "CampusDataCampusSchedulingPolicy",
+	CampusDataCampusBlockedPeriods:   "CampusDataCampusBlockedPeriods",
+	CampusDataCampusOpeningHours:     "CampusDataCampusOpeningHours",
+	CampusDataCampusRoomItemTypes:    "CampusDataCampusRoomItemTypes",
+	CampusDataLessons:                "CampusDataLessons",
+	CampusDataRooms:                  "CampusDataRooms",
+	CampusTBLScheduleDayBundles:      "CampusTBLScheduleDayBundles",
+	CampusTBLSchedules:               "CampusTBLSchedules",
+	CampusTBLUserCampusRoles:         "CampusTBLUserCampusRoles",
 }
 
 // dataCampuseR is where relationships are stored.
 type dataCampuseR struct {
-	CampusDataLessons  DataLessonSlice  `boil:"CampusDataLessons" json:"CampusDataLessons" toml:"CampusDataLessons" yaml:"CampusDataLessons"`
-	CampusDataRooms    DataRoomSlice    `boil:"CampusDataRooms" json:"CampusDataRooms" toml:"CampusDataRooms" yaml:"CampusDataRooms"`
-	CampusTBLSchedules TBLScheduleSlice `boil:"CampusTBLSchedules" json:"CampusTBLSchedules" toml:"CampusTBLSchedules" yaml:"CampusTBLSchedules"`
+	CampusDataCampusSchedulingPolicy *DataCampusSchedulingPolicy  `boil:"CampusDataCampusSchedulingPolicy" json:"CampusDataCampusSchedulingPolicy" toml:"CampusDataCampusSchedulingPolicy" yaml:"CampusDataCampusSchedulingPolicy"`
+	CampusDataCampusBlockedPeriods   DataCampusBlockedPeriodSlice `boil:"CampusDataCampusBlockedPeriods" json:"CampusDataCampusBlockedPeriods" toml:"CampusDataCampusBlockedPeriods" yaml:"CampusDataCampusBlockedPeriods"`
+	CampusDataCampusOpeningHours     DataCampusOpeningHourSlice   `boil:"CampusDataCampusOpeningHours" json:"CampusDataCampusOpeningHours" toml:"CampusDataCampusOpeningHours" yaml:"CampusDataCampusOpeningHours"`
+	CampusDataCampusRoomItemTypes    DataCampusRoomItemTypeSlice  `boil:"CampusDataCampusRoomItemTypes" json:"CampusDataCampusRoomItemTypes" toml:"CampusDataCampusRoomItemTypes" yaml:"CampusDataCampusRoomItemTypes"`
+	CampusDataLessons                DataLessonSlice              `boil:"CampusDataLessons" json:"CampusDataLessons" toml:"CampusDataLessons" yaml:"CampusDataLessons"`
+	CampusDataRooms                  DataRoomSlice                `boil:"CampusDataRooms" json:"CampusDataRooms" toml:"CampusDataRooms" yaml:"CampusDataRooms"`
+	CampusTBLScheduleDayBundles      TBLScheduleDayBundleSlice    `boil:"CampusTBLScheduleDayBundles" json:"CampusTBLScheduleDayBundles" toml:"CampusTBLScheduleDayBundles" yaml:"CampusTBLScheduleDayBundles"`
+	CampusTBLSchedules               TBLScheduleSlice             `boil:"CampusTBLSchedules" json:"CampusTBLSchedules" toml:"CampusTBLSchedules" yaml:"CampusTBLSchedules"`
+	CampusTBLUserCampusRoles         TBLUserCampusRoleSlice       `boil:"CampusTBLUserCampusRoles" json:"CampusTBLUserCampusRoles" toml:"CampusTBLUserCampusRoles" yaml:"CampusTBLUserCampusRoles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -176,6 +125,70 @@ func (*dataCampuseR) NewStruct() *dataCampuseR {
 	return &dataCampuseR{}
 }
 
+func (o *DataCampuse) GetCampusDataCampusSchedulingPolicy() *DataCampusSchedulingPolicy {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampusSchedulingPolicy()
+}
+
+func (r *dataCampuseR) GetCampusDataCampusSchedulingPolicy() *DataCampusSchedulingPolicy {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampusSchedulingPolicy
+}
+
+func (o *DataCampuse) GetCampusDataCampusBlockedPeriods() DataCampusBlockedPeriodSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampusBlockedPeriods()
+}
+
+func (r *dataCampuseR) GetCampusDataCampusBlockedPeriods() DataCampusBlockedPeriodSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampusBlockedPeriods
+}
+
+func (o *DataCampuse) GetCampusDataCampusOpeningHours() DataCampusOpeningHourSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampusOpeningHours()
+}
+
+func (r *dataCampuseR) GetCampusDataCampusOpeningHours() DataCampusOpeningHourSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampusOpeningHours
+}
+
+func (o *DataCampuse) GetCampusDataCampusRoomItemTypes() DataCampusRoomItemTypeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampusRoomItemTypes()
+}
+
+func (r *dataCampuseR) GetCampusDataCampusRoomItemTypes() DataCampusRoomItemTypeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampusRoomItemTypes
+}
+
 func (o *DataCampuse) GetCampusDataLessons() DataLessonSlice {
 	if o == nil {
 		return nil
@@ -208,6 +221,22 @@ func (r *dataCampuseR) GetCampusDataRooms() DataRoomSlice {
 	return r.CampusDataRooms
 }
 
+func (o *DataCampuse) GetCampusTBLScheduleDayBundles() TBLScheduleDayBundleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusTBLScheduleDayBundles()
+}
+
+func (r *dataCampuseR) GetCampusTBLScheduleDayBundles() TBLScheduleDayBundleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusTBLScheduleDayBundles
+}
+
 func (o *DataCampuse) GetCampusTBLSchedules() TBLScheduleSlice {
 	if o == nil {
 		return nil
@@ -224,6 +253,22 @@ func (r *dataCampuseR) GetCampusTBLSchedules() TBLScheduleSlice {
 	return r.CampusTBLSchedules
 }
 
+func (o *DataCampuse) GetCampusTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusTBLUserCampusRoles()
+}
+
+func (r *dataCampuseR) GetCampusTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusTBLUserCampusRoles
+}
+
 // dataCampuseL is where Load methods for each relationship are stored.
 type dataCampuseL struct{}
 
@@ -540,6 +585,59 @@ func (q dataCampuseQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// CampusDataCampusSchedulingPolicy pointed to by the foreign key.
+func (o *DataCampuse) CampusDataCampusSchedulingPolicy(mods ...qm.QueryMod) dataCampusSchedulingPolicyQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampusSchedulingPolicies(queryMods...)
+}
+
+// CampusDataCampusBlockedPeriods retrieves all the data_campus_blocked_period's DataCampusBlockedPeriods with an executor via campus column.
+func (o *DataCampuse) CampusDataCampusBlockedPeriods(mods ...qm.QueryMod) dataCampusBlockedPeriodQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_campus_blocked_periods`.`campus`=?", o.Campus),
+	)
+
+	return DataCampusBlockedPeriods(queryMods...)
+}
+
+// CampusDataCampusOpeningHours retrieves all the data_campus_opening_hour's DataCampusOpeningHours with an executor via campus column.
+func (o *DataCampuse) CampusDataCampusOpeningHours(mods ...qm.QueryMod) dataCampusOpeningHourQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_campus_opening_hours`.`campus`=?", o.Campus),
+	)
+
+	return DataCampusOpeningHours(queryMods...)
+}
+
+// CampusDataCampusRoomItemTypes retrieves all the data_campus_room_item_type's DataCampusRoomItemTypes with an executor via campus column.
+func (o *DataCampuse) CampusDataCampusRoomItemTypes(mods ...qm.QueryMod) dataCampusRoomItemTypeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_campus_room_item_types`.`campus`=?", o.Campus),
+	)
+
+	return DataCampusRoomItemTypes(queryMods...)
+}
+
 // CampusDataLessons retrieves all the data_lesson's DataLessons with an executor via campus column.
 func (o *DataCampuse) CampusDataLessons(mods ...qm.QueryMod) dataLessonQuery {
 	var queryMods []qm.QueryMod
@@ -568,6 +666,20 @@ func (o *DataCampuse) CampusDataRooms(mods ...qm.QueryMod) dataRoomQuery {
 	return DataRooms(queryMods...)
 }
 
+// CampusTBLScheduleDayBundles retrieves all the tbl_schedule_day_bundle's TBLScheduleDayBundles with an executor via campus column.
+func (o *DataCampuse) CampusTBLScheduleDayBundles(mods ...qm.QueryMod) tblScheduleDayBundleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_day_bundles`.`campus`=?", o.Campus),
+	)
+
+	return TBLScheduleDayBundles(queryMods...)
+}
+
 // CampusTBLSchedules retrieves all the tbl_schedule's TBLSchedules with an executor via campus column.
 func (o *DataCampuse) CampusTBLSchedules(mods ...qm.QueryMod) tblScheduleQuery {
 	var queryMods []qm.QueryMod
@@ -582,9 +694,23 @@ func (o *DataCampuse) CampusTBLSchedules(mods ...qm.QueryMod) tblScheduleQuery {
 	return TBLSchedules(queryMods...)
 }
 
-// LoadCampusDataLessons allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+// CampusTBLUserCampusRoles retrieves all the tbl_user_campus_role's TBLUserCampusRoles with an executor via campus column.
+func (o *DataCampuse) CampusTBLUserCampusRoles(mods ...qm.QueryMod) tblUserCampusRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_campus_roles`.`campus`=?", o.Campus),
+	)
+
+	return TBLUserCampusRoles(queryMods...)
+}
+
+// LoadCampusDataCampusSchedulingPolicy allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (dataCampuseL) LoadCampusDataCampusSchedulingPolicy(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
 	var slice []*DataCampuse
 	var object *DataCampuse
 
@@ -621,6 +747,7 @@ func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExe
 			if obj.R == nil {
 				obj.R = &dataCampuseR{}
 			}
+
 			args[obj.Campus] = struct{}{}
 		}
 	}
@@ -637,8 +764,8 @@ func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExe
 	}
 
 	query := NewQuery(
-		qm.From(`data_lessons`),
-		qm.WhereIn(`data_lessons.campus in ?`, argsSlice...),
+		qm.From(`data_campus_scheduling_policies`),
+		qm.WhereIn(`data_campus_scheduling_policies.campus in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -646,45 +773,48 @@ func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExe
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load data_lessons")
+		return errors.Wrap(err, "failed to eager load DataCampusSchedulingPolicy")
 	}
 
-	var resultSlice []*DataLesson
+	var resultSlice []*DataCampusSchedulingPolicy
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice data_lessons")
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampusSchedulingPolicy")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on data_lessons")
+		return errors.Wrap(err, "failed to close results of eager load for data_campus_scheduling_policies")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_lessons")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campus_scheduling_policies")
 	}
 
-	if len(dataLessonAfterSelectHooks) != 0 {
+	if len(dataCampusSchedulingPolicyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CampusDataLessons = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &dataLessonR{}
-			}
-			foreign.R.CampusDataCampuse = object
+		foreign := resultSlice[0]
+		object.R.CampusDataCampusSchedulingPolicy = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampusSchedulingPolicyR{}
 		}
-		return nil
+		foreign.R.CampusDataCampuse = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.Campus == foreign.Campus {
-				local.R.CampusDataLessons = append(local.R.CampusDataLessons, foreign)
+				local.R.CampusDataCampusSchedulingPolicy = foreign
 				if foreign.R == nil {
-					foreign.R = &dataLessonR{}
+					foreign.R = &dataCampusSchedulingPolicyR{}
 				}
 				foreign.R.CampusDataCampuse = local
 				break
@@ -695,9 +825,9 @@ func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExe
 	return nil
 }
 
-// LoadCampusDataRooms allows an eager lookup of values, cached into the
+// LoadCampusDataCampusBlockedPeriods allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+func (dataCampuseL) LoadCampusDataCampusBlockedPeriods(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
 	var slice []*DataCampuse
 	var object *DataCampuse
 
@@ -750,8 +880,8 @@ func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecu
 	}
 
 	query := NewQuery(
-		qm.From(`data_rooms`),
-		qm.WhereIn(`data_rooms.campus in ?`, argsSlice...),
+		qm.From(`data_campus_blocked_periods`),
+		qm.WhereIn(`data_campus_blocked_periods.campus in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -759,22 +889,22 @@ func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load data_rooms")
+		return errors.Wrap(err, "failed to eager load data_campus_blocked_periods")
 	}
 
-	var resultSlice []*DataRoom
+	var resultSlice []*DataCampusBlockedPeriod
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice data_rooms")
+		return errors.Wrap(err, "failed to bind eager loaded slice data_campus_blocked_periods")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on data_rooms")
+		return errors.Wrap(err, "failed to close results in eager load on data_campus_blocked_periods")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_rooms")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campus_blocked_periods")
 	}
 
-	if len(dataRoomAfterSelectHooks) != 0 {
+	if len(dataCampusBlockedPeriodAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -782,10 +912,10 @@ func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.CampusDataRooms = resultSlice
+		object.R.CampusDataCampusBlockedPeriods = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &dataRoomR{}
+				foreign.R = &dataCampusBlockedPeriodR{}
 			}
 			foreign.R.CampusDataCampuse = object
 		}
@@ -795,9 +925,9 @@ func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.Campus == foreign.Campus {
-				local.R.CampusDataRooms = append(local.R.CampusDataRooms, foreign)
+				local.R.CampusDataCampusBlockedPeriods = append(local.R.CampusDataCampusBlockedPeriods, foreign)
 				if foreign.R == nil {
-					foreign.R = &dataRoomR{}
+					foreign.R = &dataCampusBlockedPeriodR{}
 				}
 				foreign.R.CampusDataCampuse = local
 				break
@@ -808,9 +938,9 @@ func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadCampusTBLSchedules allows an eager lookup of values, cached into the
+// LoadCampusDataCampusOpeningHours allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+func (dataCampuseL) LoadCampusDataCampusOpeningHours(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
 	var slice []*DataCampuse
 	var object *DataCampuse
 
@@ -863,8 +993,8 @@ func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_schedules`),
-		qm.WhereIn(`tbl_schedules.campus in ?`, argsSlice...),
+		qm.From(`data_campus_opening_hours`),
+		qm.WhereIn(`data_campus_opening_hours.campus in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -872,22 +1002,22 @@ func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_schedules")
+		return errors.Wrap(err, "failed to eager load data_campus_opening_hours")
 	}
 
-	var resultSlice []*TBLSchedule
+	var resultSlice []*DataCampusOpeningHour
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+		return errors.Wrap(err, "failed to bind eager loaded slice data_campus_opening_hours")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+		return errors.Wrap(err, "failed to close results in eager load on data_campus_opening_hours")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campus_opening_hours")
 	}
 
-	if len(tblScheduleAfterSelectHooks) != 0 {
+	if len(dataCampusOpeningHourAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -895,10 +1025,10 @@ func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextEx
 		}
 	}
 	if singular {
-		object.R.CampusTBLSchedules = resultSlice
+		object.R.CampusDataCampusOpeningHours = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tblScheduleR{}
+				foreign.R = &dataCampusOpeningHourR{}
 			}
 			foreign.R.CampusDataCampuse = object
 		}
@@ -908,9 +1038,9 @@ func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextEx
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.Campus == foreign.Campus {
-				local.R.CampusTBLSchedules = append(local.R.CampusTBLSchedules, foreign)
+				local.R.CampusDataCampusOpeningHours = append(local.R.CampusDataCampusOpeningHours, foreign)
 				if foreign.R == nil {
-					foreign.R = &tblScheduleR{}
+					foreign.R = &dataCampusOpeningHourR{}
 				}
 				foreign.R.CampusDataCampuse = local
 				break
@@ -921,45 +1051,932 @@ func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// AddCampusDataLessons adds the given related objects to the existing relationships
-// of the data_campuse, optionally inserting them as new records.
-// Appends related to o.R.CampusDataLessons.
-// Sets related.R.CampusDataCampuse appropriately.
-func (o *DataCampuse) AddCampusDataLessons(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataLesson) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.Campus = o.Campus
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `data_lessons` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
-				strmangle.WhereClause("`", "`", 0, dataLessonPrimaryKeyColumns),
-			)
-			values := []interface{}{o.Campus, rel.ID}
+// LoadCampusDataCampusRoomItemTypes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusDataCampusRoomItemTypes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
 			}
-
-			rel.Campus = o.Campus
 		}
 	}
 
-	if o.R == nil {
-		o.R = &dataCampuseR{
-			CampusDataLessons: related,
-		}
-	} else {
-		o.R.CampusDataLessons = append(o.R.CampusDataLessons, related...)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campus_room_item_types`),
+		qm.WhereIn(`data_campus_room_item_types.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_campus_room_item_types")
+	}
+
+	var resultSlice []*DataCampusRoomItemType
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_campus_room_item_types")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_campus_room_item_types")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campus_room_item_types")
+	}
+
+	if len(dataCampusRoomItemTypeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusDataCampusRoomItemTypes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataCampusRoomItemTypeR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampusRoomItemTypes = append(local.R.CampusDataCampusRoomItemTypes, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataCampusRoomItemTypeR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusDataLessons allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusDataLessons(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_lessons`),
+		qm.WhereIn(`data_lessons.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_lessons")
+	}
+
+	var resultSlice []*DataLesson
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_lessons")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_lessons")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_lessons")
+	}
+
+	if len(dataLessonAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusDataLessons = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataLessonR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataLessons = append(local.R.CampusDataLessons, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataLessonR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusDataRooms allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusDataRooms(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_rooms`),
+		qm.WhereIn(`data_rooms.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_rooms")
+	}
+
+	var resultSlice []*DataRoom
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_rooms")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_rooms")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_rooms")
+	}
+
+	if len(dataRoomAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusDataRooms = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataRoomR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataRooms = append(local.R.CampusDataRooms, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataRoomR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusTBLScheduleDayBundles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusTBLScheduleDayBundles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_day_bundles`),
+		qm.WhereIn(`tbl_schedule_day_bundles.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_day_bundles")
+	}
+
+	var resultSlice []*TBLScheduleDayBundle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_day_bundles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_day_bundles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_day_bundles")
+	}
+
+	if len(tblScheduleDayBundleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusTBLScheduleDayBundles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleDayBundleR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusTBLScheduleDayBundles = append(local.R.CampusTBLScheduleDayBundles, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleDayBundleR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusTBLSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedules")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusTBLSchedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusTBLSchedules = append(local.R.CampusTBLSchedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusTBLUserCampusRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataCampuseL) LoadCampusTBLUserCampusRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataCampuse interface{}, mods queries.Applicator) error {
+	var slice []*DataCampuse
+	var object *DataCampuse
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataCampuse.(*DataCampuse)
+		if !ok {
+			object = new(DataCampuse)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataCampuse))
+			}
+		}
+	} else {
+		s, ok := maybeDataCampuse.(*[]*DataCampuse)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataCampuse)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataCampuse))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataCampuseR{}
+		}
+		args[object.Campus] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataCampuseR{}
+			}
+			args[obj.Campus] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_campus_roles`),
+		qm.WhereIn(`tbl_user_campus_roles.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_campus_roles")
+	}
+
+	var resultSlice []*TBLUserCampusRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_campus_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_campus_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_campus_roles")
+	}
+
+	if len(tblUserCampusRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CampusTBLUserCampusRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserCampusRoleR{}
+			}
+			foreign.R.CampusDataCampuse = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusTBLUserCampusRoles = append(local.R.CampusTBLUserCampusRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserCampusRoleR{}
+				}
+				foreign.R.CampusDataCampuse = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampusSchedulingPolicy of the dataCampuse to the related item.
+// Sets o.R.CampusDataCampusSchedulingPolicy to related.
+// Adds o to related.R.CampusDataCampuse.
+func (o *DataCampuse) SetCampusDataCampusSchedulingPolicy(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampusSchedulingPolicy) error {
+	var err error
+
+	if insert {
+		related.Campus = o.Campus
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `data_campus_scheduling_policies` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+			strmangle.WhereClause("`", "`", 0, dataCampusSchedulingPolicyPrimaryKeyColumns),
+		)
+		values := []interface{}{o.Campus, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.Campus = o.Campus
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusDataCampusSchedulingPolicy: related,
+		}
+	} else {
+		o.R.CampusDataCampusSchedulingPolicy = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampusSchedulingPolicyR{
+			CampusDataCampuse: o,
+		}
+	} else {
+		related.R.CampusDataCampuse = o
+	}
+	return nil
+}
+
+// AddCampusDataCampusBlockedPeriods adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusDataCampusBlockedPeriods.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusDataCampusBlockedPeriods(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataCampusBlockedPeriod) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_campus_blocked_periods` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, dataCampusBlockedPeriodPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusDataCampusBlockedPeriods: related,
+		}
+	} else {
+		o.R.CampusDataCampusBlockedPeriods = append(o.R.CampusDataCampusBlockedPeriods, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataCampusBlockedPeriodR{
+				CampusDataCampuse: o,
+			}
+		} else {
+			rel.R.CampusDataCampuse = o
+		}
+	}
+	return nil
+}
+
+// AddCampusDataCampusOpeningHours adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusDataCampusOpeningHours.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusDataCampusOpeningHours(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataCampusOpeningHour) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_campus_opening_hours` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, dataCampusOpeningHourPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusDataCampusOpeningHours: related,
+		}
+	} else {
+		o.R.CampusDataCampusOpeningHours = append(o.R.CampusDataCampusOpeningHours, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataCampusOpeningHourR{
+				CampusDataCampuse: o,
+			}
+		} else {
+			rel.R.CampusDataCampuse = o
+		}
+	}
+	return nil
+}
+
+// AddCampusDataCampusRoomItemTypes adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusDataCampusRoomItemTypes.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusDataCampusRoomItemTypes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataCampusRoomItemType) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_campus_room_item_types` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, dataCampusRoomItemTypePrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusDataCampusRoomItemTypes: related,
+		}
+	} else {
+		o.R.CampusDataCampusRoomItemTypes = append(o.R.CampusDataCampusRoomItemTypes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataCampusRoomItemTypeR{
+				CampusDataCampuse: o,
+			}
+		} else {
+			rel.R.CampusDataCampuse = o
+		}
+	}
+	return nil
+}
+
+// AddCampusDataLessons adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusDataLessons.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusDataLessons(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataLesson) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_lessons` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, dataLessonPrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusDataLessons: related,
+		}
+	} else {
+		o.R.CampusDataLessons = append(o.R.CampusDataLessons, related...)
 	}
 
 	for _, rel := range related {
@@ -1027,6 +2044,59 @@ func (o *DataCampuse) AddCampusDataRooms(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
+// AddCampusTBLScheduleDayBundles adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusTBLScheduleDayBundles.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusTBLScheduleDayBundles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleDayBundle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusTBLScheduleDayBundles: related,
+		}
+	} else {
+		o.R.CampusTBLScheduleDayBundles = append(o.R.CampusTBLScheduleDayBundles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleDayBundleR{
+				CampusDataCampuse: o,
+			}
+		} else {
+			rel.R.CampusDataCampuse = o
+		}
+	}
+	return nil
+}
+
 // AddCampusTBLSchedules adds the given related objects to the existing relationships
 // of the data_campuse, optionally inserting them as new records.
 // Appends related to o.R.CampusTBLSchedules.
@@ -1080,6 +2150,59 @@ func (o *DataCampuse) AddCampusTBLSchedules(ctx context.Context, exec boil.Conte
 	return nil
 }
 
+// AddCampusTBLUserCampusRoles adds the given related objects to the existing relationships
+// of the data_campuse, optionally inserting them as new records.
+// Appends related to o.R.CampusTBLUserCampusRoles.
+// Sets related.R.CampusDataCampuse appropriately.
+func (o *DataCampuse) AddCampusTBLUserCampusRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserCampusRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Campus = o.Campus
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+				strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.Campus, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Campus = o.Campus
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataCampuseR{
+			CampusTBLUserCampusRoles: related,
+		}
+	} else {
+		o.R.CampusTBLUserCampusRoles = append(o.R.CampusTBLUserCampusRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserCampusRoleR{
+				CampusDataCampuse: o,
+			}
+		} else {
+			rel.R.CampusDataCampuse = o
+		}
+	}
+	return nil
+}
+
 // DataCampuses retrieves all the records using an executor.
 func DataCampuses(mods ...qm.QueryMod) dataCampuseQuery {
 	mods = append(mods, qm.From("`data_campuses`"))
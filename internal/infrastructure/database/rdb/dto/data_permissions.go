@@ -0,0 +1,1208 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataPermission is an object representing the database table.
+type DataPermission struct {
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PermissionKey  string    `boil:"permission_key" json:"permission_key" toml:"permission_key" yaml:"permission_key"`
+	PermissionName string    `boil:"permission_name" json:"permission_name" toml:"permission_name" yaml:"permission_name"`
+	OrderIndex     int       `boil:"order_index" json:"order_index" toml:"order_index" yaml:"order_index"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataPermissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataPermissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataPermissionColumns = struct {
+	ID             string
+	PermissionKey  string
+	PermissionName string
+	OrderIndex     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	PermissionKey:  "permission_key",
+	PermissionName: "permission_name",
+	OrderIndex:     "order_index",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var DataPermissionTableColumns = struct {
+	ID             string
+	PermissionKey  string
+	PermissionName string
+	OrderIndex     string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "data_permissions.id",
+	PermissionKey:  "data_permissions.permission_key",
+	PermissionName: "data_permissions.permission_name",
+	OrderIndex:     "data_permissions.order_index",
+	CreatedAt:      "data_permissions.created_at",
+	UpdatedAt:      "data_permissions.updated_at",
+}
+
+// Generated where
+
+var DataPermissionWhere = struct {
+	ID             whereHelperint
+	PermissionKey  whereHelperstring
+	PermissionName whereHelperstring
+	OrderIndex     whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint{field: "`data_permissions`.`id`"},
+	PermissionKey:  whereHelperstring{field: "`data_permissions`.`permission_key`"},
+	PermissionName: whereHelperstring{field: "`data_permissions`.`permission_name`"},
+	OrderIndex:     whereHelperint{field: "`data_permissions`.`order_index`"},
+	CreatedAt:      whereHelpertime_Time{field: "`data_permissions`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`data_permissions`.`updated_at`"},
+}
+
+// DataPermissionRels is where relationship names are stored.
+var DataPermissionRels = struct {
+	PermissionKeyDataRolePermissions string
+}{
+	PermissionKeyDataRolePermissions: "PermissionKeyDataRolePermissions",
+}
+
+// dataPermissionR is where relationships are stored.
+type dataPermissionR struct {
+	PermissionKeyDataRolePermissions DataRolePermissionSlice `boil:"PermissionKeyDataRolePermissions" json:"PermissionKeyDataRolePermissions" toml:"PermissionKeyDataRolePermissions" yaml:"PermissionKeyDataRolePermissions"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataPermissionR) NewStruct() *dataPermissionR {
+	return &dataPermissionR{}
+}
+
+func (o *DataPermission) GetPermissionKeyDataRolePermissions() DataRolePermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPermissionKeyDataRolePermissions()
+}
+
+func (r *dataPermissionR) GetPermissionKeyDataRolePermissions() DataRolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PermissionKeyDataRolePermissions
+}
+
+// dataPermissionL is where Load methods for each relationship are stored.
+type dataPermissionL struct{}
+
+var (
+	dataPermissionAllColumns            = []string{"id", "permission_key", "permission_name", "order_index", "created_at", "updated_at"}
+	dataPermissionColumnsWithoutDefault = []string{"permission_key", "permission_name", "order_index"}
+	dataPermissionColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	dataPermissionPrimaryKeyColumns     = []string{"id"}
+	dataPermissionGeneratedColumns      = []string{}
+)
+
+type (
+	// DataPermissionSlice is an alias for a slice of pointers to DataPermission.
+	// This should almost always be used instead of []DataPermission.
+	DataPermissionSlice []*DataPermission
+	// DataPermissionHook is the signature for custom DataPermission hook methods
+	DataPermissionHook func(context.Context, boil.ContextExecutor, *DataPermission) error
+
+	dataPermissionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataPermissionType                 = reflect.TypeOf(&DataPermission{})
+	dataPermissionMapping              = queries.MakeStructMapping(dataPermissionType)
+	dataPermissionPrimaryKeyMapping, _ = queries.BindMapping(dataPermissionType, dataPermissionMapping, dataPermissionPrimaryKeyColumns)
+	dataPermissionInsertCacheMut       sync.RWMutex
+	dataPermissionInsertCache          = make(map[string]insertCache)
+	dataPermissionUpdateCacheMut       sync.RWMutex
+	dataPermissionUpdateCache          = make(map[string]updateCache)
+	dataPermissionUpsertCacheMut       sync.RWMutex
+	dataPermissionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataPermissionAfterSelectMu sync.Mutex
+var dataPermissionAfterSelectHooks []DataPermissionHook
+
+var dataPermissionBeforeInsertMu sync.Mutex
+var dataPermissionBeforeInsertHooks []DataPermissionHook
+var dataPermissionAfterInsertMu sync.Mutex
+var dataPermissionAfterInsertHooks []DataPermissionHook
+
+var dataPermissionBeforeUpdateMu sync.Mutex
+var dataPermissionBeforeUpdateHooks []DataPermissionHook
+var dataPermissionAfterUpdateMu sync.Mutex
+var dataPermissionAfterUpdateHooks []DataPermissionHook
+
+var dataPermissionBeforeDeleteMu sync.Mutex
+var dataPermissionBeforeDeleteHooks []DataPermissionHook
+var dataPermissionAfterDeleteMu sync.Mutex
+var dataPermissionAfterDeleteHooks []DataPermissionHook
+
+var dataPermissionBeforeUpsertMu sync.Mutex
+var dataPermissionBeforeUpsertHooks []DataPermissionHook
+var dataPermissionAfterUpsertMu sync.Mutex
+var dataPermissionAfterUpsertHooks []DataPermissionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataPermission) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataPermission) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataPermission) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataPermission) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataPermission) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataPermission) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataPermission) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataPermission) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataPermission) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataPermissionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataPermissionHook registers your hook function for all future operations.
+func AddDataPermissionHook(hookPoint boil.HookPoint, dataPermissionHook DataPermissionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataPermissionAfterSelectMu.Lock()
+		dataPermissionAfterSelectHooks = append(dataPermissionAfterSelectHooks, dataPermissionHook)
+		dataPermissionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataPermissionBeforeInsertMu.Lock()
+		dataPermissionBeforeInsertHooks = append(dataPermissionBeforeInsertHooks, dataPermissionHook)
+		dataPermissionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataPermissionAfterInsertMu.Lock()
+		dataPermissionAfterInsertHooks = append(dataPermissionAfterInsertHooks, dataPermissionHook)
+		dataPermissionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataPermissionBeforeUpdateMu.Lock()
+		dataPermissionBeforeUpdateHooks = append(dataPermissionBeforeUpdateHooks, dataPermissionHook)
+		dataPermissionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataPermissionAfterUpdateMu.Lock()
+		dataPermissionAfterUpdateHooks = append(dataPermissionAfterUpdateHooks, dataPermissionHook)
+		dataPermissionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataPermissionBeforeDeleteMu.Lock()
+		dataPermissionBeforeDeleteHooks = append(dataPermissionBeforeDeleteHooks, dataPermissionHook)
+		dataPermissionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataPermissionAfterDeleteMu.Lock()
+		dataPermissionAfterDeleteHooks = append(dataPermissionAfterDeleteHooks, dataPermissionHook)
+		dataPermissionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataPermissionBeforeUpsertMu.Lock()
+		dataPermissionBeforeUpsertHooks = append(dataPermissionBeforeUpsertHooks, dataPermissionHook)
+		dataPermissionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataPermissionAfterUpsertMu.Lock()
+		dataPermissionAfterUpsertHooks = append(dataPermissionAfterUpsertHooks, dataPermissionHook)
+		dataPermissionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataPermission record from the query.
+func (q dataPermissionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataPermission, error) {
+	o := &DataPermission{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_permissions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataPermission records from the query.
+func (q dataPermissionQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataPermissionSlice, error) {
+	var o []*DataPermission
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataPermission slice")
+	}
+
+	if len(dataPermissionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataPermission records in the query.
+func (q dataPermissionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_permissions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataPermissionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_permissions exists")
+	}
+
+	return count > 0, nil
+}
+
+// PermissionKeyDataRolePermissions retrieves all the data_role_permission's DataRolePermissions with an executor via permission_key column.
+func (o *DataPermission) PermissionKeyDataRolePermissions(mods ...qm.QueryMod) dataRolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_role_permissions`.`permission_key`=?", o.PermissionKey),
+	)
+
+	return DataRolePermissions(queryMods...)
+}
+
+// LoadPermissionKeyDataRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataPermissionL) LoadPermissionKeyDataRolePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataPermission interface{}, mods queries.Applicator) error {
+	var slice []*DataPermission
+	var object *DataPermission
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataPermission.(*DataPermission)
+		if !ok {
+			object = new(DataPermission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataPermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataPermission))
+			}
+		}
+	} else {
+		s, ok := maybeDataPermission.(*[]*DataPermission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataPermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataPermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataPermissionR{}
+		}
+		args[object.PermissionKey] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataPermissionR{}
+			}
+			args[obj.PermissionKey] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_role_permissions`),
+		qm.WhereIn(`data_role_permissions.permission_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_role_permissions")
+	}
+
+	var resultSlice []*DataRolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_role_permissions")
+	}
+
+	if len(dataRolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PermissionKeyDataRolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataRolePermissionR{}
+			}
+			foreign.R.PermissionKeyDataPermission = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.PermissionKey == foreign.PermissionKey {
+				local.R.PermissionKeyDataRolePermissions = append(local.R.PermissionKeyDataRolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataRolePermissionR{}
+				}
+				foreign.R.PermissionKeyDataPermission = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPermissionKeyDataRolePermissions adds the given related objects to the existing relationships
+// of the data_permission, optionally inserting them as new records.
+// Appends related to o.R.PermissionKeyDataRolePermissions.
+// Sets related.R.PermissionKeyDataPermission appropriately.
+func (o *DataPermission) AddPermissionKeyDataRolePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataRolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PermissionKey = o.PermissionKey
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_role_permissions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"permission_key"}),
+				strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.PermissionKey, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PermissionKey = o.PermissionKey
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataPermissionR{
+			PermissionKeyDataRolePermissions: related,
+		}
+	} else {
+		o.R.PermissionKeyDataRolePermissions = append(o.R.PermissionKeyDataRolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataRolePermissionR{
+				PermissionKeyDataPermission: o,
+			}
+		} else {
+			rel.R.PermissionKeyDataPermission = o
+		}
+	}
+	return nil
+}
+
+// DataPermissions retrieves all the records using an executor.
+func DataPermissions(mods ...qm.QueryMod) dataPermissionQuery {
+	mods = append(mods, qm.From("`data_permissions`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_permissions`.*"})
+	}
+
+	return dataPermissionQuery{q}
+}
+
+// FindDataPermission retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataPermission(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataPermission, error) {
+	dataPermissionObj := &DataPermission{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_permissions` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataPermissionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_permissions")
+	}
+
+	if err = dataPermissionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataPermissionObj, err
+	}
+
+	return dataPermissionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataPermission) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_permissions provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataPermissionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataPermissionInsertCacheMut.RLock()
+	cache, cached := dataPermissionInsertCache[key]
+	dataPermissionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataPermissionAllColumns,
+			dataPermissionColumnsWithDefault,
+			dataPermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_permissions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_permissions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_permissions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataPermissionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_permissions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataPermissionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_permissions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataPermissionInsertCacheMut.Lock()
+		dataPermissionInsertCache[key] = cache
+		dataPermissionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataPermission.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataPermission) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataPermissionUpdateCacheMut.RLock()
+	cache, cached := dataPermissionUpdateCache[key]
+	dataPermissionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataPermissionAllColumns,
+			dataPermissionPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_permissions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_permissions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataPermissionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, append(wl, dataPermissionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_permissions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_permissions")
+	}
+
+	if !cached {
+		dataPermissionUpdateCacheMut.Lock()
+		dataPermissionUpdateCache[key] = cache
+		dataPermissionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataPermissionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataPermissionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataPermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_permissions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataPermissionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataPermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataPermission")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataPermissionUniqueColumns = []string{
+	"id",
+	"permission_key",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataPermission) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_permissions provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataPermissionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataPermissionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataPermissionUpsertCacheMut.RLock()
+	cache, cached := dataPermissionUpsertCache[key]
+	dataPermissionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataPermissionAllColumns,
+			dataPermissionColumnsWithDefault,
+			dataPermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataPermissionAllColumns,
+			dataPermissionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_permissions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataPermissionAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_permissions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_permissions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_permissions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataPermissionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataPermissionType, dataPermissionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_permissions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_permissions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataPermissionUpsertCacheMut.Lock()
+		dataPermissionUpsertCache[key] = cache
+		dataPermissionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataPermission record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataPermission) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataPermission provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataPermissionPrimaryKeyMapping)
+	sql := "DELETE FROM `data_permissions` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_permissions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataPermissionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataPermissionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataPermissionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataPermissionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataPermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_permissions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataPermissionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataPermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_permissions")
+	}
+
+	if len(dataPermissionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataPermission) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataPermission(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataPermissionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataPermissionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataPermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_permissions`.* FROM `data_permissions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataPermissionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataPermissionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataPermissionExists checks if the DataPermission row exists.
+func DataPermissionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_permissions` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_permissions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataPermission row exists.
+func (o *DataPermission) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataPermissionExists(ctx, exec, o.ID)
+}
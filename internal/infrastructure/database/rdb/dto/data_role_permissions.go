@@ -0,0 +1,1395 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// DataRolePermission is an object representing the database table.
+type DataRolePermission struct {
+	ID            int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	RoleKey       string    `boil:"role_key" json:"role_key" toml:"role_key" yaml:"role_key"`
+	PermissionKey string    `boil:"permission_key" json:"permission_key" toml:"permission_key" yaml:"permission_key"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *dataRolePermissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataRolePermissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataRolePermissionColumns = struct {
+	ID            string
+	RoleKey       string
+	PermissionKey string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	RoleKey:       "role_key",
+	PermissionKey: "permission_key",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var DataRolePermissionTableColumns = struct {
+	ID            string
+	RoleKey       string
+	PermissionKey string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "data_role_permissions.id",
+	RoleKey:       "data_role_permissions.role_key",
+	PermissionKey: "data_role_permissions.permission_key",
+	CreatedAt:     "data_role_permissions.created_at",
+	UpdatedAt:     "data_role_permissions.updated_at",
+}
+
+// Generated where
+
+var DataRolePermissionWhere = struct {
+	ID            whereHelperint
+	RoleKey       whereHelperstring
+	PermissionKey whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperint{field: "`data_role_permissions`.`id`"},
+	RoleKey:       whereHelperstring{field: "`data_role_permissions`.`role_key`"},
+	PermissionKey: whereHelperstring{field: "`data_role_permissions`.`permission_key`"},
+	CreatedAt:     whereHelpertime_Time{field: "`data_role_permissions`.`created_at`"},
+	UpdatedAt:     whereHelpertime_Time{field: "`data_role_permissions`.`updated_at`"},
+}
+
+// DataRolePermissionRels is where relationship names are stored.
+var DataRolePermissionRels = struct {
+	RoleKeyDataRole             string
+	PermissionKeyDataPermission string
+}{
+	RoleKeyDataRole:             "RoleKeyDataRole",
+	PermissionKeyDataPermission: "PermissionKeyDataPermission",
+}
+
+// dataRolePermissionR is where relationships are stored.
+type dataRolePermissionR struct {
+	RoleKeyDataRole             *DataRole       `boil:"RoleKeyDataRole" json:"RoleKeyDataRole" toml:"RoleKeyDataRole" yaml:"RoleKeyDataRole"`
+	PermissionKeyDataPermission *DataPermission `boil:"PermissionKeyDataPermission" json:"PermissionKeyDataPermission" toml:"PermissionKeyDataPermission" yaml:"PermissionKeyDataPermission"`
+}
+
+// NewStruct creates a new relationship struct
+func (*dataRolePermissionR) NewStruct() *dataRolePermissionR {
+	return &dataRolePermissionR{}
+}
+
+func (o *DataRolePermission) GetRoleKeyDataRole() *DataRole {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoleKeyDataRole()
+}
+
+func (r *dataRolePermissionR) GetRoleKeyDataRole() *DataRole {
+	if r == nil {
+		return nil
+	}
+
+	return r.RoleKeyDataRole
+}
+
+func (o *DataRolePermission) GetPermissionKeyDataPermission() *DataPermission {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPermissionKeyDataPermission()
+}
+
+func (r *dataRolePermissionR) GetPermissionKeyDataPermission() *DataPermission {
+	if r == nil {
+		return nil
+	}
+
+	return r.PermissionKeyDataPermission
+}
+
+// dataRolePermissionL is where Load methods for each relationship are stored.
+type dataRolePermissionL struct{}
+
+var (
+	dataRolePermissionAllColumns            = []string{"id", "role_key", "permission_key", "created_at", "updated_at"}
+	dataRolePermissionColumnsWithoutDefault = []string{"role_key", "permission_key"}
+	dataRolePermissionColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	dataRolePermissionPrimaryKeyColumns     = []string{"id"}
+	dataRolePermissionGeneratedColumns      = []string{}
+)
+
+type (
+	// DataRolePermissionSlice is an alias for a slice of pointers to DataRolePermission.
+	// This should almost always be used instead of []DataRolePermission.
+	DataRolePermissionSlice []*DataRolePermission
+	// DataRolePermissionHook is the signature for custom DataRolePermission hook methods
+	DataRolePermissionHook func(context.Context, boil.ContextExecutor, *DataRolePermission) error
+
+	dataRolePermissionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataRolePermissionType                 = reflect.TypeOf(&DataRolePermission{})
+	dataRolePermissionMapping              = queries.MakeStructMapping(dataRolePermissionType)
+	dataRolePermissionPrimaryKeyMapping, _ = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, dataRolePermissionPrimaryKeyColumns)
+	dataRolePermissionInsertCacheMut       sync.RWMutex
+	dataRolePermissionInsertCache          = make(map[string]insertCache)
+	dataRolePermissionUpdateCacheMut       sync.RWMutex
+	dataRolePermissionUpdateCache          = make(map[string]updateCache)
+	dataRolePermissionUpsertCacheMut       sync.RWMutex
+	dataRolePermissionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataRolePermissionAfterSelectMu sync.Mutex
+var dataRolePermissionAfterSelectHooks []DataRolePermissionHook
+
+var dataRolePermissionBeforeInsertMu sync.Mutex
+var dataRolePermissionBeforeInsertHooks []DataRolePermissionHook
+var dataRolePermissionAfterInsertMu sync.Mutex
+var dataRolePermissionAfterInsertHooks []DataRolePermissionHook
+
+var dataRolePermissionBeforeUpdateMu sync.Mutex
+var dataRolePermissionBeforeUpdateHooks []DataRolePermissionHook
+var dataRolePermissionAfterUpdateMu sync.Mutex
+var dataRolePermissionAfterUpdateHooks []DataRolePermissionHook
+
+var dataRolePermissionBeforeDeleteMu sync.Mutex
+var dataRolePermissionBeforeDeleteHooks []DataRolePermissionHook
+var dataRolePermissionAfterDeleteMu sync.Mutex
+var dataRolePermissionAfterDeleteHooks []DataRolePermissionHook
+
+var dataRolePermissionBeforeUpsertMu sync.Mutex
+var dataRolePermissionBeforeUpsertHooks []DataRolePermissionHook
+var dataRolePermissionAfterUpsertMu sync.Mutex
+var dataRolePermissionAfterUpsertHooks []DataRolePermissionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataRolePermission) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataRolePermission) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataRolePermission) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataRolePermission) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataRolePermission) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataRolePermission) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataRolePermission) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataRolePermission) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataRolePermission) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataRolePermissionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataRolePermissionHook registers your hook function for all future operations.
+func AddDataRolePermissionHook(hookPoint boil.HookPoint, dataRolePermissionHook DataRolePermissionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		dataRolePermissionAfterSelectMu.Lock()
+		dataRolePermissionAfterSelectHooks = append(dataRolePermissionAfterSelectHooks, dataRolePermissionHook)
+		dataRolePermissionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		dataRolePermissionBeforeInsertMu.Lock()
+		dataRolePermissionBeforeInsertHooks = append(dataRolePermissionBeforeInsertHooks, dataRolePermissionHook)
+		dataRolePermissionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		dataRolePermissionAfterInsertMu.Lock()
+		dataRolePermissionAfterInsertHooks = append(dataRolePermissionAfterInsertHooks, dataRolePermissionHook)
+		dataRolePermissionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		dataRolePermissionBeforeUpdateMu.Lock()
+		dataRolePermissionBeforeUpdateHooks = append(dataRolePermissionBeforeUpdateHooks, dataRolePermissionHook)
+		dataRolePermissionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		dataRolePermissionAfterUpdateMu.Lock()
+		dataRolePermissionAfterUpdateHooks = append(dataRolePermissionAfterUpdateHooks, dataRolePermissionHook)
+		dataRolePermissionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		dataRolePermissionBeforeDeleteMu.Lock()
+		dataRolePermissionBeforeDeleteHooks = append(dataRolePermissionBeforeDeleteHooks, dataRolePermissionHook)
+		dataRolePermissionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		dataRolePermissionAfterDeleteMu.Lock()
+		dataRolePermissionAfterDeleteHooks = append(dataRolePermissionAfterDeleteHooks, dataRolePermissionHook)
+		dataRolePermissionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		dataRolePermissionBeforeUpsertMu.Lock()
+		dataRolePermissionBeforeUpsertHooks = append(dataRolePermissionBeforeUpsertHooks, dataRolePermissionHook)
+		dataRolePermissionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		dataRolePermissionAfterUpsertMu.Lock()
+		dataRolePermissionAfterUpsertHooks = append(dataRolePermissionAfterUpsertHooks, dataRolePermissionHook)
+		dataRolePermissionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single dataRolePermission record from the query.
+func (q dataRolePermissionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataRolePermission, error) {
+	o := &DataRolePermission{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for data_role_permissions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataRolePermission records from the query.
+func (q dataRolePermissionQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataRolePermissionSlice, error) {
+	var o []*DataRolePermission
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to DataRolePermission slice")
+	}
+
+	if len(dataRolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataRolePermission records in the query.
+func (q dataRolePermissionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count data_role_permissions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataRolePermissionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if data_role_permissions exists")
+	}
+
+	return count > 0, nil
+}
+
+// RoleKeyDataRole pointed to by the foreign key.
+func (o *DataRolePermission) RoleKeyDataRole(mods ...qm.QueryMod) dataRoleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`role_key` = ?", o.RoleKey),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataRoles(queryMods...)
+}
+
+// PermissionKeyDataPermission pointed to by the foreign key.
+func (o *DataRolePermission) PermissionKeyDataPermission(mods ...qm.QueryMod) dataPermissionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`permission_key` = ?", o.PermissionKey),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataPermissions(queryMods...)
+}
+
+// LoadRoleKeyDataRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataRolePermissionL) LoadRoleKeyDataRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataRolePermission interface{}, mods queries.Applicator) error {
+	var slice []*DataRolePermission
+	var object *DataRolePermission
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataRolePermission.(*DataRolePermission)
+		if !ok {
+			object = new(DataRolePermission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataRolePermission))
+			}
+		}
+	} else {
+		s, ok := maybeDataRolePermission.(*[]*DataRolePermission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataRolePermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataRolePermissionR{}
+		}
+		args[object.RoleKey] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataRolePermissionR{}
+			}
+
+			args[obj.RoleKey] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_roles`),
+		qm.WhereIn(`data_roles.role_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataRole")
+	}
+
+	var resultSlice []*DataRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataRole")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_roles")
+	}
+
+	if len(dataRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RoleKeyDataRole = foreign
+		if foreign.R == nil {
+			foreign.R = &dataRoleR{}
+		}
+		foreign.R.RoleKeyDataRolePermissions = append(foreign.R.RoleKeyDataRolePermissions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoleKey == foreign.RoleKey {
+				local.R.RoleKeyDataRole = foreign
+				if foreign.R == nil {
+					foreign.R = &dataRoleR{}
+				}
+				foreign.R.RoleKeyDataRolePermissions = append(foreign.R.RoleKeyDataRolePermissions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPermissionKeyDataPermission allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataRolePermissionL) LoadPermissionKeyDataPermission(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataRolePermission interface{}, mods queries.Applicator) error {
+	var slice []*DataRolePermission
+	var object *DataRolePermission
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataRolePermission.(*DataRolePermission)
+		if !ok {
+			object = new(DataRolePermission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataRolePermission))
+			}
+		}
+	} else {
+		s, ok := maybeDataRolePermission.(*[]*DataRolePermission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataRolePermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataRolePermissionR{}
+		}
+		args[object.PermissionKey] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataRolePermissionR{}
+			}
+
+			args[obj.PermissionKey] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_permissions`),
+		qm.WhereIn(`data_permissions.permission_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataPermission")
+	}
+
+	var resultSlice []*DataPermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataPermission")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_permissions")
+	}
+
+	if len(dataPermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PermissionKeyDataPermission = foreign
+		if foreign.R == nil {
+			foreign.R = &dataPermissionR{}
+		}
+		foreign.R.PermissionKeyDataRolePermissions = append(foreign.R.PermissionKeyDataRolePermissions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PermissionKey == foreign.PermissionKey {
+				local.R.PermissionKeyDataPermission = foreign
+				if foreign.R == nil {
+					foreign.R = &dataPermissionR{}
+				}
+				foreign.R.PermissionKeyDataRolePermissions = append(foreign.R.PermissionKeyDataRolePermissions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRoleKeyDataRole of the dataRolePermission to the related item.
+// Sets o.R.RoleKeyDataRole to related.
+// Adds o to related.R.RoleKeyDataRolePermissions.
+func (o *DataRolePermission) SetRoleKeyDataRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataRole) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_role_permissions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
+		strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RoleKey, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleKey = related.RoleKey
+	if o.R == nil {
+		o.R = &dataRolePermissionR{
+			RoleKeyDataRole: related,
+		}
+	} else {
+		o.R.RoleKeyDataRole = related
+	}
+
+	if related.R == nil {
+		related.R = &dataRoleR{
+			RoleKeyDataRolePermissions: DataRolePermissionSlice{o},
+		}
+	} else {
+		related.R.RoleKeyDataRolePermissions = append(related.R.RoleKeyDataRolePermissions, o)
+	}
+
+	return nil
+}
+
+// SetPermissionKeyDataPermission of the dataRolePermission to the related item.
+// Sets o.R.PermissionKeyDataPermission to related.
+// Adds o to related.R.PermissionKeyDataRolePermissions.
+func (o *DataRolePermission) SetPermissionKeyDataPermission(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataPermission) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `data_role_permissions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"permission_key"}),
+		strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.PermissionKey, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PermissionKey = related.PermissionKey
+	if o.R == nil {
+		o.R = &dataRolePermissionR{
+			PermissionKeyDataPermission: related,
+		}
+	} else {
+		o.R.PermissionKeyDataPermission = related
+	}
+
+	if related.R == nil {
+		related.R = &dataPermissionR{
+			PermissionKeyDataRolePermissions: DataRolePermissionSlice{o},
+		}
+	} else {
+		related.R.PermissionKeyDataRolePermissions = append(related.R.PermissionKeyDataRolePermissions, o)
+	}
+
+	return nil
+}
+
+// DataRolePermissions retrieves all the records using an executor.
+func DataRolePermissions(mods ...qm.QueryMod) dataRolePermissionQuery {
+	mods = append(mods, qm.From("`data_role_permissions`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`data_role_permissions`.*"})
+	}
+
+	return dataRolePermissionQuery{q}
+}
+
+// FindDataRolePermission retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataRolePermission(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*DataRolePermission, error) {
+	dataRolePermissionObj := &DataRolePermission{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `data_role_permissions` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataRolePermissionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from data_role_permissions")
+	}
+
+	if err = dataRolePermissionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return dataRolePermissionObj, err
+	}
+
+	return dataRolePermissionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataRolePermission) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_role_permissions provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataRolePermissionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataRolePermissionInsertCacheMut.RLock()
+	cache, cached := dataRolePermissionInsertCache[key]
+	dataRolePermissionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataRolePermissionAllColumns,
+			dataRolePermissionColumnsWithDefault,
+			dataRolePermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `data_role_permissions` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `data_role_permissions` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `data_role_permissions` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into data_role_permissions")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataRolePermissionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_role_permissions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataRolePermissionInsertCacheMut.Lock()
+		dataRolePermissionInsertCache[key] = cache
+		dataRolePermissionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataRolePermission.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataRolePermission) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataRolePermissionUpdateCacheMut.RLock()
+	cache, cached := dataRolePermissionUpdateCache[key]
+	dataRolePermissionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataRolePermissionAllColumns,
+			dataRolePermissionPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update data_role_permissions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `data_role_permissions` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, append(wl, dataRolePermissionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update data_role_permissions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for data_role_permissions")
+	}
+
+	if !cached {
+		dataRolePermissionUpdateCacheMut.Lock()
+		dataRolePermissionUpdateCache[key] = cache
+		dataRolePermissionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataRolePermissionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for data_role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for data_role_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataRolePermissionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataRolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `data_role_permissions` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataRolePermissionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in dataRolePermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all dataRolePermission")
+	}
+	return rowsAff, nil
+}
+
+var mySQLDataRolePermissionUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *DataRolePermission) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no data_role_permissions provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataRolePermissionColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLDataRolePermissionUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	dataRolePermissionUpsertCacheMut.RLock()
+	cache, cached := dataRolePermissionUpsertCache[key]
+	dataRolePermissionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			dataRolePermissionAllColumns,
+			dataRolePermissionColumnsWithDefault,
+			dataRolePermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			dataRolePermissionAllColumns,
+			dataRolePermissionPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert data_role_permissions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(dataRolePermissionAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`data_role_permissions`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `data_role_permissions` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for data_role_permissions")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == dataRolePermissionMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(dataRolePermissionType, dataRolePermissionMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for data_role_permissions")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for data_role_permissions")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataRolePermissionUpsertCacheMut.Lock()
+		dataRolePermissionUpsertCache[key] = cache
+		dataRolePermissionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single DataRolePermission record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataRolePermission) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no DataRolePermission provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataRolePermissionPrimaryKeyMapping)
+	sql := "DELETE FROM `data_role_permissions` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from data_role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for data_role_permissions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataRolePermissionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no dataRolePermissionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from data_role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_role_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataRolePermissionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataRolePermissionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataRolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `data_role_permissions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataRolePermissionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from dataRolePermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for data_role_permissions")
+	}
+
+	if len(dataRolePermissionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataRolePermission) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataRolePermission(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataRolePermissionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataRolePermissionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataRolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `data_role_permissions`.* FROM `data_role_permissions` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataRolePermissionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in DataRolePermissionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataRolePermissionExists checks if the DataRolePermission row exists.
+func DataRolePermissionExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `data_role_permissions` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if data_role_permissions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the DataRolePermission row exists.
+func (o *DataRolePermission) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return DataRolePermissionExists(ctx, exec, o.ID)
+}
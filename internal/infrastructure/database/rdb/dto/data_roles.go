@@ -26,6 +26,7 @@ type DataRole struct {
 	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
 	RoleKey   string    `boil:"role_key" json:"role_key" toml:"role_key" yaml:"role_key"`
 	RoleName  string    `boil:"role_name" json:"role_name" toml:"role_name" yaml:"role_name"`
+	Builtin   int       `boil:"builtin" json:"builtin" toml:"builtin" yaml:"builtin"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
@@ -37,12 +38,14 @@ var DataRoleColumns = struct {
 	ID        string
 	RoleKey   string
 	RoleName  string
+	Builtin   string
 	CreatedAt string
 	UpdatedAt string
 }{
 	ID:        "id",
 	RoleKey:   "role_key",
 	RoleName:  "role_name",
+	Builtin:   "builtin",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
 }
@@ -51,12 +54,14 @@ var DataRoleTableColumns = struct {
 	ID        string
 	RoleKey   string
 	RoleName  string
+	Builtin   string
 	CreatedAt string
 	UpdatedAt string
 }{
 	ID:        "data_roles.id",
 	RoleKey:   "data_roles.role_key",
 	RoleName:  "data_roles.role_name",
+	Builtin:   "data_roles.builtin",
 	CreatedAt: "data_roles.created_at",
 	UpdatedAt: "data_roles.updated_at",
 }
@@ -67,26 +72,34 @@ var DataRoleWhere = struct {
 	ID        whereHelperint
 	RoleKey   whereHelperstring
 	RoleName  whereHelperstring
+	Builtin   whereHelperint
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
 }{
 	ID:        whereHelperint{field: "`data_roles`.`id`"},
 	RoleKey:   whereHelperstring{field: "`data_roles`.`role_key`"},
 	RoleName:  whereHelperstring{field: "`data_roles`.`role_name`"},
+	Builtin:   whereHelperint{field: "`data_roles`.`builtin`"},
 	CreatedAt: whereHelpertime_Time{field: "`data_roles`.`created_at`"},
 	UpdatedAt: whereHelpertime_Time{field: "`data_roles`.`updated_at`"},
 }
 
 // DataRoleRels is where relationship names are stored.
 var DataRoleRels = struct {
-	RoleKeyTBLUsers string
+	RoleKeyDataRolePermissions string
+	RoleKeyTBLUserCampusRoles  string
+	RoleKeyTBLUsers            string
 }{
-	RoleKeyTBLUsers: "RoleKeyTBLUsers",
+	RoleKeyDataRolePermissions: "RoleKeyDataRolePermissions",
+	RoleKeyTBLUserCampusRoles:  "RoleKeyTBLUserCampusRoles",
+	RoleKeyTBLUsers:            "RoleKeyTBLUsers",
 }
 
 // dataRoleR is where relationships are stored.
 type dataRoleR struct {
-	RoleKeyTBLUsers TBLUserSlice `boil:"RoleKeyTBLUsers" json:"RoleKeyTBLUsers" toml:"RoleKeyTBLUsers" yaml:"RoleKeyTBLUsers"`
+	RoleKeyDataRolePermissions DataRolePermissionSlice `boil:"RoleKeyDataRolePermissions" json:"RoleKeyDataRolePermissions" toml:"RoleKeyDataRolePermissions" yaml:"RoleKeyDataRolePermissions"`
+	RoleKeyTBLUserCampusRoles  TBLUserCampusRoleSlice  `boil:"RoleKeyTBLUserCampusRoles" json:"RoleKeyTBLUserCampusRoles" toml:"RoleKeyTBLUserCampusRoles" yaml:"RoleKeyTBLUserCampusRoles"`
+	RoleKeyTBLUsers            TBLUserSlice            `boil:"RoleKeyTBLUsers" json:"RoleKeyTBLUsers" toml:"RoleKeyTBLUsers" yaml:"RoleKeyTBLUsers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -94,6 +107,38 @@ func (*dataRoleR) NewStruct() *dataRoleR {
 	return &dataRoleR{}
 }
 
+func (o *DataRole) GetRoleKeyDataRolePermissions() DataRolePermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoleKeyDataRolePermissions()
+}
+
+func (r *dataRoleR) GetRoleKeyDataRolePermissions() DataRolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RoleKeyDataRolePermissions
+}
+
+func (o *DataRole) GetRoleKeyTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoleKeyTBLUserCampusRoles()
+}
+
+func (r *dataRoleR) GetRoleKeyTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RoleKeyTBLUserCampusRoles
+}
+
 func (o *DataRole) GetRoleKeyTBLUsers() TBLUserSlice {
 	if o == nil {
 		return nil
@@ -114,9 +159,9 @@ func (r *dataRoleR) GetRoleKeyTBLUsers() TBLUserSlice {
 type dataRoleL struct{}
 
 var (
-	dataRoleAllColumns            = []string{"id", "role_key", "role_name", "created_at", "updated_at"}
+	dataRoleAllColumns            = []string{"id", "role_key", "role_name", "builtin", "created_at", "updated_at"}
 	dataRoleColumnsWithoutDefault = []string{"role_key", "role_name"}
-	dataRoleColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	dataRoleColumnsWithDefault    = []string{"id", "builtin", "created_at", "updated_at"}
 	dataRolePrimaryKeyColumns     = []string{"id"}
 	dataRoleGeneratedColumns      = []string{}
 )
@@ -426,6 +471,34 @@ func (q dataRoleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// RoleKeyDataRolePermissions retrieves all the data_role_permission's DataRolePermissions with an executor via role_key column.
+func (o *DataRole) RoleKeyDataRolePermissions(mods ...qm.QueryMod) dataRolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`data_role_permissions`.`role_key`=?", o.RoleKey),
+	)
+
+	return DataRolePermissions(queryMods...)
+}
+
+// RoleKeyTBLUserCampusRoles retrieves all the tbl_user_campus_role's TBLUserCampusRoles with an executor via role_key column.
+func (o *DataRole) RoleKeyTBLUserCampusRoles(mods ...qm.QueryMod) tblUserCampusRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_campus_roles`.`role_key`=?", o.RoleKey),
+	)
+
+	return TBLUserCampusRoles(queryMods...)
+}
+
 // RoleKeyTBLUsers retrieves all the tbl_user's TBLUsers with an executor via role_key column.
 func (o *DataRole) RoleKeyTBLUsers(mods ...qm.QueryMod) tblUserQuery {
 	var queryMods []qm.QueryMod
@@ -440,6 +513,232 @@ func (o *DataRole) RoleKeyTBLUsers(mods ...qm.QueryMod) tblUserQuery {
 	return TBLUsers(queryMods...)
 }
 
+// LoadRoleKeyDataRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataRoleL) LoadRoleKeyDataRolePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataRole interface{}, mods queries.Applicator) error {
+	var slice []*DataRole
+	var object *DataRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataRole.(*DataRole)
+		if !ok {
+			object = new(DataRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataRole))
+			}
+		}
+	} else {
+		s, ok := maybeDataRole.(*[]*DataRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataRoleR{}
+		}
+		args[object.RoleKey] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataRoleR{}
+			}
+			args[obj.RoleKey] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_role_permissions`),
+		qm.WhereIn(`data_role_permissions.role_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_role_permissions")
+	}
+
+	var resultSlice []*DataRolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_role_permissions")
+	}
+
+	if len(dataRolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RoleKeyDataRolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataRolePermissionR{}
+			}
+			foreign.R.RoleKeyDataRole = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RoleKey == foreign.RoleKey {
+				local.R.RoleKeyDataRolePermissions = append(local.R.RoleKeyDataRolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataRolePermissionR{}
+				}
+				foreign.R.RoleKeyDataRole = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoleKeyTBLUserCampusRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataRoleL) LoadRoleKeyTBLUserCampusRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataRole interface{}, mods queries.Applicator) error {
+	var slice []*DataRole
+	var object *DataRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeDataRole.(*DataRole)
+		if !ok {
+			object = new(DataRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeDataRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeDataRole))
+			}
+		}
+	} else {
+		s, ok := maybeDataRole.(*[]*DataRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeDataRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeDataRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &dataRoleR{}
+		}
+		args[object.RoleKey] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataRoleR{}
+			}
+			args[obj.RoleKey] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_campus_roles`),
+		qm.WhereIn(`tbl_user_campus_roles.role_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_campus_roles")
+	}
+
+	var resultSlice []*TBLUserCampusRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_campus_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_campus_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_campus_roles")
+	}
+
+	if len(tblUserCampusRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RoleKeyTBLUserCampusRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserCampusRoleR{}
+			}
+			foreign.R.RoleKeyDataRole = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.RoleKey == foreign.RoleKey {
+				local.R.RoleKeyTBLUserCampusRoles = append(local.R.RoleKeyTBLUserCampusRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserCampusRoleR{}
+				}
+				foreign.R.RoleKeyDataRole = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadRoleKeyTBLUsers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (dataRoleL) LoadRoleKeyTBLUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataRole interface{}, mods queries.Applicator) error {
@@ -553,6 +852,112 @@ func (dataRoleL) LoadRoleKeyTBLUsers(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
+// AddRoleKeyDataRolePermissions adds the given related objects to the existing relationships
+// of the data_role, optionally inserting them as new records.
+// Appends related to o.R.RoleKeyDataRolePermissions.
+// Sets related.R.RoleKeyDataRole appropriately.
+func (o *DataRole) AddRoleKeyDataRolePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataRolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleKey = o.RoleKey
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `data_role_permissions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
+				strmangle.WhereClause("`", "`", 0, dataRolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.RoleKey, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleKey = o.RoleKey
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataRoleR{
+			RoleKeyDataRolePermissions: related,
+		}
+	} else {
+		o.R.RoleKeyDataRolePermissions = append(o.R.RoleKeyDataRolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataRolePermissionR{
+				RoleKeyDataRole: o,
+			}
+		} else {
+			rel.R.RoleKeyDataRole = o
+		}
+	}
+	return nil
+}
+
+// AddRoleKeyTBLUserCampusRoles adds the given related objects to the existing relationships
+// of the data_role, optionally inserting them as new records.
+// Appends related to o.R.RoleKeyTBLUserCampusRoles.
+// Sets related.R.RoleKeyDataRole appropriately.
+func (o *DataRole) AddRoleKeyTBLUserCampusRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserCampusRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleKey = o.RoleKey
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
+				strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.RoleKey, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleKey = o.RoleKey
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataRoleR{
+			RoleKeyTBLUserCampusRoles: related,
+		}
+	} else {
+		o.R.RoleKeyTBLUserCampusRoles = append(o.R.RoleKeyTBLUserCampusRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserCampusRoleR{
+				RoleKeyDataRole: o,
+			}
+		} else {
+			rel.R.RoleKeyDataRole = o
+		}
+	}
+	return nil
+}
+
 // AddRoleKeyTBLUsers adds the given related objects to the existing relationships
 // of the data_role, optionally inserting them as new records.
 // Appends related to o.R.RoleKeyTBLUsers.
@@ -0,0 +1,1257 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLLoginAttempt is an object representing the database table.
+type TBLLoginAttempt struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserName  string    `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	UserID    null.Int  `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	IPAddress string    `boil:"ip_address" json:"ip_address" toml:"ip_address" yaml:"ip_address"`
+	UserAgent string    `boil:"user_agent" json:"user_agent" toml:"user_agent" yaml:"user_agent"`
+	Result    string    `boil:"result" json:"result" toml:"result" yaml:"result"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblLoginAttemptR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblLoginAttemptL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLLoginAttemptColumns = struct {
+	ID        string
+	UserName  string
+	UserID    string
+	IPAddress string
+	UserAgent string
+	Result    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserName:  "user_name",
+	UserID:    "user_id",
+	IPAddress: "ip_address",
+	UserAgent: "user_agent",
+	Result:    "result",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TBLLoginAttemptTableColumns = struct {
+	ID        string
+	UserName  string
+	UserID    string
+	IPAddress string
+	UserAgent string
+	Result    string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "tbl_login_attempts.id",
+	UserName:  "tbl_login_attempts.user_name",
+	UserID:    "tbl_login_attempts.user_id",
+	IPAddress: "tbl_login_attempts.ip_address",
+	UserAgent: "tbl_login_attempts.user_agent",
+	Result:    "tbl_login_attempts.result",
+	CreatedAt: "tbl_login_attempts.created_at",
+	UpdatedAt: "tbl_login_attempts.updated_at",
+}
+
+// Generated where
+
+var TBLLoginAttemptWhere = struct {
+	ID        whereHelperint
+	UserName  whereHelperstring
+	UserID    whereHelpernull_Int
+	IPAddress whereHelperstring
+	UserAgent whereHelperstring
+	Result    whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`tbl_login_attempts`.`id`"},
+	UserName:  whereHelperstring{field: "`tbl_login_attempts`.`user_name`"},
+	UserID:    whereHelpernull_Int{field: "`tbl_login_attempts`.`user_id`"},
+	IPAddress: whereHelperstring{field: "`tbl_login_attempts`.`ip_address`"},
+	UserAgent: whereHelperstring{field: "`tbl_login_attempts`.`user_agent`"},
+	Result:    whereHelperstring{field: "`tbl_login_attempts`.`result`"},
+	CreatedAt: whereHelpertime_Time{field: "`tbl_login_attempts`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`tbl_login_attempts`.`updated_at`"},
+}
+
+// TBLLoginAttemptRels is where relationship names are stored.
+var TBLLoginAttemptRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tblLoginAttemptR is where relationships are stored.
+type tblLoginAttemptR struct {
+	User *TBLUser `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblLoginAttemptR) NewStruct() *tblLoginAttemptR {
+	return &tblLoginAttemptR{}
+}
+
+func (o *TBLLoginAttempt) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblLoginAttemptR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblLoginAttemptL is where Load methods for each relationship are stored.
+type tblLoginAttemptL struct{}
+
+var (
+	tblLoginAttemptAllColumns            = []string{"id", "user_name", "user_id", "ip_address", "user_agent", "result", "created_at", "updated_at"}
+	tblLoginAttemptColumnsWithoutDefault = []string{"user_name", "user_id", "ip_address", "user_agent", "result"}
+	tblLoginAttemptColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblLoginAttemptPrimaryKeyColumns     = []string{"id"}
+	tblLoginAttemptGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLLoginAttemptSlice is an alias for a slice of pointers to TBLLoginAttempt.
+	// This should almost always be used instead of []TBLLoginAttempt.
+	TBLLoginAttemptSlice []*TBLLoginAttempt
+	// TBLLoginAttemptHook is the signature for custom TBLLoginAttempt hook methods
+	TBLLoginAttemptHook func(context.Context, boil.ContextExecutor, *TBLLoginAttempt) error
+
+	tblLoginAttemptQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblLoginAttemptType                 = reflect.TypeOf(&TBLLoginAttempt{})
+	tblLoginAttemptMapping              = queries.MakeStructMapping(tblLoginAttemptType)
+	tblLoginAttemptPrimaryKeyMapping, _ = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, tblLoginAttemptPrimaryKeyColumns)
+	tblLoginAttemptInsertCacheMut       sync.RWMutex
+	tblLoginAttemptInsertCache          = make(map[string]insertCache)
+	tblLoginAttemptUpdateCacheMut       sync.RWMutex
+	tblLoginAttemptUpdateCache          = make(map[string]updateCache)
+	tblLoginAttemptUpsertCacheMut       sync.RWMutex
+	tblLoginAttemptUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblLoginAttemptAfterSelectMu sync.Mutex
+var tblLoginAttemptAfterSelectHooks []TBLLoginAttemptHook
+
+var tblLoginAttemptBeforeInsertMu sync.Mutex
+var tblLoginAttemptBeforeInsertHooks []TBLLoginAttemptHook
+var tblLoginAttemptAfterInsertMu sync.Mutex
+var tblLoginAttemptAfterInsertHooks []TBLLoginAttemptHook
+
+var tblLoginAttemptBeforeUpdateMu sync.Mutex
+var tblLoginAttemptBeforeUpdateHooks []TBLLoginAttemptHook
+var tblLoginAttemptAfterUpdateMu sync.Mutex
+var tblLoginAttemptAfterUpdateHooks []TBLLoginAttemptHook
+
+var tblLoginAttemptBeforeDeleteMu sync.Mutex
+var tblLoginAttemptBeforeDeleteHooks []TBLLoginAttemptHook
+var tblLoginAttemptAfterDeleteMu sync.Mutex
+var tblLoginAttemptAfterDeleteHooks []TBLLoginAttemptHook
+
+var tblLoginAttemptBeforeUpsertMu sync.Mutex
+var tblLoginAttemptBeforeUpsertHooks []TBLLoginAttemptHook
+var tblLoginAttemptAfterUpsertMu sync.Mutex
+var tblLoginAttemptAfterUpsertHooks []TBLLoginAttemptHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLLoginAttempt) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLLoginAttempt) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLLoginAttempt) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLLoginAttempt) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLLoginAttempt) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLLoginAttempt) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLLoginAttempt) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLLoginAttempt) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLLoginAttempt) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginAttemptAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLLoginAttemptHook registers your hook function for all future operations.
+func AddTBLLoginAttemptHook(hookPoint boil.HookPoint, tblLoginAttemptHook TBLLoginAttemptHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblLoginAttemptAfterSelectMu.Lock()
+		tblLoginAttemptAfterSelectHooks = append(tblLoginAttemptAfterSelectHooks, tblLoginAttemptHook)
+		tblLoginAttemptAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblLoginAttemptBeforeInsertMu.Lock()
+		tblLoginAttemptBeforeInsertHooks = append(tblLoginAttemptBeforeInsertHooks, tblLoginAttemptHook)
+		tblLoginAttemptBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblLoginAttemptAfterInsertMu.Lock()
+		tblLoginAttemptAfterInsertHooks = append(tblLoginAttemptAfterInsertHooks, tblLoginAttemptHook)
+		tblLoginAttemptAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblLoginAttemptBeforeUpdateMu.Lock()
+		tblLoginAttemptBeforeUpdateHooks = append(tblLoginAttemptBeforeUpdateHooks, tblLoginAttemptHook)
+		tblLoginAttemptBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblLoginAttemptAfterUpdateMu.Lock()
+		tblLoginAttemptAfterUpdateHooks = append(tblLoginAttemptAfterUpdateHooks, tblLoginAttemptHook)
+		tblLoginAttemptAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblLoginAttemptBeforeDeleteMu.Lock()
+		tblLoginAttemptBeforeDeleteHooks = append(tblLoginAttemptBeforeDeleteHooks, tblLoginAttemptHook)
+		tblLoginAttemptBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblLoginAttemptAfterDeleteMu.Lock()
+		tblLoginAttemptAfterDeleteHooks = append(tblLoginAttemptAfterDeleteHooks, tblLoginAttemptHook)
+		tblLoginAttemptAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblLoginAttemptBeforeUpsertMu.Lock()
+		tblLoginAttemptBeforeUpsertHooks = append(tblLoginAttemptBeforeUpsertHooks, tblLoginAttemptHook)
+		tblLoginAttemptBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblLoginAttemptAfterUpsertMu.Lock()
+		tblLoginAttemptAfterUpsertHooks = append(tblLoginAttemptAfterUpsertHooks, tblLoginAttemptHook)
+		tblLoginAttemptAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblLoginAttempt record from the query.
+func (q tblLoginAttemptQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLLoginAttempt, error) {
+	o := &TBLLoginAttempt{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_login_attempts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLLoginAttempt records from the query.
+func (q tblLoginAttemptQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLLoginAttemptSlice, error) {
+	var o []*TBLLoginAttempt
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLLoginAttempt slice")
+	}
+
+	if len(tblLoginAttemptAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLLoginAttempt records in the query.
+func (q tblLoginAttemptQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_login_attempts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblLoginAttemptQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_login_attempts exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLLoginAttempt) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblLoginAttemptL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLLoginAttempt interface{}, mods queries.Applicator) error {
+	var slice []*TBLLoginAttempt
+	var object *TBLLoginAttempt
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLLoginAttempt.(*TBLLoginAttempt)
+		if !ok {
+			object = new(TBLLoginAttempt)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLLoginAttempt)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLLoginAttempt))
+			}
+		}
+	} else {
+		s, ok := maybeTBLLoginAttempt.(*[]*TBLLoginAttempt)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLLoginAttempt)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLLoginAttempt))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblLoginAttemptR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblLoginAttemptR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLLoginAttempts = append(foreign.R.UserTBLLoginAttempts, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLLoginAttempts = append(foreign.R.UserTBLLoginAttempts, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblLoginAttempt to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLLoginAttempts.
+func (o *TBLLoginAttempt) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_login_attempts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblLoginAttemptPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &tblLoginAttemptR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLLoginAttempts: TBLLoginAttemptSlice{o},
+		}
+	} else {
+		related.R.UserTBLLoginAttempts = append(related.R.UserTBLLoginAttempts, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TBLLoginAttempt) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *TBLUser) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.UserTBLLoginAttempts {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.UserTBLLoginAttempts)
+		if ln > 1 && i < ln-1 {
+			related.R.UserTBLLoginAttempts[i] = related.R.UserTBLLoginAttempts[ln-1]
+		}
+		related.R.UserTBLLoginAttempts = related.R.UserTBLLoginAttempts[:ln-1]
+		break
+	}
+	return nil
+}
+
+// TBLLoginAttempts retrieves all the records using an executor.
+func TBLLoginAttempts(mods ...qm.QueryMod) tblLoginAttemptQuery {
+	mods = append(mods, qm.From("`tbl_login_attempts`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_login_attempts`.*"})
+	}
+
+	return tblLoginAttemptQuery{q}
+}
+
+// FindTBLLoginAttempt retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLLoginAttempt(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLLoginAttempt, error) {
+	tblLoginAttemptObj := &TBLLoginAttempt{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_login_attempts` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblLoginAttemptObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_login_attempts")
+	}
+
+	if err = tblLoginAttemptObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblLoginAttemptObj, err
+	}
+
+	return tblLoginAttemptObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLLoginAttempt) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_login_attempts provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblLoginAttemptColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblLoginAttemptInsertCacheMut.RLock()
+	cache, cached := tblLoginAttemptInsertCache[key]
+	tblLoginAttemptInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblLoginAttemptAllColumns,
+			tblLoginAttemptColumnsWithDefault,
+			tblLoginAttemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_login_attempts` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_login_attempts` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_login_attempts` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblLoginAttemptPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_login_attempts")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblLoginAttemptMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_login_attempts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblLoginAttemptInsertCacheMut.Lock()
+		tblLoginAttemptInsertCache[key] = cache
+		tblLoginAttemptInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLLoginAttempt.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLLoginAttempt) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblLoginAttemptUpdateCacheMut.RLock()
+	cache, cached := tblLoginAttemptUpdateCache[key]
+	tblLoginAttemptUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblLoginAttemptAllColumns,
+			tblLoginAttemptPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_login_attempts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_login_attempts` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblLoginAttemptPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, append(wl, tblLoginAttemptPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_login_attempts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_login_attempts")
+	}
+
+	if !cached {
+		tblLoginAttemptUpdateCacheMut.Lock()
+		tblLoginAttemptUpdateCache[key] = cache
+		tblLoginAttemptUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblLoginAttemptQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_login_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_login_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLLoginAttemptSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_login_attempts` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginAttemptPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblLoginAttempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblLoginAttempt")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLLoginAttemptUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLLoginAttempt) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_login_attempts provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblLoginAttemptColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLLoginAttemptUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblLoginAttemptUpsertCacheMut.RLock()
+	cache, cached := tblLoginAttemptUpsertCache[key]
+	tblLoginAttemptUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblLoginAttemptAllColumns,
+			tblLoginAttemptColumnsWithDefault,
+			tblLoginAttemptColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblLoginAttemptAllColumns,
+			tblLoginAttemptPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_login_attempts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblLoginAttemptAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_login_attempts`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_login_attempts` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_login_attempts")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblLoginAttemptMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblLoginAttemptType, tblLoginAttemptMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_login_attempts")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_login_attempts")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblLoginAttemptUpsertCacheMut.Lock()
+		tblLoginAttemptUpsertCache[key] = cache
+		tblLoginAttemptUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLLoginAttempt record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLLoginAttempt) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLLoginAttempt provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblLoginAttemptPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_login_attempts` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_login_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_login_attempts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblLoginAttemptQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblLoginAttemptQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_login_attempts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_login_attempts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLLoginAttemptSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblLoginAttemptBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_login_attempts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginAttemptPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblLoginAttempt slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_login_attempts")
+	}
+
+	if len(tblLoginAttemptAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLLoginAttempt) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLLoginAttempt(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLLoginAttemptSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLLoginAttemptSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginAttemptPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_login_attempts`.* FROM `tbl_login_attempts` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginAttemptPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLLoginAttemptSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLLoginAttemptExists checks if the TBLLoginAttempt row exists.
+func TBLLoginAttemptExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_login_attempts` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_login_attempts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLLoginAttempt row exists.
+func (o *TBLLoginAttempt) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLLoginAttemptExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1022 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLLoginThrottle is an object representing the database table.
+type TBLLoginThrottle struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ThrottleType string    `boil:"throttle_type" json:"throttle_type" toml:"throttle_type" yaml:"throttle_type"`
+	ThrottleKey  string    `boil:"throttle_key" json:"throttle_key" toml:"throttle_key" yaml:"throttle_key"`
+	FailureCount int       `boil:"failure_count" json:"failure_count" toml:"failure_count" yaml:"failure_count"`
+	LastFailedAt time.Time `boil:"last_failed_at" json:"last_failed_at" toml:"last_failed_at" yaml:"last_failed_at"`
+	LockedUntil  null.Time `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblLoginThrottleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblLoginThrottleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLLoginThrottleColumns = struct {
+	ID           string
+	ThrottleType string
+	ThrottleKey  string
+	FailureCount string
+	LastFailedAt string
+	LockedUntil  string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	ThrottleType: "throttle_type",
+	ThrottleKey:  "throttle_key",
+	FailureCount: "failure_count",
+	LastFailedAt: "last_failed_at",
+	LockedUntil:  "locked_until",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var TBLLoginThrottleTableColumns = struct {
+	ID           string
+	ThrottleType string
+	ThrottleKey  string
+	FailureCount string
+	LastFailedAt string
+	LockedUntil  string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "tbl_login_throttles.id",
+	ThrottleType: "tbl_login_throttles.throttle_type",
+	ThrottleKey:  "tbl_login_throttles.throttle_key",
+	FailureCount: "tbl_login_throttles.failure_count",
+	LastFailedAt: "tbl_login_throttles.last_failed_at",
+	LockedUntil:  "tbl_login_throttles.locked_until",
+	CreatedAt:    "tbl_login_throttles.created_at",
+	UpdatedAt:    "tbl_login_throttles.updated_at",
+}
+
+// Generated where
+
+var TBLLoginThrottleWhere = struct {
+	ID           whereHelperint
+	ThrottleType whereHelperstring
+	ThrottleKey  whereHelperstring
+	FailureCount whereHelperint
+	LastFailedAt whereHelpertime_Time
+	LockedUntil  whereHelpernull_Time
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperint{field: "`tbl_login_throttles`.`id`"},
+	ThrottleType: whereHelperstring{field: "`tbl_login_throttles`.`throttle_type`"},
+	ThrottleKey:  whereHelperstring{field: "`tbl_login_throttles`.`throttle_key`"},
+	FailureCount: whereHelperint{field: "`tbl_login_throttles`.`failure_count`"},
+	LastFailedAt: whereHelpertime_Time{field: "`tbl_login_throttles`.`last_failed_at`"},
+	LockedUntil:  whereHelpernull_Time{field: "`tbl_login_throttles`.`locked_until`"},
+	CreatedAt:    whereHelpertime_Time{field: "`tbl_login_throttles`.`created_at`"},
+	UpdatedAt:    whereHelpertime_Time{field: "`tbl_login_throttles`.`updated_at`"},
+}
+
+// TBLLoginThrottleRels is where relationship names are stored.
+var TBLLoginThrottleRels = struct {
+}{}
+
+// tblLoginThrottleR is where relationships are stored.
+type tblLoginThrottleR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*tblLoginThrottleR) NewStruct() *tblLoginThrottleR {
+	return &tblLoginThrottleR{}
+}
+
+// tblLoginThrottleL is where Load methods for each relationship are stored.
+type tblLoginThrottleL struct{}
+
+var (
+	tblLoginThrottleAllColumns            = []string{"id", "throttle_type", "throttle_key", "failure_count", "last_failed_at", "locked_until", "created_at", "updated_at"}
+	tblLoginThrottleColumnsWithoutDefault = []string{"throttle_type", "throttle_key", "last_failed_at", "locked_until"}
+	tblLoginThrottleColumnsWithDefault    = []string{"id", "failure_count", "created_at", "updated_at"}
+	tblLoginThrottlePrimaryKeyColumns     = []string{"id"}
+	tblLoginThrottleGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLLoginThrottleSlice is an alias for a slice of pointers to TBLLoginThrottle.
+	// This should almost always be used instead of []TBLLoginThrottle.
+	TBLLoginThrottleSlice []*TBLLoginThrottle
+	// TBLLoginThrottleHook is the signature for custom TBLLoginThrottle hook methods
+	TBLLoginThrottleHook func(context.Context, boil.ContextExecutor, *TBLLoginThrottle) error
+
+	tblLoginThrottleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblLoginThrottleType                 = reflect.TypeOf(&TBLLoginThrottle{})
+	tblLoginThrottleMapping              = queries.MakeStructMapping(tblLoginThrottleType)
+	tblLoginThrottlePrimaryKeyMapping, _ = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, tblLoginThrottlePrimaryKeyColumns)
+	tblLoginThrottleInsertCacheMut       sync.RWMutex
+	tblLoginThrottleInsertCache          = make(map[string]insertCache)
+	tblLoginThrottleUpdateCacheMut       sync.RWMutex
+	tblLoginThrottleUpdateCache          = make(map[string]updateCache)
+	tblLoginThrottleUpsertCacheMut       sync.RWMutex
+	tblLoginThrottleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblLoginThrottleAfterSelectMu sync.Mutex
+var tblLoginThrottleAfterSelectHooks []TBLLoginThrottleHook
+
+var tblLoginThrottleBeforeInsertMu sync.Mutex
+var tblLoginThrottleBeforeInsertHooks []TBLLoginThrottleHook
+var tblLoginThrottleAfterInsertMu sync.Mutex
+var tblLoginThrottleAfterInsertHooks []TBLLoginThrottleHook
+
+var tblLoginThrottleBeforeUpdateMu sync.Mutex
+var tblLoginThrottleBeforeUpdateHooks []TBLLoginThrottleHook
+var tblLoginThrottleAfterUpdateMu sync.Mutex
+var tblLoginThrottleAfterUpdateHooks []TBLLoginThrottleHook
+
+var tblLoginThrottleBeforeDeleteMu sync.Mutex
+var tblLoginThrottleBeforeDeleteHooks []TBLLoginThrottleHook
+var tblLoginThrottleAfterDeleteMu sync.Mutex
+var tblLoginThrottleAfterDeleteHooks []TBLLoginThrottleHook
+
+var tblLoginThrottleBeforeUpsertMu sync.Mutex
+var tblLoginThrottleBeforeUpsertHooks []TBLLoginThrottleHook
+var tblLoginThrottleAfterUpsertMu sync.Mutex
+var tblLoginThrottleAfterUpsertHooks []TBLLoginThrottleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLLoginThrottle) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLLoginThrottle) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLLoginThrottle) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLLoginThrottle) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLLoginThrottle) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLLoginThrottle) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLLoginThrottle) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLLoginThrottle) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLLoginThrottle) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblLoginThrottleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLLoginThrottleHook registers your hook function for all future operations.
+func AddTBLLoginThrottleHook(hookPoint boil.HookPoint, tblLoginThrottleHook TBLLoginThrottleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblLoginThrottleAfterSelectMu.Lock()
+		tblLoginThrottleAfterSelectHooks = append(tblLoginThrottleAfterSelectHooks, tblLoginThrottleHook)
+		tblLoginThrottleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblLoginThrottleBeforeInsertMu.Lock()
+		tblLoginThrottleBeforeInsertHooks = append(tblLoginThrottleBeforeInsertHooks, tblLoginThrottleHook)
+		tblLoginThrottleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblLoginThrottleAfterInsertMu.Lock()
+		tblLoginThrottleAfterInsertHooks = append(tblLoginThrottleAfterInsertHooks, tblLoginThrottleHook)
+		tblLoginThrottleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblLoginThrottleBeforeUpdateMu.Lock()
+		tblLoginThrottleBeforeUpdateHooks = append(tblLoginThrottleBeforeUpdateHooks, tblLoginThrottleHook)
+		tblLoginThrottleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblLoginThrottleAfterUpdateMu.Lock()
+		tblLoginThrottleAfterUpdateHooks = append(tblLoginThrottleAfterUpdateHooks, tblLoginThrottleHook)
+		tblLoginThrottleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblLoginThrottleBeforeDeleteMu.Lock()
+		tblLoginThrottleBeforeDeleteHooks = append(tblLoginThrottleBeforeDeleteHooks, tblLoginThrottleHook)
+		tblLoginThrottleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblLoginThrottleAfterDeleteMu.Lock()
+		tblLoginThrottleAfterDeleteHooks = append(tblLoginThrottleAfterDeleteHooks, tblLoginThrottleHook)
+		tblLoginThrottleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblLoginThrottleBeforeUpsertMu.Lock()
+		tblLoginThrottleBeforeUpsertHooks = append(tblLoginThrottleBeforeUpsertHooks, tblLoginThrottleHook)
+		tblLoginThrottleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblLoginThrottleAfterUpsertMu.Lock()
+		tblLoginThrottleAfterUpsertHooks = append(tblLoginThrottleAfterUpsertHooks, tblLoginThrottleHook)
+		tblLoginThrottleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblLoginThrottle record from the query.
+func (q tblLoginThrottleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLLoginThrottle, error) {
+	o := &TBLLoginThrottle{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_login_throttles")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLLoginThrottle records from the query.
+func (q tblLoginThrottleQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLLoginThrottleSlice, error) {
+	var o []*TBLLoginThrottle
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLLoginThrottle slice")
+	}
+
+	if len(tblLoginThrottleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLLoginThrottle records in the query.
+func (q tblLoginThrottleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_login_throttles rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblLoginThrottleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_login_throttles exists")
+	}
+
+	return count > 0, nil
+}
+
+// TBLLoginThrottles retrieves all the records using an executor.
+func TBLLoginThrottles(mods ...qm.QueryMod) tblLoginThrottleQuery {
+	mods = append(mods, qm.From("`tbl_login_throttles`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_login_throttles`.*"})
+	}
+
+	return tblLoginThrottleQuery{q}
+}
+
+// FindTBLLoginThrottle retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLLoginThrottle(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLLoginThrottle, error) {
+	tblLoginThrottleObj := &TBLLoginThrottle{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_login_throttles` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblLoginThrottleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_login_throttles")
+	}
+
+	if err = tblLoginThrottleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblLoginThrottleObj, err
+	}
+
+	return tblLoginThrottleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLLoginThrottle) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_login_throttles provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblLoginThrottleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblLoginThrottleInsertCacheMut.RLock()
+	cache, cached := tblLoginThrottleInsertCache[key]
+	tblLoginThrottleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblLoginThrottleAllColumns,
+			tblLoginThrottleColumnsWithDefault,
+			tblLoginThrottleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_login_throttles` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_login_throttles` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_login_throttles` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblLoginThrottlePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_login_throttles")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblLoginThrottleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_login_throttles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblLoginThrottleInsertCacheMut.Lock()
+		tblLoginThrottleInsertCache[key] = cache
+		tblLoginThrottleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLLoginThrottle.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLLoginThrottle) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblLoginThrottleUpdateCacheMut.RLock()
+	cache, cached := tblLoginThrottleUpdateCache[key]
+	tblLoginThrottleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblLoginThrottleAllColumns,
+			tblLoginThrottlePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_login_throttles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_login_throttles` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblLoginThrottlePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, append(wl, tblLoginThrottlePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_login_throttles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_login_throttles")
+	}
+
+	if !cached {
+		tblLoginThrottleUpdateCacheMut.Lock()
+		tblLoginThrottleUpdateCache[key] = cache
+		tblLoginThrottleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblLoginThrottleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_login_throttles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_login_throttles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLLoginThrottleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginThrottlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_login_throttles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginThrottlePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblLoginThrottle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblLoginThrottle")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLLoginThrottleUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLLoginThrottle) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_login_throttles provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblLoginThrottleColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLLoginThrottleUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblLoginThrottleUpsertCacheMut.RLock()
+	cache, cached := tblLoginThrottleUpsertCache[key]
+	tblLoginThrottleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblLoginThrottleAllColumns,
+			tblLoginThrottleColumnsWithDefault,
+			tblLoginThrottleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblLoginThrottleAllColumns,
+			tblLoginThrottlePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_login_throttles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblLoginThrottleAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_login_throttles`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_login_throttles` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_login_throttles")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblLoginThrottleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblLoginThrottleType, tblLoginThrottleMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_login_throttles")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_login_throttles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblLoginThrottleUpsertCacheMut.Lock()
+		tblLoginThrottleUpsertCache[key] = cache
+		tblLoginThrottleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLLoginThrottle record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLLoginThrottle) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLLoginThrottle provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblLoginThrottlePrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_login_throttles` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_login_throttles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_login_throttles")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblLoginThrottleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblLoginThrottleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_login_throttles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_login_throttles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLLoginThrottleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblLoginThrottleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginThrottlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_login_throttles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginThrottlePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblLoginThrottle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_login_throttles")
+	}
+
+	if len(tblLoginThrottleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLLoginThrottle) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLLoginThrottle(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLLoginThrottleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLLoginThrottleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblLoginThrottlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_login_throttles`.* FROM `tbl_login_throttles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblLoginThrottlePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLLoginThrottleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLLoginThrottleExists checks if the TBLLoginThrottle row exists.
+func TBLLoginThrottleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_login_throttles` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_login_throttles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLLoginThrottle row exists.
+func (o *TBLLoginThrottle) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLLoginThrottleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1212 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleChangeRequestOperation is an object representing the database table.
+type TBLScheduleChangeRequestOperation struct {
+	ID               int    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChangeRequestID  int    `boil:"change_request_id" json:"change_request_id" toml:"change_request_id" yaml:"change_request_id"`
+	Operation        string `boil:"operation" json:"operation" toml:"operation" yaml:"operation"`
+	Identifier       string `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
+	RoomIndex        int    `boil:"room_index" json:"room_index" toml:"room_index" yaml:"room_index"`
+	StartTimeHour    int    `boil:"start_time_hour" json:"start_time_hour" toml:"start_time_hour" yaml:"start_time_hour"`
+	StartTimeMinutes int    `boil:"start_time_minutes" json:"start_time_minutes" toml:"start_time_minutes" yaml:"start_time_minutes"`
+
+	R *tblScheduleChangeRequestOperationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleChangeRequestOperationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleChangeRequestOperationColumns = struct {
+	ID               string
+	ChangeRequestID  string
+	Operation        string
+	Identifier       string
+	RoomIndex        string
+	StartTimeHour    string
+	StartTimeMinutes string
+}{
+	ID:               "id",
+	ChangeRequestID:  "change_request_id",
+	Operation:        "operation",
+	Identifier:       "identifier",
+	RoomIndex:        "room_index",
+	StartTimeHour:    "start_time_hour",
+	StartTimeMinutes: "start_time_minutes",
+}
+
+var TBLScheduleChangeRequestOperationTableColumns = struct {
+	ID               string
+	ChangeRequestID  string
+	Operation        string
+	Identifier       string
+	RoomIndex        string
+	StartTimeHour    string
+	StartTimeMinutes string
+}{
+	ID:               "tbl_schedule_change_request_operations.id",
+	ChangeRequestID:  "tbl_schedule_change_request_operations.change_request_id",
+	Operation:        "tbl_schedule_change_request_operations.operation",
+	Identifier:       "tbl_schedule_change_request_operations.identifier",
+	RoomIndex:        "tbl_schedule_change_request_operations.room_index",
+	StartTimeHour:    "tbl_schedule_change_request_operations.start_time_hour",
+	StartTimeMinutes: "tbl_schedule_change_request_operations.start_time_minutes",
+}
+
+// Generated where
+
+var TBLScheduleChangeRequestOperationWhere = struct {
+	ID               whereHelperint
+	ChangeRequestID  whereHelperint
+	Operation        whereHelperstring
+	Identifier       whereHelperstring
+	RoomIndex        whereHelperint
+	StartTimeHour    whereHelperint
+	StartTimeMinutes whereHelperint
+}{
+	ID:               whereHelperint{field: "`tbl_schedule_change_request_operations`.`id`"},
+	ChangeRequestID:  whereHelperint{field: "`tbl_schedule_change_request_operations`.`change_request_id`"},
+	Operation:        whereHelperstring{field: "`tbl_schedule_change_request_operations`.`operation`"},
+	Identifier:       whereHelperstring{field: "`tbl_schedule_change_request_operations`.`identifier`"},
+	RoomIndex:        whereHelperint{field: "`tbl_schedule_change_request_operations`.`room_index`"},
+	StartTimeHour:    whereHelperint{field: "`tbl_schedule_change_request_operations`.`start_time_hour`"},
+	StartTimeMinutes: whereHelperint{field: "`tbl_schedule_change_request_operations`.`start_time_minutes`"},
+}
+
+// TBLScheduleChangeRequestOperationRels is where relationship names are stored.
+var TBLScheduleChangeRequestOperationRels = struct {
+	ChangeRequest string
+}{
+	ChangeRequest: "ChangeRequest",
+}
+
+// tblScheduleChangeRequestOperationR is where relationships are stored.
+type tblScheduleChangeRequestOperationR struct {
+	ChangeRequest *TBLScheduleChangeRequest `boil:"ChangeRequest" json:"ChangeRequest" toml:"ChangeRequest" yaml:"ChangeRequest"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleChangeRequestOperationR) NewStruct() *tblScheduleChangeRequestOperationR {
+	return &tblScheduleChangeRequestOperationR{}
+}
+
+func (o *TBLScheduleChangeRequestOperation) GetChangeRequest() *TBLScheduleChangeRequest {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChangeRequest()
+}
+
+func (r *tblScheduleChangeRequestOperationR) GetChangeRequest() *TBLScheduleChangeRequest {
+	if r == nil {
+		return nil
+	}
+
+	return r.ChangeRequest
+}
+
+// tblScheduleChangeRequestOperationL is where Load methods for each relationship are stored.
+type tblScheduleChangeRequestOperationL struct{}
+
+var (
+	tblScheduleChangeRequestOperationAllColumns            = []string{"id", "change_request_id", "operation", "identifier", "room_index", "start_time_hour", "start_time_minutes"}
+	tblScheduleChangeRequestOperationColumnsWithoutDefault = []string{"change_request_id", "operation", "identifier"}
+	tblScheduleChangeRequestOperationColumnsWithDefault    = []string{"id", "room_index", "start_time_hour", "start_time_minutes"}
+	tblScheduleChangeRequestOperationPrimaryKeyColumns     = []string{"id"}
+	tblScheduleChangeRequestOperationGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleChangeRequestOperationSlice is an alias for a slice of pointers to TBLScheduleChangeRequestOperation.
+	// This should almost always be used instead of []TBLScheduleChangeRequestOperation.
+	TBLScheduleChangeRequestOperationSlice []*TBLScheduleChangeRequestOperation
+	// TBLScheduleChangeRequestOperationHook is the signature for custom TBLScheduleChangeRequestOperation hook methods
+	TBLScheduleChangeRequestOperationHook func(context.Context, boil.ContextExecutor, *TBLScheduleChangeRequestOperation) error
+
+	tblScheduleChangeRequestOperationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleChangeRequestOperationType                 = reflect.TypeOf(&TBLScheduleChangeRequestOperation{})
+	tblScheduleChangeRequestOperationMapping              = queries.MakeStructMapping(tblScheduleChangeRequestOperationType)
+	tblScheduleChangeRequestOperationPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, tblScheduleChangeRequestOperationPrimaryKeyColumns)
+	tblScheduleChangeRequestOperationInsertCacheMut       sync.RWMutex
+	tblScheduleChangeRequestOperationInsertCache          = make(map[string]insertCache)
+	tblScheduleChangeRequestOperationUpdateCacheMut       sync.RWMutex
+	tblScheduleChangeRequestOperationUpdateCache          = make(map[string]updateCache)
+	tblScheduleChangeRequestOperationUpsertCacheMut       sync.RWMutex
+	tblScheduleChangeRequestOperationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleChangeRequestOperationAfterSelectMu sync.Mutex
+var tblScheduleChangeRequestOperationAfterSelectHooks []TBLScheduleChangeRequestOperationHook
+
+var tblScheduleChangeRequestOperationBeforeInsertMu sync.Mutex
+var tblScheduleChangeRequestOperationBeforeInsertHooks []TBLScheduleChangeRequestOperationHook
+var tblScheduleChangeRequestOperationAfterInsertMu sync.Mutex
+var tblScheduleChangeRequestOperationAfterInsertHooks []TBLScheduleChangeRequestOperationHook
+
+var tblScheduleChangeRequestOperationBeforeUpdateMu sync.Mutex
+var tblScheduleChangeRequestOperationBeforeUpdateHooks []TBLScheduleChangeRequestOperationHook
+var tblScheduleChangeRequestOperationAfterUpdateMu sync.Mutex
+var tblScheduleChangeRequestOperationAfterUpdateHooks []TBLScheduleChangeRequestOperationHook
+
+var tblScheduleChangeRequestOperationBeforeDeleteMu sync.Mutex
+var tblScheduleChangeRequestOperationBeforeDeleteHooks []TBLScheduleChangeRequestOperationHook
+var tblScheduleChangeRequestOperationAfterDeleteMu sync.Mutex
+var tblScheduleChangeRequestOperationAfterDeleteHooks []TBLScheduleChangeRequestOperationHook
+
+var tblScheduleChangeRequestOperationBeforeUpsertMu sync.Mutex
+var tblScheduleChangeRequestOperationBeforeUpsertHooks []TBLScheduleChangeRequestOperationHook
+var tblScheduleChangeRequestOperationAfterUpsertMu sync.Mutex
+var tblScheduleChangeRequestOperationAfterUpsertHooks []TBLScheduleChangeRequestOperationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleChangeRequestOperation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleChangeRequestOperation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleChangeRequestOperation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleChangeRequestOperation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleChangeRequestOperation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleChangeRequestOperation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleChangeRequestOperation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleChangeRequestOperation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleChangeRequestOperation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestOperationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleChangeRequestOperationHook registers your hook function for all future operations.
+func AddTBLScheduleChangeRequestOperationHook(hookPoint boil.HookPoint, tblScheduleChangeRequestOperationHook TBLScheduleChangeRequestOperationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleChangeRequestOperationAfterSelectMu.Lock()
+		tblScheduleChangeRequestOperationAfterSelectHooks = append(tblScheduleChangeRequestOperationAfterSelectHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleChangeRequestOperationBeforeInsertMu.Lock()
+		tblScheduleChangeRequestOperationBeforeInsertHooks = append(tblScheduleChangeRequestOperationBeforeInsertHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleChangeRequestOperationAfterInsertMu.Lock()
+		tblScheduleChangeRequestOperationAfterInsertHooks = append(tblScheduleChangeRequestOperationAfterInsertHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleChangeRequestOperationBeforeUpdateMu.Lock()
+		tblScheduleChangeRequestOperationBeforeUpdateHooks = append(tblScheduleChangeRequestOperationBeforeUpdateHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleChangeRequestOperationAfterUpdateMu.Lock()
+		tblScheduleChangeRequestOperationAfterUpdateHooks = append(tblScheduleChangeRequestOperationAfterUpdateHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleChangeRequestOperationBeforeDeleteMu.Lock()
+		tblScheduleChangeRequestOperationBeforeDeleteHooks = append(tblScheduleChangeRequestOperationBeforeDeleteHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleChangeRequestOperationAfterDeleteMu.Lock()
+		tblScheduleChangeRequestOperationAfterDeleteHooks = append(tblScheduleChangeRequestOperationAfterDeleteHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleChangeRequestOperationBeforeUpsertMu.Lock()
+		tblScheduleChangeRequestOperationBeforeUpsertHooks = append(tblScheduleChangeRequestOperationBeforeUpsertHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleChangeRequestOperationAfterUpsertMu.Lock()
+		tblScheduleChangeRequestOperationAfterUpsertHooks = append(tblScheduleChangeRequestOperationAfterUpsertHooks, tblScheduleChangeRequestOperationHook)
+		tblScheduleChangeRequestOperationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleChangeRequestOperation record from the query.
+func (q tblScheduleChangeRequestOperationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleChangeRequestOperation, error) {
+	o := &TBLScheduleChangeRequestOperation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_change_request_operations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleChangeRequestOperation records from the query.
+func (q tblScheduleChangeRequestOperationQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleChangeRequestOperationSlice, error) {
+	var o []*TBLScheduleChangeRequestOperation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleChangeRequestOperation slice")
+	}
+
+	if len(tblScheduleChangeRequestOperationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleChangeRequestOperation records in the query.
+func (q tblScheduleChangeRequestOperationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_change_request_operations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleChangeRequestOperationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_change_request_operations exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChangeRequest pointed to by the foreign key.
+func (o *TBLScheduleChangeRequestOperation) ChangeRequest(mods ...qm.QueryMod) tblScheduleChangeRequestQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ChangeRequestID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLScheduleChangeRequests(queryMods...)
+}
+
+// LoadChangeRequest allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleChangeRequestOperationL) LoadChangeRequest(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleChangeRequestOperation interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleChangeRequestOperation
+	var object *TBLScheduleChangeRequestOperation
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleChangeRequestOperation.(*TBLScheduleChangeRequestOperation)
+		if !ok {
+			object = new(TBLScheduleChangeRequestOperation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleChangeRequestOperation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleChangeRequestOperation))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleChangeRequestOperation.(*[]*TBLScheduleChangeRequestOperation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleChangeRequestOperation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleChangeRequestOperation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleChangeRequestOperationR{}
+		}
+		args[object.ChangeRequestID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleChangeRequestOperationR{}
+			}
+
+			args[obj.ChangeRequestID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_change_requests`),
+		qm.WhereIn(`tbl_schedule_change_requests.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLScheduleChangeRequest")
+	}
+
+	var resultSlice []*TBLScheduleChangeRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLScheduleChangeRequest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedule_change_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_change_requests")
+	}
+
+	if len(tblScheduleChangeRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ChangeRequest = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleChangeRequestR{}
+		}
+		foreign.R.ChangeRequestTBLScheduleChangeRequestOperations = append(foreign.R.ChangeRequestTBLScheduleChangeRequestOperations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChangeRequestID == foreign.ID {
+				local.R.ChangeRequest = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleChangeRequestR{}
+				}
+				foreign.R.ChangeRequestTBLScheduleChangeRequestOperations = append(foreign.R.ChangeRequestTBLScheduleChangeRequestOperations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChangeRequest of the tblScheduleChangeRequestOperation to the related item.
+// Sets o.R.ChangeRequest to related.
+// Adds o to related.R.ChangeRequestTBLScheduleChangeRequestOperations.
+func (o *TBLScheduleChangeRequestOperation) SetChangeRequest(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLScheduleChangeRequest) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_change_request_operations` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"change_request_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestOperationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChangeRequestID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleChangeRequestOperationR{
+			ChangeRequest: related,
+		}
+	} else {
+		o.R.ChangeRequest = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleChangeRequestR{
+			ChangeRequestTBLScheduleChangeRequestOperations: TBLScheduleChangeRequestOperationSlice{o},
+		}
+	} else {
+		related.R.ChangeRequestTBLScheduleChangeRequestOperations = append(related.R.ChangeRequestTBLScheduleChangeRequestOperations, o)
+	}
+
+	return nil
+}
+
+// TBLScheduleChangeRequestOperations retrieves all the records using an executor.
+func TBLScheduleChangeRequestOperations(mods ...qm.QueryMod) tblScheduleChangeRequestOperationQuery {
+	mods = append(mods, qm.From("`tbl_schedule_change_request_operations`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_change_request_operations`.*"})
+	}
+
+	return tblScheduleChangeRequestOperationQuery{q}
+}
+
+// FindTBLScheduleChangeRequestOperation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleChangeRequestOperation(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleChangeRequestOperation, error) {
+	tblScheduleChangeRequestOperationObj := &TBLScheduleChangeRequestOperation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_change_request_operations` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleChangeRequestOperationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_change_request_operations")
+	}
+
+	if err = tblScheduleChangeRequestOperationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleChangeRequestOperationObj, err
+	}
+
+	return tblScheduleChangeRequestOperationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleChangeRequestOperation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_change_request_operations provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleChangeRequestOperationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleChangeRequestOperationInsertCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestOperationInsertCache[key]
+	tblScheduleChangeRequestOperationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleChangeRequestOperationAllColumns,
+			tblScheduleChangeRequestOperationColumnsWithDefault,
+			tblScheduleChangeRequestOperationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_change_request_operations` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_change_request_operations` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_change_request_operations` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestOperationPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_change_request_operations")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleChangeRequestOperationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_change_request_operations")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleChangeRequestOperationInsertCacheMut.Lock()
+		tblScheduleChangeRequestOperationInsertCache[key] = cache
+		tblScheduleChangeRequestOperationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleChangeRequestOperation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleChangeRequestOperation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleChangeRequestOperationUpdateCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestOperationUpdateCache[key]
+	tblScheduleChangeRequestOperationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleChangeRequestOperationAllColumns,
+			tblScheduleChangeRequestOperationPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_change_request_operations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_change_request_operations` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestOperationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, append(wl, tblScheduleChangeRequestOperationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_change_request_operations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_change_request_operations")
+	}
+
+	if !cached {
+		tblScheduleChangeRequestOperationUpdateCacheMut.Lock()
+		tblScheduleChangeRequestOperationUpdateCache[key] = cache
+		tblScheduleChangeRequestOperationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleChangeRequestOperationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_change_request_operations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_change_request_operations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleChangeRequestOperationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestOperationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_change_request_operations` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestOperationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleChangeRequestOperation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleChangeRequestOperation")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleChangeRequestOperationUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleChangeRequestOperation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_change_request_operations provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleChangeRequestOperationColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleChangeRequestOperationUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleChangeRequestOperationUpsertCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestOperationUpsertCache[key]
+	tblScheduleChangeRequestOperationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleChangeRequestOperationAllColumns,
+			tblScheduleChangeRequestOperationColumnsWithDefault,
+			tblScheduleChangeRequestOperationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleChangeRequestOperationAllColumns,
+			tblScheduleChangeRequestOperationPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_change_request_operations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleChangeRequestOperationAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_change_request_operations`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_change_request_operations` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_change_request_operations")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleChangeRequestOperationMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleChangeRequestOperationType, tblScheduleChangeRequestOperationMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_change_request_operations")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_change_request_operations")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleChangeRequestOperationUpsertCacheMut.Lock()
+		tblScheduleChangeRequestOperationUpsertCache[key] = cache
+		tblScheduleChangeRequestOperationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleChangeRequestOperation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleChangeRequestOperation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleChangeRequestOperation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleChangeRequestOperationPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_change_request_operations` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_change_request_operations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_change_request_operations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleChangeRequestOperationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleChangeRequestOperationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_change_request_operations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_change_request_operations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleChangeRequestOperationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleChangeRequestOperationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestOperationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_change_request_operations` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestOperationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleChangeRequestOperation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_change_request_operations")
+	}
+
+	if len(tblScheduleChangeRequestOperationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleChangeRequestOperation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleChangeRequestOperation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleChangeRequestOperationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleChangeRequestOperationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestOperationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_change_request_operations`.* FROM `tbl_schedule_change_request_operations` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestOperationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleChangeRequestOperationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleChangeRequestOperationExists checks if the TBLScheduleChangeRequestOperation row exists.
+func TBLScheduleChangeRequestOperationExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_change_request_operations` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_change_request_operations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleChangeRequestOperation row exists.
+func (o *TBLScheduleChangeRequestOperation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleChangeRequestOperationExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1636 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleChangeRequest is an object representing the database table.
+type TBLScheduleChangeRequest struct {
+	ID                  int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID          int       `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	HistoryIndex        int       `boil:"history_index" json:"history_index" toml:"history_index" yaml:"history_index"`
+	RequestUserID       int       `boil:"request_user_id" json:"request_user_id" toml:"request_user_id" yaml:"request_user_id"`
+	Comment             string    `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
+	Status              string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ReviewUserID        int       `boil:"review_user_id" json:"review_user_id" toml:"review_user_id" yaml:"review_user_id"`
+	ReviewComment       string    `boil:"review_comment" json:"review_comment" toml:"review_comment" yaml:"review_comment"`
+	AppliedHistoryIndex int       `boil:"applied_history_index" json:"applied_history_index" toml:"applied_history_index" yaml:"applied_history_index"`
+	CreatedAt           time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblScheduleChangeRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleChangeRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleChangeRequestColumns = struct {
+	ID                  string
+	ScheduleID          string
+	HistoryIndex        string
+	RequestUserID       string
+	Comment             string
+	Status              string
+	ReviewUserID        string
+	ReviewComment       string
+	AppliedHistoryIndex string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	ID:                  "id",
+	ScheduleID:          "schedule_id",
+	HistoryIndex:        "history_index",
+	RequestUserID:       "request_user_id",
+	Comment:             "comment",
+	Status:              "status",
+	ReviewUserID:        "review_user_id",
+	ReviewComment:       "review_comment",
+	AppliedHistoryIndex: "applied_history_index",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+}
+
+var TBLScheduleChangeRequestTableColumns = struct {
+	ID                  string
+	ScheduleID          string
+	HistoryIndex        string
+	RequestUserID       string
+	Comment             string
+	Status              string
+	ReviewUserID        string
+	ReviewComment       string
+	AppliedHistoryIndex string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	ID:                  "tbl_schedule_change_requests.id",
+	ScheduleID:          "tbl_schedule_change_requests.schedule_id",
+	HistoryIndex:        "tbl_schedule_change_requests.history_index",
+	RequestUserID:       "tbl_schedule_change_requests.request_user_id",
+	Comment:             "tbl_schedule_change_requests.comment",
+	Status:              "tbl_schedule_change_requests.status",
+	ReviewUserID:        "tbl_schedule_change_requests.review_user_id",
+	ReviewComment:       "tbl_schedule_change_requests.review_comment",
+	AppliedHistoryIndex: "tbl_schedule_change_requests.applied_history_index",
+	CreatedAt:           "tbl_schedule_change_requests.created_at",
+	UpdatedAt:           "tbl_schedule_change_requests.updated_at",
+}
+
+// Generated where
+
+var TBLScheduleChangeRequestWhere = struct {
+	ID                  whereHelperint
+	ScheduleID          whereHelperint
+	HistoryIndex        whereHelperint
+	RequestUserID       whereHelperint
+	Comment             whereHelperstring
+	Status              whereHelperstring
+	ReviewUserID        whereHelperint
+	ReviewComment       whereHelperstring
+	AppliedHistoryIndex whereHelperint
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+}{
+	ID:                  whereHelperint{field: "`tbl_schedule_change_requests`.`id`"},
+	ScheduleID:          whereHelperint{field: "`tbl_schedule_change_requests`.`schedule_id`"},
+	HistoryIndex:        whereHelperint{field: "`tbl_schedule_change_requests`.`history_index`"},
+	RequestUserID:       whereHelperint{field: "`tbl_schedule_change_requests`.`request_user_id`"},
+	Comment:             whereHelperstring{field: "`tbl_schedule_change_requests`.`comment`"},
+	Status:              whereHelperstring{field: "`tbl_schedule_change_requests`.`status`"},
+	ReviewUserID:        whereHelperint{field: "`tbl_schedule_change_requests`.`review_user_id`"},
+	ReviewComment:       whereHelperstring{field: "`tbl_schedule_change_requests`.`review_comment`"},
+	AppliedHistoryIndex: whereHelperint{field: "`tbl_schedule_change_requests`.`applied_history_index`"},
+	CreatedAt:           whereHelpertime_Time{field: "`tbl_schedule_change_requests`.`created_at`"},
+	UpdatedAt:           whereHelpertime_Time{field: "`tbl_schedule_change_requests`.`updated_at`"},
+}
+
+// TBLScheduleChangeRequestRels is where relationship names are stored.
+var TBLScheduleChangeRequestRels = struct {
+	Schedule                                        string
+	RequestUser                                     string
+	ChangeRequestTBLScheduleChangeRequestOperations string
+}{
+	Schedule:    "Schedule",
+	RequestUser: "RequestUser",
+	ChangeRequestTBLScheduleChangeRequestOperations: "ChangeRequestTBLScheduleChangeRequestOperations",
+}
+
+// tblScheduleChangeRequestR is where relationships are stored.
+type tblScheduleChangeRequestR struct {
+	Schedule                                        *TBLSchedule                           `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+	RequestUser                                     *TBLUser                               `boil:"RequestUser" json:"RequestUser" toml:"RequestUser" yaml:"RequestUser"`
+	ChangeRequestTBLScheduleChangeRequestOperations TBLScheduleChangeRequestOperationSlice `boil:"ChangeRequestTBLScheduleChangeRequestOperations" json:"ChangeRequestTBLScheduleChangeRequestOperations" toml:"ChangeRequestTBLScheduleChangeRequestOperations" yaml:"ChangeRequestTBLScheduleChangeRequestOperations"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleChangeRequestR) NewStruct() *tblScheduleChangeRequestR {
+	return &tblScheduleChangeRequestR{}
+}
+
+func (o *TBLScheduleChangeRequest) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleChangeRequestR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+func (o *TBLScheduleChangeRequest) GetRequestUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRequestUser()
+}
+
+func (r *tblScheduleChangeRequestR) GetRequestUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.RequestUser
+}
+
+func (o *TBLScheduleChangeRequest) GetChangeRequestTBLScheduleChangeRequestOperations() TBLScheduleChangeRequestOperationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetChangeRequestTBLScheduleChangeRequestOperations()
+}
+
+func (r *tblScheduleChangeRequestR) GetChangeRequestTBLScheduleChangeRequestOperations() TBLScheduleChangeRequestOperationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ChangeRequestTBLScheduleChangeRequestOperations
+}
+
+// tblScheduleChangeRequestL is where Load methods for each relationship are stored.
+type tblScheduleChangeRequestL struct{}
+
+var (
+	tblScheduleChangeRequestAllColumns            = []string{"id", "schedule_id", "history_index", "request_user_id", "comment", "status", "review_user_id", "review_comment", "applied_history_index", "created_at", "updated_at"}
+	tblScheduleChangeRequestColumnsWithoutDefault = []string{"schedule_id", "history_index", "request_user_id", "comment", "review_comment"}
+	tblScheduleChangeRequestColumnsWithDefault    = []string{"id", "status", "review_user_id", "applied_history_index", "created_at", "updated_at"}
+	tblScheduleChangeRequestPrimaryKeyColumns     = []string{"id"}
+	tblScheduleChangeRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleChangeRequestSlice is an alias for a slice of pointers to TBLScheduleChangeRequest.
+	// This should almost always be used instead of []TBLScheduleChangeRequest.
+	TBLScheduleChangeRequestSlice []*TBLScheduleChangeRequest
+	// TBLScheduleChangeRequestHook is the signature for custom TBLScheduleChangeRequest hook methods
+	TBLScheduleChangeRequestHook func(context.Context, boil.ContextExecutor, *TBLScheduleChangeRequest) error
+
+	tblScheduleChangeRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleChangeRequestType                 = reflect.TypeOf(&TBLScheduleChangeRequest{})
+	tblScheduleChangeRequestMapping              = queries.MakeStructMapping(tblScheduleChangeRequestType)
+	tblScheduleChangeRequestPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, tblScheduleChangeRequestPrimaryKeyColumns)
+	tblScheduleChangeRequestInsertCacheMut       sync.RWMutex
+	tblScheduleChangeRequestInsertCache          = make(map[string]insertCache)
+	tblScheduleChangeRequestUpdateCacheMut       sync.RWMutex
+	tblScheduleChangeRequestUpdateCache          = make(map[string]updateCache)
+	tblScheduleChangeRequestUpsertCacheMut       sync.RWMutex
+	tblScheduleChangeRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleChangeRequestAfterSelectMu sync.Mutex
+var tblScheduleChangeRequestAfterSelectHooks []TBLScheduleChangeRequestHook
+
+var tblScheduleChangeRequestBeforeInsertMu sync.Mutex
+var tblScheduleChangeRequestBeforeInsertHooks []TBLScheduleChangeRequestHook
+var tblScheduleChangeRequestAfterInsertMu sync.Mutex
+var tblScheduleChangeRequestAfterInsertHooks []TBLScheduleChangeRequestHook
+
+var tblScheduleChangeRequestBeforeUpdateMu sync.Mutex
+var tblScheduleChangeRequestBeforeUpdateHooks []TBLScheduleChangeRequestHook
+var tblScheduleChangeRequestAfterUpdateMu sync.Mutex
+var tblScheduleChangeRequestAfterUpdateHooks []TBLScheduleChangeRequestHook
+
+var tblScheduleChangeRequestBeforeDeleteMu sync.Mutex
+var tblScheduleChangeRequestBeforeDeleteHooks []TBLScheduleChangeRequestHook
+var tblScheduleChangeRequestAfterDeleteMu sync.Mutex
+var tblScheduleChangeRequestAfterDeleteHooks []TBLScheduleChangeRequestHook
+
+var tblScheduleChangeRequestBeforeUpsertMu sync.Mutex
+var tblScheduleChangeRequestBeforeUpsertHooks []TBLScheduleChangeRequestHook
+var tblScheduleChangeRequestAfterUpsertMu sync.Mutex
+var tblScheduleChangeRequestAfterUpsertHooks []TBLScheduleChangeRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleChangeRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleChangeRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleChangeRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleChangeRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleChangeRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleChangeRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleChangeRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleChangeRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleChangeRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleChangeRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleChangeRequestHook registers your hook function for all future operations.
+func AddTBLScheduleChangeRequestHook(hookPoint boil.HookPoint, tblScheduleChangeRequestHook TBLScheduleChangeRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleChangeRequestAfterSelectMu.Lock()
+		tblScheduleChangeRequestAfterSelectHooks = append(tblScheduleChangeRequestAfterSelectHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleChangeRequestBeforeInsertMu.Lock()
+		tblScheduleChangeRequestBeforeInsertHooks = append(tblScheduleChangeRequestBeforeInsertHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleChangeRequestAfterInsertMu.Lock()
+		tblScheduleChangeRequestAfterInsertHooks = append(tblScheduleChangeRequestAfterInsertHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleChangeRequestBeforeUpdateMu.Lock()
+		tblScheduleChangeRequestBeforeUpdateHooks = append(tblScheduleChangeRequestBeforeUpdateHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleChangeRequestAfterUpdateMu.Lock()
+		tblScheduleChangeRequestAfterUpdateHooks = append(tblScheduleChangeRequestAfterUpdateHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleChangeRequestBeforeDeleteMu.Lock()
+		tblScheduleChangeRequestBeforeDeleteHooks = append(tblScheduleChangeRequestBeforeDeleteHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleChangeRequestAfterDeleteMu.Lock()
+		tblScheduleChangeRequestAfterDeleteHooks = append(tblScheduleChangeRequestAfterDeleteHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleChangeRequestBeforeUpsertMu.Lock()
+		tblScheduleChangeRequestBeforeUpsertHooks = append(tblScheduleChangeRequestBeforeUpsertHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleChangeRequestAfterUpsertMu.Lock()
+		tblScheduleChangeRequestAfterUpsertHooks = append(tblScheduleChangeRequestAfterUpsertHooks, tblScheduleChangeRequestHook)
+		tblScheduleChangeRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleChangeRequest record from the query.
+func (q tblScheduleChangeRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleChangeRequest, error) {
+	o := &TBLScheduleChangeRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_change_requests")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleChangeRequest records from the query.
+func (q tblScheduleChangeRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleChangeRequestSlice, error) {
+	var o []*TBLScheduleChangeRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleChangeRequest slice")
+	}
+
+	if len(tblScheduleChangeRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleChangeRequest records in the query.
+func (q tblScheduleChangeRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_change_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleChangeRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_change_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleChangeRequest) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// RequestUser pointed to by the foreign key.
+func (o *TBLScheduleChangeRequest) RequestUser(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.RequestUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// ChangeRequestTBLScheduleChangeRequestOperations retrieves all the tbl_schedule_change_request_operation's TBLScheduleChangeRequestOperations with an executor via change_request_id column.
+func (o *TBLScheduleChangeRequest) ChangeRequestTBLScheduleChangeRequestOperations(mods ...qm.QueryMod) tblScheduleChangeRequestOperationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_change_request_operations`.`change_request_id`=?", o.ID),
+	)
+
+	return TBLScheduleChangeRequestOperations(queryMods...)
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleChangeRequestL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleChangeRequest interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleChangeRequest
+	var object *TBLScheduleChangeRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleChangeRequest.(*TBLScheduleChangeRequest)
+		if !ok {
+			object = new(TBLScheduleChangeRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleChangeRequest))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleChangeRequest.(*[]*TBLScheduleChangeRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleChangeRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleChangeRequestR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleChangeRequestR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleChangeRequests = append(foreign.R.ScheduleTBLScheduleChangeRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleChangeRequests = append(foreign.R.ScheduleTBLScheduleChangeRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRequestUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleChangeRequestL) LoadRequestUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleChangeRequest interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleChangeRequest
+	var object *TBLScheduleChangeRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleChangeRequest.(*TBLScheduleChangeRequest)
+		if !ok {
+			object = new(TBLScheduleChangeRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleChangeRequest))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleChangeRequest.(*[]*TBLScheduleChangeRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleChangeRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleChangeRequestR{}
+		}
+		args[object.RequestUserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleChangeRequestR{}
+			}
+
+			args[obj.RequestUserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RequestUser = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.RequestUserTBLScheduleChangeRequests = append(foreign.R.RequestUserTBLScheduleChangeRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RequestUserID == foreign.ID {
+				local.R.RequestUser = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.RequestUserTBLScheduleChangeRequests = append(foreign.R.RequestUserTBLScheduleChangeRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangeRequestTBLScheduleChangeRequestOperations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleChangeRequestL) LoadChangeRequestTBLScheduleChangeRequestOperations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleChangeRequest interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleChangeRequest
+	var object *TBLScheduleChangeRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleChangeRequest.(*TBLScheduleChangeRequest)
+		if !ok {
+			object = new(TBLScheduleChangeRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleChangeRequest))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleChangeRequest.(*[]*TBLScheduleChangeRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleChangeRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleChangeRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleChangeRequestR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleChangeRequestR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_change_request_operations`),
+		qm.WhereIn(`tbl_schedule_change_request_operations.change_request_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_change_request_operations")
+	}
+
+	var resultSlice []*TBLScheduleChangeRequestOperation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_change_request_operations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_change_request_operations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_change_request_operations")
+	}
+
+	if len(tblScheduleChangeRequestOperationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChangeRequestTBLScheduleChangeRequestOperations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleChangeRequestOperationR{}
+			}
+			foreign.R.ChangeRequest = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChangeRequestID {
+				local.R.ChangeRequestTBLScheduleChangeRequestOperations = append(local.R.ChangeRequestTBLScheduleChangeRequestOperations, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleChangeRequestOperationR{}
+				}
+				foreign.R.ChangeRequest = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleChangeRequest to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleChangeRequests.
+func (o *TBLScheduleChangeRequest) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleChangeRequestR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleChangeRequests: TBLScheduleChangeRequestSlice{o},
+		}
+	} else {
+		related.R.ScheduleTBLScheduleChangeRequests = append(related.R.ScheduleTBLScheduleChangeRequests, o)
+	}
+
+	return nil
+}
+
+// SetRequestUser of the tblScheduleChangeRequest to the related item.
+// Sets o.R.RequestUser to related.
+// Adds o to related.R.RequestUserTBLScheduleChangeRequests.
+func (o *TBLScheduleChangeRequest) SetRequestUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"request_user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RequestUserID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleChangeRequestR{
+			RequestUser: related,
+		}
+	} else {
+		o.R.RequestUser = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			RequestUserTBLScheduleChangeRequests: TBLScheduleChangeRequestSlice{o},
+		}
+	} else {
+		related.R.RequestUserTBLScheduleChangeRequests = append(related.R.RequestUserTBLScheduleChangeRequests, o)
+	}
+
+	return nil
+}
+
+// AddChangeRequestTBLScheduleChangeRequestOperations adds the given related objects to the existing relationships
+// of the tbl_schedule_change_request, optionally inserting them as new records.
+// Appends related to o.R.ChangeRequestTBLScheduleChangeRequestOperations.
+// Sets related.R.ChangeRequest appropriately.
+func (o *TBLScheduleChangeRequest) AddChangeRequestTBLScheduleChangeRequestOperations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleChangeRequestOperation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChangeRequestID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_change_request_operations` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"change_request_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestOperationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChangeRequestID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleChangeRequestR{
+			ChangeRequestTBLScheduleChangeRequestOperations: related,
+		}
+	} else {
+		o.R.ChangeRequestTBLScheduleChangeRequestOperations = append(o.R.ChangeRequestTBLScheduleChangeRequestOperations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleChangeRequestOperationR{
+				ChangeRequest: o,
+			}
+		} else {
+			rel.R.ChangeRequest = o
+		}
+	}
+	return nil
+}
+
+// TBLScheduleChangeRequests retrieves all the records using an executor.
+func TBLScheduleChangeRequests(mods ...qm.QueryMod) tblScheduleChangeRequestQuery {
+	mods = append(mods, qm.From("`tbl_schedule_change_requests`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_change_requests`.*"})
+	}
+
+	return tblScheduleChangeRequestQuery{q}
+}
+
+// FindTBLScheduleChangeRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleChangeRequest(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleChangeRequest, error) {
+	tblScheduleChangeRequestObj := &TBLScheduleChangeRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_change_requests` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleChangeRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_change_requests")
+	}
+
+	if err = tblScheduleChangeRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleChangeRequestObj, err
+	}
+
+	return tblScheduleChangeRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleChangeRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_change_requests provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleChangeRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleChangeRequestInsertCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestInsertCache[key]
+	tblScheduleChangeRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleChangeRequestAllColumns,
+			tblScheduleChangeRequestColumnsWithDefault,
+			tblScheduleChangeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_change_requests` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_change_requests` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_change_requests` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_change_requests")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleChangeRequestMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_change_requests")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleChangeRequestInsertCacheMut.Lock()
+		tblScheduleChangeRequestInsertCache[key] = cache
+		tblScheduleChangeRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleChangeRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleChangeRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleChangeRequestUpdateCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestUpdateCache[key]
+	tblScheduleChangeRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleChangeRequestAllColumns,
+			tblScheduleChangeRequestPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_change_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, append(wl, tblScheduleChangeRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_change_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_change_requests")
+	}
+
+	if !cached {
+		tblScheduleChangeRequestUpdateCacheMut.Lock()
+		tblScheduleChangeRequestUpdateCache[key] = cache
+		tblScheduleChangeRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleChangeRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleChangeRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleChangeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleChangeRequest")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleChangeRequestUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleChangeRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_change_requests provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleChangeRequestColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleChangeRequestUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleChangeRequestUpsertCacheMut.RLock()
+	cache, cached := tblScheduleChangeRequestUpsertCache[key]
+	tblScheduleChangeRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleChangeRequestAllColumns,
+			tblScheduleChangeRequestColumnsWithDefault,
+			tblScheduleChangeRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleChangeRequestAllColumns,
+			tblScheduleChangeRequestPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_change_requests, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleChangeRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_change_requests`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_change_requests` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_change_requests")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleChangeRequestMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleChangeRequestType, tblScheduleChangeRequestMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_change_requests")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_change_requests")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleChangeRequestUpsertCacheMut.Lock()
+		tblScheduleChangeRequestUpsertCache[key] = cache
+		tblScheduleChangeRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleChangeRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleChangeRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleChangeRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleChangeRequestPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_change_requests` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_change_requests")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleChangeRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleChangeRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_change_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_change_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleChangeRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleChangeRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_change_requests` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleChangeRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_change_requests")
+	}
+
+	if len(tblScheduleChangeRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleChangeRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleChangeRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleChangeRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleChangeRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleChangeRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_change_requests`.* FROM `tbl_schedule_change_requests` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleChangeRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleChangeRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleChangeRequestExists checks if the TBLScheduleChangeRequest row exists.
+func TBLScheduleChangeRequestExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_change_requests` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_change_requests exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleChangeRequest row exists.
+func (o *TBLScheduleChangeRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleChangeRequestExists(ctx, exec, o.ID)
+}
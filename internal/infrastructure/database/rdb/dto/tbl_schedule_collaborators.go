@@ -0,0 +1,1402 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleCollaborator is an object representing the database table.
+type TBLScheduleCollaborator struct {
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID int       `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	UserID     int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Permission string    `boil:"permission" json:"permission" toml:"permission" yaml:"permission"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblScheduleCollaboratorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleCollaboratorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleCollaboratorColumns = struct {
+	ID         string
+	ScheduleID string
+	UserID     string
+	Permission string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	ScheduleID: "schedule_id",
+	UserID:     "user_id",
+	Permission: "permission",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+var TBLScheduleCollaboratorTableColumns = struct {
+	ID         string
+	ScheduleID string
+	UserID     string
+	Permission string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "tbl_schedule_collaborators.id",
+	ScheduleID: "tbl_schedule_collaborators.schedule_id",
+	UserID:     "tbl_schedule_collaborators.user_id",
+	Permission: "tbl_schedule_collaborators.permission",
+	CreatedAt:  "tbl_schedule_collaborators.created_at",
+	UpdatedAt:  "tbl_schedule_collaborators.updated_at",
+}
+
+// Generated where
+
+var TBLScheduleCollaboratorWhere = struct {
+	ID         whereHelperint
+	ScheduleID whereHelperint
+	UserID     whereHelperint
+	Permission whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: "`tbl_schedule_collaborators`.`id`"},
+	ScheduleID: whereHelperint{field: "`tbl_schedule_collaborators`.`schedule_id`"},
+	UserID:     whereHelperint{field: "`tbl_schedule_collaborators`.`user_id`"},
+	Permission: whereHelperstring{field: "`tbl_schedule_collaborators`.`permission`"},
+	CreatedAt:  whereHelpertime_Time{field: "`tbl_schedule_collaborators`.`created_at`"},
+	UpdatedAt:  whereHelpertime_Time{field: "`tbl_schedule_collaborators`.`updated_at`"},
+}
+
+// TBLScheduleCollaboratorRels is where relationship names are stored.
+var TBLScheduleCollaboratorRels = struct {
+	Schedule string
+	User     string
+}{
+	Schedule: "Schedule",
+	User:     "User",
+}
+
+// tblScheduleCollaboratorR is where relationships are stored.
+type tblScheduleCollaboratorR struct {
+	Schedule *TBLSchedule `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+	User     *TBLUser     `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleCollaboratorR) NewStruct() *tblScheduleCollaboratorR {
+	return &tblScheduleCollaboratorR{}
+}
+
+func (o *TBLScheduleCollaborator) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleCollaboratorR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+func (o *TBLScheduleCollaborator) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblScheduleCollaboratorR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblScheduleCollaboratorL is where Load methods for each relationship are stored.
+type tblScheduleCollaboratorL struct{}
+
+var (
+	tblScheduleCollaboratorAllColumns            = []string{"id", "schedule_id", "user_id", "permission", "created_at", "updated_at"}
+	tblScheduleCollaboratorColumnsWithoutDefault = []string{"schedule_id", "user_id", "permission"}
+	tblScheduleCollaboratorColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblScheduleCollaboratorPrimaryKeyColumns     = []string{"id"}
+	tblScheduleCollaboratorGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleCollaboratorSlice is an alias for a slice of pointers to TBLScheduleCollaborator.
+	// This should almost always be used instead of []TBLScheduleCollaborator.
+	TBLScheduleCollaboratorSlice []*TBLScheduleCollaborator
+	// TBLScheduleCollaboratorHook is the signature for custom TBLScheduleCollaborator hook methods
+	TBLScheduleCollaboratorHook func(context.Context, boil.ContextExecutor, *TBLScheduleCollaborator) error
+
+	tblScheduleCollaboratorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleCollaboratorType                 = reflect.TypeOf(&TBLScheduleCollaborator{})
+	tblScheduleCollaboratorMapping              = queries.MakeStructMapping(tblScheduleCollaboratorType)
+	tblScheduleCollaboratorPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, tblScheduleCollaboratorPrimaryKeyColumns)
+	tblScheduleCollaboratorInsertCacheMut       sync.RWMutex
+	tblScheduleCollaboratorInsertCache          = make(map[string]insertCache)
+	tblScheduleCollaboratorUpdateCacheMut       sync.RWMutex
+	tblScheduleCollaboratorUpdateCache          = make(map[string]updateCache)
+	tblScheduleCollaboratorUpsertCacheMut       sync.RWMutex
+	tblScheduleCollaboratorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleCollaboratorAfterSelectMu sync.Mutex
+var tblScheduleCollaboratorAfterSelectHooks []TBLScheduleCollaboratorHook
+
+var tblScheduleCollaboratorBeforeInsertMu sync.Mutex
+var tblScheduleCollaboratorBeforeInsertHooks []TBLScheduleCollaboratorHook
+var tblScheduleCollaboratorAfterInsertMu sync.Mutex
+var tblScheduleCollaboratorAfterInsertHooks []TBLScheduleCollaboratorHook
+
+var tblScheduleCollaboratorBeforeUpdateMu sync.Mutex
+var tblScheduleCollaboratorBeforeUpdateHooks []TBLScheduleCollaboratorHook
+var tblScheduleCollaboratorAfterUpdateMu sync.Mutex
+var tblScheduleCollaboratorAfterUpdateHooks []TBLScheduleCollaboratorHook
+
+var tblScheduleCollaboratorBeforeDeleteMu sync.Mutex
+var tblScheduleCollaboratorBeforeDeleteHooks []TBLScheduleCollaboratorHook
+var tblScheduleCollaboratorAfterDeleteMu sync.Mutex
+var tblScheduleCollaboratorAfterDeleteHooks []TBLScheduleCollaboratorHook
+
+var tblScheduleCollaboratorBeforeUpsertMu sync.Mutex
+var tblScheduleCollaboratorBeforeUpsertHooks []TBLScheduleCollaboratorHook
+var tblScheduleCollaboratorAfterUpsertMu sync.Mutex
+var tblScheduleCollaboratorAfterUpsertHooks []TBLScheduleCollaboratorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleCollaborator) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleCollaborator) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleCollaborator) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleCollaborator) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleCollaborator) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleCollaborator) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleCollaborator) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleCollaborator) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleCollaborator) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCollaboratorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleCollaboratorHook registers your hook function for all future operations.
+func AddTBLScheduleCollaboratorHook(hookPoint boil.HookPoint, tblScheduleCollaboratorHook TBLScheduleCollaboratorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleCollaboratorAfterSelectMu.Lock()
+		tblScheduleCollaboratorAfterSelectHooks = append(tblScheduleCollaboratorAfterSelectHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleCollaboratorBeforeInsertMu.Lock()
+		tblScheduleCollaboratorBeforeInsertHooks = append(tblScheduleCollaboratorBeforeInsertHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleCollaboratorAfterInsertMu.Lock()
+		tblScheduleCollaboratorAfterInsertHooks = append(tblScheduleCollaboratorAfterInsertHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleCollaboratorBeforeUpdateMu.Lock()
+		tblScheduleCollaboratorBeforeUpdateHooks = append(tblScheduleCollaboratorBeforeUpdateHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleCollaboratorAfterUpdateMu.Lock()
+		tblScheduleCollaboratorAfterUpdateHooks = append(tblScheduleCollaboratorAfterUpdateHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleCollaboratorBeforeDeleteMu.Lock()
+		tblScheduleCollaboratorBeforeDeleteHooks = append(tblScheduleCollaboratorBeforeDeleteHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleCollaboratorAfterDeleteMu.Lock()
+		tblScheduleCollaboratorAfterDeleteHooks = append(tblScheduleCollaboratorAfterDeleteHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleCollaboratorBeforeUpsertMu.Lock()
+		tblScheduleCollaboratorBeforeUpsertHooks = append(tblScheduleCollaboratorBeforeUpsertHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleCollaboratorAfterUpsertMu.Lock()
+		tblScheduleCollaboratorAfterUpsertHooks = append(tblScheduleCollaboratorAfterUpsertHooks, tblScheduleCollaboratorHook)
+		tblScheduleCollaboratorAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleCollaborator record from the query.
+func (q tblScheduleCollaboratorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleCollaborator, error) {
+	o := &TBLScheduleCollaborator{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_collaborators")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleCollaborator records from the query.
+func (q tblScheduleCollaboratorQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleCollaboratorSlice, error) {
+	var o []*TBLScheduleCollaborator
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleCollaborator slice")
+	}
+
+	if len(tblScheduleCollaboratorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleCollaborator records in the query.
+func (q tblScheduleCollaboratorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_collaborators rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleCollaboratorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_collaborators exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleCollaborator) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *TBLScheduleCollaborator) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleCollaboratorL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleCollaborator interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleCollaborator
+	var object *TBLScheduleCollaborator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleCollaborator.(*TBLScheduleCollaborator)
+		if !ok {
+			object = new(TBLScheduleCollaborator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleCollaborator))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleCollaborator.(*[]*TBLScheduleCollaborator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleCollaborator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleCollaboratorR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleCollaboratorR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleCollaborators = append(foreign.R.ScheduleTBLScheduleCollaborators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleCollaborators = append(foreign.R.ScheduleTBLScheduleCollaborators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleCollaboratorL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleCollaborator interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleCollaborator
+	var object *TBLScheduleCollaborator
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleCollaborator.(*TBLScheduleCollaborator)
+		if !ok {
+			object = new(TBLScheduleCollaborator)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleCollaborator))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleCollaborator.(*[]*TBLScheduleCollaborator)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleCollaborator)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleCollaborator))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleCollaboratorR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleCollaboratorR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLScheduleCollaborators = append(foreign.R.UserTBLScheduleCollaborators, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLScheduleCollaborators = append(foreign.R.UserTBLScheduleCollaborators, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleCollaborator to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleCollaborators.
+func (o *TBLScheduleCollaborator) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleCollaboratorR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleCollaborators: TBLScheduleCollaboratorSlice{o},
+		}
+	} else {
+		related.R.ScheduleTBLScheduleCollaborators = append(related.R.ScheduleTBLScheduleCollaborators, o)
+	}
+
+	return nil
+}
+
+// SetUser of the tblScheduleCollaborator to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLScheduleCollaborators.
+func (o *TBLScheduleCollaborator) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleCollaboratorR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLScheduleCollaborators: TBLScheduleCollaboratorSlice{o},
+		}
+	} else {
+		related.R.UserTBLScheduleCollaborators = append(related.R.UserTBLScheduleCollaborators, o)
+	}
+
+	return nil
+}
+
+// TBLScheduleCollaborators retrieves all the records using an executor.
+func TBLScheduleCollaborators(mods ...qm.QueryMod) tblScheduleCollaboratorQuery {
+	mods = append(mods, qm.From("`tbl_schedule_collaborators`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_collaborators`.*"})
+	}
+
+	return tblScheduleCollaboratorQuery{q}
+}
+
+// FindTBLScheduleCollaborator retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleCollaborator(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleCollaborator, error) {
+	tblScheduleCollaboratorObj := &TBLScheduleCollaborator{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_collaborators` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleCollaboratorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_collaborators")
+	}
+
+	if err = tblScheduleCollaboratorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleCollaboratorObj, err
+	}
+
+	return tblScheduleCollaboratorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleCollaborator) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_collaborators provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleCollaboratorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleCollaboratorInsertCacheMut.RLock()
+	cache, cached := tblScheduleCollaboratorInsertCache[key]
+	tblScheduleCollaboratorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleCollaboratorAllColumns,
+			tblScheduleCollaboratorColumnsWithDefault,
+			tblScheduleCollaboratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_collaborators` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_collaborators` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_collaborators` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_collaborators")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleCollaboratorMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_collaborators")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleCollaboratorInsertCacheMut.Lock()
+		tblScheduleCollaboratorInsertCache[key] = cache
+		tblScheduleCollaboratorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleCollaborator.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleCollaborator) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleCollaboratorUpdateCacheMut.RLock()
+	cache, cached := tblScheduleCollaboratorUpdateCache[key]
+	tblScheduleCollaboratorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleCollaboratorAllColumns,
+			tblScheduleCollaboratorPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_collaborators, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, append(wl, tblScheduleCollaboratorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_collaborators row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_collaborators")
+	}
+
+	if !cached {
+		tblScheduleCollaboratorUpdateCacheMut.Lock()
+		tblScheduleCollaboratorUpdateCache[key] = cache
+		tblScheduleCollaboratorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleCollaboratorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_collaborators")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleCollaboratorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCollaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCollaboratorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleCollaborator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleCollaborator")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleCollaboratorUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleCollaborator) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_collaborators provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleCollaboratorColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleCollaboratorUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleCollaboratorUpsertCacheMut.RLock()
+	cache, cached := tblScheduleCollaboratorUpsertCache[key]
+	tblScheduleCollaboratorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleCollaboratorAllColumns,
+			tblScheduleCollaboratorColumnsWithDefault,
+			tblScheduleCollaboratorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleCollaboratorAllColumns,
+			tblScheduleCollaboratorPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_collaborators, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleCollaboratorAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_collaborators`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_collaborators` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_collaborators")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleCollaboratorMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleCollaboratorType, tblScheduleCollaboratorMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_collaborators")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_collaborators")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleCollaboratorUpsertCacheMut.Lock()
+		tblScheduleCollaboratorUpsertCache[key] = cache
+		tblScheduleCollaboratorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleCollaborator record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleCollaborator) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleCollaborator provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleCollaboratorPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_collaborators` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_collaborators")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleCollaboratorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleCollaboratorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_collaborators")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_collaborators")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleCollaboratorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleCollaboratorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCollaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_collaborators` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCollaboratorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleCollaborator slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_collaborators")
+	}
+
+	if len(tblScheduleCollaboratorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleCollaborator) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleCollaborator(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleCollaboratorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleCollaboratorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCollaboratorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_collaborators`.* FROM `tbl_schedule_collaborators` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCollaboratorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleCollaboratorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleCollaboratorExists checks if the TBLScheduleCollaborator row exists.
+func TBLScheduleCollaboratorExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_collaborators` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_collaborators exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleCollaborator row exists.
+func (o *TBLScheduleCollaborator) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleCollaboratorExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1423 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleComment is an object representing the database table.
+type TBLScheduleComment struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID   int       `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	Identifier   string    `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
+	ParentID     int       `boil:"parent_id" json:"parent_id" toml:"parent_id" yaml:"parent_id"`
+	AuthorUserID int       `boil:"author_user_id" json:"author_user_id" toml:"author_user_id" yaml:"author_user_id"`
+	Body         string    `boil:"body" json:"body" toml:"body" yaml:"body"`
+	Resolved     int       `boil:"resolved" json:"resolved" toml:"resolved" yaml:"resolved"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblScheduleCommentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleCommentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleCommentColumns = struct {
+	ID           string
+	ScheduleID   string
+	Identifier   string
+	ParentID     string
+	AuthorUserID string
+	Body         string
+	Resolved     string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	ScheduleID:   "schedule_id",
+	Identifier:   "identifier",
+	ParentID:     "parent_id",
+	AuthorUserID: "author_user_id",
+	Body:         "body",
+	Resolved:     "resolved",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var TBLScheduleCommentTableColumns = struct {
+	ID           string
+	ScheduleID   string
+	Identifier   string
+	ParentID     string
+	AuthorUserID string
+	Body         string
+	Resolved     string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "tbl_schedule_comments.id",
+	ScheduleID:   "tbl_schedule_comments.schedule_id",
+	Identifier:   "tbl_schedule_comments.identifier",
+	ParentID:     "tbl_schedule_comments.parent_id",
+	AuthorUserID: "tbl_schedule_comments.author_user_id",
+	Body:         "tbl_schedule_comments.body",
+	Resolved:     "tbl_schedule_comments.resolved",
+	CreatedAt:    "tbl_schedule_comments.created_at",
+	UpdatedAt:    "tbl_schedule_comments.updated_at",
+}
+
+// Generated where
+
+var TBLScheduleCommentWhere = struct {
+	ID           whereHelperint
+	ScheduleID   whereHelperint
+	Identifier   whereHelperstring
+	ParentID     whereHelperint
+	AuthorUserID whereHelperint
+	Body         whereHelperstring
+	Resolved     whereHelperint
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperint{field: "`tbl_schedule_comments`.`id`"},
+	ScheduleID:   whereHelperint{field: "`tbl_schedule_comments`.`schedule_id`"},
+	Identifier:   whereHelperstring{field: "`tbl_schedule_comments`.`identifier`"},
+	ParentID:     whereHelperint{field: "`tbl_schedule_comments`.`parent_id`"},
+	AuthorUserID: whereHelperint{field: "`tbl_schedule_comments`.`author_user_id`"},
+	Body:         whereHelperstring{field: "`tbl_schedule_comments`.`body`"},
+	Resolved:     whereHelperint{field: "`tbl_schedule_comments`.`resolved`"},
+	CreatedAt:    whereHelpertime_Time{field: "`tbl_schedule_comments`.`created_at`"},
+	UpdatedAt:    whereHelpertime_Time{field: "`tbl_schedule_comments`.`updated_at`"},
+}
+
+// TBLScheduleCommentRels is where relationship names are stored.
+var TBLScheduleCommentRels = struct {
+	Schedule   string
+	AuthorUser string
+}{
+	Schedule:   "Schedule",
+	AuthorUser: "AuthorUser",
+}
+
+// tblScheduleCommentR is where relationships are stored.
+type tblScheduleCommentR struct {
+	Schedule   *TBLSchedule `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+	AuthorUser *TBLUser     `boil:"AuthorUser" json:"AuthorUser" toml:"AuthorUser" yaml:"AuthorUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleCommentR) NewStruct() *tblScheduleCommentR {
+	return &tblScheduleCommentR{}
+}
+
+func (o *TBLScheduleComment) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleCommentR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+func (o *TBLScheduleComment) GetAuthorUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAuthorUser()
+}
+
+func (r *tblScheduleCommentR) GetAuthorUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.AuthorUser
+}
+
+// tblScheduleCommentL is where Load methods for each relationship are stored.
+type tblScheduleCommentL struct{}
+
+var (
+	tblScheduleCommentAllColumns            = []string{"id", "schedule_id", "identifier", "parent_id", "author_user_id", "body", "resolved", "created_at", "updated_at"}
+	tblScheduleCommentColumnsWithoutDefault = []string{"schedule_id", "identifier", "author_user_id", "body"}
+	tblScheduleCommentColumnsWithDefault    = []string{"id", "parent_id", "resolved", "created_at", "updated_at"}
+	tblScheduleCommentPrimaryKeyColumns     = []string{"id"}
+	tblScheduleCommentGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleCommentSlice is an alias for a slice of pointers to TBLScheduleComment.
+	// This should almost always be used instead of []TBLScheduleComment.
+	TBLScheduleCommentSlice []*TBLScheduleComment
+	// TBLScheduleCommentHook is the signature for custom TBLScheduleComment hook methods
+	TBLScheduleCommentHook func(context.Context, boil.ContextExecutor, *TBLScheduleComment) error
+
+	tblScheduleCommentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleCommentType                 = reflect.TypeOf(&TBLScheduleComment{})
+	tblScheduleCommentMapping              = queries.MakeStructMapping(tblScheduleCommentType)
+	tblScheduleCommentPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, tblScheduleCommentPrimaryKeyColumns)
+	tblScheduleCommentInsertCacheMut       sync.RWMutex
+	tblScheduleCommentInsertCache          = make(map[string]insertCache)
+	tblScheduleCommentUpdateCacheMut       sync.RWMutex
+	tblScheduleCommentUpdateCache          = make(map[string]updateCache)
+	tblScheduleCommentUpsertCacheMut       sync.RWMutex
+	tblScheduleCommentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleCommentAfterSelectMu sync.Mutex
+var tblScheduleCommentAfterSelectHooks []TBLScheduleCommentHook
+
+var tblScheduleCommentBeforeInsertMu sync.Mutex
+var tblScheduleCommentBeforeInsertHooks []TBLScheduleCommentHook
+var tblScheduleCommentAfterInsertMu sync.Mutex
+var tblScheduleCommentAfterInsertHooks []TBLScheduleCommentHook
+
+var tblScheduleCommentBeforeUpdateMu sync.Mutex
+var tblScheduleCommentBeforeUpdateHooks []TBLScheduleCommentHook
+var tblScheduleCommentAfterUpdateMu sync.Mutex
+var tblScheduleCommentAfterUpdateHooks []TBLScheduleCommentHook
+
+var tblScheduleCommentBeforeDeleteMu sync.Mutex
+var tblScheduleCommentBeforeDeleteHooks []TBLScheduleCommentHook
+var tblScheduleCommentAfterDeleteMu sync.Mutex
+var tblScheduleCommentAfterDeleteHooks []TBLScheduleCommentHook
+
+var tblScheduleCommentBeforeUpsertMu sync.Mutex
+var tblScheduleCommentBeforeUpsertHooks []TBLScheduleCommentHook
+var tblScheduleCommentAfterUpsertMu sync.Mutex
+var tblScheduleCommentAfterUpsertHooks []TBLScheduleCommentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleComment) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleComment) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleComment) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleComment) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleComment) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleComment) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleComment) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleComment) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleComment) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleCommentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleCommentHook registers your hook function for all future operations.
+func AddTBLScheduleCommentHook(hookPoint boil.HookPoint, tblScheduleCommentHook TBLScheduleCommentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleCommentAfterSelectMu.Lock()
+		tblScheduleCommentAfterSelectHooks = append(tblScheduleCommentAfterSelectHooks, tblScheduleCommentHook)
+		tblScheduleCommentAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleCommentBeforeInsertMu.Lock()
+		tblScheduleCommentBeforeInsertHooks = append(tblScheduleCommentBeforeInsertHooks, tblScheduleCommentHook)
+		tblScheduleCommentBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleCommentAfterInsertMu.Lock()
+		tblScheduleCommentAfterInsertHooks = append(tblScheduleCommentAfterInsertHooks, tblScheduleCommentHook)
+		tblScheduleCommentAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleCommentBeforeUpdateMu.Lock()
+		tblScheduleCommentBeforeUpdateHooks = append(tblScheduleCommentBeforeUpdateHooks, tblScheduleCommentHook)
+		tblScheduleCommentBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleCommentAfterUpdateMu.Lock()
+		tblScheduleCommentAfterUpdateHooks = append(tblScheduleCommentAfterUpdateHooks, tblScheduleCommentHook)
+		tblScheduleCommentAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleCommentBeforeDeleteMu.Lock()
+		tblScheduleCommentBeforeDeleteHooks = append(tblScheduleCommentBeforeDeleteHooks, tblScheduleCommentHook)
+		tblScheduleCommentBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleCommentAfterDeleteMu.Lock()
+		tblScheduleCommentAfterDeleteHooks = append(tblScheduleCommentAfterDeleteHooks, tblScheduleCommentHook)
+		tblScheduleCommentAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleCommentBeforeUpsertMu.Lock()
+		tblScheduleCommentBeforeUpsertHooks = append(tblScheduleCommentBeforeUpsertHooks, tblScheduleCommentHook)
+		tblScheduleCommentBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleCommentAfterUpsertMu.Lock()
+		tblScheduleCommentAfterUpsertHooks = append(tblScheduleCommentAfterUpsertHooks, tblScheduleCommentHook)
+		tblScheduleCommentAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleComment record from the query.
+func (q tblScheduleCommentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleComment, error) {
+	o := &TBLScheduleComment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_comments")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleComment records from the query.
+func (q tblScheduleCommentQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleCommentSlice, error) {
+	var o []*TBLScheduleComment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleComment slice")
+	}
+
+	if len(tblScheduleCommentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleComment records in the query.
+func (q tblScheduleCommentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_comments rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleCommentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_comments exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleComment) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// AuthorUser pointed to by the foreign key.
+func (o *TBLScheduleComment) AuthorUser(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.AuthorUserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleCommentL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleComment interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleComment
+	var object *TBLScheduleComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleComment.(*TBLScheduleComment)
+		if !ok {
+			object = new(TBLScheduleComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleComment))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleComment.(*[]*TBLScheduleComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleCommentR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleCommentR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleComments = append(foreign.R.ScheduleTBLScheduleComments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleComments = append(foreign.R.ScheduleTBLScheduleComments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleCommentL) LoadAuthorUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleComment interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleComment
+	var object *TBLScheduleComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleComment.(*TBLScheduleComment)
+		if !ok {
+			object = new(TBLScheduleComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleComment))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleComment.(*[]*TBLScheduleComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleCommentR{}
+		}
+		args[object.AuthorUserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleCommentR{}
+			}
+
+			args[obj.AuthorUserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AuthorUser = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.AuthorUserTBLScheduleComments = append(foreign.R.AuthorUserTBLScheduleComments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthorUserID == foreign.ID {
+				local.R.AuthorUser = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.AuthorUserTBLScheduleComments = append(foreign.R.AuthorUserTBLScheduleComments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleComment to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleComments.
+func (o *TBLScheduleComment) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleCommentR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleComments: TBLScheduleCommentSlice{o},
+		}
+	} else {
+		related.R.ScheduleTBLScheduleComments = append(related.R.ScheduleTBLScheduleComments, o)
+	}
+
+	return nil
+}
+
+// SetAuthorUser of the tblScheduleComment to the related item.
+// Sets o.R.AuthorUser to related.
+// Adds o to related.R.AuthorUserTBLScheduleComments.
+func (o *TBLScheduleComment) SetAuthorUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"author_user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthorUserID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleCommentR{
+			AuthorUser: related,
+		}
+	} else {
+		o.R.AuthorUser = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			AuthorUserTBLScheduleComments: TBLScheduleCommentSlice{o},
+		}
+	} else {
+		related.R.AuthorUserTBLScheduleComments = append(related.R.AuthorUserTBLScheduleComments, o)
+	}
+
+	return nil
+}
+
+// TBLScheduleComments retrieves all the records using an executor.
+func TBLScheduleComments(mods ...qm.QueryMod) tblScheduleCommentQuery {
+	mods = append(mods, qm.From("`tbl_schedule_comments`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_comments`.*"})
+	}
+
+	return tblScheduleCommentQuery{q}
+}
+
+// FindTBLScheduleComment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleComment(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleComment, error) {
+	tblScheduleCommentObj := &TBLScheduleComment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_comments` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleCommentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_comments")
+	}
+
+	if err = tblScheduleCommentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleCommentObj, err
+	}
+
+	return tblScheduleCommentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleComment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_comments provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleCommentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleCommentInsertCacheMut.RLock()
+	cache, cached := tblScheduleCommentInsertCache[key]
+	tblScheduleCommentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleCommentAllColumns,
+			tblScheduleCommentColumnsWithDefault,
+			tblScheduleCommentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_comments` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_comments` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_comments` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_comments")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleCommentMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_comments")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleCommentInsertCacheMut.Lock()
+		tblScheduleCommentInsertCache[key] = cache
+		tblScheduleCommentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleComment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleComment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleCommentUpdateCacheMut.RLock()
+	cache, cached := tblScheduleCommentUpdateCache[key]
+	tblScheduleCommentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleCommentAllColumns,
+			tblScheduleCommentPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_comments, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, append(wl, tblScheduleCommentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_comments row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_comments")
+	}
+
+	if !cached {
+		tblScheduleCommentUpdateCacheMut.Lock()
+		tblScheduleCommentUpdateCache[key] = cache
+		tblScheduleCommentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleCommentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_comments")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleCommentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCommentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleComment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleComment")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleCommentUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleComment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_comments provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleCommentColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleCommentUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleCommentUpsertCacheMut.RLock()
+	cache, cached := tblScheduleCommentUpsertCache[key]
+	tblScheduleCommentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleCommentAllColumns,
+			tblScheduleCommentColumnsWithDefault,
+			tblScheduleCommentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleCommentAllColumns,
+			tblScheduleCommentPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_comments, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleCommentAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_comments`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_comments` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_comments")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleCommentMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleCommentType, tblScheduleCommentMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_comments")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_comments")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleCommentUpsertCacheMut.Lock()
+		tblScheduleCommentUpsertCache[key] = cache
+		tblScheduleCommentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleComment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleComment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleComment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleCommentPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_comments` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_comments")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleCommentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleCommentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_comments")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleCommentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleCommentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_comments` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCommentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleComment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_comments")
+	}
+
+	if len(tblScheduleCommentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleComment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleComment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleCommentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleCommentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_comments`.* FROM `tbl_schedule_comments` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleCommentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleCommentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleCommentExists checks if the TBLScheduleComment row exists.
+func TBLScheduleCommentExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_comments` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_comments exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleComment row exists.
+func (o *TBLScheduleComment) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleCommentExists(ctx, exec, o.ID)
+}
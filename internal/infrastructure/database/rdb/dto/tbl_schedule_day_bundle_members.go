@@ -0,0 +1,1389 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleDayBundleMember is an object representing the database table.
+type TBLScheduleDayBundleMember struct {
+	ID         int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BundleID   int       `boil:"bundle_id" json:"bundle_id" toml:"bundle_id" yaml:"bundle_id"`
+	ScheduleID int       `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *tblScheduleDayBundleMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleDayBundleMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleDayBundleMemberColumns = struct {
+	ID         string
+	BundleID   string
+	ScheduleID string
+	CreatedAt  string
+}{
+	ID:         "id",
+	BundleID:   "bundle_id",
+	ScheduleID: "schedule_id",
+	CreatedAt:  "created_at",
+}
+
+var TBLScheduleDayBundleMemberTableColumns = struct {
+	ID         string
+	BundleID   string
+	ScheduleID string
+	CreatedAt  string
+}{
+	ID:         "tbl_schedule_day_bundle_members.id",
+	BundleID:   "tbl_schedule_day_bundle_members.bundle_id",
+	ScheduleID: "tbl_schedule_day_bundle_members.schedule_id",
+	CreatedAt:  "tbl_schedule_day_bundle_members.created_at",
+}
+
+// Generated where
+
+var TBLScheduleDayBundleMemberWhere = struct {
+	ID         whereHelperint
+	BundleID   whereHelperint
+	ScheduleID whereHelperint
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperint{field: "`tbl_schedule_day_bundle_members`.`id`"},
+	BundleID:   whereHelperint{field: "`tbl_schedule_day_bundle_members`.`bundle_id`"},
+	ScheduleID: whereHelperint{field: "`tbl_schedule_day_bundle_members`.`schedule_id`"},
+	CreatedAt:  whereHelpertime_Time{field: "`tbl_schedule_day_bundle_members`.`created_at`"},
+}
+
+// TBLScheduleDayBundleMemberRels is where relationship names are stored.
+var TBLScheduleDayBundleMemberRels = struct {
+	Bundle   string
+	Schedule string
+}{
+	Bundle:   "Bundle",
+	Schedule: "Schedule",
+}
+
+// tblScheduleDayBundleMemberR is where relationships are stored.
+type tblScheduleDayBundleMemberR struct {
+	Bundle   *TBLScheduleDayBundle `boil:"Bundle" json:"Bundle" toml:"Bundle" yaml:"Bundle"`
+	Schedule *TBLSchedule          `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleDayBundleMemberR) NewStruct() *tblScheduleDayBundleMemberR {
+	return &tblScheduleDayBundleMemberR{}
+}
+
+func (o *TBLScheduleDayBundleMember) GetBundle() *TBLScheduleDayBundle {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBundle()
+}
+
+func (r *tblScheduleDayBundleMemberR) GetBundle() *TBLScheduleDayBundle {
+	if r == nil {
+		return nil
+	}
+
+	return r.Bundle
+}
+
+func (o *TBLScheduleDayBundleMember) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleDayBundleMemberR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+// tblScheduleDayBundleMemberL is where Load methods for each relationship are stored.
+type tblScheduleDayBundleMemberL struct{}
+
+var (
+	tblScheduleDayBundleMemberAllColumns            = []string{"id", "bundle_id", "schedule_id", "created_at"}
+	tblScheduleDayBundleMemberColumnsWithoutDefault = []string{"bundle_id", "schedule_id"}
+	tblScheduleDayBundleMemberColumnsWithDefault    = []string{"id", "created_at"}
+	tblScheduleDayBundleMemberPrimaryKeyColumns     = []string{"id"}
+	tblScheduleDayBundleMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleDayBundleMemberSlice is an alias for a slice of pointers to TBLScheduleDayBundleMember.
+	// This should almost always be used instead of []TBLScheduleDayBundleMember.
+	TBLScheduleDayBundleMemberSlice []*TBLScheduleDayBundleMember
+	// TBLScheduleDayBundleMemberHook is the signature for custom TBLScheduleDayBundleMember hook methods
+	TBLScheduleDayBundleMemberHook func(context.Context, boil.ContextExecutor, *TBLScheduleDayBundleMember) error
+
+	tblScheduleDayBundleMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleDayBundleMemberType                 = reflect.TypeOf(&TBLScheduleDayBundleMember{})
+	tblScheduleDayBundleMemberMapping              = queries.MakeStructMapping(tblScheduleDayBundleMemberType)
+	tblScheduleDayBundleMemberPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, tblScheduleDayBundleMemberPrimaryKeyColumns)
+	tblScheduleDayBundleMemberInsertCacheMut       sync.RWMutex
+	tblScheduleDayBundleMemberInsertCache          = make(map[string]insertCache)
+	tblScheduleDayBundleMemberUpdateCacheMut       sync.RWMutex
+	tblScheduleDayBundleMemberUpdateCache          = make(map[string]updateCache)
+	tblScheduleDayBundleMemberUpsertCacheMut       sync.RWMutex
+	tblScheduleDayBundleMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleDayBundleMemberAfterSelectMu sync.Mutex
+var tblScheduleDayBundleMemberAfterSelectHooks []TBLScheduleDayBundleMemberHook
+
+var tblScheduleDayBundleMemberBeforeInsertMu sync.Mutex
+var tblScheduleDayBundleMemberBeforeInsertHooks []TBLScheduleDayBundleMemberHook
+var tblScheduleDayBundleMemberAfterInsertMu sync.Mutex
+var tblScheduleDayBundleMemberAfterInsertHooks []TBLScheduleDayBundleMemberHook
+
+var tblScheduleDayBundleMemberBeforeUpdateMu sync.Mutex
+var tblScheduleDayBundleMemberBeforeUpdateHooks []TBLScheduleDayBundleMemberHook
+var tblScheduleDayBundleMemberAfterUpdateMu sync.Mutex
+var tblScheduleDayBundleMemberAfterUpdateHooks []TBLScheduleDayBundleMemberHook
+
+var tblScheduleDayBundleMemberBeforeDeleteMu sync.Mutex
+var tblScheduleDayBundleMemberBeforeDeleteHooks []TBLScheduleDayBundleMemberHook
+var tblScheduleDayBundleMemberAfterDeleteMu sync.Mutex
+var tblScheduleDayBundleMemberAfterDeleteHooks []TBLScheduleDayBundleMemberHook
+
+var tblScheduleDayBundleMemberBeforeUpsertMu sync.Mutex
+var tblScheduleDayBundleMemberBeforeUpsertHooks []TBLScheduleDayBundleMemberHook
+var tblScheduleDayBundleMemberAfterUpsertMu sync.Mutex
+var tblScheduleDayBundleMemberAfterUpsertHooks []TBLScheduleDayBundleMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleDayBundleMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleDayBundleMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleDayBundleMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleDayBundleMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleDayBundleMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleDayBundleMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleDayBundleMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleDayBundleMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleDayBundleMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleDayBundleMemberHook registers your hook function for all future operations.
+func AddTBLScheduleDayBundleMemberHook(hookPoint boil.HookPoint, tblScheduleDayBundleMemberHook TBLScheduleDayBundleMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleDayBundleMemberAfterSelectMu.Lock()
+		tblScheduleDayBundleMemberAfterSelectHooks = append(tblScheduleDayBundleMemberAfterSelectHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleDayBundleMemberBeforeInsertMu.Lock()
+		tblScheduleDayBundleMemberBeforeInsertHooks = append(tblScheduleDayBundleMemberBeforeInsertHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleDayBundleMemberAfterInsertMu.Lock()
+		tblScheduleDayBundleMemberAfterInsertHooks = append(tblScheduleDayBundleMemberAfterInsertHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleDayBundleMemberBeforeUpdateMu.Lock()
+		tblScheduleDayBundleMemberBeforeUpdateHooks = append(tblScheduleDayBundleMemberBeforeUpdateHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleDayBundleMemberAfterUpdateMu.Lock()
+		tblScheduleDayBundleMemberAfterUpdateHooks = append(tblScheduleDayBundleMemberAfterUpdateHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleDayBundleMemberBeforeDeleteMu.Lock()
+		tblScheduleDayBundleMemberBeforeDeleteHooks = append(tblScheduleDayBundleMemberBeforeDeleteHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleDayBundleMemberAfterDeleteMu.Lock()
+		tblScheduleDayBundleMemberAfterDeleteHooks = append(tblScheduleDayBundleMemberAfterDeleteHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleDayBundleMemberBeforeUpsertMu.Lock()
+		tblScheduleDayBundleMemberBeforeUpsertHooks = append(tblScheduleDayBundleMemberBeforeUpsertHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleDayBundleMemberAfterUpsertMu.Lock()
+		tblScheduleDayBundleMemberAfterUpsertHooks = append(tblScheduleDayBundleMemberAfterUpsertHooks, tblScheduleDayBundleMemberHook)
+		tblScheduleDayBundleMemberAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleDayBundleMember record from the query.
+func (q tblScheduleDayBundleMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleDayBundleMember, error) {
+	o := &TBLScheduleDayBundleMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_day_bundle_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleDayBundleMember records from the query.
+func (q tblScheduleDayBundleMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleDayBundleMemberSlice, error) {
+	var o []*TBLScheduleDayBundleMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleDayBundleMember slice")
+	}
+
+	if len(tblScheduleDayBundleMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleDayBundleMember records in the query.
+func (q tblScheduleDayBundleMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_day_bundle_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleDayBundleMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_day_bundle_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// Bundle pointed to by the foreign key.
+func (o *TBLScheduleDayBundleMember) Bundle(mods ...qm.QueryMod) tblScheduleDayBundleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BundleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLScheduleDayBundles(queryMods...)
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleDayBundleMember) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// LoadBundle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleDayBundleMemberL) LoadBundle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleDayBundleMember interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleDayBundleMember
+	var object *TBLScheduleDayBundleMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleDayBundleMember.(*TBLScheduleDayBundleMember)
+		if !ok {
+			object = new(TBLScheduleDayBundleMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleDayBundleMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleDayBundleMember))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleDayBundleMember.(*[]*TBLScheduleDayBundleMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleDayBundleMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleDayBundleMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleDayBundleMemberR{}
+		}
+		args[object.BundleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleDayBundleMemberR{}
+			}
+
+			args[obj.BundleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_day_bundles`),
+		qm.WhereIn(`tbl_schedule_day_bundles.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLScheduleDayBundle")
+	}
+
+	var resultSlice []*TBLScheduleDayBundle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLScheduleDayBundle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedule_day_bundles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_day_bundles")
+	}
+
+	if len(tblScheduleDayBundleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Bundle = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleDayBundleR{}
+		}
+		foreign.R.BundleTBLScheduleDayBundleMembers = append(foreign.R.BundleTBLScheduleDayBundleMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BundleID == foreign.ID {
+				local.R.Bundle = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleDayBundleR{}
+				}
+				foreign.R.BundleTBLScheduleDayBundleMembers = append(foreign.R.BundleTBLScheduleDayBundleMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleDayBundleMemberL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleDayBundleMember interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleDayBundleMember
+	var object *TBLScheduleDayBundleMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleDayBundleMember.(*TBLScheduleDayBundleMember)
+		if !ok {
+			object = new(TBLScheduleDayBundleMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleDayBundleMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleDayBundleMember))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleDayBundleMember.(*[]*TBLScheduleDayBundleMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleDayBundleMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleDayBundleMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleDayBundleMemberR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleDayBundleMemberR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleDayBundleMember = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleDayBundleMember = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBundle of the tblScheduleDayBundleMember to the related item.
+// Sets o.R.Bundle to related.
+// Adds o to related.R.BundleTBLScheduleDayBundleMembers.
+func (o *TBLScheduleDayBundleMember) SetBundle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLScheduleDayBundle) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"bundle_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BundleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleDayBundleMemberR{
+			Bundle: related,
+		}
+	} else {
+		o.R.Bundle = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleDayBundleR{
+			BundleTBLScheduleDayBundleMembers: TBLScheduleDayBundleMemberSlice{o},
+		}
+	} else {
+		related.R.BundleTBLScheduleDayBundleMembers = append(related.R.BundleTBLScheduleDayBundleMembers, o)
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleDayBundleMember to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleDayBundleMember.
+func (o *TBLScheduleDayBundleMember) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleDayBundleMemberR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleDayBundleMember: o,
+		}
+	} else {
+		related.R.ScheduleTBLScheduleDayBundleMember = o
+	}
+
+	return nil
+}
+
+// TBLScheduleDayBundleMembers retrieves all the records using an executor.
+func TBLScheduleDayBundleMembers(mods ...qm.QueryMod) tblScheduleDayBundleMemberQuery {
+	mods = append(mods, qm.From("`tbl_schedule_day_bundle_members`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_day_bundle_members`.*"})
+	}
+
+	return tblScheduleDayBundleMemberQuery{q}
+}
+
+// FindTBLScheduleDayBundleMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleDayBundleMember(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleDayBundleMember, error) {
+	tblScheduleDayBundleMemberObj := &TBLScheduleDayBundleMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_day_bundle_members` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleDayBundleMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_day_bundle_members")
+	}
+
+	if err = tblScheduleDayBundleMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleDayBundleMemberObj, err
+	}
+
+	return tblScheduleDayBundleMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleDayBundleMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_day_bundle_members provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleDayBundleMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleDayBundleMemberInsertCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleMemberInsertCache[key]
+	tblScheduleDayBundleMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleDayBundleMemberAllColumns,
+			tblScheduleDayBundleMemberColumnsWithDefault,
+			tblScheduleDayBundleMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_day_bundle_members` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_day_bundle_members` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_day_bundle_members` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_day_bundle_members")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleDayBundleMemberMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_day_bundle_members")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleDayBundleMemberInsertCacheMut.Lock()
+		tblScheduleDayBundleMemberInsertCache[key] = cache
+		tblScheduleDayBundleMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleDayBundleMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleDayBundleMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleDayBundleMemberUpdateCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleMemberUpdateCache[key]
+	tblScheduleDayBundleMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleDayBundleMemberAllColumns,
+			tblScheduleDayBundleMemberPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_day_bundle_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, append(wl, tblScheduleDayBundleMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_day_bundle_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_day_bundle_members")
+	}
+
+	if !cached {
+		tblScheduleDayBundleMemberUpdateCacheMut.Lock()
+		tblScheduleDayBundleMemberUpdateCache[key] = cache
+		tblScheduleDayBundleMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleDayBundleMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_day_bundle_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_day_bundle_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleDayBundleMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundleMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleDayBundleMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleDayBundleMember")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleDayBundleMemberUniqueColumns = []string{
+	"id",
+	"schedule_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleDayBundleMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_day_bundle_members provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleDayBundleMemberColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleDayBundleMemberUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleDayBundleMemberUpsertCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleMemberUpsertCache[key]
+	tblScheduleDayBundleMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleDayBundleMemberAllColumns,
+			tblScheduleDayBundleMemberColumnsWithDefault,
+			tblScheduleDayBundleMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleDayBundleMemberAllColumns,
+			tblScheduleDayBundleMemberPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_day_bundle_members, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleDayBundleMemberAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_day_bundle_members`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_day_bundle_members` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_day_bundle_members")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleDayBundleMemberMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleDayBundleMemberType, tblScheduleDayBundleMemberMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_day_bundle_members")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_day_bundle_members")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleDayBundleMemberUpsertCacheMut.Lock()
+		tblScheduleDayBundleMemberUpsertCache[key] = cache
+		tblScheduleDayBundleMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleDayBundleMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleDayBundleMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleDayBundleMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleDayBundleMemberPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_day_bundle_members` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_day_bundle_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_day_bundle_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleDayBundleMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleDayBundleMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_day_bundle_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_day_bundle_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleDayBundleMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleDayBundleMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_day_bundle_members` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundleMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleDayBundleMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_day_bundle_members")
+	}
+
+	if len(tblScheduleDayBundleMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleDayBundleMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleDayBundleMember(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleDayBundleMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleDayBundleMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundleMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_day_bundle_members`.* FROM `tbl_schedule_day_bundle_members` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundleMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleDayBundleMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleDayBundleMemberExists checks if the TBLScheduleDayBundleMember row exists.
+func TBLScheduleDayBundleMemberExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_day_bundle_members` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_day_bundle_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleDayBundleMember row exists.
+func (o *TBLScheduleDayBundleMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleDayBundleMemberExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1608 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleDayBundle is an object representing the database table.
+type TBLScheduleDayBundle struct {
+	ID              int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus          string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	Title           string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	RefuseCollision int       `boil:"refuse_collision" json:"refuse_collision" toml:"refuse_collision" yaml:"refuse_collision"`
+	CreateUser      int       `boil:"create_user" json:"create_user" toml:"create_user" yaml:"create_user"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblScheduleDayBundleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleDayBundleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleDayBundleColumns = struct {
+	ID              string
+	Campus          string
+	Title           string
+	RefuseCollision string
+	CreateUser      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	Campus:          "campus",
+	Title:           "title",
+	RefuseCollision: "refuse_collision",
+	CreateUser:      "create_user",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var TBLScheduleDayBundleTableColumns = struct {
+	ID              string
+	Campus          string
+	Title           string
+	RefuseCollision string
+	CreateUser      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "tbl_schedule_day_bundles.id",
+	Campus:          "tbl_schedule_day_bundles.campus",
+	Title:           "tbl_schedule_day_bundles.title",
+	RefuseCollision: "tbl_schedule_day_bundles.refuse_collision",
+	CreateUser:      "tbl_schedule_day_bundles.create_user",
+	CreatedAt:       "tbl_schedule_day_bundles.created_at",
+	UpdatedAt:       "tbl_schedule_day_bundles.updated_at",
+}
+
+// Generated where
+
+var TBLScheduleDayBundleWhere = struct {
+	ID              whereHelperint
+	Campus          whereHelperstring
+	Title           whereHelperstring
+	RefuseCollision whereHelperint
+	CreateUser      whereHelperint
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperint{field: "`tbl_schedule_day_bundles`.`id`"},
+	Campus:          whereHelperstring{field: "`tbl_schedule_day_bundles`.`campus`"},
+	Title:           whereHelperstring{field: "`tbl_schedule_day_bundles`.`title`"},
+	RefuseCollision: whereHelperint{field: "`tbl_schedule_day_bundles`.`refuse_collision`"},
+	CreateUser:      whereHelperint{field: "`tbl_schedule_day_bundles`.`create_user`"},
+	CreatedAt:       whereHelpertime_Time{field: "`tbl_schedule_day_bundles`.`created_at`"},
+	UpdatedAt:       whereHelpertime_Time{field: "`tbl_schedule_day_bundles`.`updated_at`"},
+}
+
+// TBLScheduleDayBundleRels is where relationship names are stored.
+var TBLScheduleDayBundleRels = struct {
+	CampusDataCampuse                 string
+	CreateUserTBLUser                 string
+	BundleTBLScheduleDayBundleMembers string
+}{
+	CampusDataCampuse:                 "CampusDataCampuse",
+	CreateUserTBLUser:                 "CreateUserTBLUser",
+	BundleTBLScheduleDayBundleMembers: "BundleTBLScheduleDayBundleMembers",
+}
+
+// tblScheduleDayBundleR is where relationships are stored.
+type tblScheduleDayBundleR struct {
+	CampusDataCampuse                 *DataCampuse                    `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+	CreateUserTBLUser                 *TBLUser                        `boil:"CreateUserTBLUser" json:"CreateUserTBLUser" toml:"CreateUserTBLUser" yaml:"CreateUserTBLUser"`
+	BundleTBLScheduleDayBundleMembers TBLScheduleDayBundleMemberSlice `boil:"BundleTBLScheduleDayBundleMembers" json:"BundleTBLScheduleDayBundleMembers" toml:"BundleTBLScheduleDayBundleMembers" yaml:"BundleTBLScheduleDayBundleMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleDayBundleR) NewStruct() *tblScheduleDayBundleR {
+	return &tblScheduleDayBundleR{}
+}
+
+func (o *TBLScheduleDayBundle) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *tblScheduleDayBundleR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+func (o *TBLScheduleDayBundle) GetCreateUserTBLUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreateUserTBLUser()
+}
+
+func (r *tblScheduleDayBundleR) GetCreateUserTBLUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreateUserTBLUser
+}
+
+func (o *TBLScheduleDayBundle) GetBundleTBLScheduleDayBundleMembers() TBLScheduleDayBundleMemberSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBundleTBLScheduleDayBundleMembers()
+}
+
+func (r *tblScheduleDayBundleR) GetBundleTBLScheduleDayBundleMembers() TBLScheduleDayBundleMemberSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BundleTBLScheduleDayBundleMembers
+}
+
+// tblScheduleDayBundleL is where Load methods for each relationship are stored.
+type tblScheduleDayBundleL struct{}
+
+var (
+	tblScheduleDayBundleAllColumns            = []string{"id", "campus", "title", "refuse_collision", "create_user", "created_at", "updated_at"}
+	tblScheduleDayBundleColumnsWithoutDefault = []string{"campus", "title", "create_user"}
+	tblScheduleDayBundleColumnsWithDefault    = []string{"id", "refuse_collision", "created_at", "updated_at"}
+	tblScheduleDayBundlePrimaryKeyColumns     = []string{"id"}
+	tblScheduleDayBundleGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleDayBundleSlice is an alias for a slice of pointers to TBLScheduleDayBundle.
+	// This should almost always be used instead of []TBLScheduleDayBundle.
+	TBLScheduleDayBundleSlice []*TBLScheduleDayBundle
+	// TBLScheduleDayBundleHook is the signature for custom TBLScheduleDayBundle hook methods
+	TBLScheduleDayBundleHook func(context.Context, boil.ContextExecutor, *TBLScheduleDayBundle) error
+
+	tblScheduleDayBundleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleDayBundleType                 = reflect.TypeOf(&TBLScheduleDayBundle{})
+	tblScheduleDayBundleMapping              = queries.MakeStructMapping(tblScheduleDayBundleType)
+	tblScheduleDayBundlePrimaryKeyMapping, _ = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, tblScheduleDayBundlePrimaryKeyColumns)
+	tblScheduleDayBundleInsertCacheMut       sync.RWMutex
+	tblScheduleDayBundleInsertCache          = make(map[string]insertCache)
+	tblScheduleDayBundleUpdateCacheMut       sync.RWMutex
+	tblScheduleDayBundleUpdateCache          = make(map[string]updateCache)
+	tblScheduleDayBundleUpsertCacheMut       sync.RWMutex
+	tblScheduleDayBundleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleDayBundleAfterSelectMu sync.Mutex
+var tblScheduleDayBundleAfterSelectHooks []TBLScheduleDayBundleHook
+
+var tblScheduleDayBundleBeforeInsertMu sync.Mutex
+var tblScheduleDayBundleBeforeInsertHooks []TBLScheduleDayBundleHook
+var tblScheduleDayBundleAfterInsertMu sync.Mutex
+var tblScheduleDayBundleAfterInsertHooks []TBLScheduleDayBundleHook
+
+var tblScheduleDayBundleBeforeUpdateMu sync.Mutex
+var tblScheduleDayBundleBeforeUpdateHooks []TBLScheduleDayBundleHook
+var tblScheduleDayBundleAfterUpdateMu sync.Mutex
+var tblScheduleDayBundleAfterUpdateHooks []TBLScheduleDayBundleHook
+
+var tblScheduleDayBundleBeforeDeleteMu sync.Mutex
+var tblScheduleDayBundleBeforeDeleteHooks []TBLScheduleDayBundleHook
+var tblScheduleDayBundleAfterDeleteMu sync.Mutex
+var tblScheduleDayBundleAfterDeleteHooks []TBLScheduleDayBundleHook
+
+var tblScheduleDayBundleBeforeUpsertMu sync.Mutex
+var tblScheduleDayBundleBeforeUpsertHooks []TBLScheduleDayBundleHook
+var tblScheduleDayBundleAfterUpsertMu sync.Mutex
+var tblScheduleDayBundleAfterUpsertHooks []TBLScheduleDayBundleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleDayBundle) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleDayBundle) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleDayBundle) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleDayBundle) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleDayBundle) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleDayBundle) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleDayBundle) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleDayBundle) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleDayBundle) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleDayBundleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleDayBundleHook registers your hook function for all future operations.
+func AddTBLScheduleDayBundleHook(hookPoint boil.HookPoint, tblScheduleDayBundleHook TBLScheduleDayBundleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleDayBundleAfterSelectMu.Lock()
+		tblScheduleDayBundleAfterSelectHooks = append(tblScheduleDayBundleAfterSelectHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleDayBundleBeforeInsertMu.Lock()
+		tblScheduleDayBundleBeforeInsertHooks = append(tblScheduleDayBundleBeforeInsertHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleDayBundleAfterInsertMu.Lock()
+		tblScheduleDayBundleAfterInsertHooks = append(tblScheduleDayBundleAfterInsertHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleDayBundleBeforeUpdateMu.Lock()
+		tblScheduleDayBundleBeforeUpdateHooks = append(tblScheduleDayBundleBeforeUpdateHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleDayBundleAfterUpdateMu.Lock()
+		tblScheduleDayBundleAfterUpdateHooks = append(tblScheduleDayBundleAfterUpdateHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleDayBundleBeforeDeleteMu.Lock()
+		tblScheduleDayBundleBeforeDeleteHooks = append(tblScheduleDayBundleBeforeDeleteHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleDayBundleAfterDeleteMu.Lock()
+		tblScheduleDayBundleAfterDeleteHooks = append(tblScheduleDayBundleAfterDeleteHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleDayBundleBeforeUpsertMu.Lock()
+		tblScheduleDayBundleBeforeUpsertHooks = append(tblScheduleDayBundleBeforeUpsertHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleDayBundleAfterUpsertMu.Lock()
+		tblScheduleDayBundleAfterUpsertHooks = append(tblScheduleDayBundleAfterUpsertHooks, tblScheduleDayBundleHook)
+		tblScheduleDayBundleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleDayBundle record from the query.
+func (q tblScheduleDayBundleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleDayBundle, error) {
+	o := &TBLScheduleDayBundle{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_day_bundles")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleDayBundle records from the query.
+func (q tblScheduleDayBundleQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleDayBundleSlice, error) {
+	var o []*TBLScheduleDayBundle
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleDayBundle slice")
+	}
+
+	if len(tblScheduleDayBundleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleDayBundle records in the query.
+func (q tblScheduleDayBundleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_day_bundles rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleDayBundleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_day_bundles exists")
+	}
+
+	return count > 0, nil
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *TBLScheduleDayBundle) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// CreateUserTBLUser pointed to by the foreign key.
+func (o *TBLScheduleDayBundle) CreateUserTBLUser(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.CreateUser),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// BundleTBLScheduleDayBundleMembers retrieves all the tbl_schedule_day_bundle_member's TBLScheduleDayBundleMembers with an executor via bundle_id column.
+func (o *TBLScheduleDayBundle) BundleTBLScheduleDayBundleMembers(mods ...qm.QueryMod) tblScheduleDayBundleMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_day_bundle_members`.`bundle_id`=?", o.ID),
+	)
+
+	return TBLScheduleDayBundleMembers(queryMods...)
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleDayBundleL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleDayBundle interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleDayBundle
+	var object *TBLScheduleDayBundle
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleDayBundle.(*TBLScheduleDayBundle)
+		if !ok {
+			object = new(TBLScheduleDayBundle)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleDayBundle))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleDayBundle.(*[]*TBLScheduleDayBundle)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleDayBundle))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleDayBundleR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleDayBundleR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusTBLScheduleDayBundles = append(foreign.R.CampusTBLScheduleDayBundles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusTBLScheduleDayBundles = append(foreign.R.CampusTBLScheduleDayBundles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreateUserTBLUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleDayBundleL) LoadCreateUserTBLUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleDayBundle interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleDayBundle
+	var object *TBLScheduleDayBundle
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleDayBundle.(*TBLScheduleDayBundle)
+		if !ok {
+			object = new(TBLScheduleDayBundle)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleDayBundle))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleDayBundle.(*[]*TBLScheduleDayBundle)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleDayBundle))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleDayBundleR{}
+		}
+		args[object.CreateUser] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleDayBundleR{}
+			}
+
+			args[obj.CreateUser] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreateUserTBLUser = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.CreateUserTBLScheduleDayBundles = append(foreign.R.CreateUserTBLScheduleDayBundles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreateUser == foreign.ID {
+				local.R.CreateUserTBLUser = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.CreateUserTBLScheduleDayBundles = append(foreign.R.CreateUserTBLScheduleDayBundles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBundleTBLScheduleDayBundleMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleDayBundleL) LoadBundleTBLScheduleDayBundleMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleDayBundle interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleDayBundle
+	var object *TBLScheduleDayBundle
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleDayBundle.(*TBLScheduleDayBundle)
+		if !ok {
+			object = new(TBLScheduleDayBundle)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleDayBundle))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleDayBundle.(*[]*TBLScheduleDayBundle)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleDayBundle)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleDayBundle))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleDayBundleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleDayBundleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_day_bundle_members`),
+		qm.WhereIn(`tbl_schedule_day_bundle_members.bundle_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_day_bundle_members")
+	}
+
+	var resultSlice []*TBLScheduleDayBundleMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_day_bundle_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_day_bundle_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_day_bundle_members")
+	}
+
+	if len(tblScheduleDayBundleMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BundleTBLScheduleDayBundleMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleDayBundleMemberR{}
+			}
+			foreign.R.Bundle = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BundleID {
+				local.R.BundleTBLScheduleDayBundleMembers = append(local.R.BundleTBLScheduleDayBundleMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleDayBundleMemberR{}
+				}
+				foreign.R.Bundle = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the tblScheduleDayBundle to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusTBLScheduleDayBundles.
+func (o *TBLScheduleDayBundle) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &tblScheduleDayBundleR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusTBLScheduleDayBundles: TBLScheduleDayBundleSlice{o},
+		}
+	} else {
+		related.R.CampusTBLScheduleDayBundles = append(related.R.CampusTBLScheduleDayBundles, o)
+	}
+
+	return nil
+}
+
+// SetCreateUserTBLUser of the tblScheduleDayBundle to the related item.
+// Sets o.R.CreateUserTBLUser to related.
+// Adds o to related.R.CreateUserTBLScheduleDayBundles.
+func (o *TBLScheduleDayBundle) SetCreateUserTBLUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"create_user"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreateUser = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleDayBundleR{
+			CreateUserTBLUser: related,
+		}
+	} else {
+		o.R.CreateUserTBLUser = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			CreateUserTBLScheduleDayBundles: TBLScheduleDayBundleSlice{o},
+		}
+	} else {
+		related.R.CreateUserTBLScheduleDayBundles = append(related.R.CreateUserTBLScheduleDayBundles, o)
+	}
+
+	return nil
+}
+
+// AddBundleTBLScheduleDayBundleMembers adds the given related objects to the existing relationships
+// of the tbl_schedule_day_bundle, optionally inserting them as new records.
+// Appends related to o.R.BundleTBLScheduleDayBundleMembers.
+// Sets related.R.Bundle appropriately.
+func (o *TBLScheduleDayBundle) AddBundleTBLScheduleDayBundleMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleDayBundleMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BundleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"bundle_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BundleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleDayBundleR{
+			BundleTBLScheduleDayBundleMembers: related,
+		}
+	} else {
+		o.R.BundleTBLScheduleDayBundleMembers = append(o.R.BundleTBLScheduleDayBundleMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleDayBundleMemberR{
+				Bundle: o,
+			}
+		} else {
+			rel.R.Bundle = o
+		}
+	}
+	return nil
+}
+
+// TBLScheduleDayBundles retrieves all the records using an executor.
+func TBLScheduleDayBundles(mods ...qm.QueryMod) tblScheduleDayBundleQuery {
+	mods = append(mods, qm.From("`tbl_schedule_day_bundles`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_day_bundles`.*"})
+	}
+
+	return tblScheduleDayBundleQuery{q}
+}
+
+// FindTBLScheduleDayBundle retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleDayBundle(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleDayBundle, error) {
+	tblScheduleDayBundleObj := &TBLScheduleDayBundle{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_day_bundles` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleDayBundleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_day_bundles")
+	}
+
+	if err = tblScheduleDayBundleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleDayBundleObj, err
+	}
+
+	return tblScheduleDayBundleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleDayBundle) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_day_bundles provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleDayBundleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleDayBundleInsertCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleInsertCache[key]
+	tblScheduleDayBundleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleDayBundleAllColumns,
+			tblScheduleDayBundleColumnsWithDefault,
+			tblScheduleDayBundleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_day_bundles` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_day_bundles` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_day_bundles` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_day_bundles")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleDayBundleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_day_bundles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleDayBundleInsertCacheMut.Lock()
+		tblScheduleDayBundleInsertCache[key] = cache
+		tblScheduleDayBundleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleDayBundle.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleDayBundle) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleDayBundleUpdateCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleUpdateCache[key]
+	tblScheduleDayBundleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleDayBundleAllColumns,
+			tblScheduleDayBundlePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_day_bundles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, append(wl, tblScheduleDayBundlePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_day_bundles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_day_bundles")
+	}
+
+	if !cached {
+		tblScheduleDayBundleUpdateCacheMut.Lock()
+		tblScheduleDayBundleUpdateCache[key] = cache
+		tblScheduleDayBundleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleDayBundleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_day_bundles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_day_bundles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleDayBundleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundlePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleDayBundle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleDayBundle")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleDayBundleUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleDayBundle) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_day_bundles provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleDayBundleColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleDayBundleUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleDayBundleUpsertCacheMut.RLock()
+	cache, cached := tblScheduleDayBundleUpsertCache[key]
+	tblScheduleDayBundleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleDayBundleAllColumns,
+			tblScheduleDayBundleColumnsWithDefault,
+			tblScheduleDayBundleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleDayBundleAllColumns,
+			tblScheduleDayBundlePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_day_bundles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleDayBundleAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_day_bundles`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_day_bundles` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_day_bundles")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleDayBundleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleDayBundleType, tblScheduleDayBundleMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_day_bundles")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_day_bundles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleDayBundleUpsertCacheMut.Lock()
+		tblScheduleDayBundleUpsertCache[key] = cache
+		tblScheduleDayBundleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleDayBundle record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleDayBundle) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleDayBundle provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleDayBundlePrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_day_bundles` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_day_bundles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_day_bundles")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleDayBundleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleDayBundleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_day_bundles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_day_bundles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleDayBundleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleDayBundleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_day_bundles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundlePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleDayBundle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_day_bundles")
+	}
+
+	if len(tblScheduleDayBundleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleDayBundle) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleDayBundle(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleDayBundleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleDayBundleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleDayBundlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_day_bundles`.* FROM `tbl_schedule_day_bundles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleDayBundlePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleDayBundleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleDayBundleExists checks if the TBLScheduleDayBundle row exists.
+func TBLScheduleDayBundleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_day_bundles` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_day_bundles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleDayBundle row exists.
+func (o *TBLScheduleDayBundle) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleDayBundleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1212 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleHistory is an object representing the database table.
+type TBLScheduleHistory struct {
+	ID               int `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID       int `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	HistoryIndex     int `boil:"history_index" json:"history_index" toml:"history_index" yaml:"history_index"`
+	StartTimeHour    int `boil:"start_time_hour" json:"start_time_hour" toml:"start_time_hour" yaml:"start_time_hour"`
+	StartTimeMinutes int `boil:"start_time_minutes" json:"start_time_minutes" toml:"start_time_minutes" yaml:"start_time_minutes"`
+	EndTimeHour      int `boil:"end_time_hour" json:"end_time_hour" toml:"end_time_hour" yaml:"end_time_hour"`
+	EndTimeMinutes   int `boil:"end_time_minutes" json:"end_time_minutes" toml:"end_time_minutes" yaml:"end_time_minutes"`
+
+	R *tblScheduleHistoryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleHistoryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleHistoryColumns = struct {
+	ID               string
+	ScheduleID       string
+	HistoryIndex     string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+}{
+	ID:               "id",
+	ScheduleID:       "schedule_id",
+	HistoryIndex:     "history_index",
+	StartTimeHour:    "start_time_hour",
+	StartTimeMinutes: "start_time_minutes",
+	EndTimeHour:      "end_time_hour",
+	EndTimeMinutes:   "end_time_minutes",
+}
+
+var TBLScheduleHistoryTableColumns = struct {
+	ID               string
+	ScheduleID       string
+	HistoryIndex     string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+}{
+	ID:               "tbl_schedule_histories.id",
+	ScheduleID:       "tbl_schedule_histories.schedule_id",
+	HistoryIndex:     "tbl_schedule_histories.history_index",
+	StartTimeHour:    "tbl_schedule_histories.start_time_hour",
+	StartTimeMinutes: "tbl_schedule_histories.start_time_minutes",
+	EndTimeHour:      "tbl_schedule_histories.end_time_hour",
+	EndTimeMinutes:   "tbl_schedule_histories.end_time_minutes",
+}
+
+// Generated where
+
+var TBLScheduleHistoryWhere = struct {
+	ID               whereHelperint
+	ScheduleID       whereHelperint
+	HistoryIndex     whereHelperint
+	StartTimeHour    whereHelperint
+	StartTimeMinutes whereHelperint
+	EndTimeHour      whereHelperint
+	EndTimeMinutes   whereHelperint
+}{
+	ID:               whereHelperint{field: "`tbl_schedule_histories`.`id`"},
+	ScheduleID:       whereHelperint{field: "`tbl_schedule_histories`.`schedule_id`"},
+	HistoryIndex:     whereHelperint{field: "`tbl_schedule_histories`.`history_index`"},
+	StartTimeHour:    whereHelperint{field: "`tbl_schedule_histories`.`start_time_hour`"},
+	StartTimeMinutes: whereHelperint{field: "`tbl_schedule_histories`.`start_time_minutes`"},
+	EndTimeHour:      whereHelperint{field: "`tbl_schedule_histories`.`end_time_hour`"},
+	EndTimeMinutes:   whereHelperint{field: "`tbl_schedule_histories`.`end_time_minutes`"},
+}
+
+// TBLScheduleHistoryRels is where relationship names are stored.
+var TBLScheduleHistoryRels = struct {
+	Schedule string
+}{
+	Schedule: "Schedule",
+}
+
+// tblScheduleHistoryR is where relationships are stored.
+type tblScheduleHistoryR struct {
+	Schedule *TBLSchedule `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleHistoryR) NewStruct() *tblScheduleHistoryR {
+	return &tblScheduleHistoryR{}
+}
+
+func (o *TBLScheduleHistory) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleHistoryR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+// tblScheduleHistoryL is where Load methods for each relationship are stored.
+type tblScheduleHistoryL struct{}
+
+var (
+	tblScheduleHistoryAllColumns            = []string{"id", "schedule_id", "history_index", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes"}
+	tblScheduleHistoryColumnsWithoutDefault = []string{"schedule_id", "history_index", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes"}
+	tblScheduleHistoryColumnsWithDefault    = []string{"id"}
+	tblScheduleHistoryPrimaryKeyColumns     = []string{"id"}
+	tblScheduleHistoryGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleHistorySlice is an alias for a slice of pointers to TBLScheduleHistory.
+	// This should almost always be used instead of []TBLScheduleHistory.
+	TBLScheduleHistorySlice []*TBLScheduleHistory
+	// TBLScheduleHistoryHook is the signature for custom TBLScheduleHistory hook methods
+	TBLScheduleHistoryHook func(context.Context, boil.ContextExecutor, *TBLScheduleHistory) error
+
+	tblScheduleHistoryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleHistoryType                 = reflect.TypeOf(&TBLScheduleHistory{})
+	tblScheduleHistoryMapping              = queries.MakeStructMapping(tblScheduleHistoryType)
+	tblScheduleHistoryPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, tblScheduleHistoryPrimaryKeyColumns)
+	tblScheduleHistoryInsertCacheMut       sync.RWMutex
+	tblScheduleHistoryInsertCache          = make(map[string]insertCache)
+	tblScheduleHistoryUpdateCacheMut       sync.RWMutex
+	tblScheduleHistoryUpdateCache          = make(map[string]updateCache)
+	tblScheduleHistoryUpsertCacheMut       sync.RWMutex
+	tblScheduleHistoryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleHistoryAfterSelectMu sync.Mutex
+var tblScheduleHistoryAfterSelectHooks []TBLScheduleHistoryHook
+
+var tblScheduleHistoryBeforeInsertMu sync.Mutex
+var tblScheduleHistoryBeforeInsertHooks []TBLScheduleHistoryHook
+var tblScheduleHistoryAfterInsertMu sync.Mutex
+var tblScheduleHistoryAfterInsertHooks []TBLScheduleHistoryHook
+
+var tblScheduleHistoryBeforeUpdateMu sync.Mutex
+var tblScheduleHistoryBeforeUpdateHooks []TBLScheduleHistoryHook
+var tblScheduleHistoryAfterUpdateMu sync.Mutex
+var tblScheduleHistoryAfterUpdateHooks []TBLScheduleHistoryHook
+
+var tblScheduleHistoryBeforeDeleteMu sync.Mutex
+var tblScheduleHistoryBeforeDeleteHooks []TBLScheduleHistoryHook
+var tblScheduleHistoryAfterDeleteMu sync.Mutex
+var tblScheduleHistoryAfterDeleteHooks []TBLScheduleHistoryHook
+
+var tblScheduleHistoryBeforeUpsertMu sync.Mutex
+var tblScheduleHistoryBeforeUpsertHooks []TBLScheduleHistoryHook
+var tblScheduleHistoryAfterUpsertMu sync.Mutex
+var tblScheduleHistoryAfterUpsertHooks []TBLScheduleHistoryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleHistory) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleHistory) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleHistory) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleHistory) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleHistory) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleHistory) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleHistory) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleHistory) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleHistory) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleHistoryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleHistoryHook registers your hook function for all future operations.
+func AddTBLScheduleHistoryHook(hookPoint boil.HookPoint, tblScheduleHistoryHook TBLScheduleHistoryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleHistoryAfterSelectMu.Lock()
+		tblScheduleHistoryAfterSelectHooks = append(tblScheduleHistoryAfterSelectHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleHistoryBeforeInsertMu.Lock()
+		tblScheduleHistoryBeforeInsertHooks = append(tblScheduleHistoryBeforeInsertHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleHistoryAfterInsertMu.Lock()
+		tblScheduleHistoryAfterInsertHooks = append(tblScheduleHistoryAfterInsertHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleHistoryBeforeUpdateMu.Lock()
+		tblScheduleHistoryBeforeUpdateHooks = append(tblScheduleHistoryBeforeUpdateHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleHistoryAfterUpdateMu.Lock()
+		tblScheduleHistoryAfterUpdateHooks = append(tblScheduleHistoryAfterUpdateHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleHistoryBeforeDeleteMu.Lock()
+		tblScheduleHistoryBeforeDeleteHooks = append(tblScheduleHistoryBeforeDeleteHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleHistoryAfterDeleteMu.Lock()
+		tblScheduleHistoryAfterDeleteHooks = append(tblScheduleHistoryAfterDeleteHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleHistoryBeforeUpsertMu.Lock()
+		tblScheduleHistoryBeforeUpsertHooks = append(tblScheduleHistoryBeforeUpsertHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleHistoryAfterUpsertMu.Lock()
+		tblScheduleHistoryAfterUpsertHooks = append(tblScheduleHistoryAfterUpsertHooks, tblScheduleHistoryHook)
+		tblScheduleHistoryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleHistory record from the query.
+func (q tblScheduleHistoryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleHistory, error) {
+	o := &TBLScheduleHistory{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_histories")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleHistory records from the query.
+func (q tblScheduleHistoryQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleHistorySlice, error) {
+	var o []*TBLScheduleHistory
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleHistory slice")
+	}
+
+	if len(tblScheduleHistoryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleHistory records in the query.
+func (q tblScheduleHistoryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_histories rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleHistoryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_histories exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleHistory) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleHistoryL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleHistory interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleHistory
+	var object *TBLScheduleHistory
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleHistory.(*TBLScheduleHistory)
+		if !ok {
+			object = new(TBLScheduleHistory)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleHistory))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleHistory.(*[]*TBLScheduleHistory)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleHistory)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleHistory))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleHistoryR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleHistoryR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleHistories = append(foreign.R.ScheduleTBLScheduleHistories, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleHistories = append(foreign.R.ScheduleTBLScheduleHistories, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleHistory to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleHistories.
+func (o *TBLScheduleHistory) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_histories` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleHistoryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleHistoryR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleHistories: TBLScheduleHistorySlice{o},
+		}
+	} else {
+		related.R.ScheduleTBLScheduleHistories = append(related.R.ScheduleTBLScheduleHistories, o)
+	}
+
+	return nil
+}
+
+// TBLScheduleHistories retrieves all the records using an executor.
+func TBLScheduleHistories(mods ...qm.QueryMod) tblScheduleHistoryQuery {
+	mods = append(mods, qm.From("`tbl_schedule_histories`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_histories`.*"})
+	}
+
+	return tblScheduleHistoryQuery{q}
+}
+
+// FindTBLScheduleHistory retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleHistory(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleHistory, error) {
+	tblScheduleHistoryObj := &TBLScheduleHistory{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_histories` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleHistoryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_histories")
+	}
+
+	if err = tblScheduleHistoryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleHistoryObj, err
+	}
+
+	return tblScheduleHistoryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleHistory) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_histories provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleHistoryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleHistoryInsertCacheMut.RLock()
+	cache, cached := tblScheduleHistoryInsertCache[key]
+	tblScheduleHistoryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleHistoryAllColumns,
+			tblScheduleHistoryColumnsWithDefault,
+			tblScheduleHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_histories` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_histories` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_histories` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleHistoryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_histories")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleHistoryMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_histories")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleHistoryInsertCacheMut.Lock()
+		tblScheduleHistoryInsertCache[key] = cache
+		tblScheduleHistoryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleHistory.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleHistory) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleHistoryUpdateCacheMut.RLock()
+	cache, cached := tblScheduleHistoryUpdateCache[key]
+	tblScheduleHistoryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleHistoryAllColumns,
+			tblScheduleHistoryPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_histories, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_histories` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleHistoryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, append(wl, tblScheduleHistoryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_histories row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_histories")
+	}
+
+	if !cached {
+		tblScheduleHistoryUpdateCacheMut.Lock()
+		tblScheduleHistoryUpdateCache[key] = cache
+		tblScheduleHistoryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleHistoryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleHistorySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_histories` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleHistoryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleHistory")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleHistoryUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleHistory) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_histories provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleHistoryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleHistoryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleHistoryUpsertCacheMut.RLock()
+	cache, cached := tblScheduleHistoryUpsertCache[key]
+	tblScheduleHistoryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleHistoryAllColumns,
+			tblScheduleHistoryColumnsWithDefault,
+			tblScheduleHistoryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleHistoryAllColumns,
+			tblScheduleHistoryPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_histories, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleHistoryAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_histories`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_histories` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_histories")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleHistoryMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleHistoryType, tblScheduleHistoryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_histories")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_histories")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleHistoryUpsertCacheMut.Lock()
+		tblScheduleHistoryUpsertCache[key] = cache
+		tblScheduleHistoryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleHistory record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleHistory) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleHistory provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleHistoryPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_histories` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_histories")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleHistoryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleHistoryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_histories")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_histories")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleHistorySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleHistoryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_histories` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleHistoryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleHistory slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_histories")
+	}
+
+	if len(tblScheduleHistoryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleHistory) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleHistory(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleHistorySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleHistorySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleHistoryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_histories`.* FROM `tbl_schedule_histories` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleHistoryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleHistorySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleHistoryExists checks if the TBLScheduleHistory row exists.
+func TBLScheduleHistoryExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_histories` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_histories exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleHistory row exists.
+func (o *TBLScheduleHistory) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleHistoryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1205 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLScheduleItemGroup is an object representing the database table.
+type TBLScheduleItemGroup struct {
+	ID              int    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScheduleID      int    `boil:"schedule_id" json:"schedule_id" toml:"schedule_id" yaml:"schedule_id"`
+	HistoryIndex    int    `boil:"history_index" json:"history_index" toml:"history_index" yaml:"history_index"`
+	GroupIdentifier string `boil:"group_identifier" json:"group_identifier" toml:"group_identifier" yaml:"group_identifier"`
+	Identifier      string `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
+	OffsetMinutes   int    `boil:"offset_minutes" json:"offset_minutes" toml:"offset_minutes" yaml:"offset_minutes"`
+
+	R *tblScheduleItemGroupR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblScheduleItemGroupL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLScheduleItemGroupColumns = struct {
+	ID              string
+	ScheduleID      string
+	HistoryIndex    string
+	GroupIdentifier string
+	Identifier      string
+	OffsetMinutes   string
+}{
+	ID:              "id",
+	ScheduleID:      "schedule_id",
+	HistoryIndex:    "history_index",
+	GroupIdentifier: "group_identifier",
+	Identifier:      "identifier",
+	OffsetMinutes:   "offset_minutes",
+}
+
+var TBLScheduleItemGroupTableColumns = struct {
+	ID              string
+	ScheduleID      string
+	HistoryIndex    string
+	GroupIdentifier string
+	Identifier      string
+	OffsetMinutes   string
+}{
+	ID:              "tbl_schedule_item_groups.id",
+	ScheduleID:      "tbl_schedule_item_groups.schedule_id",
+	HistoryIndex:    "tbl_schedule_item_groups.history_index",
+	GroupIdentifier: "tbl_schedule_item_groups.group_identifier",
+	Identifier:      "tbl_schedule_item_groups.identifier",
+	OffsetMinutes:   "tbl_schedule_item_groups.offset_minutes",
+}
+
+// Generated where
+
+var TBLScheduleItemGroupWhere = struct {
+	ID              whereHelperint
+	ScheduleID      whereHelperint
+	HistoryIndex    whereHelperint
+	GroupIdentifier whereHelperstring
+	Identifier      whereHelperstring
+	OffsetMinutes   whereHelperint
+}{
+	ID:              whereHelperint{field: "`tbl_schedule_item_groups`.`id`"},
+	ScheduleID:      whereHelperint{field: "`tbl_schedule_item_groups`.`schedule_id`"},
+	HistoryIndex:    whereHelperint{field: "`tbl_schedule_item_groups`.`history_index`"},
+	GroupIdentifier: whereHelperstring{field: "`tbl_schedule_item_groups`.`group_identifier`"},
+	Identifier:      whereHelperstring{field: "`tbl_schedule_item_groups`.`identifier`"},
+	OffsetMinutes:   whereHelperint{field: "`tbl_schedule_item_groups`.`offset_minutes`"},
+}
+
+// TBLScheduleItemGroupRels is where relationship names are stored.
+var TBLScheduleItemGroupRels = struct {
+	Schedule string
+}{
+	Schedule: "Schedule",
+}
+
+// tblScheduleItemGroupR is where relationships are stored.
+type tblScheduleItemGroupR struct {
+	Schedule *TBLSchedule `boil:"Schedule" json:"Schedule" toml:"Schedule" yaml:"Schedule"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblScheduleItemGroupR) NewStruct() *tblScheduleItemGroupR {
+	return &tblScheduleItemGroupR{}
+}
+
+func (o *TBLScheduleItemGroup) GetSchedule() *TBLSchedule {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSchedule()
+}
+
+func (r *tblScheduleItemGroupR) GetSchedule() *TBLSchedule {
+	if r == nil {
+		return nil
+	}
+
+	return r.Schedule
+}
+
+// tblScheduleItemGroupL is where Load methods for each relationship are stored.
+type tblScheduleItemGroupL struct{}
+
+var (
+	tblScheduleItemGroupAllColumns            = []string{"id", "schedule_id", "history_index", "group_identifier", "identifier", "offset_minutes"}
+	tblScheduleItemGroupColumnsWithoutDefault = []string{"schedule_id", "history_index", "group_identifier", "identifier", "offset_minutes"}
+	tblScheduleItemGroupColumnsWithDefault    = []string{"id"}
+	tblScheduleItemGroupPrimaryKeyColumns     = []string{"id"}
+	tblScheduleItemGroupGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLScheduleItemGroupSlice is an alias for a slice of pointers to TBLScheduleItemGroup.
+	// This should almost always be used instead of []TBLScheduleItemGroup.
+	TBLScheduleItemGroupSlice []*TBLScheduleItemGroup
+	// TBLScheduleItemGroupHook is the signature for custom TBLScheduleItemGroup hook methods
+	TBLScheduleItemGroupHook func(context.Context, boil.ContextExecutor, *TBLScheduleItemGroup) error
+
+	tblScheduleItemGroupQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblScheduleItemGroupType                 = reflect.TypeOf(&TBLScheduleItemGroup{})
+	tblScheduleItemGroupMapping              = queries.MakeStructMapping(tblScheduleItemGroupType)
+	tblScheduleItemGroupPrimaryKeyMapping, _ = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, tblScheduleItemGroupPrimaryKeyColumns)
+	tblScheduleItemGroupInsertCacheMut       sync.RWMutex
+	tblScheduleItemGroupInsertCache          = make(map[string]insertCache)
+	tblScheduleItemGroupUpdateCacheMut       sync.RWMutex
+	tblScheduleItemGroupUpdateCache          = make(map[string]updateCache)
+	tblScheduleItemGroupUpsertCacheMut       sync.RWMutex
+	tblScheduleItemGroupUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblScheduleItemGroupAfterSelectMu sync.Mutex
+var tblScheduleItemGroupAfterSelectHooks []TBLScheduleItemGroupHook
+
+var tblScheduleItemGroupBeforeInsertMu sync.Mutex
+var tblScheduleItemGroupBeforeInsertHooks []TBLScheduleItemGroupHook
+var tblScheduleItemGroupAfterInsertMu sync.Mutex
+var tblScheduleItemGroupAfterInsertHooks []TBLScheduleItemGroupHook
+
+var tblScheduleItemGroupBeforeUpdateMu sync.Mutex
+var tblScheduleItemGroupBeforeUpdateHooks []TBLScheduleItemGroupHook
+var tblScheduleItemGroupAfterUpdateMu sync.Mutex
+var tblScheduleItemGroupAfterUpdateHooks []TBLScheduleItemGroupHook
+
+var tblScheduleItemGroupBeforeDeleteMu sync.Mutex
+var tblScheduleItemGroupBeforeDeleteHooks []TBLScheduleItemGroupHook
+var tblScheduleItemGroupAfterDeleteMu sync.Mutex
+var tblScheduleItemGroupAfterDeleteHooks []TBLScheduleItemGroupHook
+
+var tblScheduleItemGroupBeforeUpsertMu sync.Mutex
+var tblScheduleItemGroupBeforeUpsertHooks []TBLScheduleItemGroupHook
+var tblScheduleItemGroupAfterUpsertMu sync.Mutex
+var tblScheduleItemGroupAfterUpsertHooks []TBLScheduleItemGroupHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLScheduleItemGroup) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLScheduleItemGroup) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLScheduleItemGroup) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLScheduleItemGroup) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLScheduleItemGroup) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLScheduleItemGroup) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLScheduleItemGroup) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLScheduleItemGroup) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLScheduleItemGroup) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblScheduleItemGroupAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLScheduleItemGroupHook registers your hook function for all future operations.
+func AddTBLScheduleItemGroupHook(hookPoint boil.HookPoint, tblScheduleItemGroupHook TBLScheduleItemGroupHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblScheduleItemGroupAfterSelectMu.Lock()
+		tblScheduleItemGroupAfterSelectHooks = append(tblScheduleItemGroupAfterSelectHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblScheduleItemGroupBeforeInsertMu.Lock()
+		tblScheduleItemGroupBeforeInsertHooks = append(tblScheduleItemGroupBeforeInsertHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblScheduleItemGroupAfterInsertMu.Lock()
+		tblScheduleItemGroupAfterInsertHooks = append(tblScheduleItemGroupAfterInsertHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblScheduleItemGroupBeforeUpdateMu.Lock()
+		tblScheduleItemGroupBeforeUpdateHooks = append(tblScheduleItemGroupBeforeUpdateHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblScheduleItemGroupAfterUpdateMu.Lock()
+		tblScheduleItemGroupAfterUpdateHooks = append(tblScheduleItemGroupAfterUpdateHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblScheduleItemGroupBeforeDeleteMu.Lock()
+		tblScheduleItemGroupBeforeDeleteHooks = append(tblScheduleItemGroupBeforeDeleteHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblScheduleItemGroupAfterDeleteMu.Lock()
+		tblScheduleItemGroupAfterDeleteHooks = append(tblScheduleItemGroupAfterDeleteHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblScheduleItemGroupBeforeUpsertMu.Lock()
+		tblScheduleItemGroupBeforeUpsertHooks = append(tblScheduleItemGroupBeforeUpsertHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblScheduleItemGroupAfterUpsertMu.Lock()
+		tblScheduleItemGroupAfterUpsertHooks = append(tblScheduleItemGroupAfterUpsertHooks, tblScheduleItemGroupHook)
+		tblScheduleItemGroupAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblScheduleItemGroup record from the query.
+func (q tblScheduleItemGroupQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLScheduleItemGroup, error) {
+	o := &TBLScheduleItemGroup{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_schedule_item_groups")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLScheduleItemGroup records from the query.
+func (q tblScheduleItemGroupQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLScheduleItemGroupSlice, error) {
+	var o []*TBLScheduleItemGroup
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLScheduleItemGroup slice")
+	}
+
+	if len(tblScheduleItemGroupAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLScheduleItemGroup records in the query.
+func (q tblScheduleItemGroupQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_schedule_item_groups rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblScheduleItemGroupQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_schedule_item_groups exists")
+	}
+
+	return count > 0, nil
+}
+
+// Schedule pointed to by the foreign key.
+func (o *TBLScheduleItemGroup) Schedule(mods ...qm.QueryMod) tblScheduleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ScheduleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLSchedules(queryMods...)
+}
+
+// LoadSchedule allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblScheduleItemGroupL) LoadSchedule(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLScheduleItemGroup interface{}, mods queries.Applicator) error {
+	var slice []*TBLScheduleItemGroup
+	var object *TBLScheduleItemGroup
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLScheduleItemGroup.(*TBLScheduleItemGroup)
+		if !ok {
+			object = new(TBLScheduleItemGroup)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLScheduleItemGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLScheduleItemGroup))
+			}
+		}
+	} else {
+		s, ok := maybeTBLScheduleItemGroup.(*[]*TBLScheduleItemGroup)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLScheduleItemGroup)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLScheduleItemGroup))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleItemGroupR{}
+		}
+		args[object.ScheduleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleItemGroupR{}
+			}
+
+			args[obj.ScheduleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLSchedule")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLSchedule")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Schedule = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleR{}
+		}
+		foreign.R.ScheduleTBLScheduleItemGroups = append(foreign.R.ScheduleTBLScheduleItemGroups, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ScheduleID == foreign.ID {
+				local.R.Schedule = foreign
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.ScheduleTBLScheduleItemGroups = append(foreign.R.ScheduleTBLScheduleItemGroups, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSchedule of the tblScheduleItemGroup to the related item.
+// Sets o.R.Schedule to related.
+// Adds o to related.R.ScheduleTBLScheduleItemGroups.
+func (o *TBLScheduleItemGroup) SetSchedule(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLSchedule) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedule_item_groups` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+		strmangle.WhereClause("`", "`", 0, tblScheduleItemGroupPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ScheduleID = related.ID
+	if o.R == nil {
+		o.R = &tblScheduleItemGroupR{
+			Schedule: related,
+		}
+	} else {
+		o.R.Schedule = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleR{
+			ScheduleTBLScheduleItemGroups: TBLScheduleItemGroupSlice{o},
+		}
+	} else {
+		related.R.ScheduleTBLScheduleItemGroups = append(related.R.ScheduleTBLScheduleItemGroups, o)
+	}
+
+	return nil
+}
+
+// TBLScheduleItemGroups retrieves all the records using an executor.
+func TBLScheduleItemGroups(mods ...qm.QueryMod) tblScheduleItemGroupQuery {
+	mods = append(mods, qm.From("`tbl_schedule_item_groups`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_schedule_item_groups`.*"})
+	}
+
+	return tblScheduleItemGroupQuery{q}
+}
+
+// FindTBLScheduleItemGroup retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLScheduleItemGroup(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLScheduleItemGroup, error) {
+	tblScheduleItemGroupObj := &TBLScheduleItemGroup{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_schedule_item_groups` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblScheduleItemGroupObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_schedule_item_groups")
+	}
+
+	if err = tblScheduleItemGroupObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblScheduleItemGroupObj, err
+	}
+
+	return tblScheduleItemGroupObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLScheduleItemGroup) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_item_groups provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleItemGroupColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblScheduleItemGroupInsertCacheMut.RLock()
+	cache, cached := tblScheduleItemGroupInsertCache[key]
+	tblScheduleItemGroupInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblScheduleItemGroupAllColumns,
+			tblScheduleItemGroupColumnsWithDefault,
+			tblScheduleItemGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_schedule_item_groups` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_schedule_item_groups` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_schedule_item_groups` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblScheduleItemGroupPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_schedule_item_groups")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleItemGroupMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_item_groups")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleItemGroupInsertCacheMut.Lock()
+		tblScheduleItemGroupInsertCache[key] = cache
+		tblScheduleItemGroupInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLScheduleItemGroup.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLScheduleItemGroup) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblScheduleItemGroupUpdateCacheMut.RLock()
+	cache, cached := tblScheduleItemGroupUpdateCache[key]
+	tblScheduleItemGroupUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblScheduleItemGroupAllColumns,
+			tblScheduleItemGroupPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_schedule_item_groups, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_schedule_item_groups` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblScheduleItemGroupPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, append(wl, tblScheduleItemGroupPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_schedule_item_groups row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_schedule_item_groups")
+	}
+
+	if !cached {
+		tblScheduleItemGroupUpdateCacheMut.Lock()
+		tblScheduleItemGroupUpdateCache[key] = cache
+		tblScheduleItemGroupUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblScheduleItemGroupQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_schedule_item_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_schedule_item_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLScheduleItemGroupSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleItemGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_schedule_item_groups` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleItemGroupPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblScheduleItemGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblScheduleItemGroup")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLScheduleItemGroupUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLScheduleItemGroup) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_schedule_item_groups provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblScheduleItemGroupColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLScheduleItemGroupUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblScheduleItemGroupUpsertCacheMut.RLock()
+	cache, cached := tblScheduleItemGroupUpsertCache[key]
+	tblScheduleItemGroupUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblScheduleItemGroupAllColumns,
+			tblScheduleItemGroupColumnsWithDefault,
+			tblScheduleItemGroupColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblScheduleItemGroupAllColumns,
+			tblScheduleItemGroupPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_schedule_item_groups, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblScheduleItemGroupAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_schedule_item_groups`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_schedule_item_groups` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_schedule_item_groups")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblScheduleItemGroupMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblScheduleItemGroupType, tblScheduleItemGroupMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_schedule_item_groups")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_schedule_item_groups")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblScheduleItemGroupUpsertCacheMut.Lock()
+		tblScheduleItemGroupUpsertCache[key] = cache
+		tblScheduleItemGroupUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLScheduleItemGroup record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLScheduleItemGroup) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLScheduleItemGroup provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblScheduleItemGroupPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_schedule_item_groups` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_schedule_item_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_schedule_item_groups")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblScheduleItemGroupQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblScheduleItemGroupQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_schedule_item_groups")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_item_groups")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLScheduleItemGroupSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblScheduleItemGroupBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleItemGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_schedule_item_groups` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleItemGroupPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblScheduleItemGroup slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_schedule_item_groups")
+	}
+
+	if len(tblScheduleItemGroupAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLScheduleItemGroup) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLScheduleItemGroup(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLScheduleItemGroupSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLScheduleItemGroupSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblScheduleItemGroupPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_schedule_item_groups`.* FROM `tbl_schedule_item_groups` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblScheduleItemGroupPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLScheduleItemGroupSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLScheduleItemGroupExists checks if the TBLScheduleItemGroup row exists.
+func TBLScheduleItemGroupExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_schedule_item_groups` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_schedule_item_groups exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLScheduleItemGroup row exists.
+func (o *TBLScheduleItemGroup) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLScheduleItemGroupExists(ctx, exec, o.ID)
+}
@@ -29,12 +29,14 @@ type TBLScheduleRoomItem struct {
 	ItemTag          string `boil:"item_tag" json:"item_tag" toml:"item_tag" yaml:"item_tag"`
 	LessonID         int    `boil:"lesson_id" json:"lesson_id" toml:"lesson_id" yaml:"lesson_id"`
 	Identifier       string `boil:"identifier" json:"identifier" toml:"identifier" yaml:"identifier"`
+	Title            string `boil:"title" json:"title" toml:"title" yaml:"title"`
 	Duration         int    `boil:"duration" json:"duration" toml:"duration" yaml:"duration"`
 	StartTimeHour    int    `boil:"start_time_hour" json:"start_time_hour" toml:"start_time_hour" yaml:"start_time_hour"`
 	StartTimeMinutes int    `boil:"start_time_minutes" json:"start_time_minutes" toml:"start_time_minutes" yaml:"start_time_minutes"`
 	EndTimeHour      int    `boil:"end_time_hour" json:"end_time_hour" toml:"end_time_hour" yaml:"end_time_hour"`
 	EndTimeMinutes   int    `boil:"end_time_minutes" json:"end_time_minutes" toml:"end_time_minutes" yaml:"end_time_minutes"`
 	RoomIndex        int    `boil:"room_index" json:"room_index" toml:"room_index" yaml:"room_index"`
+	Pinned           int    `boil:"pinned" json:"pinned" toml:"pinned" yaml:"pinned"`
 
 	R *tblScheduleRoomItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tblScheduleRoomItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -47,12 +49,14 @@ var TBLScheduleRoomItemColumns = struct {
 	ItemTag          string
 	LessonID         string
 	Identifier       string
+	Title            string
 	Duration         string
 	StartTimeHour    string
 	StartTimeMinutes string
 	EndTimeHour      string
 	EndTimeMinutes   string
 	RoomIndex        string
+	Pinned           string
 }{
 	ID:               "id",
 	ScheduleID:       "schedule_id",
@@ -60,12 +64,14 @@ var TBLScheduleRoomItemColumns = struct {
 	ItemTag:          "item_tag",
 	LessonID:         "lesson_id",
 	Identifier:       "identifier",
+	Title:            "title",
 	Duration:         "duration",
 	StartTimeHour:    "start_time_hour",
 	StartTimeMinutes: "start_time_minutes",
 	EndTimeHour:      "end_time_hour",
 	EndTimeMinutes:   "end_time_minutes",
 	RoomIndex:        "room_index",
+	Pinned:           "pinned",
 }
 
 var TBLScheduleRoomItemTableColumns = struct {
@@ -75,12 +81,14 @@ var TBLScheduleRoomItemTableColumns = struct {
 	ItemTag          string
 	LessonID         string
 	Identifier       string
+	Title            string
 	Duration         string
 	StartTimeHour    string
 	StartTimeMinutes string
 	EndTimeHour      string
 	EndTimeMinutes   string
 	RoomIndex        string
+	Pinned           string
 }{
 	ID:               "tbl_schedule_room_items.id",
 	ScheduleID:       "tbl_schedule_room_items.schedule_id",
@@ -88,12 +96,14 @@ var TBLScheduleRoomItemTableColumns = struct {
 	ItemTag:          "tbl_schedule_room_items.item_tag",
 	LessonID:         "tbl_schedule_room_items.lesson_id",
 	Identifier:       "tbl_schedule_room_items.identifier",
+	Title:            "tbl_schedule_room_items.title",
 	Duration:         "tbl_schedule_room_items.duration",
 	StartTimeHour:    "tbl_schedule_room_items.start_time_hour",
 	StartTimeMinutes: "tbl_schedule_room_items.start_time_minutes",
 	EndTimeHour:      "tbl_schedule_room_items.end_time_hour",
 	EndTimeMinutes:   "tbl_schedule_room_items.end_time_minutes",
 	RoomIndex:        "tbl_schedule_room_items.room_index",
+	Pinned:           "tbl_schedule_room_items.pinned",
 }
 
 // Generated where
@@ -105,12 +115,14 @@ var TBLScheduleRoomItemWhere = struct {
 	ItemTag          whereHelperstring
 	LessonID         whereHelperint
 	Identifier       whereHelperstring
+	Title            whereHelperstring
 	Duration         whereHelperint
 	StartTimeHour    whereHelperint
 	StartTimeMinutes whereHelperint
 	EndTimeHour      whereHelperint
 	EndTimeMinutes   whereHelperint
 	RoomIndex        whereHelperint
+	Pinned           whereHelperint
 }{
 	ID:               whereHelperint{field: "`tbl_schedule_room_items`.`id`"},
 	ScheduleID:       whereHelperint{field: "`tbl_schedule_room_items`.`schedule_id`"},
@@ -118,12 +130,14 @@ var TBLScheduleRoomItemWhere = struct {
 	ItemTag:          whereHelperstring{field: "`tbl_schedule_room_items`.`item_tag`"},
 	LessonID:         whereHelperint{field: "`tbl_schedule_room_items`.`lesson_id`"},
 	Identifier:       whereHelperstring{field: "`tbl_schedule_room_items`.`identifier`"},
+	Title:            whereHelperstring{field: "`tbl_schedule_room_items`.`title`"},
 	Duration:         whereHelperint{field: "`tbl_schedule_room_items`.`duration`"},
 	StartTimeHour:    whereHelperint{field: "`tbl_schedule_room_items`.`start_time_hour`"},
 	StartTimeMinutes: whereHelperint{field: "`tbl_schedule_room_items`.`start_time_minutes`"},
 	EndTimeHour:      whereHelperint{field: "`tbl_schedule_room_items`.`end_time_hour`"},
 	EndTimeMinutes:   whereHelperint{field: "`tbl_schedule_room_items`.`end_time_minutes`"},
 	RoomIndex:        whereHelperint{field: "`tbl_schedule_room_items`.`room_index`"},
+	Pinned:           whereHelperint{field: "`tbl_schedule_room_items`.`pinned`"},
 }
 
 // TBLScheduleRoomItemRels is where relationship names are stored.
@@ -163,9 +177,9 @@ func (r *tblScheduleRoomItemR) GetSchedule() *TBLSchedule {
 type tblScheduleRoomItemL struct{}
 
 var (
-	tblScheduleRoomItemAllColumns            = []string{"id", "schedule_id", "history_index", "item_tag", "lesson_id", "identifier", "duration", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "room_index"}
-	tblScheduleRoomItemColumnsWithoutDefault = []string{"schedule_id", "history_index", "item_tag", "lesson_id", "identifier", "duration", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "room_index"}
-	tblScheduleRoomItemColumnsWithDefault    = []string{"id"}
+	tblScheduleRoomItemAllColumns            = []string{"id", "schedule_id", "history_index", "item_tag", "lesson_id", "identifier", "title", "duration", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "room_index", "pinned"}
+	tblScheduleRoomItemColumnsWithoutDefault = []string{"schedule_id", "history_index", "item_tag", "lesson_id", "identifier", "title", "duration", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "room_index"}
+	tblScheduleRoomItemColumnsWithDefault    = []string{"id", "pinned"}
 	tblScheduleRoomItemPrimaryKeyColumns     = []string{"id"}
 	tblScheduleRoomItemGeneratedColumns      = []string{}
 )
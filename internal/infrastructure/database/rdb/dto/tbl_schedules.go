@@ -13,6 +13,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
@@ -23,117 +24,170 @@ import (
 
 // TBLSchedule is an object representing the database table.
 type TBLSchedule struct {
-	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Campus         string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
-	Title          string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	HistoryIndex   int       `boil:"history_index" json:"history_index" toml:"history_index" yaml:"history_index"`
-	StartTime      int       `boil:"start_time" json:"start_time" toml:"start_time" yaml:"start_time"`
-	EndTime        int       `boil:"end_time" json:"end_time" toml:"end_time" yaml:"end_time"`
-	CreateUser     int       `boil:"create_user" json:"create_user" toml:"create_user" yaml:"create_user"`
-	LastUpdateUser int       `boil:"last_update_user" json:"last_update_user" toml:"last_update_user" yaml:"last_update_user"`
-	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID               int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Campus           string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	Title            string    `boil:"title" json:"title" toml:"title" yaml:"title"`
+	TargetDate       null.Time `boil:"target_date" json:"target_date,omitempty" toml:"target_date" yaml:"target_date,omitempty"`
+	HistoryIndex     int       `boil:"history_index" json:"history_index" toml:"history_index" yaml:"history_index"`
+	StartTimeHour    int       `boil:"start_time_hour" json:"start_time_hour" toml:"start_time_hour" yaml:"start_time_hour"`
+	StartTimeMinutes int       `boil:"start_time_minutes" json:"start_time_minutes" toml:"start_time_minutes" yaml:"start_time_minutes"`
+	EndTimeHour      int       `boil:"end_time_hour" json:"end_time_hour" toml:"end_time_hour" yaml:"end_time_hour"`
+	EndTimeMinutes   int       `boil:"end_time_minutes" json:"end_time_minutes" toml:"end_time_minutes" yaml:"end_time_minutes"`
+	Status           string    `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ReviewComment    string    `boil:"review_comment" json:"review_comment" toml:"review_comment" yaml:"review_comment"`
+	CreateUser       int       `boil:"create_user" json:"create_user" toml:"create_user" yaml:"create_user"`
+	LastUpdateUser   int       `boil:"last_update_user" json:"last_update_user" toml:"last_update_user" yaml:"last_update_user"`
+	CreatedAt        time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt        time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 
 	R *tblScheduleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L tblScheduleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TBLScheduleColumns = struct {
-	ID             string
-	Campus         string
-	Title          string
-	HistoryIndex   string
-	StartTime      string
-	EndTime        string
-	CreateUser     string
-	LastUpdateUser string
-	CreatedAt      string
-	UpdatedAt      string
+	ID               string
+	Campus           string
+	Title            string
+	TargetDate       string
+	HistoryIndex     string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+	Status           string
+	ReviewComment    string
+	CreateUser       string
+	LastUpdateUser   string
+	CreatedAt        string
+	UpdatedAt        string
 }{
-	ID:             "id",
-	Campus:         "campus",
-	Title:          "title",
-	HistoryIndex:   "history_index",
-	StartTime:      "start_time",
-	EndTime:        "end_time",
-	CreateUser:     "create_user",
-	LastUpdateUser: "last_update_user",
-	CreatedAt:      "created_at",
-	UpdatedAt:      "updated_at",
+	ID:               "id",
+	Campus:           "campus",
+	Title:            "title",
+	TargetDate:       "target_date",
+	HistoryIndex:     "history_index",
+	StartTimeHour:    "start_time_hour",
+	StartTimeMinutes: "start_time_minutes",
+	EndTimeHour:      "end_time_hour",
+	EndTimeMinutes:   "end_time_minutes",
+	Status:           "status",
+	ReviewComment:    "review_comment",
+	CreateUser:       "create_user",
+	LastUpdateUser:   "last_update_user",
+	CreatedAt:        "created_at",
+	UpdatedAt:        "updated_at",
 }
 
 var TBLScheduleTableColumns = struct {
-	ID             string
-	Campus         string
-	Title          string
-	HistoryIndex   string
-	StartTime      string
-	EndTime        string
-	CreateUser     string
-	LastUpdateUser string
-	CreatedAt      string
-	UpdatedAt      string
+	ID               string
+	Campus           string
+	Title            string
+	TargetDate       string
+	HistoryIndex     string
+	StartTimeHour    string
+	StartTimeMinutes string
+	EndTimeHour      string
+	EndTimeMinutes   string
+	Status           string
+	ReviewComment    string
+	CreateUser       string
+	LastUpdateUser   string
+	CreatedAt        string
+	UpdatedAt        string
 }{
-	ID:             "tbl_schedules.id",
-	Campus:         "tbl_schedules.campus",
-	Title:          "tbl_schedules.title",
-	HistoryIndex:   "tbl_schedules.history_index",
-	StartTime:      "tbl_schedules.start_time",
-	EndTime:        "tbl_schedules.end_time",
-	CreateUser:     "tbl_schedules.create_user",
-	LastUpdateUser: "tbl_schedules.last_update_user",
-	CreatedAt:      "tbl_schedules.created_at",
-	UpdatedAt:      "tbl_schedules.updated_at",
+	ID:               "tbl_schedules.id",
+	Campus:           "tbl_schedules.campus",
+	Title:            "tbl_schedules.title",
+	TargetDate:       "tbl_schedules.target_date",
+	HistoryIndex:     "tbl_schedules.history_index",
+	StartTimeHour:    "tbl_schedules.start_time_hour",
+	StartTimeMinutes: "tbl_schedules.start_time_minutes",
+	EndTimeHour:      "tbl_schedules.end_time_hour",
+	EndTimeMinutes:   "tbl_schedules.end_time_minutes",
+	Status:           "tbl_schedules.status",
+	ReviewComment:    "tbl_schedules.review_comment",
+	CreateUser:       "tbl_schedules.create_user",
+	LastUpdateUser:   "tbl_schedules.last_update_user",
+	CreatedAt:        "tbl_schedules.created_at",
+	UpdatedAt:        "tbl_schedules.updated_at",
 }
 
 // Generated where
 
 var TBLScheduleWhere = struct {
-	ID             whereHelperint
-	Campus         whereHelperstring
-	Title          whereHelperstring
-	HistoryIndex   whereHelperint
-	StartTime      whereHelperint
-	EndTime        whereHelperint
-	CreateUser     whereHelperint
-	LastUpdateUser whereHelperint
-	CreatedAt      whereHelpertime_Time
-	UpdatedAt      whereHelpertime_Time
+	ID               whereHelperint
+	Campus           whereHelperstring
+	Title            whereHelperstring
+	TargetDate       whereHelpernull_Time
+	HistoryIndex     whereHelperint
+	StartTimeHour    whereHelperint
+	StartTimeMinutes whereHelperint
+	EndTimeHour      whereHelperint
+	EndTimeMinutes   whereHelperint
+	Status           whereHelperstring
+	ReviewComment    whereHelperstring
+	CreateUser       whereHelperint
+	LastUpdateUser   whereHelperint
+	CreatedAt        whereHelpertime_Time
+	UpdatedAt        whereHelpertime_Time
 }{
-	ID:             whereHelperint{field: "`tbl_schedules`.`id`"},
-	Campus:         whereHelperstring{field: "`tbl_schedules`.`campus`"},
-	Title:          whereHelperstring{field: "`tbl_schedules`.`title`"},
-	HistoryIndex:   whereHelperint{field: "`tbl_schedules`.`history_index`"},
-	StartTime:      whereHelperint{field: "`tbl_schedules`.`start_time`"},
-	EndTime:        whereHelperint{field: "`tbl_schedules`.`end_time`"},
-	CreateUser:     whereHelperint{field: "`tbl_schedules`.`create_user`"},
-	LastUpdateUser: whereHelperint{field: "`tbl_schedules`.`last_update_user`"},
-	CreatedAt:      whereHelpertime_Time{field: "`tbl_schedules`.`created_at`"},
-	UpdatedAt:      whereHelpertime_Time{field: "`tbl_schedules`.`updated_at`"},
+	ID:               whereHelperint{field: "`tbl_schedules`.`id`"},
+	Campus:           whereHelperstring{field: "`tbl_schedules`.`campus`"},
+	Title:            whereHelperstring{field: "`tbl_schedules`.`title`"},
+	TargetDate:       whereHelpernull_Time{field: "`tbl_schedules`.`target_date`"},
+	HistoryIndex:     whereHelperint{field: "`tbl_schedules`.`history_index`"},
+	StartTimeHour:    whereHelperint{field: "`tbl_schedules`.`start_time_hour`"},
+	StartTimeMinutes: whereHelperint{field: "`tbl_schedules`.`start_time_minutes`"},
+	EndTimeHour:      whereHelperint{field: "`tbl_schedules`.`end_time_hour`"},
+	EndTimeMinutes:   whereHelperint{field: "`tbl_schedules`.`end_time_minutes`"},
+	Status:           whereHelperstring{field: "`tbl_schedules`.`status`"},
+	ReviewComment:    whereHelperstring{field: "`tbl_schedules`.`review_comment`"},
+	CreateUser:       whereHelperint{field: "`tbl_schedules`.`create_user`"},
+	LastUpdateUser:   whereHelperint{field: "`tbl_schedules`.`last_update_user`"},
+	CreatedAt:        whereHelpertime_Time{field: "`tbl_schedules`.`created_at`"},
+	UpdatedAt:        whereHelpertime_Time{field: "`tbl_schedules`.`updated_at`"},
 }
 
 // TBLScheduleRels is where relationship names are stored.
 var TBLScheduleRels = struct {
-	CampusDataCampuse            string
-	CreateUserTBLUser            string
-	LastUpdateUserTBLUser        string
-	ScheduleTBLScheduleItems     string
-	ScheduleTBLScheduleRoomItems string
+	CampusDataCampuse                  string
+	CreateUserTBLUser                  string
+	LastUpdateUserTBLUser              string
+	ScheduleTBLScheduleDayBundleMember string
+	ScheduleTBLScheduleChangeRequests  string
+	ScheduleTBLScheduleCollaborators   string
+	ScheduleTBLScheduleComments        string
+	ScheduleTBLScheduleHistories       string
+	ScheduleTBLScheduleItemGroups      string
+	ScheduleTBLScheduleItems           string
+	ScheduleTBLScheduleRoomItems       string
 }{
-	CampusDataCampuse:            "CampusDataCampuse",
-	CreateUserTBLUser:            "CreateUserTBLUser",
-	LastUpdateUserTBLUser:        "LastUpdateUserTBLUser",
-	ScheduleTBLScheduleItems:     "ScheduleTBLScheduleItems",
-	ScheduleTBLScheduleRoomItems: "ScheduleTBLScheduleRoomItems",
+	CampusDataCampuse:                  "CampusDataCampuse",
+	CreateUserTBLUser:                  "CreateUserTBLUser",
+	LastUpdateUserTBLUser:              "LastUpdateUserTBLUser",
+	ScheduleTBLScheduleDayBundleMember: "ScheduleTBLScheduleDayBundleMember",
+	ScheduleTBLScheduleChangeRequests:  "ScheduleTBLScheduleChangeRequests",
+	ScheduleTBLScheduleCollaborators:   "ScheduleTBLScheduleCollaborators",
+	ScheduleTBLScheduleComments:        "ScheduleTBLScheduleComments",
+	ScheduleTBLScheduleHistories:       "ScheduleTBLScheduleHistories",
+	ScheduleTBLScheduleItemGroups:      "ScheduleTBLScheduleItemGroups",
+	ScheduleTBLScheduleItems:           "ScheduleTBLScheduleItems",
+	ScheduleTBLScheduleRoomItems:       "ScheduleTBLScheduleRoomItems",
 }
 
 // tblScheduleR is where relationships are stored.
 type tblScheduleR struct {
-	CampusDataCampuse            *DataCampuse             `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
-	CreateUserTBLUser            *TBLUser                 `boil:"CreateUserTBLUser" json:"CreateUserTBLUser" toml:"CreateUserTBLUser" yaml:"CreateUserTBLUser"`
-	LastUpdateUserTBLUser        *TBLUser                 `boil:"LastUpdateUserTBLUser" json:"LastUpdateUserTBLUser" toml:"LastUpdateUserTBLUser" yaml:"LastUpdateUserTBLUser"`
-	ScheduleTBLScheduleItems     TBLScheduleItemSlice     `boil:"ScheduleTBLScheduleItems" json:"ScheduleTBLScheduleItems" toml:"ScheduleTBLScheduleItems" yaml:"ScheduleTBLScheduleItems"`
-	ScheduleTBLScheduleRoomItems TBLScheduleRoomItemSlice `boil:"ScheduleTBLScheduleRoomItems" json:"ScheduleTBLScheduleRoomItems" toml:"ScheduleTBLScheduleRoomItems" yaml:"ScheduleTBLScheduleRoomItems"`
+	CampusDataCampuse                  *DataCampuse                  `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+	CreateUserTBLUser                  *TBLUser                      `boil:"CreateUserTBLUser" json:"CreateUserTBLUser" toml:"CreateUserTBLUser" yaml:"CreateUserTBLUser"`
+	LastUpdateUserTBLUser              *TBLUser                      `boil:"LastUpdateUserTBLUser" json:"LastUpdateUserTBLUser" toml:"LastUpdateUserTBLUser" yaml:"LastUpdateUserTBLUser"`
+	ScheduleTBLScheduleDayBundleMember *TBLScheduleDayBundleMember   `boil:"ScheduleTBLScheduleDayBundleMember" json:"ScheduleTBLScheduleDayBundleMember" toml:"ScheduleTBLScheduleDayBundleMember" yaml:"ScheduleTBLScheduleDayBundleMember"`
+	ScheduleTBLScheduleChangeRequests  TBLScheduleChangeRequestSlice `boil:"ScheduleTBLScheduleChangeRequests" json:"ScheduleTBLScheduleChangeRequests" toml:"ScheduleTBLScheduleChangeRequests" yaml:"ScheduleTBLScheduleChangeRequests"`
+	ScheduleTBLScheduleCollaborators   TBLScheduleCollaboratorSlice  `boil:"ScheduleTBLScheduleCollaborators" json:"ScheduleTBLScheduleCollaborators" toml:"ScheduleTBLScheduleCollaborators" yaml:"ScheduleTBLScheduleCollaborators"`
+	ScheduleTBLScheduleComments        TBLScheduleCommentSlice       `boil:"ScheduleTBLScheduleComments" json:"ScheduleTBLScheduleComments" toml:"ScheduleTBLScheduleComments" yaml:"ScheduleTBLScheduleComments"`
+	ScheduleTBLScheduleHistories       TBLScheduleHistorySlice       `boil:"ScheduleTBLScheduleHistories" json:"ScheduleTBLScheduleHistories" toml:"ScheduleTBLScheduleHistories" yaml:"ScheduleTBLScheduleHistories"`
+	ScheduleTBLScheduleItemGroups      TBLScheduleItemGroupSlice     `boil:"ScheduleTBLScheduleItemGroups" json:"ScheduleTBLScheduleItemGroups" toml:"ScheduleTBLScheduleItemGroups" yaml:"ScheduleTBLScheduleItemGroups"`
+	ScheduleTBLScheduleItems           TBLScheduleItemSlice          `boil:"ScheduleTBLScheduleItems" json:"ScheduleTBLScheduleItems" toml:"ScheduleTBLScheduleItems" yaml:"ScheduleTBLScheduleItems"`
+	ScheduleTBLScheduleRoomItems       TBLScheduleRoomItemSlice      `boil:"ScheduleTBLScheduleRoomItems" json:"ScheduleTBLScheduleRoomItems" toml:"ScheduleTBLScheduleRoomItems" yaml:"ScheduleTBLScheduleRoomItems"`
 }
 
 // NewStruct creates a new relationship struct
@@ -189,6 +243,102 @@ func (r *tblScheduleR) GetLastUpdateUserTBLUser() *TBLUser {
 	return r.LastUpdateUserTBLUser
 }
 
+func (o *TBLSchedule) GetScheduleTBLScheduleDayBundleMember() *TBLScheduleDayBundleMember {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleDayBundleMember()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleDayBundleMember() *TBLScheduleDayBundleMember {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleDayBundleMember
+}
+
+func (o *TBLSchedule) GetScheduleTBLScheduleChangeRequests() TBLScheduleChangeRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleChangeRequests()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleChangeRequests() TBLScheduleChangeRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleChangeRequests
+}
+
+func (o *TBLSchedule) GetScheduleTBLScheduleCollaborators() TBLScheduleCollaboratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleCollaborators()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleCollaborators() TBLScheduleCollaboratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleCollaborators
+}
+
+func (o *TBLSchedule) GetScheduleTBLScheduleComments() TBLScheduleCommentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleComments()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleComments() TBLScheduleCommentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleComments
+}
+
+func (o *TBLSchedule) GetScheduleTBLScheduleHistories() TBLScheduleHistorySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleHistories()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleHistories() TBLScheduleHistorySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleHistories
+}
+
+func (o *TBLSchedule) GetScheduleTBLScheduleItemGroups() TBLScheduleItemGroupSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetScheduleTBLScheduleItemGroups()
+}
+
+func (r *tblScheduleR) GetScheduleTBLScheduleItemGroups() TBLScheduleItemGroupSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ScheduleTBLScheduleItemGroups
+}
+
 func (o *TBLSchedule) GetScheduleTBLScheduleItems() TBLScheduleItemSlice {
 	if o == nil {
 		return nil
@@ -225,9 +375,9 @@ func (r *tblScheduleR) GetScheduleTBLScheduleRoomItems() TBLScheduleRoomItemSlic
 type tblScheduleL struct{}
 
 var (
-	tblScheduleAllColumns            = []string{"id", "campus", "title", "history_index", "start_time", "end_time", "create_user", "last_update_user", "created_at", "updated_at"}
-	tblScheduleColumnsWithoutDefault = []string{"campus", "title", "history_index", "start_time", "end_time", "create_user", "last_update_user"}
-	tblScheduleColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblScheduleAllColumns            = []string{"id", "campus", "title", "target_date", "history_index", "start_time_hour", "start_time_minutes", "end_time_hour", "end_time_minutes", "status", "review_comment", "create_user", "last_update_user", "created_at", "updated_at"}
+	tblScheduleColumnsWithoutDefault = []string{"campus", "title", "target_date", "history_index", "start_time_hour", "end_time_hour", "review_comment", "create_user", "last_update_user"}
+	tblScheduleColumnsWithDefault    = []string{"id", "start_time_minutes", "end_time_minutes", "status", "created_at", "updated_at"}
 	tblSchedulePrimaryKeyColumns     = []string{"id"}
 	tblScheduleGeneratedColumns      = []string{}
 )
@@ -570,6 +720,87 @@ func (o *TBLSchedule) LastUpdateUserTBLUser(mods ...qm.QueryMod) tblUserQuery {
 	return TBLUsers(queryMods...)
 }
 
+// ScheduleTBLScheduleDayBundleMember pointed to by the foreign key.
+func (o *TBLSchedule) ScheduleTBLScheduleDayBundleMember(mods ...qm.QueryMod) tblScheduleDayBundleMemberQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`schedule_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLScheduleDayBundleMembers(queryMods...)
+}
+
+// ScheduleTBLScheduleChangeRequests retrieves all the tbl_schedule_change_request's TBLScheduleChangeRequests with an executor via schedule_id column.
+func (o *TBLSchedule) ScheduleTBLScheduleChangeRequests(mods ...qm.QueryMod) tblScheduleChangeRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_change_requests`.`schedule_id`=?", o.ID),
+	)
+
+	return TBLScheduleChangeRequests(queryMods...)
+}
+
+// ScheduleTBLScheduleCollaborators retrieves all the tbl_schedule_collaborator's TBLScheduleCollaborators with an executor via schedule_id column.
+func (o *TBLSchedule) ScheduleTBLScheduleCollaborators(mods ...qm.QueryMod) tblScheduleCollaboratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_collaborators`.`schedule_id`=?", o.ID),
+	)
+
+	return TBLScheduleCollaborators(queryMods...)
+}
+
+// ScheduleTBLScheduleComments retrieves all the tbl_schedule_comment's TBLScheduleComments with an executor via schedule_id column.
+func (o *TBLSchedule) ScheduleTBLScheduleComments(mods ...qm.QueryMod) tblScheduleCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_comments`.`schedule_id`=?", o.ID),
+	)
+
+	return TBLScheduleComments(queryMods...)
+}
+
+// ScheduleTBLScheduleHistories retrieves all the tbl_schedule_history's TBLScheduleHistories with an executor via schedule_id column.
+func (o *TBLSchedule) ScheduleTBLScheduleHistories(mods ...qm.QueryMod) tblScheduleHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_histories`.`schedule_id`=?", o.ID),
+	)
+
+	return TBLScheduleHistories(queryMods...)
+}
+
+// ScheduleTBLScheduleItemGroups retrieves all the tbl_schedule_item_group's TBLScheduleItemGroups with an executor via schedule_id column.
+func (o *TBLSchedule) ScheduleTBLScheduleItemGroups(mods ...qm.QueryMod) tblScheduleItemGroupQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_item_groups`.`schedule_id`=?", o.ID),
+	)
+
+	return TBLScheduleItemGroups(queryMods...)
+}
+
 // ScheduleTBLScheduleItems retrieves all the tbl_schedule_item's TBLScheduleItems with an executor via schedule_id column.
 func (o *TBLSchedule) ScheduleTBLScheduleItems(mods ...qm.QueryMod) tblScheduleItemQuery {
 	var queryMods []qm.QueryMod
@@ -958,9 +1189,9 @@ func (tblScheduleL) LoadLastUpdateUserTBLUser(ctx context.Context, e boil.Contex
 	return nil
 }
 
-// LoadScheduleTBLScheduleItems allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+// LoadScheduleTBLScheduleDayBundleMember allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleDayBundleMember(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
 	var slice []*TBLSchedule
 	var object *TBLSchedule
 
@@ -997,6 +1228,7 @@ func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.Con
 			if obj.R == nil {
 				obj.R = &tblScheduleR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1013,8 +1245,8 @@ func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.Con
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_schedule_items`),
-		qm.WhereIn(`tbl_schedule_items.schedule_id in ?`, argsSlice...),
+		qm.From(`tbl_schedule_day_bundle_members`),
+		qm.WhereIn(`tbl_schedule_day_bundle_members.schedule_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1022,45 +1254,48 @@ func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.Con
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_schedule_items")
+		return errors.Wrap(err, "failed to eager load TBLScheduleDayBundleMember")
 	}
 
-	var resultSlice []*TBLScheduleItem
+	var resultSlice []*TBLScheduleDayBundleMember
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLScheduleDayBundleMember")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_items")
+		return errors.Wrap(err, "failed to close results of eager load for tbl_schedule_day_bundle_members")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_day_bundle_members")
 	}
 
-	if len(tblScheduleItemAfterSelectHooks) != 0 {
+	if len(tblScheduleDayBundleMemberAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ScheduleTBLScheduleItems = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &tblScheduleItemR{}
-			}
-			foreign.R.Schedule = object
+		foreign := resultSlice[0]
+		object.R.ScheduleTBLScheduleDayBundleMember = foreign
+		if foreign.R == nil {
+			foreign.R = &tblScheduleDayBundleMemberR{}
 		}
-		return nil
+		foreign.R.Schedule = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ScheduleID {
-				local.R.ScheduleTBLScheduleItems = append(local.R.ScheduleTBLScheduleItems, foreign)
+				local.R.ScheduleTBLScheduleDayBundleMember = foreign
 				if foreign.R == nil {
-					foreign.R = &tblScheduleItemR{}
+					foreign.R = &tblScheduleDayBundleMemberR{}
 				}
 				foreign.R.Schedule = local
 				break
@@ -1071,9 +1306,9 @@ func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.Con
 	return nil
 }
 
-// LoadScheduleTBLScheduleRoomItems allows an eager lookup of values, cached into the
+// LoadScheduleTBLScheduleChangeRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+func (tblScheduleL) LoadScheduleTBLScheduleChangeRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
 	var slice []*TBLSchedule
 	var object *TBLSchedule
 
@@ -1126,8 +1361,8 @@ func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_schedule_room_items`),
-		qm.WhereIn(`tbl_schedule_room_items.schedule_id in ?`, argsSlice...),
+		qm.From(`tbl_schedule_change_requests`),
+		qm.WhereIn(`tbl_schedule_change_requests.schedule_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1135,22 +1370,22 @@ func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_schedule_room_items")
+		return errors.Wrap(err, "failed to eager load tbl_schedule_change_requests")
 	}
 
-	var resultSlice []*TBLScheduleRoomItem
+	var resultSlice []*TBLScheduleChangeRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_room_items")
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_change_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_room_items")
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_change_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_room_items")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_change_requests")
 	}
 
-	if len(tblScheduleRoomItemAfterSelectHooks) != 0 {
+	if len(tblScheduleChangeRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1158,10 +1393,10 @@ func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil
 		}
 	}
 	if singular {
-		object.R.ScheduleTBLScheduleRoomItems = resultSlice
+		object.R.ScheduleTBLScheduleChangeRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tblScheduleRoomItemR{}
+				foreign.R = &tblScheduleChangeRequestR{}
 			}
 			foreign.R.Schedule = object
 		}
@@ -1171,9 +1406,9 @@ func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ScheduleID {
-				local.R.ScheduleTBLScheduleRoomItems = append(local.R.ScheduleTBLScheduleRoomItems, foreign)
+				local.R.ScheduleTBLScheduleChangeRequests = append(local.R.ScheduleTBLScheduleChangeRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &tblScheduleRoomItemR{}
+					foreign.R = &tblScheduleChangeRequestR{}
 				}
 				foreign.R.Schedule = local
 				break
@@ -1184,62 +1419,740 @@ func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil
 	return nil
 }
 
-// SetCampusDataCampuse of the tblSchedule to the related item.
-// Sets o.R.CampusDataCampuse to related.
-// Adds o to related.R.CampusTBLSchedules.
-func (o *TBLSchedule) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `tbl_schedules` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
-		strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
-	)
-	values := []interface{}{related.Campus, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadScheduleTBLScheduleCollaborators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleCollaborators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
 
-	o.Campus = related.Campus
-	if o.R == nil {
-		o.R = &tblScheduleR{
-			CampusDataCampuse: related,
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
 		}
 	} else {
-		o.R.CampusDataCampuse = related
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &dataCampuseR{
-			CampusTBLSchedules: TBLScheduleSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		related.R.CampusTBLSchedules = append(related.R.CampusTBLSchedules, o)
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	return nil
-}
-
-// SetCreateUserTBLUser of the tblSchedule to the related item.
-// Sets o.R.CreateUserTBLUser to related.
-// Adds o to related.R.CreateUserTBLSchedules.
-func (o *TBLSchedule) SetCreateUserTBLUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_collaborators`),
+		qm.WhereIn(`tbl_schedule_collaborators.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_collaborators")
+	}
+
+	var resultSlice []*TBLScheduleCollaborator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_collaborators")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_collaborators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_collaborators")
+	}
+
+	if len(tblScheduleCollaboratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleCollaborators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleCollaboratorR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleCollaborators = append(local.R.ScheduleTBLScheduleCollaborators, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleCollaboratorR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScheduleTBLScheduleComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_comments`),
+		qm.WhereIn(`tbl_schedule_comments.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_comments")
+	}
+
+	var resultSlice []*TBLScheduleComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_comments")
+	}
+
+	if len(tblScheduleCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleCommentR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleComments = append(local.R.ScheduleTBLScheduleComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleCommentR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScheduleTBLScheduleHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_histories`),
+		qm.WhereIn(`tbl_schedule_histories.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_histories")
+	}
+
+	var resultSlice []*TBLScheduleHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_histories")
+	}
+
+	if len(tblScheduleHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleHistoryR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleHistories = append(local.R.ScheduleTBLScheduleHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleHistoryR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScheduleTBLScheduleItemGroups allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleItemGroups(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_item_groups`),
+		qm.WhereIn(`tbl_schedule_item_groups.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_item_groups")
+	}
+
+	var resultSlice []*TBLScheduleItemGroup
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_item_groups")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_item_groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_item_groups")
+	}
+
+	if len(tblScheduleItemGroupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleItemGroups = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleItemGroupR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleItemGroups = append(local.R.ScheduleTBLScheduleItemGroups, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleItemGroupR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScheduleTBLScheduleItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_items`),
+		qm.WhereIn(`tbl_schedule_items.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_items")
+	}
+
+	var resultSlice []*TBLScheduleItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_items")
+	}
+
+	if len(tblScheduleItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleItemR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleItems = append(local.R.ScheduleTBLScheduleItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleItemR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadScheduleTBLScheduleRoomItems allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblScheduleL) LoadScheduleTBLScheduleRoomItems(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLSchedule interface{}, mods queries.Applicator) error {
+	var slice []*TBLSchedule
+	var object *TBLSchedule
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLSchedule.(*TBLSchedule)
+		if !ok {
+			object = new(TBLSchedule)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLSchedule))
+			}
+		}
+	} else {
+		s, ok := maybeTBLSchedule.(*[]*TBLSchedule)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLSchedule)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLSchedule))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblScheduleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblScheduleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_room_items`),
+		qm.WhereIn(`tbl_schedule_room_items.schedule_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_room_items")
+	}
+
+	var resultSlice []*TBLScheduleRoomItem
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_room_items")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_room_items")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_room_items")
+	}
+
+	if len(tblScheduleRoomItemAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ScheduleTBLScheduleRoomItems = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleRoomItemR{}
+			}
+			foreign.R.Schedule = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ScheduleID {
+				local.R.ScheduleTBLScheduleRoomItems = append(local.R.ScheduleTBLScheduleRoomItems, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleRoomItemR{}
+				}
+				foreign.R.Schedule = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the tblSchedule to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusTBLSchedules.
+func (o *TBLSchedule) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_schedules` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusTBLSchedules: TBLScheduleSlice{o},
+		}
+	} else {
+		related.R.CampusTBLSchedules = append(related.R.CampusTBLSchedules, o)
+	}
+
+	return nil
+}
+
+// SetCreateUserTBLUser of the tblSchedule to the related item.
+// Sets o.R.CreateUserTBLUser to related.
+// Adds o to related.R.CreateUserTBLSchedules.
+func (o *TBLSchedule) SetCreateUserTBLUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
 	}
 
 	updateQuery := fmt.Sprintf(
@@ -1325,6 +2238,321 @@ func (o *TBLSchedule) SetLastUpdateUserTBLUser(ctx context.Context, exec boil.Co
 	return nil
 }
 
+// SetScheduleTBLScheduleDayBundleMember of the tblSchedule to the related item.
+// Sets o.R.ScheduleTBLScheduleDayBundleMember to related.
+// Adds o to related.R.Schedule.
+func (o *TBLSchedule) SetScheduleTBLScheduleDayBundleMember(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLScheduleDayBundleMember) error {
+	var err error
+
+	if insert {
+		related.ScheduleID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `tbl_schedule_day_bundle_members` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+			strmangle.WhereClause("`", "`", 0, tblScheduleDayBundleMemberPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ScheduleID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleDayBundleMember: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleDayBundleMember = related
+	}
+
+	if related.R == nil {
+		related.R = &tblScheduleDayBundleMemberR{
+			Schedule: o,
+		}
+	} else {
+		related.R.Schedule = o
+	}
+	return nil
+}
+
+// AddScheduleTBLScheduleChangeRequests adds the given related objects to the existing relationships
+// of the tbl_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleTBLScheduleChangeRequests.
+// Sets related.R.Schedule appropriately.
+func (o *TBLSchedule) AddScheduleTBLScheduleChangeRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleChangeRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleChangeRequests: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleChangeRequests = append(o.R.ScheduleTBLScheduleChangeRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleChangeRequestR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
+// AddScheduleTBLScheduleCollaborators adds the given related objects to the existing relationships
+// of the tbl_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleTBLScheduleCollaborators.
+// Sets related.R.Schedule appropriately.
+func (o *TBLSchedule) AddScheduleTBLScheduleCollaborators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleCollaborator) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleCollaborators: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleCollaborators = append(o.R.ScheduleTBLScheduleCollaborators, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleCollaboratorR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
+// AddScheduleTBLScheduleComments adds the given related objects to the existing relationships
+// of the tbl_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleTBLScheduleComments.
+// Sets related.R.Schedule appropriately.
+func (o *TBLSchedule) AddScheduleTBLScheduleComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleComments: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleComments = append(o.R.ScheduleTBLScheduleComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleCommentR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
+// AddScheduleTBLScheduleHistories adds the given related objects to the existing relationships
+// of the tbl_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleTBLScheduleHistories.
+// Sets related.R.Schedule appropriately.
+func (o *TBLSchedule) AddScheduleTBLScheduleHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_histories` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleHistories: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleHistories = append(o.R.ScheduleTBLScheduleHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleHistoryR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
+// AddScheduleTBLScheduleItemGroups adds the given related objects to the existing relationships
+// of the tbl_schedule, optionally inserting them as new records.
+// Appends related to o.R.ScheduleTBLScheduleItemGroups.
+// Sets related.R.Schedule appropriately.
+func (o *TBLSchedule) AddScheduleTBLScheduleItemGroups(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleItemGroup) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ScheduleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_item_groups` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"schedule_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleItemGroupPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ScheduleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblScheduleR{
+			ScheduleTBLScheduleItemGroups: related,
+		}
+	} else {
+		o.R.ScheduleTBLScheduleItemGroups = append(o.R.ScheduleTBLScheduleItemGroups, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleItemGroupR{
+				Schedule: o,
+			}
+		} else {
+			rel.R.Schedule = o
+		}
+	}
+	return nil
+}
+
 // AddScheduleTBLScheduleItems adds the given related objects to the existing relationships
 // of the tbl_schedule, optionally inserting them as new records.
 // Appends related to o.R.ScheduleTBLScheduleItems.
@@ -0,0 +1,1235 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLUserAPIToken is an object representing the database table.
+type TBLUserAPIToken struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	TokenName   string    `boil:"token_name" json:"token_name" toml:"token_name" yaml:"token_name"`
+	TokenPrefix string    `boil:"token_prefix" json:"token_prefix" toml:"token_prefix" yaml:"token_prefix"`
+	TokenHash   string    `boil:"token_hash" json:"token_hash" toml:"token_hash" yaml:"token_hash"`
+	Scope       string    `boil:"scope" json:"scope" toml:"scope" yaml:"scope"`
+	ExpiresAt   time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	LastUsedAt  null.Time `boil:"last_used_at" json:"last_used_at,omitempty" toml:"last_used_at" yaml:"last_used_at,omitempty"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblUserAPITokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblUserAPITokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLUserAPITokenColumns = struct {
+	ID          string
+	UserID      string
+	TokenName   string
+	TokenPrefix string
+	TokenHash   string
+	Scope       string
+	ExpiresAt   string
+	LastUsedAt  string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	TokenName:   "token_name",
+	TokenPrefix: "token_prefix",
+	TokenHash:   "token_hash",
+	Scope:       "scope",
+	ExpiresAt:   "expires_at",
+	LastUsedAt:  "last_used_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var TBLUserAPITokenTableColumns = struct {
+	ID          string
+	UserID      string
+	TokenName   string
+	TokenPrefix string
+	TokenHash   string
+	Scope       string
+	ExpiresAt   string
+	LastUsedAt  string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "tbl_user_api_tokens.id",
+	UserID:      "tbl_user_api_tokens.user_id",
+	TokenName:   "tbl_user_api_tokens.token_name",
+	TokenPrefix: "tbl_user_api_tokens.token_prefix",
+	TokenHash:   "tbl_user_api_tokens.token_hash",
+	Scope:       "tbl_user_api_tokens.scope",
+	ExpiresAt:   "tbl_user_api_tokens.expires_at",
+	LastUsedAt:  "tbl_user_api_tokens.last_used_at",
+	CreatedAt:   "tbl_user_api_tokens.created_at",
+	UpdatedAt:   "tbl_user_api_tokens.updated_at",
+}
+
+// Generated where
+
+var TBLUserAPITokenWhere = struct {
+	ID          whereHelperint
+	UserID      whereHelperint
+	TokenName   whereHelperstring
+	TokenPrefix whereHelperstring
+	TokenHash   whereHelperstring
+	Scope       whereHelperstring
+	ExpiresAt   whereHelpertime_Time
+	LastUsedAt  whereHelpernull_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "`tbl_user_api_tokens`.`id`"},
+	UserID:      whereHelperint{field: "`tbl_user_api_tokens`.`user_id`"},
+	TokenName:   whereHelperstring{field: "`tbl_user_api_tokens`.`token_name`"},
+	TokenPrefix: whereHelperstring{field: "`tbl_user_api_tokens`.`token_prefix`"},
+	TokenHash:   whereHelperstring{field: "`tbl_user_api_tokens`.`token_hash`"},
+	Scope:       whereHelperstring{field: "`tbl_user_api_tokens`.`scope`"},
+	ExpiresAt:   whereHelpertime_Time{field: "`tbl_user_api_tokens`.`expires_at`"},
+	LastUsedAt:  whereHelpernull_Time{field: "`tbl_user_api_tokens`.`last_used_at`"},
+	CreatedAt:   whereHelpertime_Time{field: "`tbl_user_api_tokens`.`created_at`"},
+	UpdatedAt:   whereHelpertime_Time{field: "`tbl_user_api_tokens`.`updated_at`"},
+}
+
+// TBLUserAPITokenRels is where relationship names are stored.
+var TBLUserAPITokenRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tblUserAPITokenR is where relationships are stored.
+type tblUserAPITokenR struct {
+	User *TBLUser `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblUserAPITokenR) NewStruct() *tblUserAPITokenR {
+	return &tblUserAPITokenR{}
+}
+
+func (o *TBLUserAPIToken) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblUserAPITokenR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblUserAPITokenL is where Load methods for each relationship are stored.
+type tblUserAPITokenL struct{}
+
+var (
+	tblUserAPITokenAllColumns            = []string{"id", "user_id", "token_name", "token_prefix", "token_hash", "scope", "expires_at", "last_used_at", "created_at", "updated_at"}
+	tblUserAPITokenColumnsWithoutDefault = []string{"user_id", "token_name", "token_prefix", "token_hash", "scope", "expires_at", "last_used_at"}
+	tblUserAPITokenColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblUserAPITokenPrimaryKeyColumns     = []string{"id"}
+	tblUserAPITokenGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLUserAPITokenSlice is an alias for a slice of pointers to TBLUserAPIToken.
+	// This should almost always be used instead of []TBLUserAPIToken.
+	TBLUserAPITokenSlice []*TBLUserAPIToken
+	// TBLUserAPITokenHook is the signature for custom TBLUserAPIToken hook methods
+	TBLUserAPITokenHook func(context.Context, boil.ContextExecutor, *TBLUserAPIToken) error
+
+	tblUserAPITokenQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblUserAPITokenType                 = reflect.TypeOf(&TBLUserAPIToken{})
+	tblUserAPITokenMapping              = queries.MakeStructMapping(tblUserAPITokenType)
+	tblUserAPITokenPrimaryKeyMapping, _ = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, tblUserAPITokenPrimaryKeyColumns)
+	tblUserAPITokenInsertCacheMut       sync.RWMutex
+	tblUserAPITokenInsertCache          = make(map[string]insertCache)
+	tblUserAPITokenUpdateCacheMut       sync.RWMutex
+	tblUserAPITokenUpdateCache          = make(map[string]updateCache)
+	tblUserAPITokenUpsertCacheMut       sync.RWMutex
+	tblUserAPITokenUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblUserAPITokenAfterSelectMu sync.Mutex
+var tblUserAPITokenAfterSelectHooks []TBLUserAPITokenHook
+
+var tblUserAPITokenBeforeInsertMu sync.Mutex
+var tblUserAPITokenBeforeInsertHooks []TBLUserAPITokenHook
+var tblUserAPITokenAfterInsertMu sync.Mutex
+var tblUserAPITokenAfterInsertHooks []TBLUserAPITokenHook
+
+var tblUserAPITokenBeforeUpdateMu sync.Mutex
+var tblUserAPITokenBeforeUpdateHooks []TBLUserAPITokenHook
+var tblUserAPITokenAfterUpdateMu sync.Mutex
+var tblUserAPITokenAfterUpdateHooks []TBLUserAPITokenHook
+
+var tblUserAPITokenBeforeDeleteMu sync.Mutex
+var tblUserAPITokenBeforeDeleteHooks []TBLUserAPITokenHook
+var tblUserAPITokenAfterDeleteMu sync.Mutex
+var tblUserAPITokenAfterDeleteHooks []TBLUserAPITokenHook
+
+var tblUserAPITokenBeforeUpsertMu sync.Mutex
+var tblUserAPITokenBeforeUpsertHooks []TBLUserAPITokenHook
+var tblUserAPITokenAfterUpsertMu sync.Mutex
+var tblUserAPITokenAfterUpsertHooks []TBLUserAPITokenHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLUserAPIToken) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLUserAPIToken) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLUserAPIToken) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLUserAPIToken) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLUserAPIToken) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLUserAPIToken) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLUserAPIToken) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLUserAPIToken) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLUserAPIToken) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserAPITokenAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLUserAPITokenHook registers your hook function for all future operations.
+func AddTBLUserAPITokenHook(hookPoint boil.HookPoint, tblUserAPITokenHook TBLUserAPITokenHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblUserAPITokenAfterSelectMu.Lock()
+		tblUserAPITokenAfterSelectHooks = append(tblUserAPITokenAfterSelectHooks, tblUserAPITokenHook)
+		tblUserAPITokenAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblUserAPITokenBeforeInsertMu.Lock()
+		tblUserAPITokenBeforeInsertHooks = append(tblUserAPITokenBeforeInsertHooks, tblUserAPITokenHook)
+		tblUserAPITokenBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblUserAPITokenAfterInsertMu.Lock()
+		tblUserAPITokenAfterInsertHooks = append(tblUserAPITokenAfterInsertHooks, tblUserAPITokenHook)
+		tblUserAPITokenAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblUserAPITokenBeforeUpdateMu.Lock()
+		tblUserAPITokenBeforeUpdateHooks = append(tblUserAPITokenBeforeUpdateHooks, tblUserAPITokenHook)
+		tblUserAPITokenBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblUserAPITokenAfterUpdateMu.Lock()
+		tblUserAPITokenAfterUpdateHooks = append(tblUserAPITokenAfterUpdateHooks, tblUserAPITokenHook)
+		tblUserAPITokenAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblUserAPITokenBeforeDeleteMu.Lock()
+		tblUserAPITokenBeforeDeleteHooks = append(tblUserAPITokenBeforeDeleteHooks, tblUserAPITokenHook)
+		tblUserAPITokenBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblUserAPITokenAfterDeleteMu.Lock()
+		tblUserAPITokenAfterDeleteHooks = append(tblUserAPITokenAfterDeleteHooks, tblUserAPITokenHook)
+		tblUserAPITokenAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblUserAPITokenBeforeUpsertMu.Lock()
+		tblUserAPITokenBeforeUpsertHooks = append(tblUserAPITokenBeforeUpsertHooks, tblUserAPITokenHook)
+		tblUserAPITokenBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblUserAPITokenAfterUpsertMu.Lock()
+		tblUserAPITokenAfterUpsertHooks = append(tblUserAPITokenAfterUpsertHooks, tblUserAPITokenHook)
+		tblUserAPITokenAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblUserAPIToken record from the query.
+func (q tblUserAPITokenQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLUserAPIToken, error) {
+	o := &TBLUserAPIToken{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_user_api_tokens")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLUserAPIToken records from the query.
+func (q tblUserAPITokenQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLUserAPITokenSlice, error) {
+	var o []*TBLUserAPIToken
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLUserAPIToken slice")
+	}
+
+	if len(tblUserAPITokenAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLUserAPIToken records in the query.
+func (q tblUserAPITokenQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_user_api_tokens rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblUserAPITokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_user_api_tokens exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLUserAPIToken) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserAPITokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserAPIToken interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserAPIToken
+	var object *TBLUserAPIToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserAPIToken.(*TBLUserAPIToken)
+		if !ok {
+			object = new(TBLUserAPIToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserAPIToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserAPIToken))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserAPIToken.(*[]*TBLUserAPIToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserAPIToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserAPIToken))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserAPITokenR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserAPITokenR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLUserAPITokens = append(foreign.R.UserTBLUserAPITokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLUserAPITokens = append(foreign.R.UserTBLUserAPITokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblUserAPIToken to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLUserAPITokens.
+func (o *TBLUserAPIToken) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_api_tokens` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserAPITokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserAPITokenR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLUserAPITokens: TBLUserAPITokenSlice{o},
+		}
+	} else {
+		related.R.UserTBLUserAPITokens = append(related.R.UserTBLUserAPITokens, o)
+	}
+
+	return nil
+}
+
+// TBLUserAPITokens retrieves all the records using an executor.
+func TBLUserAPITokens(mods ...qm.QueryMod) tblUserAPITokenQuery {
+	mods = append(mods, qm.From("`tbl_user_api_tokens`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_user_api_tokens`.*"})
+	}
+
+	return tblUserAPITokenQuery{q}
+}
+
+// FindTBLUserAPIToken retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLUserAPIToken(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLUserAPIToken, error) {
+	tblUserAPITokenObj := &TBLUserAPIToken{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_user_api_tokens` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblUserAPITokenObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_user_api_tokens")
+	}
+
+	if err = tblUserAPITokenObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblUserAPITokenObj, err
+	}
+
+	return tblUserAPITokenObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLUserAPIToken) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_api_tokens provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserAPITokenColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblUserAPITokenInsertCacheMut.RLock()
+	cache, cached := tblUserAPITokenInsertCache[key]
+	tblUserAPITokenInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblUserAPITokenAllColumns,
+			tblUserAPITokenColumnsWithDefault,
+			tblUserAPITokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_user_api_tokens` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_user_api_tokens` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_user_api_tokens` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblUserAPITokenPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_user_api_tokens")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserAPITokenMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_api_tokens")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserAPITokenInsertCacheMut.Lock()
+		tblUserAPITokenInsertCache[key] = cache
+		tblUserAPITokenInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLUserAPIToken.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLUserAPIToken) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblUserAPITokenUpdateCacheMut.RLock()
+	cache, cached := tblUserAPITokenUpdateCache[key]
+	tblUserAPITokenUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblUserAPITokenAllColumns,
+			tblUserAPITokenPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_user_api_tokens, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_user_api_tokens` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblUserAPITokenPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, append(wl, tblUserAPITokenPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_user_api_tokens row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_user_api_tokens")
+	}
+
+	if !cached {
+		tblUserAPITokenUpdateCacheMut.Lock()
+		tblUserAPITokenUpdateCache[key] = cache
+		tblUserAPITokenUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblUserAPITokenQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_user_api_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_user_api_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLUserAPITokenSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserAPITokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_user_api_tokens` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserAPITokenPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblUserAPIToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblUserAPIToken")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLUserAPITokenUniqueColumns = []string{
+	"id",
+	"token_hash",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLUserAPIToken) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_api_tokens provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserAPITokenColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLUserAPITokenUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblUserAPITokenUpsertCacheMut.RLock()
+	cache, cached := tblUserAPITokenUpsertCache[key]
+	tblUserAPITokenUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblUserAPITokenAllColumns,
+			tblUserAPITokenColumnsWithDefault,
+			tblUserAPITokenColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblUserAPITokenAllColumns,
+			tblUserAPITokenPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_user_api_tokens, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblUserAPITokenAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_user_api_tokens`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_user_api_tokens` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_user_api_tokens")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserAPITokenMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblUserAPITokenType, tblUserAPITokenMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_user_api_tokens")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_api_tokens")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserAPITokenUpsertCacheMut.Lock()
+		tblUserAPITokenUpsertCache[key] = cache
+		tblUserAPITokenUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLUserAPIToken record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLUserAPIToken) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLUserAPIToken provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblUserAPITokenPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_user_api_tokens` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_user_api_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_user_api_tokens")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblUserAPITokenQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblUserAPITokenQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_user_api_tokens")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_api_tokens")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLUserAPITokenSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblUserAPITokenBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserAPITokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_user_api_tokens` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserAPITokenPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblUserAPIToken slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_api_tokens")
+	}
+
+	if len(tblUserAPITokenAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLUserAPIToken) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLUserAPIToken(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLUserAPITokenSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLUserAPITokenSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserAPITokenPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_user_api_tokens`.* FROM `tbl_user_api_tokens` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserAPITokenPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLUserAPITokenSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLUserAPITokenExists checks if the TBLUserAPIToken row exists.
+func TBLUserAPITokenExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_user_api_tokens` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_user_api_tokens exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLUserAPIToken row exists.
+func (o *TBLUserAPIToken) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLUserAPITokenExists(ctx, exec, o.ID)
+}
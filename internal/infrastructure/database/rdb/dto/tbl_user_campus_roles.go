@@ -0,0 +1,1599 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLUserCampusRole is an object representing the database table.
+type TBLUserCampusRole struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Campus    string    `boil:"campus" json:"campus" toml:"campus" yaml:"campus"`
+	RoleKey   string    `boil:"role_key" json:"role_key" toml:"role_key" yaml:"role_key"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblUserCampusRoleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblUserCampusRoleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLUserCampusRoleColumns = struct {
+	ID        string
+	UserID    string
+	Campus    string
+	RoleKey   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	Campus:    "campus",
+	RoleKey:   "role_key",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TBLUserCampusRoleTableColumns = struct {
+	ID        string
+	UserID    string
+	Campus    string
+	RoleKey   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "tbl_user_campus_roles.id",
+	UserID:    "tbl_user_campus_roles.user_id",
+	Campus:    "tbl_user_campus_roles.campus",
+	RoleKey:   "tbl_user_campus_roles.role_key",
+	CreatedAt: "tbl_user_campus_roles.created_at",
+	UpdatedAt: "tbl_user_campus_roles.updated_at",
+}
+
+// Generated where
+
+var TBLUserCampusRoleWhere = struct {
+	ID        whereHelperint
+	UserID    whereHelperint
+	Campus    whereHelperstring
+	RoleKey   whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`tbl_user_campus_roles`.`id`"},
+	UserID:    whereHelperint{field: "`tbl_user_campus_roles`.`user_id`"},
+	Campus:    whereHelperstring{field: "`tbl_user_campus_roles`.`campus`"},
+	RoleKey:   whereHelperstring{field: "`tbl_user_campus_roles`.`role_key`"},
+	CreatedAt: whereHelpertime_Time{field: "`tbl_user_campus_roles`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`tbl_user_campus_roles`.`updated_at`"},
+}
+
+// TBLUserCampusRoleRels is where relationship names are stored.
+var TBLUserCampusRoleRels = struct {
+	User              string
+	CampusDataCampuse string
+	RoleKeyDataRole   string
+}{
+	User:              "User",
+	CampusDataCampuse: "CampusDataCampuse",
+	RoleKeyDataRole:   "RoleKeyDataRole",
+}
+
+// tblUserCampusRoleR is where relationships are stored.
+type tblUserCampusRoleR struct {
+	User              *TBLUser     `boil:"User" json:"User" toml:"User" yaml:"User"`
+	CampusDataCampuse *DataCampuse `boil:"CampusDataCampuse" json:"CampusDataCampuse" toml:"CampusDataCampuse" yaml:"CampusDataCampuse"`
+	RoleKeyDataRole   *DataRole    `boil:"RoleKeyDataRole" json:"RoleKeyDataRole" toml:"RoleKeyDataRole" yaml:"RoleKeyDataRole"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblUserCampusRoleR) NewStruct() *tblUserCampusRoleR {
+	return &tblUserCampusRoleR{}
+}
+
+func (o *TBLUserCampusRole) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblUserCampusRoleR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+func (o *TBLUserCampusRole) GetCampusDataCampuse() *DataCampuse {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCampusDataCampuse()
+}
+
+func (r *tblUserCampusRoleR) GetCampusDataCampuse() *DataCampuse {
+	if r == nil {
+		return nil
+	}
+
+	return r.CampusDataCampuse
+}
+
+func (o *TBLUserCampusRole) GetRoleKeyDataRole() *DataRole {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRoleKeyDataRole()
+}
+
+func (r *tblUserCampusRoleR) GetRoleKeyDataRole() *DataRole {
+	if r == nil {
+		return nil
+	}
+
+	return r.RoleKeyDataRole
+}
+
+// tblUserCampusRoleL is where Load methods for each relationship are stored.
+type tblUserCampusRoleL struct{}
+
+var (
+	tblUserCampusRoleAllColumns            = []string{"id", "user_id", "campus", "role_key", "created_at", "updated_at"}
+	tblUserCampusRoleColumnsWithoutDefault = []string{"user_id", "campus", "role_key"}
+	tblUserCampusRoleColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblUserCampusRolePrimaryKeyColumns     = []string{"id"}
+	tblUserCampusRoleGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLUserCampusRoleSlice is an alias for a slice of pointers to TBLUserCampusRole.
+	// This should almost always be used instead of []TBLUserCampusRole.
+	TBLUserCampusRoleSlice []*TBLUserCampusRole
+	// TBLUserCampusRoleHook is the signature for custom TBLUserCampusRole hook methods
+	TBLUserCampusRoleHook func(context.Context, boil.ContextExecutor, *TBLUserCampusRole) error
+
+	tblUserCampusRoleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblUserCampusRoleType                 = reflect.TypeOf(&TBLUserCampusRole{})
+	tblUserCampusRoleMapping              = queries.MakeStructMapping(tblUserCampusRoleType)
+	tblUserCampusRolePrimaryKeyMapping, _ = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, tblUserCampusRolePrimaryKeyColumns)
+	tblUserCampusRoleInsertCacheMut       sync.RWMutex
+	tblUserCampusRoleInsertCache          = make(map[string]insertCache)
+	tblUserCampusRoleUpdateCacheMut       sync.RWMutex
+	tblUserCampusRoleUpdateCache          = make(map[string]updateCache)
+	tblUserCampusRoleUpsertCacheMut       sync.RWMutex
+	tblUserCampusRoleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblUserCampusRoleAfterSelectMu sync.Mutex
+var tblUserCampusRoleAfterSelectHooks []TBLUserCampusRoleHook
+
+var tblUserCampusRoleBeforeInsertMu sync.Mutex
+var tblUserCampusRoleBeforeInsertHooks []TBLUserCampusRoleHook
+var tblUserCampusRoleAfterInsertMu sync.Mutex
+var tblUserCampusRoleAfterInsertHooks []TBLUserCampusRoleHook
+
+var tblUserCampusRoleBeforeUpdateMu sync.Mutex
+var tblUserCampusRoleBeforeUpdateHooks []TBLUserCampusRoleHook
+var tblUserCampusRoleAfterUpdateMu sync.Mutex
+var tblUserCampusRoleAfterUpdateHooks []TBLUserCampusRoleHook
+
+var tblUserCampusRoleBeforeDeleteMu sync.Mutex
+var tblUserCampusRoleBeforeDeleteHooks []TBLUserCampusRoleHook
+var tblUserCampusRoleAfterDeleteMu sync.Mutex
+var tblUserCampusRoleAfterDeleteHooks []TBLUserCampusRoleHook
+
+var tblUserCampusRoleBeforeUpsertMu sync.Mutex
+var tblUserCampusRoleBeforeUpsertHooks []TBLUserCampusRoleHook
+var tblUserCampusRoleAfterUpsertMu sync.Mutex
+var tblUserCampusRoleAfterUpsertHooks []TBLUserCampusRoleHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLUserCampusRole) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLUserCampusRole) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLUserCampusRole) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLUserCampusRole) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLUserCampusRole) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLUserCampusRole) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLUserCampusRole) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLUserCampusRole) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLUserCampusRole) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserCampusRoleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLUserCampusRoleHook registers your hook function for all future operations.
+func AddTBLUserCampusRoleHook(hookPoint boil.HookPoint, tblUserCampusRoleHook TBLUserCampusRoleHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblUserCampusRoleAfterSelectMu.Lock()
+		tblUserCampusRoleAfterSelectHooks = append(tblUserCampusRoleAfterSelectHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblUserCampusRoleBeforeInsertMu.Lock()
+		tblUserCampusRoleBeforeInsertHooks = append(tblUserCampusRoleBeforeInsertHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblUserCampusRoleAfterInsertMu.Lock()
+		tblUserCampusRoleAfterInsertHooks = append(tblUserCampusRoleAfterInsertHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblUserCampusRoleBeforeUpdateMu.Lock()
+		tblUserCampusRoleBeforeUpdateHooks = append(tblUserCampusRoleBeforeUpdateHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblUserCampusRoleAfterUpdateMu.Lock()
+		tblUserCampusRoleAfterUpdateHooks = append(tblUserCampusRoleAfterUpdateHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblUserCampusRoleBeforeDeleteMu.Lock()
+		tblUserCampusRoleBeforeDeleteHooks = append(tblUserCampusRoleBeforeDeleteHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblUserCampusRoleAfterDeleteMu.Lock()
+		tblUserCampusRoleAfterDeleteHooks = append(tblUserCampusRoleAfterDeleteHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblUserCampusRoleBeforeUpsertMu.Lock()
+		tblUserCampusRoleBeforeUpsertHooks = append(tblUserCampusRoleBeforeUpsertHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblUserCampusRoleAfterUpsertMu.Lock()
+		tblUserCampusRoleAfterUpsertHooks = append(tblUserCampusRoleAfterUpsertHooks, tblUserCampusRoleHook)
+		tblUserCampusRoleAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblUserCampusRole record from the query.
+func (q tblUserCampusRoleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLUserCampusRole, error) {
+	o := &TBLUserCampusRole{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_user_campus_roles")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLUserCampusRole records from the query.
+func (q tblUserCampusRoleQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLUserCampusRoleSlice, error) {
+	var o []*TBLUserCampusRole
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLUserCampusRole slice")
+	}
+
+	if len(tblUserCampusRoleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLUserCampusRole records in the query.
+func (q tblUserCampusRoleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_user_campus_roles rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblUserCampusRoleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_user_campus_roles exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLUserCampusRole) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// CampusDataCampuse pointed to by the foreign key.
+func (o *TBLUserCampusRole) CampusDataCampuse(mods ...qm.QueryMod) dataCampuseQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`campus` = ?", o.Campus),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataCampuses(queryMods...)
+}
+
+// RoleKeyDataRole pointed to by the foreign key.
+func (o *TBLUserCampusRole) RoleKeyDataRole(mods ...qm.QueryMod) dataRoleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`role_key` = ?", o.RoleKey),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return DataRoles(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserCampusRoleL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserCampusRole interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserCampusRole
+	var object *TBLUserCampusRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserCampusRole.(*TBLUserCampusRole)
+		if !ok {
+			object = new(TBLUserCampusRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserCampusRole))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserCampusRole.(*[]*TBLUserCampusRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserCampusRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserCampusRoleR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserCampusRoleR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLUserCampusRoles = append(foreign.R.UserTBLUserCampusRoles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLUserCampusRoles = append(foreign.R.UserTBLUserCampusRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCampusDataCampuse allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserCampusRoleL) LoadCampusDataCampuse(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserCampusRole interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserCampusRole
+	var object *TBLUserCampusRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserCampusRole.(*TBLUserCampusRole)
+		if !ok {
+			object = new(TBLUserCampusRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserCampusRole))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserCampusRole.(*[]*TBLUserCampusRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserCampusRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserCampusRoleR{}
+		}
+		args[object.Campus] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserCampusRoleR{}
+			}
+
+			args[obj.Campus] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_campuses`),
+		qm.WhereIn(`data_campuses.campus in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataCampuse")
+	}
+
+	var resultSlice []*DataCampuse
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataCampuse")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_campuses")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_campuses")
+	}
+
+	if len(dataCampuseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CampusDataCampuse = foreign
+		if foreign.R == nil {
+			foreign.R = &dataCampuseR{}
+		}
+		foreign.R.CampusTBLUserCampusRoles = append(foreign.R.CampusTBLUserCampusRoles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Campus == foreign.Campus {
+				local.R.CampusDataCampuse = foreign
+				if foreign.R == nil {
+					foreign.R = &dataCampuseR{}
+				}
+				foreign.R.CampusTBLUserCampusRoles = append(foreign.R.CampusTBLUserCampusRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoleKeyDataRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserCampusRoleL) LoadRoleKeyDataRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserCampusRole interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserCampusRole
+	var object *TBLUserCampusRole
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserCampusRole.(*TBLUserCampusRole)
+		if !ok {
+			object = new(TBLUserCampusRole)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserCampusRole))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserCampusRole.(*[]*TBLUserCampusRole)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserCampusRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserCampusRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserCampusRoleR{}
+		}
+		args[object.RoleKey] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserCampusRoleR{}
+			}
+
+			args[obj.RoleKey] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`data_roles`),
+		qm.WhereIn(`data_roles.role_key in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataRole")
+	}
+
+	var resultSlice []*DataRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataRole")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_roles")
+	}
+
+	if len(dataRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RoleKeyDataRole = foreign
+		if foreign.R == nil {
+			foreign.R = &dataRoleR{}
+		}
+		foreign.R.RoleKeyTBLUserCampusRoles = append(foreign.R.RoleKeyTBLUserCampusRoles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoleKey == foreign.RoleKey {
+				local.R.RoleKeyDataRole = foreign
+				if foreign.R == nil {
+					foreign.R = &dataRoleR{}
+				}
+				foreign.R.RoleKeyTBLUserCampusRoles = append(foreign.R.RoleKeyTBLUserCampusRoles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblUserCampusRole to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLUserCampusRoles.
+func (o *TBLUserCampusRole) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserCampusRoleR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLUserCampusRoles: TBLUserCampusRoleSlice{o},
+		}
+	} else {
+		related.R.UserTBLUserCampusRoles = append(related.R.UserTBLUserCampusRoles, o)
+	}
+
+	return nil
+}
+
+// SetCampusDataCampuse of the tblUserCampusRole to the related item.
+// Sets o.R.CampusDataCampuse to related.
+// Adds o to related.R.CampusTBLUserCampusRoles.
+func (o *TBLUserCampusRole) SetCampusDataCampuse(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataCampuse) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"campus"}),
+		strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.Campus, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Campus = related.Campus
+	if o.R == nil {
+		o.R = &tblUserCampusRoleR{
+			CampusDataCampuse: related,
+		}
+	} else {
+		o.R.CampusDataCampuse = related
+	}
+
+	if related.R == nil {
+		related.R = &dataCampuseR{
+			CampusTBLUserCampusRoles: TBLUserCampusRoleSlice{o},
+		}
+	} else {
+		related.R.CampusTBLUserCampusRoles = append(related.R.CampusTBLUserCampusRoles, o)
+	}
+
+	return nil
+}
+
+// SetRoleKeyDataRole of the tblUserCampusRole to the related item.
+// Sets o.R.RoleKeyDataRole to related.
+// Adds o to related.R.RoleKeyTBLUserCampusRoles.
+func (o *TBLUserCampusRole) SetRoleKeyDataRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataRole) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
+		strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+	)
+	values := []interface{}{related.RoleKey, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleKey = related.RoleKey
+	if o.R == nil {
+		o.R = &tblUserCampusRoleR{
+			RoleKeyDataRole: related,
+		}
+	} else {
+		o.R.RoleKeyDataRole = related
+	}
+
+	if related.R == nil {
+		related.R = &dataRoleR{
+			RoleKeyTBLUserCampusRoles: TBLUserCampusRoleSlice{o},
+		}
+	} else {
+		related.R.RoleKeyTBLUserCampusRoles = append(related.R.RoleKeyTBLUserCampusRoles, o)
+	}
+
+	return nil
+}
+
+// TBLUserCampusRoles retrieves all the records using an executor.
+func TBLUserCampusRoles(mods ...qm.QueryMod) tblUserCampusRoleQuery {
+	mods = append(mods, qm.From("`tbl_user_campus_roles`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_user_campus_roles`.*"})
+	}
+
+	return tblUserCampusRoleQuery{q}
+}
+
+// FindTBLUserCampusRole retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLUserCampusRole(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLUserCampusRole, error) {
+	tblUserCampusRoleObj := &TBLUserCampusRole{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_user_campus_roles` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblUserCampusRoleObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_user_campus_roles")
+	}
+
+	if err = tblUserCampusRoleObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblUserCampusRoleObj, err
+	}
+
+	return tblUserCampusRoleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLUserCampusRole) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_campus_roles provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserCampusRoleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblUserCampusRoleInsertCacheMut.RLock()
+	cache, cached := tblUserCampusRoleInsertCache[key]
+	tblUserCampusRoleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblUserCampusRoleAllColumns,
+			tblUserCampusRoleColumnsWithDefault,
+			tblUserCampusRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_user_campus_roles` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_user_campus_roles` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_user_campus_roles` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_user_campus_roles")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserCampusRoleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_campus_roles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserCampusRoleInsertCacheMut.Lock()
+		tblUserCampusRoleInsertCache[key] = cache
+		tblUserCampusRoleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLUserCampusRole.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLUserCampusRole) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblUserCampusRoleUpdateCacheMut.RLock()
+	cache, cached := tblUserCampusRoleUpdateCache[key]
+	tblUserCampusRoleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblUserCampusRoleAllColumns,
+			tblUserCampusRolePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_user_campus_roles, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, append(wl, tblUserCampusRolePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_user_campus_roles row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_user_campus_roles")
+	}
+
+	if !cached {
+		tblUserCampusRoleUpdateCacheMut.Lock()
+		tblUserCampusRoleUpdateCache[key] = cache
+		tblUserCampusRoleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblUserCampusRoleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_user_campus_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_user_campus_roles")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLUserCampusRoleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserCampusRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserCampusRolePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblUserCampusRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblUserCampusRole")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLUserCampusRoleUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLUserCampusRole) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_campus_roles provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserCampusRoleColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLUserCampusRoleUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblUserCampusRoleUpsertCacheMut.RLock()
+	cache, cached := tblUserCampusRoleUpsertCache[key]
+	tblUserCampusRoleUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblUserCampusRoleAllColumns,
+			tblUserCampusRoleColumnsWithDefault,
+			tblUserCampusRoleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblUserCampusRoleAllColumns,
+			tblUserCampusRolePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_user_campus_roles, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblUserCampusRoleAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_user_campus_roles`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_user_campus_roles` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_user_campus_roles")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserCampusRoleMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblUserCampusRoleType, tblUserCampusRoleMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_user_campus_roles")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_campus_roles")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserCampusRoleUpsertCacheMut.Lock()
+		tblUserCampusRoleUpsertCache[key] = cache
+		tblUserCampusRoleUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLUserCampusRole record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLUserCampusRole) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLUserCampusRole provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblUserCampusRolePrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_user_campus_roles` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_user_campus_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_user_campus_roles")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblUserCampusRoleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblUserCampusRoleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_user_campus_roles")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_campus_roles")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLUserCampusRoleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblUserCampusRoleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserCampusRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_user_campus_roles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserCampusRolePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblUserCampusRole slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_campus_roles")
+	}
+
+	if len(tblUserCampusRoleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLUserCampusRole) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLUserCampusRole(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLUserCampusRoleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLUserCampusRoleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserCampusRolePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_user_campus_roles`.* FROM `tbl_user_campus_roles` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserCampusRolePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLUserCampusRoleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLUserCampusRoleExists checks if the TBLUserCampusRole row exists.
+func TBLUserCampusRoleExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_user_campus_roles` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_user_campus_roles exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLUserCampusRole row exists.
+func (o *TBLUserCampusRole) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLUserCampusRoleExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1219 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLUserIdentity is an object representing the database table.
+type TBLUserIdentity struct {
+	ID          int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID      int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Issuer      string    `boil:"issuer" json:"issuer" toml:"issuer" yaml:"issuer"`
+	Subject     string    `boil:"subject" json:"subject" toml:"subject" yaml:"subject"`
+	Email       string    `boil:"email" json:"email" toml:"email" yaml:"email"`
+	LastLoginAt time.Time `boil:"last_login_at" json:"last_login_at" toml:"last_login_at" yaml:"last_login_at"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblUserIdentityR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblUserIdentityL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLUserIdentityColumns = struct {
+	ID          string
+	UserID      string
+	Issuer      string
+	Subject     string
+	Email       string
+	LastLoginAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	UserID:      "user_id",
+	Issuer:      "issuer",
+	Subject:     "subject",
+	Email:       "email",
+	LastLoginAt: "last_login_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var TBLUserIdentityTableColumns = struct {
+	ID          string
+	UserID      string
+	Issuer      string
+	Subject     string
+	Email       string
+	LastLoginAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "tbl_user_identities.id",
+	UserID:      "tbl_user_identities.user_id",
+	Issuer:      "tbl_user_identities.issuer",
+	Subject:     "tbl_user_identities.subject",
+	Email:       "tbl_user_identities.email",
+	LastLoginAt: "tbl_user_identities.last_login_at",
+	CreatedAt:   "tbl_user_identities.created_at",
+	UpdatedAt:   "tbl_user_identities.updated_at",
+}
+
+// Generated where
+
+var TBLUserIdentityWhere = struct {
+	ID          whereHelperint
+	UserID      whereHelperint
+	Issuer      whereHelperstring
+	Subject     whereHelperstring
+	Email       whereHelperstring
+	LastLoginAt whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperint{field: "`tbl_user_identities`.`id`"},
+	UserID:      whereHelperint{field: "`tbl_user_identities`.`user_id`"},
+	Issuer:      whereHelperstring{field: "`tbl_user_identities`.`issuer`"},
+	Subject:     whereHelperstring{field: "`tbl_user_identities`.`subject`"},
+	Email:       whereHelperstring{field: "`tbl_user_identities`.`email`"},
+	LastLoginAt: whereHelpertime_Time{field: "`tbl_user_identities`.`last_login_at`"},
+	CreatedAt:   whereHelpertime_Time{field: "`tbl_user_identities`.`created_at`"},
+	UpdatedAt:   whereHelpertime_Time{field: "`tbl_user_identities`.`updated_at`"},
+}
+
+// TBLUserIdentityRels is where relationship names are stored.
+var TBLUserIdentityRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tblUserIdentityR is where relationships are stored.
+type tblUserIdentityR struct {
+	User *TBLUser `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblUserIdentityR) NewStruct() *tblUserIdentityR {
+	return &tblUserIdentityR{}
+}
+
+func (o *TBLUserIdentity) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblUserIdentityR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblUserIdentityL is where Load methods for each relationship are stored.
+type tblUserIdentityL struct{}
+
+var (
+	tblUserIdentityAllColumns            = []string{"id", "user_id", "issuer", "subject", "email", "last_login_at", "created_at", "updated_at"}
+	tblUserIdentityColumnsWithoutDefault = []string{"user_id", "issuer", "subject", "email", "last_login_at"}
+	tblUserIdentityColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	tblUserIdentityPrimaryKeyColumns     = []string{"id"}
+	tblUserIdentityGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLUserIdentitySlice is an alias for a slice of pointers to TBLUserIdentity.
+	// This should almost always be used instead of []TBLUserIdentity.
+	TBLUserIdentitySlice []*TBLUserIdentity
+	// TBLUserIdentityHook is the signature for custom TBLUserIdentity hook methods
+	TBLUserIdentityHook func(context.Context, boil.ContextExecutor, *TBLUserIdentity) error
+
+	tblUserIdentityQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblUserIdentityType                 = reflect.TypeOf(&TBLUserIdentity{})
+	tblUserIdentityMapping              = queries.MakeStructMapping(tblUserIdentityType)
+	tblUserIdentityPrimaryKeyMapping, _ = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, tblUserIdentityPrimaryKeyColumns)
+	tblUserIdentityInsertCacheMut       sync.RWMutex
+	tblUserIdentityInsertCache          = make(map[string]insertCache)
+	tblUserIdentityUpdateCacheMut       sync.RWMutex
+	tblUserIdentityUpdateCache          = make(map[string]updateCache)
+	tblUserIdentityUpsertCacheMut       sync.RWMutex
+	tblUserIdentityUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblUserIdentityAfterSelectMu sync.Mutex
+var tblUserIdentityAfterSelectHooks []TBLUserIdentityHook
+
+var tblUserIdentityBeforeInsertMu sync.Mutex
+var tblUserIdentityBeforeInsertHooks []TBLUserIdentityHook
+var tblUserIdentityAfterInsertMu sync.Mutex
+var tblUserIdentityAfterInsertHooks []TBLUserIdentityHook
+
+var tblUserIdentityBeforeUpdateMu sync.Mutex
+var tblUserIdentityBeforeUpdateHooks []TBLUserIdentityHook
+var tblUserIdentityAfterUpdateMu sync.Mutex
+var tblUserIdentityAfterUpdateHooks []TBLUserIdentityHook
+
+var tblUserIdentityBeforeDeleteMu sync.Mutex
+var tblUserIdentityBeforeDeleteHooks []TBLUserIdentityHook
+var tblUserIdentityAfterDeleteMu sync.Mutex
+var tblUserIdentityAfterDeleteHooks []TBLUserIdentityHook
+
+var tblUserIdentityBeforeUpsertMu sync.Mutex
+var tblUserIdentityBeforeUpsertHooks []TBLUserIdentityHook
+var tblUserIdentityAfterUpsertMu sync.Mutex
+var tblUserIdentityAfterUpsertHooks []TBLUserIdentityHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLUserIdentity) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLUserIdentity) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLUserIdentity) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLUserIdentity) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLUserIdentity) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLUserIdentity) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLUserIdentity) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLUserIdentity) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLUserIdentity) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserIdentityAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLUserIdentityHook registers your hook function for all future operations.
+func AddTBLUserIdentityHook(hookPoint boil.HookPoint, tblUserIdentityHook TBLUserIdentityHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblUserIdentityAfterSelectMu.Lock()
+		tblUserIdentityAfterSelectHooks = append(tblUserIdentityAfterSelectHooks, tblUserIdentityHook)
+		tblUserIdentityAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblUserIdentityBeforeInsertMu.Lock()
+		tblUserIdentityBeforeInsertHooks = append(tblUserIdentityBeforeInsertHooks, tblUserIdentityHook)
+		tblUserIdentityBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblUserIdentityAfterInsertMu.Lock()
+		tblUserIdentityAfterInsertHooks = append(tblUserIdentityAfterInsertHooks, tblUserIdentityHook)
+		tblUserIdentityAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblUserIdentityBeforeUpdateMu.Lock()
+		tblUserIdentityBeforeUpdateHooks = append(tblUserIdentityBeforeUpdateHooks, tblUserIdentityHook)
+		tblUserIdentityBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblUserIdentityAfterUpdateMu.Lock()
+		tblUserIdentityAfterUpdateHooks = append(tblUserIdentityAfterUpdateHooks, tblUserIdentityHook)
+		tblUserIdentityAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblUserIdentityBeforeDeleteMu.Lock()
+		tblUserIdentityBeforeDeleteHooks = append(tblUserIdentityBeforeDeleteHooks, tblUserIdentityHook)
+		tblUserIdentityBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblUserIdentityAfterDeleteMu.Lock()
+		tblUserIdentityAfterDeleteHooks = append(tblUserIdentityAfterDeleteHooks, tblUserIdentityHook)
+		tblUserIdentityAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblUserIdentityBeforeUpsertMu.Lock()
+		tblUserIdentityBeforeUpsertHooks = append(tblUserIdentityBeforeUpsertHooks, tblUserIdentityHook)
+		tblUserIdentityBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblUserIdentityAfterUpsertMu.Lock()
+		tblUserIdentityAfterUpsertHooks = append(tblUserIdentityAfterUpsertHooks, tblUserIdentityHook)
+		tblUserIdentityAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblUserIdentity record from the query.
+func (q tblUserIdentityQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLUserIdentity, error) {
+	o := &TBLUserIdentity{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_user_identities")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLUserIdentity records from the query.
+func (q tblUserIdentityQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLUserIdentitySlice, error) {
+	var o []*TBLUserIdentity
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLUserIdentity slice")
+	}
+
+	if len(tblUserIdentityAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLUserIdentity records in the query.
+func (q tblUserIdentityQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_user_identities rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblUserIdentityQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_user_identities exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLUserIdentity) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserIdentityL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserIdentity interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserIdentity
+	var object *TBLUserIdentity
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserIdentity.(*TBLUserIdentity)
+		if !ok {
+			object = new(TBLUserIdentity)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserIdentity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserIdentity))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserIdentity.(*[]*TBLUserIdentity)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserIdentity)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserIdentity))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserIdentityR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserIdentityR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLUserIdentities = append(foreign.R.UserTBLUserIdentities, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLUserIdentities = append(foreign.R.UserTBLUserIdentities, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblUserIdentity to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLUserIdentities.
+func (o *TBLUserIdentity) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_identities` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserIdentityPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserIdentityR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLUserIdentities: TBLUserIdentitySlice{o},
+		}
+	} else {
+		related.R.UserTBLUserIdentities = append(related.R.UserTBLUserIdentities, o)
+	}
+
+	return nil
+}
+
+// TBLUserIdentities retrieves all the records using an executor.
+func TBLUserIdentities(mods ...qm.QueryMod) tblUserIdentityQuery {
+	mods = append(mods, qm.From("`tbl_user_identities`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_user_identities`.*"})
+	}
+
+	return tblUserIdentityQuery{q}
+}
+
+// FindTBLUserIdentity retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLUserIdentity(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLUserIdentity, error) {
+	tblUserIdentityObj := &TBLUserIdentity{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_user_identities` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblUserIdentityObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_user_identities")
+	}
+
+	if err = tblUserIdentityObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblUserIdentityObj, err
+	}
+
+	return tblUserIdentityObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLUserIdentity) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_identities provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserIdentityColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblUserIdentityInsertCacheMut.RLock()
+	cache, cached := tblUserIdentityInsertCache[key]
+	tblUserIdentityInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblUserIdentityAllColumns,
+			tblUserIdentityColumnsWithDefault,
+			tblUserIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_user_identities` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_user_identities` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_user_identities` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblUserIdentityPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_user_identities")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserIdentityMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_identities")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserIdentityInsertCacheMut.Lock()
+		tblUserIdentityInsertCache[key] = cache
+		tblUserIdentityInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLUserIdentity.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLUserIdentity) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblUserIdentityUpdateCacheMut.RLock()
+	cache, cached := tblUserIdentityUpdateCache[key]
+	tblUserIdentityUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblUserIdentityAllColumns,
+			tblUserIdentityPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_user_identities, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_user_identities` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblUserIdentityPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, append(wl, tblUserIdentityPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_user_identities row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_user_identities")
+	}
+
+	if !cached {
+		tblUserIdentityUpdateCacheMut.Lock()
+		tblUserIdentityUpdateCache[key] = cache
+		tblUserIdentityUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblUserIdentityQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_user_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_user_identities")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLUserIdentitySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_user_identities` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserIdentityPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblUserIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblUserIdentity")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLUserIdentityUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLUserIdentity) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_identities provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserIdentityColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLUserIdentityUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblUserIdentityUpsertCacheMut.RLock()
+	cache, cached := tblUserIdentityUpsertCache[key]
+	tblUserIdentityUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblUserIdentityAllColumns,
+			tblUserIdentityColumnsWithDefault,
+			tblUserIdentityColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblUserIdentityAllColumns,
+			tblUserIdentityPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_user_identities, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblUserIdentityAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_user_identities`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_user_identities` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_user_identities")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserIdentityMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblUserIdentityType, tblUserIdentityMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_user_identities")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_identities")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserIdentityUpsertCacheMut.Lock()
+		tblUserIdentityUpsertCache[key] = cache
+		tblUserIdentityUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLUserIdentity record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLUserIdentity) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLUserIdentity provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblUserIdentityPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_user_identities` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_user_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_user_identities")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblUserIdentityQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblUserIdentityQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_user_identities")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_identities")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLUserIdentitySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblUserIdentityBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_user_identities` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserIdentityPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblUserIdentity slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_identities")
+	}
+
+	if len(tblUserIdentityAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLUserIdentity) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLUserIdentity(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLUserIdentitySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLUserIdentitySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserIdentityPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_user_identities`.* FROM `tbl_user_identities` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserIdentityPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLUserIdentitySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLUserIdentityExists checks if the TBLUserIdentity row exists.
+func TBLUserIdentityExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_user_identities` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_user_identities exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLUserIdentity row exists.
+func (o *TBLUserIdentity) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLUserIdentityExists(ctx, exec, o.ID)
+}
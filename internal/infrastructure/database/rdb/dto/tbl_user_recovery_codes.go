@@ -0,0 +1,1205 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLUserRecoveryCode is an object representing the database table.
+type TBLUserRecoveryCode struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CodeHash  string    `boil:"code_hash" json:"code_hash" toml:"code_hash" yaml:"code_hash"`
+	Used      int       `boil:"used" json:"used" toml:"used" yaml:"used"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblUserRecoveryCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblUserRecoveryCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLUserRecoveryCodeColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	Used      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	CodeHash:  "code_hash",
+	Used:      "used",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TBLUserRecoveryCodeTableColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	Used      string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "tbl_user_recovery_codes.id",
+	UserID:    "tbl_user_recovery_codes.user_id",
+	CodeHash:  "tbl_user_recovery_codes.code_hash",
+	Used:      "tbl_user_recovery_codes.used",
+	CreatedAt: "tbl_user_recovery_codes.created_at",
+	UpdatedAt: "tbl_user_recovery_codes.updated_at",
+}
+
+// Generated where
+
+var TBLUserRecoveryCodeWhere = struct {
+	ID        whereHelperint
+	UserID    whereHelperint
+	CodeHash  whereHelperstring
+	Used      whereHelperint
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`tbl_user_recovery_codes`.`id`"},
+	UserID:    whereHelperint{field: "`tbl_user_recovery_codes`.`user_id`"},
+	CodeHash:  whereHelperstring{field: "`tbl_user_recovery_codes`.`code_hash`"},
+	Used:      whereHelperint{field: "`tbl_user_recovery_codes`.`used`"},
+	CreatedAt: whereHelpertime_Time{field: "`tbl_user_recovery_codes`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`tbl_user_recovery_codes`.`updated_at`"},
+}
+
+// TBLUserRecoveryCodeRels is where relationship names are stored.
+var TBLUserRecoveryCodeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tblUserRecoveryCodeR is where relationships are stored.
+type tblUserRecoveryCodeR struct {
+	User *TBLUser `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblUserRecoveryCodeR) NewStruct() *tblUserRecoveryCodeR {
+	return &tblUserRecoveryCodeR{}
+}
+
+func (o *TBLUserRecoveryCode) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblUserRecoveryCodeR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblUserRecoveryCodeL is where Load methods for each relationship are stored.
+type tblUserRecoveryCodeL struct{}
+
+var (
+	tblUserRecoveryCodeAllColumns            = []string{"id", "user_id", "code_hash", "used", "created_at", "updated_at"}
+	tblUserRecoveryCodeColumnsWithoutDefault = []string{"user_id", "code_hash"}
+	tblUserRecoveryCodeColumnsWithDefault    = []string{"id", "used", "created_at", "updated_at"}
+	tblUserRecoveryCodePrimaryKeyColumns     = []string{"id"}
+	tblUserRecoveryCodeGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLUserRecoveryCodeSlice is an alias for a slice of pointers to TBLUserRecoveryCode.
+	// This should almost always be used instead of []TBLUserRecoveryCode.
+	TBLUserRecoveryCodeSlice []*TBLUserRecoveryCode
+	// TBLUserRecoveryCodeHook is the signature for custom TBLUserRecoveryCode hook methods
+	TBLUserRecoveryCodeHook func(context.Context, boil.ContextExecutor, *TBLUserRecoveryCode) error
+
+	tblUserRecoveryCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblUserRecoveryCodeType                 = reflect.TypeOf(&TBLUserRecoveryCode{})
+	tblUserRecoveryCodeMapping              = queries.MakeStructMapping(tblUserRecoveryCodeType)
+	tblUserRecoveryCodePrimaryKeyMapping, _ = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, tblUserRecoveryCodePrimaryKeyColumns)
+	tblUserRecoveryCodeInsertCacheMut       sync.RWMutex
+	tblUserRecoveryCodeInsertCache          = make(map[string]insertCache)
+	tblUserRecoveryCodeUpdateCacheMut       sync.RWMutex
+	tblUserRecoveryCodeUpdateCache          = make(map[string]updateCache)
+	tblUserRecoveryCodeUpsertCacheMut       sync.RWMutex
+	tblUserRecoveryCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblUserRecoveryCodeAfterSelectMu sync.Mutex
+var tblUserRecoveryCodeAfterSelectHooks []TBLUserRecoveryCodeHook
+
+var tblUserRecoveryCodeBeforeInsertMu sync.Mutex
+var tblUserRecoveryCodeBeforeInsertHooks []TBLUserRecoveryCodeHook
+var tblUserRecoveryCodeAfterInsertMu sync.Mutex
+var tblUserRecoveryCodeAfterInsertHooks []TBLUserRecoveryCodeHook
+
+var tblUserRecoveryCodeBeforeUpdateMu sync.Mutex
+var tblUserRecoveryCodeBeforeUpdateHooks []TBLUserRecoveryCodeHook
+var tblUserRecoveryCodeAfterUpdateMu sync.Mutex
+var tblUserRecoveryCodeAfterUpdateHooks []TBLUserRecoveryCodeHook
+
+var tblUserRecoveryCodeBeforeDeleteMu sync.Mutex
+var tblUserRecoveryCodeBeforeDeleteHooks []TBLUserRecoveryCodeHook
+var tblUserRecoveryCodeAfterDeleteMu sync.Mutex
+var tblUserRecoveryCodeAfterDeleteHooks []TBLUserRecoveryCodeHook
+
+var tblUserRecoveryCodeBeforeUpsertMu sync.Mutex
+var tblUserRecoveryCodeBeforeUpsertHooks []TBLUserRecoveryCodeHook
+var tblUserRecoveryCodeAfterUpsertMu sync.Mutex
+var tblUserRecoveryCodeAfterUpsertHooks []TBLUserRecoveryCodeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLUserRecoveryCode) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLUserRecoveryCode) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLUserRecoveryCode) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLUserRecoveryCode) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLUserRecoveryCode) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLUserRecoveryCode) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLUserRecoveryCode) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLUserRecoveryCode) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLUserRecoveryCode) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserRecoveryCodeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLUserRecoveryCodeHook registers your hook function for all future operations.
+func AddTBLUserRecoveryCodeHook(hookPoint boil.HookPoint, tblUserRecoveryCodeHook TBLUserRecoveryCodeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblUserRecoveryCodeAfterSelectMu.Lock()
+		tblUserRecoveryCodeAfterSelectHooks = append(tblUserRecoveryCodeAfterSelectHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblUserRecoveryCodeBeforeInsertMu.Lock()
+		tblUserRecoveryCodeBeforeInsertHooks = append(tblUserRecoveryCodeBeforeInsertHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblUserRecoveryCodeAfterInsertMu.Lock()
+		tblUserRecoveryCodeAfterInsertHooks = append(tblUserRecoveryCodeAfterInsertHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblUserRecoveryCodeBeforeUpdateMu.Lock()
+		tblUserRecoveryCodeBeforeUpdateHooks = append(tblUserRecoveryCodeBeforeUpdateHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblUserRecoveryCodeAfterUpdateMu.Lock()
+		tblUserRecoveryCodeAfterUpdateHooks = append(tblUserRecoveryCodeAfterUpdateHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblUserRecoveryCodeBeforeDeleteMu.Lock()
+		tblUserRecoveryCodeBeforeDeleteHooks = append(tblUserRecoveryCodeBeforeDeleteHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblUserRecoveryCodeAfterDeleteMu.Lock()
+		tblUserRecoveryCodeAfterDeleteHooks = append(tblUserRecoveryCodeAfterDeleteHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblUserRecoveryCodeBeforeUpsertMu.Lock()
+		tblUserRecoveryCodeBeforeUpsertHooks = append(tblUserRecoveryCodeBeforeUpsertHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblUserRecoveryCodeAfterUpsertMu.Lock()
+		tblUserRecoveryCodeAfterUpsertHooks = append(tblUserRecoveryCodeAfterUpsertHooks, tblUserRecoveryCodeHook)
+		tblUserRecoveryCodeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblUserRecoveryCode record from the query.
+func (q tblUserRecoveryCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLUserRecoveryCode, error) {
+	o := &TBLUserRecoveryCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_user_recovery_codes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLUserRecoveryCode records from the query.
+func (q tblUserRecoveryCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLUserRecoveryCodeSlice, error) {
+	var o []*TBLUserRecoveryCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLUserRecoveryCode slice")
+	}
+
+	if len(tblUserRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLUserRecoveryCode records in the query.
+func (q tblUserRecoveryCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_user_recovery_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblUserRecoveryCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_user_recovery_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLUserRecoveryCode) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserRecoveryCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserRecoveryCode interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserRecoveryCode
+	var object *TBLUserRecoveryCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserRecoveryCode.(*TBLUserRecoveryCode)
+		if !ok {
+			object = new(TBLUserRecoveryCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserRecoveryCode))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserRecoveryCode.(*[]*TBLUserRecoveryCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserRecoveryCode))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserRecoveryCodeR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserRecoveryCodeR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLUserRecoveryCodes = append(foreign.R.UserTBLUserRecoveryCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLUserRecoveryCodes = append(foreign.R.UserTBLUserRecoveryCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblUserRecoveryCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLUserRecoveryCodes.
+func (o *TBLUserRecoveryCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_recovery_codes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserRecoveryCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserRecoveryCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLUserRecoveryCodes: TBLUserRecoveryCodeSlice{o},
+		}
+	} else {
+		related.R.UserTBLUserRecoveryCodes = append(related.R.UserTBLUserRecoveryCodes, o)
+	}
+
+	return nil
+}
+
+// TBLUserRecoveryCodes retrieves all the records using an executor.
+func TBLUserRecoveryCodes(mods ...qm.QueryMod) tblUserRecoveryCodeQuery {
+	mods = append(mods, qm.From("`tbl_user_recovery_codes`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_user_recovery_codes`.*"})
+	}
+
+	return tblUserRecoveryCodeQuery{q}
+}
+
+// FindTBLUserRecoveryCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLUserRecoveryCode(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLUserRecoveryCode, error) {
+	tblUserRecoveryCodeObj := &TBLUserRecoveryCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_user_recovery_codes` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblUserRecoveryCodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_user_recovery_codes")
+	}
+
+	if err = tblUserRecoveryCodeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblUserRecoveryCodeObj, err
+	}
+
+	return tblUserRecoveryCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLUserRecoveryCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_recovery_codes provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserRecoveryCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblUserRecoveryCodeInsertCacheMut.RLock()
+	cache, cached := tblUserRecoveryCodeInsertCache[key]
+	tblUserRecoveryCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblUserRecoveryCodeAllColumns,
+			tblUserRecoveryCodeColumnsWithDefault,
+			tblUserRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_user_recovery_codes` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_user_recovery_codes` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_user_recovery_codes` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblUserRecoveryCodePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_user_recovery_codes")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserRecoveryCodeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_recovery_codes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserRecoveryCodeInsertCacheMut.Lock()
+		tblUserRecoveryCodeInsertCache[key] = cache
+		tblUserRecoveryCodeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLUserRecoveryCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLUserRecoveryCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblUserRecoveryCodeUpdateCacheMut.RLock()
+	cache, cached := tblUserRecoveryCodeUpdateCache[key]
+	tblUserRecoveryCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblUserRecoveryCodeAllColumns,
+			tblUserRecoveryCodePrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_user_recovery_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_user_recovery_codes` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblUserRecoveryCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, append(wl, tblUserRecoveryCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_user_recovery_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_user_recovery_codes")
+	}
+
+	if !cached {
+		tblUserRecoveryCodeUpdateCacheMut.Lock()
+		tblUserRecoveryCodeUpdateCache[key] = cache
+		tblUserRecoveryCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblUserRecoveryCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_user_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_user_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLUserRecoveryCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_user_recovery_codes` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserRecoveryCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblUserRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblUserRecoveryCode")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLUserRecoveryCodeUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLUserRecoveryCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_recovery_codes provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserRecoveryCodeColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLUserRecoveryCodeUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblUserRecoveryCodeUpsertCacheMut.RLock()
+	cache, cached := tblUserRecoveryCodeUpsertCache[key]
+	tblUserRecoveryCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblUserRecoveryCodeAllColumns,
+			tblUserRecoveryCodeColumnsWithDefault,
+			tblUserRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblUserRecoveryCodeAllColumns,
+			tblUserRecoveryCodePrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_user_recovery_codes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblUserRecoveryCodeAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_user_recovery_codes`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_user_recovery_codes` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_user_recovery_codes")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserRecoveryCodeMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblUserRecoveryCodeType, tblUserRecoveryCodeMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_user_recovery_codes")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_recovery_codes")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserRecoveryCodeUpsertCacheMut.Lock()
+		tblUserRecoveryCodeUpsertCache[key] = cache
+		tblUserRecoveryCodeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLUserRecoveryCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLUserRecoveryCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLUserRecoveryCode provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblUserRecoveryCodePrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_user_recovery_codes` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_user_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_user_recovery_codes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblUserRecoveryCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblUserRecoveryCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_user_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLUserRecoveryCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblUserRecoveryCodeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_user_recovery_codes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserRecoveryCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblUserRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_recovery_codes")
+	}
+
+	if len(tblUserRecoveryCodeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLUserRecoveryCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLUserRecoveryCode(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLUserRecoveryCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLUserRecoveryCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_user_recovery_codes`.* FROM `tbl_user_recovery_codes` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserRecoveryCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLUserRecoveryCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLUserRecoveryCodeExists checks if the TBLUserRecoveryCode row exists.
+func TBLUserRecoveryCodeExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_user_recovery_codes` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_user_recovery_codes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLUserRecoveryCode row exists.
+func (o *TBLUserRecoveryCode) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLUserRecoveryCodeExists(ctx, exec, o.ID)
+}
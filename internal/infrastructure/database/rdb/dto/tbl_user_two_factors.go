@@ -0,0 +1,1243 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dto
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TBLUserTwoFactor is an object representing the database table.
+type TBLUserTwoFactor struct {
+	ID           int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Secret       string    `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	Enabled      int       `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	Required     int       `boil:"required" json:"required" toml:"required" yaml:"required"`
+	LastUsedStep int64     `boil:"last_used_step" json:"last_used_step" toml:"last_used_step" yaml:"last_used_step"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *tblUserTwoFactorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L tblUserTwoFactorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TBLUserTwoFactorColumns = struct {
+	ID           string
+	UserID       string
+	Secret       string
+	Enabled      string
+	Required     string
+	LastUsedStep string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	UserID:       "user_id",
+	Secret:       "secret",
+	Enabled:      "enabled",
+	Required:     "required",
+	LastUsedStep: "last_used_step",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var TBLUserTwoFactorTableColumns = struct {
+	ID           string
+	UserID       string
+	Secret       string
+	Enabled      string
+	Required     string
+	LastUsedStep string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "tbl_user_two_factors.id",
+	UserID:       "tbl_user_two_factors.user_id",
+	Secret:       "tbl_user_two_factors.secret",
+	Enabled:      "tbl_user_two_factors.enabled",
+	Required:     "tbl_user_two_factors.required",
+	LastUsedStep: "tbl_user_two_factors.last_used_step",
+	CreatedAt:    "tbl_user_two_factors.created_at",
+	UpdatedAt:    "tbl_user_two_factors.updated_at",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var TBLUserTwoFactorWhere = struct {
+	ID           whereHelperint
+	UserID       whereHelperint
+	Secret       whereHelperstring
+	Enabled      whereHelperint
+	Required     whereHelperint
+	LastUsedStep whereHelperint64
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperint{field: "`tbl_user_two_factors`.`id`"},
+	UserID:       whereHelperint{field: "`tbl_user_two_factors`.`user_id`"},
+	Secret:       whereHelperstring{field: "`tbl_user_two_factors`.`secret`"},
+	Enabled:      whereHelperint{field: "`tbl_user_two_factors`.`enabled`"},
+	Required:     whereHelperint{field: "`tbl_user_two_factors`.`required`"},
+	LastUsedStep: whereHelperint64{field: "`tbl_user_two_factors`.`last_used_step`"},
+	CreatedAt:    whereHelpertime_Time{field: "`tbl_user_two_factors`.`created_at`"},
+	UpdatedAt:    whereHelpertime_Time{field: "`tbl_user_two_factors`.`updated_at`"},
+}
+
+// TBLUserTwoFactorRels is where relationship names are stored.
+var TBLUserTwoFactorRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// tblUserTwoFactorR is where relationships are stored.
+type tblUserTwoFactorR struct {
+	User *TBLUser `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*tblUserTwoFactorR) NewStruct() *tblUserTwoFactorR {
+	return &tblUserTwoFactorR{}
+}
+
+func (o *TBLUserTwoFactor) GetUser() *TBLUser {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *tblUserTwoFactorR) GetUser() *TBLUser {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// tblUserTwoFactorL is where Load methods for each relationship are stored.
+type tblUserTwoFactorL struct{}
+
+var (
+	tblUserTwoFactorAllColumns            = []string{"id", "user_id", "secret", "enabled", "required", "last_used_step", "created_at", "updated_at"}
+	tblUserTwoFactorColumnsWithoutDefault = []string{"user_id", "secret"}
+	tblUserTwoFactorColumnsWithDefault    = []string{"id", "enabled", "required", "last_used_step", "created_at", "updated_at"}
+	tblUserTwoFactorPrimaryKeyColumns     = []string{"id"}
+	tblUserTwoFactorGeneratedColumns      = []string{}
+)
+
+type (
+	// TBLUserTwoFactorSlice is an alias for a slice of pointers to TBLUserTwoFactor.
+	// This should almost always be used instead of []TBLUserTwoFactor.
+	TBLUserTwoFactorSlice []*TBLUserTwoFactor
+	// TBLUserTwoFactorHook is the signature for custom TBLUserTwoFactor hook methods
+	TBLUserTwoFactorHook func(context.Context, boil.ContextExecutor, *TBLUserTwoFactor) error
+
+	tblUserTwoFactorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	tblUserTwoFactorType                 = reflect.TypeOf(&TBLUserTwoFactor{})
+	tblUserTwoFactorMapping              = queries.MakeStructMapping(tblUserTwoFactorType)
+	tblUserTwoFactorPrimaryKeyMapping, _ = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, tblUserTwoFactorPrimaryKeyColumns)
+	tblUserTwoFactorInsertCacheMut       sync.RWMutex
+	tblUserTwoFactorInsertCache          = make(map[string]insertCache)
+	tblUserTwoFactorUpdateCacheMut       sync.RWMutex
+	tblUserTwoFactorUpdateCache          = make(map[string]updateCache)
+	tblUserTwoFactorUpsertCacheMut       sync.RWMutex
+	tblUserTwoFactorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var tblUserTwoFactorAfterSelectMu sync.Mutex
+var tblUserTwoFactorAfterSelectHooks []TBLUserTwoFactorHook
+
+var tblUserTwoFactorBeforeInsertMu sync.Mutex
+var tblUserTwoFactorBeforeInsertHooks []TBLUserTwoFactorHook
+var tblUserTwoFactorAfterInsertMu sync.Mutex
+var tblUserTwoFactorAfterInsertHooks []TBLUserTwoFactorHook
+
+var tblUserTwoFactorBeforeUpdateMu sync.Mutex
+var tblUserTwoFactorBeforeUpdateHooks []TBLUserTwoFactorHook
+var tblUserTwoFactorAfterUpdateMu sync.Mutex
+var tblUserTwoFactorAfterUpdateHooks []TBLUserTwoFactorHook
+
+var tblUserTwoFactorBeforeDeleteMu sync.Mutex
+var tblUserTwoFactorBeforeDeleteHooks []TBLUserTwoFactorHook
+var tblUserTwoFactorAfterDeleteMu sync.Mutex
+var tblUserTwoFactorAfterDeleteHooks []TBLUserTwoFactorHook
+
+var tblUserTwoFactorBeforeUpsertMu sync.Mutex
+var tblUserTwoFactorBeforeUpsertHooks []TBLUserTwoFactorHook
+var tblUserTwoFactorAfterUpsertMu sync.Mutex
+var tblUserTwoFactorAfterUpsertHooks []TBLUserTwoFactorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TBLUserTwoFactor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TBLUserTwoFactor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TBLUserTwoFactor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TBLUserTwoFactor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TBLUserTwoFactor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TBLUserTwoFactor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TBLUserTwoFactor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TBLUserTwoFactor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TBLUserTwoFactor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range tblUserTwoFactorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTBLUserTwoFactorHook registers your hook function for all future operations.
+func AddTBLUserTwoFactorHook(hookPoint boil.HookPoint, tblUserTwoFactorHook TBLUserTwoFactorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		tblUserTwoFactorAfterSelectMu.Lock()
+		tblUserTwoFactorAfterSelectHooks = append(tblUserTwoFactorAfterSelectHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		tblUserTwoFactorBeforeInsertMu.Lock()
+		tblUserTwoFactorBeforeInsertHooks = append(tblUserTwoFactorBeforeInsertHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		tblUserTwoFactorAfterInsertMu.Lock()
+		tblUserTwoFactorAfterInsertHooks = append(tblUserTwoFactorAfterInsertHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		tblUserTwoFactorBeforeUpdateMu.Lock()
+		tblUserTwoFactorBeforeUpdateHooks = append(tblUserTwoFactorBeforeUpdateHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		tblUserTwoFactorAfterUpdateMu.Lock()
+		tblUserTwoFactorAfterUpdateHooks = append(tblUserTwoFactorAfterUpdateHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		tblUserTwoFactorBeforeDeleteMu.Lock()
+		tblUserTwoFactorBeforeDeleteHooks = append(tblUserTwoFactorBeforeDeleteHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		tblUserTwoFactorAfterDeleteMu.Lock()
+		tblUserTwoFactorAfterDeleteHooks = append(tblUserTwoFactorAfterDeleteHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		tblUserTwoFactorBeforeUpsertMu.Lock()
+		tblUserTwoFactorBeforeUpsertHooks = append(tblUserTwoFactorBeforeUpsertHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		tblUserTwoFactorAfterUpsertMu.Lock()
+		tblUserTwoFactorAfterUpsertHooks = append(tblUserTwoFactorAfterUpsertHooks, tblUserTwoFactorHook)
+		tblUserTwoFactorAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single tblUserTwoFactor record from the query.
+func (q tblUserTwoFactorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TBLUserTwoFactor, error) {
+	o := &TBLUserTwoFactor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: failed to execute a one query for tbl_user_two_factors")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TBLUserTwoFactor records from the query.
+func (q tblUserTwoFactorQuery) All(ctx context.Context, exec boil.ContextExecutor) (TBLUserTwoFactorSlice, error) {
+	var o []*TBLUserTwoFactor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dto: failed to assign all query results to TBLUserTwoFactor slice")
+	}
+
+	if len(tblUserTwoFactorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TBLUserTwoFactor records in the query.
+func (q tblUserTwoFactorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to count tbl_user_two_factors rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q tblUserTwoFactorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: failed to check if tbl_user_two_factors exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *TBLUserTwoFactor) User(mods ...qm.QueryMod) tblUserQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUsers(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (tblUserTwoFactorL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUserTwoFactor interface{}, mods queries.Applicator) error {
+	var slice []*TBLUserTwoFactor
+	var object *TBLUserTwoFactor
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUserTwoFactor.(*TBLUserTwoFactor)
+		if !ok {
+			object = new(TBLUserTwoFactor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUserTwoFactor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUserTwoFactor))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUserTwoFactor.(*[]*TBLUserTwoFactor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUserTwoFactor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUserTwoFactor))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserTwoFactorR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserTwoFactorR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TBLUser")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUser")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserR{}
+		}
+		foreign.R.UserTBLUserTwoFactor = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UserTBLUserTwoFactor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the tblUserTwoFactor to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTBLUserTwoFactor.
+func (o *TBLUserTwoFactor) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_user_two_factors` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserTwoFactorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserTwoFactorR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UserTBLUserTwoFactor: o,
+		}
+	} else {
+		related.R.UserTBLUserTwoFactor = o
+	}
+
+	return nil
+}
+
+// TBLUserTwoFactors retrieves all the records using an executor.
+func TBLUserTwoFactors(mods ...qm.QueryMod) tblUserTwoFactorQuery {
+	mods = append(mods, qm.From("`tbl_user_two_factors`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`tbl_user_two_factors`.*"})
+	}
+
+	return tblUserTwoFactorQuery{q}
+}
+
+// FindTBLUserTwoFactor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTBLUserTwoFactor(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TBLUserTwoFactor, error) {
+	tblUserTwoFactorObj := &TBLUserTwoFactor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `tbl_user_two_factors` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, tblUserTwoFactorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dto: unable to select from tbl_user_two_factors")
+	}
+
+	if err = tblUserTwoFactorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return tblUserTwoFactorObj, err
+	}
+
+	return tblUserTwoFactorObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TBLUserTwoFactor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_two_factors provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserTwoFactorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	tblUserTwoFactorInsertCacheMut.RLock()
+	cache, cached := tblUserTwoFactorInsertCache[key]
+	tblUserTwoFactorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			tblUserTwoFactorAllColumns,
+			tblUserTwoFactorColumnsWithDefault,
+			tblUserTwoFactorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `tbl_user_two_factors` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `tbl_user_two_factors` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `tbl_user_two_factors` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, tblUserTwoFactorPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to insert into tbl_user_two_factors")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserTwoFactorMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_two_factors")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserTwoFactorInsertCacheMut.Lock()
+		tblUserTwoFactorInsertCache[key] = cache
+		tblUserTwoFactorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TBLUserTwoFactor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TBLUserTwoFactor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	tblUserTwoFactorUpdateCacheMut.RLock()
+	cache, cached := tblUserTwoFactorUpdateCache[key]
+	tblUserTwoFactorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			tblUserTwoFactorAllColumns,
+			tblUserTwoFactorPrimaryKeyColumns,
+		)
+		if len(wl) == 0 {
+			return 0, errors.New("dto: unable to update tbl_user_two_factors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `tbl_user_two_factors` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, tblUserTwoFactorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, append(wl, tblUserTwoFactorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update tbl_user_two_factors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by update for tbl_user_two_factors")
+	}
+
+	if !cached {
+		tblUserTwoFactorUpdateCacheMut.Lock()
+		tblUserTwoFactorUpdateCache[key] = cache
+		tblUserTwoFactorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q tblUserTwoFactorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all for tbl_user_two_factors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected for tbl_user_two_factors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TBLUserTwoFactorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dto: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserTwoFactorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `tbl_user_two_factors` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserTwoFactorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to update all in tblUserTwoFactor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to retrieve rows affected all in update all tblUserTwoFactor")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTBLUserTwoFactorUniqueColumns = []string{
+	"id",
+	"user_id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TBLUserTwoFactor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dto: no tbl_user_two_factors provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(tblUserTwoFactorColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTBLUserTwoFactorUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	tblUserTwoFactorUpsertCacheMut.RLock()
+	cache, cached := tblUserTwoFactorUpsertCache[key]
+	tblUserTwoFactorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			tblUserTwoFactorAllColumns,
+			tblUserTwoFactorColumnsWithDefault,
+			tblUserTwoFactorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			tblUserTwoFactorAllColumns,
+			tblUserTwoFactorPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("dto: unable to upsert tbl_user_two_factors, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(tblUserTwoFactorAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`tbl_user_two_factors`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `tbl_user_two_factors` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to upsert for tbl_user_two_factors")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == tblUserTwoFactorMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(tblUserTwoFactorType, tblUserTwoFactorMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to retrieve unique values for tbl_user_two_factors")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to populate default values for tbl_user_two_factors")
+	}
+
+CacheNoHooks:
+	if !cached {
+		tblUserTwoFactorUpsertCacheMut.Lock()
+		tblUserTwoFactorUpsertCache[key] = cache
+		tblUserTwoFactorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TBLUserTwoFactor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TBLUserTwoFactor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dto: no TBLUserTwoFactor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), tblUserTwoFactorPrimaryKeyMapping)
+	sql := "DELETE FROM `tbl_user_two_factors` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete from tbl_user_two_factors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by delete for tbl_user_two_factors")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q tblUserTwoFactorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dto: no tblUserTwoFactorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tbl_user_two_factors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_two_factors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TBLUserTwoFactorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(tblUserTwoFactorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserTwoFactorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `tbl_user_two_factors` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserTwoFactorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: unable to delete all from tblUserTwoFactor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dto: failed to get rows affected by deleteall for tbl_user_two_factors")
+	}
+
+	if len(tblUserTwoFactorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TBLUserTwoFactor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTBLUserTwoFactor(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TBLUserTwoFactorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TBLUserTwoFactorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), tblUserTwoFactorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `tbl_user_two_factors`.* FROM `tbl_user_two_factors` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, tblUserTwoFactorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dto: unable to reload all in TBLUserTwoFactorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TBLUserTwoFactorExists checks if the TBLUserTwoFactor row exists.
+func TBLUserTwoFactorExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `tbl_user_two_factors` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dto: unable to check if tbl_user_two_factors exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TBLUserTwoFactor row exists.
+func (o *TBLUserTwoFactor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TBLUserTwoFactorExists(ctx, exec, o.ID)
+}
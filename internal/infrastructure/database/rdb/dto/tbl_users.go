@@ -107,26 +107,56 @@ var TBLUserWhere = struct {
 
 // TBLUserRels is where relationship names are stored.
 var TBLUserRels = struct {
-	RoleKeyDataRole            string
-	UpdateUser                 string
-	CreateUserTBLSchedules     string
-	LastUpdateUserTBLSchedules string
-	UpdateUserTBLUsers         string
+	RoleKeyDataRole                      string
+	UpdateUser                           string
+	UserTBLUserTwoFactor                 string
+	UserTBLLoginAttempts                 string
+	RequestUserTBLScheduleChangeRequests string
+	UserTBLScheduleCollaborators         string
+	AuthorUserTBLScheduleComments        string
+	CreateUserTBLScheduleDayBundles      string
+	CreateUserTBLSchedules               string
+	LastUpdateUserTBLSchedules           string
+	UserTBLUserAPITokens                 string
+	UserTBLUserCampusRoles               string
+	UserTBLUserIdentities                string
+	UserTBLUserRecoveryCodes             string
+	UpdateUserTBLUsers                   string
 }{
-	RoleKeyDataRole:            "RoleKeyDataRole",
-	UpdateUser:                 "UpdateUser",
-	CreateUserTBLSchedules:     "CreateUserTBLSchedules",
-	LastUpdateUserTBLSchedules: "LastUpdateUserTBLSchedules",
-	UpdateUserTBLUsers:         "UpdateUserTBLUsers",
+	RoleKeyDataRole:                      "RoleKeyDataRole",
+	UpdateUser:                           "UpdateUser",
+	UserTBLUserTwoFactor:                 "UserTBLUserTwoFactor",
+	UserTBLLoginAttempts:                 "UserTBLLoginAttempts",
+	RequestUserTBLScheduleChangeRequests: "RequestUserTBLScheduleChangeRequests",
+	UserTBLScheduleCollaborators:         "UserTBLScheduleCollaborators",
+	AuthorUserTBLScheduleComments:        "AuthorUserTBLScheduleComments",
+	CreateUserTBLScheduleDayBundles:      "CreateUserTBLScheduleDayBundles",
+	CreateUserTBLSchedules:               "CreateUserTBLSchedules",
+	LastUpdateUserTBLSchedules:           "LastUpdateUserTBLSchedules",
+	UserTBLUserAPITokens:                 "UserTBLUserAPITokens",
+	UserTBLUserCampusRoles:               "UserTBLUserCampusRoles",
+	UserTBLUserIdentities:                "UserTBLUserIdentities",
+	UserTBLUserRecoveryCodes:             "UserTBLUserRecoveryCodes",
+	UpdateUserTBLUsers:                   "UpdateUserTBLUsers",
 }
 
 // tblUserR is where relationships are stored.
 type tblUserR struct {
-	RoleKeyDataRole            *DataRole        `boil:"RoleKeyDataRole" json:"RoleKeyDataRole" toml:"RoleKeyDataRole" yaml:"RoleKeyDataRole"`
-	UpdateUser                 *TBLUser         `boil:"UpdateUser" json:"UpdateUser" toml:"UpdateUser" yaml:"UpdateUser"`
-	CreateUserTBLSchedules     TBLScheduleSlice `boil:"CreateUserTBLSchedules" json:"CreateUserTBLSchedules" toml:"CreateUserTBLSchedules" yaml:"CreateUserTBLSchedules"`
-	LastUpdateUserTBLSchedules TBLScheduleSlice `boil:"LastUpdateUserTBLSchedules" json:"LastUpdateUserTBLSchedules" toml:"LastUpdateUserTBLSchedules" yaml:"LastUpdateUserTBLSchedules"`
-	UpdateUserTBLUsers         TBLUserSlice     `boil:"UpdateUserTBLUsers" json:"UpdateUserTBLUsers" toml:"UpdateUserTBLUsers" yaml:"UpdateUserTBLUsers"`
+	RoleKeyDataRole                      *DataRole                     `boil:"RoleKeyDataRole" json:"RoleKeyDataRole" toml:"RoleKeyDataRole" yaml:"RoleKeyDataRole"`
+	UpdateUser                           *TBLUser                      `boil:"UpdateUser" json:"UpdateUser" toml:"UpdateUser" yaml:"UpdateUser"`
+	UserTBLUserTwoFactor                 *TBLUserTwoFactor             `boil:"UserTBLUserTwoFactor" json:"UserTBLUserTwoFactor" toml:"UserTBLUserTwoFactor" yaml:"UserTBLUserTwoFactor"`
+	UserTBLLoginAttempts                 TBLLoginAttemptSlice          `boil:"UserTBLLoginAttempts" json:"UserTBLLoginAttempts" toml:"UserTBLLoginAttempts" yaml:"UserTBLLoginAttempts"`
+	RequestUserTBLScheduleChangeRequests TBLScheduleChangeRequestSlice `boil:"RequestUserTBLScheduleChangeRequests" json:"RequestUserTBLScheduleChangeRequests" toml:"RequestUserTBLScheduleChangeRequests" yaml:"RequestUserTBLScheduleChangeRequests"`
+	UserTBLScheduleCollaborators         TBLScheduleCollaboratorSlice  `boil:"UserTBLScheduleCollaborators" json:"UserTBLScheduleCollaborators" toml:"UserTBLScheduleCollaborators" yaml:"UserTBLScheduleCollaborators"`
+	AuthorUserTBLScheduleComments        TBLScheduleCommentSlice       `boil:"AuthorUserTBLScheduleComments" json:"AuthorUserTBLScheduleComments" toml:"AuthorUserTBLScheduleComments" yaml:"AuthorUserTBLScheduleComments"`
+	CreateUserTBLScheduleDayBundles      TBLScheduleDayBundleSlice     `boil:"CreateUserTBLScheduleDayBundles" json:"CreateUserTBLScheduleDayBundles" toml:"CreateUserTBLScheduleDayBundles" yaml:"CreateUserTBLScheduleDayBundles"`
+	CreateUserTBLSchedules               TBLScheduleSlice              `boil:"CreateUserTBLSchedules" json:"CreateUserTBLSchedules" toml:"CreateUserTBLSchedules" yaml:"CreateUserTBLSchedules"`
+	LastUpdateUserTBLSchedules           TBLScheduleSlice              `boil:"LastUpdateUserTBLSchedules" json:"LastUpdateUserTBLSchedules" toml:"LastUpdateUserTBLSchedules" yaml:"LastUpdateUserTBLSchedules"`
+	UserTBLUserAPITokens                 TBLUserAPITokenSlice          `boil:"UserTBLUserAPITokens" json:"UserTBLUserAPITokens" toml:"UserTBLUserAPITokens" yaml:"UserTBLUserAPITokens"`
+	UserTBLUserCampusRoles               TBLUserCampusRoleSlice        `boil:"UserTBLUserCampusRoles" json:"UserTBLUserCampusRoles" toml:"UserTBLUserCampusRoles" yaml:"UserTBLUserCampusRoles"`
+	UserTBLUserIdentities                TBLUserIdentitySlice          `boil:"UserTBLUserIdentities" json:"UserTBLUserIdentities" toml:"UserTBLUserIdentities" yaml:"UserTBLUserIdentities"`
+	UserTBLUserRecoveryCodes             TBLUserRecoveryCodeSlice      `boil:"UserTBLUserRecoveryCodes" json:"UserTBLUserRecoveryCodes" toml:"UserTBLUserRecoveryCodes" yaml:"UserTBLUserRecoveryCodes"`
+	UpdateUserTBLUsers                   TBLUserSlice                  `boil:"UpdateUserTBLUsers" json:"UpdateUserTBLUsers" toml:"UpdateUserTBLUsers" yaml:"UpdateUserTBLUsers"`
 }
 
 // NewStruct creates a new relationship struct
@@ -166,6 +196,102 @@ func (r *tblUserR) GetUpdateUser() *TBLUser {
 	return r.UpdateUser
 }
 
+func (o *TBLUser) GetUserTBLUserTwoFactor() *TBLUserTwoFactor {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLUserTwoFactor()
+}
+
+func (r *tblUserR) GetUserTBLUserTwoFactor() *TBLUserTwoFactor {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLUserTwoFactor
+}
+
+func (o *TBLUser) GetUserTBLLoginAttempts() TBLLoginAttemptSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLLoginAttempts()
+}
+
+func (r *tblUserR) GetUserTBLLoginAttempts() TBLLoginAttemptSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLLoginAttempts
+}
+
+func (o *TBLUser) GetRequestUserTBLScheduleChangeRequests() TBLScheduleChangeRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRequestUserTBLScheduleChangeRequests()
+}
+
+func (r *tblUserR) GetRequestUserTBLScheduleChangeRequests() TBLScheduleChangeRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RequestUserTBLScheduleChangeRequests
+}
+
+func (o *TBLUser) GetUserTBLScheduleCollaborators() TBLScheduleCollaboratorSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLScheduleCollaborators()
+}
+
+func (r *tblUserR) GetUserTBLScheduleCollaborators() TBLScheduleCollaboratorSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLScheduleCollaborators
+}
+
+func (o *TBLUser) GetAuthorUserTBLScheduleComments() TBLScheduleCommentSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAuthorUserTBLScheduleComments()
+}
+
+func (r *tblUserR) GetAuthorUserTBLScheduleComments() TBLScheduleCommentSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AuthorUserTBLScheduleComments
+}
+
+func (o *TBLUser) GetCreateUserTBLScheduleDayBundles() TBLScheduleDayBundleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreateUserTBLScheduleDayBundles()
+}
+
+func (r *tblUserR) GetCreateUserTBLScheduleDayBundles() TBLScheduleDayBundleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreateUserTBLScheduleDayBundles
+}
+
 func (o *TBLUser) GetCreateUserTBLSchedules() TBLScheduleSlice {
 	if o == nil {
 		return nil
@@ -198,6 +324,70 @@ func (r *tblUserR) GetLastUpdateUserTBLSchedules() TBLScheduleSlice {
 	return r.LastUpdateUserTBLSchedules
 }
 
+func (o *TBLUser) GetUserTBLUserAPITokens() TBLUserAPITokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLUserAPITokens()
+}
+
+func (r *tblUserR) GetUserTBLUserAPITokens() TBLUserAPITokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLUserAPITokens
+}
+
+func (o *TBLUser) GetUserTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLUserCampusRoles()
+}
+
+func (r *tblUserR) GetUserTBLUserCampusRoles() TBLUserCampusRoleSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLUserCampusRoles
+}
+
+func (o *TBLUser) GetUserTBLUserIdentities() TBLUserIdentitySlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLUserIdentities()
+}
+
+func (r *tblUserR) GetUserTBLUserIdentities() TBLUserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLUserIdentities
+}
+
+func (o *TBLUser) GetUserTBLUserRecoveryCodes() TBLUserRecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTBLUserRecoveryCodes()
+}
+
+func (r *tblUserR) GetUserTBLUserRecoveryCodes() TBLUserRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTBLUserRecoveryCodes
+}
+
 func (o *TBLUser) GetUpdateUserTBLUsers() TBLUserSlice {
 	if o == nil {
 		return nil
@@ -552,6 +742,87 @@ func (o *TBLUser) UpdateUser(mods ...qm.QueryMod) tblUserQuery {
 	return TBLUsers(queryMods...)
 }
 
+// UserTBLUserTwoFactor pointed to by the foreign key.
+func (o *TBLUser) UserTBLUserTwoFactor(mods ...qm.QueryMod) tblUserTwoFactorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`user_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TBLUserTwoFactors(queryMods...)
+}
+
+// UserTBLLoginAttempts retrieves all the tbl_login_attempt's TBLLoginAttempts with an executor via user_id column.
+func (o *TBLUser) UserTBLLoginAttempts(mods ...qm.QueryMod) tblLoginAttemptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_login_attempts`.`user_id`=?", o.ID),
+	)
+
+	return TBLLoginAttempts(queryMods...)
+}
+
+// RequestUserTBLScheduleChangeRequests retrieves all the tbl_schedule_change_request's TBLScheduleChangeRequests with an executor via request_user_id column.
+func (o *TBLUser) RequestUserTBLScheduleChangeRequests(mods ...qm.QueryMod) tblScheduleChangeRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_change_requests`.`request_user_id`=?", o.ID),
+	)
+
+	return TBLScheduleChangeRequests(queryMods...)
+}
+
+// UserTBLScheduleCollaborators retrieves all the tbl_schedule_collaborator's TBLScheduleCollaborators with an executor via user_id column.
+func (o *TBLUser) UserTBLScheduleCollaborators(mods ...qm.QueryMod) tblScheduleCollaboratorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_collaborators`.`user_id`=?", o.ID),
+	)
+
+	return TBLScheduleCollaborators(queryMods...)
+}
+
+// AuthorUserTBLScheduleComments retrieves all the tbl_schedule_comment's TBLScheduleComments with an executor via author_user_id column.
+func (o *TBLUser) AuthorUserTBLScheduleComments(mods ...qm.QueryMod) tblScheduleCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_comments`.`author_user_id`=?", o.ID),
+	)
+
+	return TBLScheduleComments(queryMods...)
+}
+
+// CreateUserTBLScheduleDayBundles retrieves all the tbl_schedule_day_bundle's TBLScheduleDayBundles with an executor via create_user column.
+func (o *TBLUser) CreateUserTBLScheduleDayBundles(mods ...qm.QueryMod) tblScheduleDayBundleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_schedule_day_bundles`.`create_user`=?", o.ID),
+	)
+
+	return TBLScheduleDayBundles(queryMods...)
+}
+
 // CreateUserTBLSchedules retrieves all the tbl_schedule's TBLSchedules with an executor via create_user column.
 func (o *TBLUser) CreateUserTBLSchedules(mods ...qm.QueryMod) tblScheduleQuery {
 	var queryMods []qm.QueryMod
@@ -580,6 +851,62 @@ func (o *TBLUser) LastUpdateUserTBLSchedules(mods ...qm.QueryMod) tblScheduleQue
 	return TBLSchedules(queryMods...)
 }
 
+// UserTBLUserAPITokens retrieves all the tbl_user_api_token's TBLUserAPITokens with an executor via user_id column.
+func (o *TBLUser) UserTBLUserAPITokens(mods ...qm.QueryMod) tblUserAPITokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_api_tokens`.`user_id`=?", o.ID),
+	)
+
+	return TBLUserAPITokens(queryMods...)
+}
+
+// UserTBLUserCampusRoles retrieves all the tbl_user_campus_role's TBLUserCampusRoles with an executor via user_id column.
+func (o *TBLUser) UserTBLUserCampusRoles(mods ...qm.QueryMod) tblUserCampusRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_campus_roles`.`user_id`=?", o.ID),
+	)
+
+	return TBLUserCampusRoles(queryMods...)
+}
+
+// UserTBLUserIdentities retrieves all the tbl_user_identity's TBLUserIdentities with an executor via user_id column.
+func (o *TBLUser) UserTBLUserIdentities(mods ...qm.QueryMod) tblUserIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_identities`.`user_id`=?", o.ID),
+	)
+
+	return TBLUserIdentities(queryMods...)
+}
+
+// UserTBLUserRecoveryCodes retrieves all the tbl_user_recovery_code's TBLUserRecoveryCodes with an executor via user_id column.
+func (o *TBLUser) UserTBLUserRecoveryCodes(mods ...qm.QueryMod) tblUserRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`tbl_user_recovery_codes`.`user_id`=?", o.ID),
+	)
+
+	return TBLUserRecoveryCodes(queryMods...)
+}
+
 // UpdateUserTBLUsers retrieves all the tbl_user's TBLUsers with an executor via update_user_id column.
 func (o *TBLUser) UpdateUserTBLUsers(mods ...qm.QueryMod) tblUserQuery {
 	var queryMods []qm.QueryMod
@@ -834,9 +1161,9 @@ func (tblUserL) LoadUpdateUser(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadCreateUserTBLSchedules allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+// LoadUserTBLUserTwoFactor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (tblUserL) LoadUserTBLUserTwoFactor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
 	var slice []*TBLUser
 	var object *TBLUser
 
@@ -873,6 +1200,7 @@ func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextEx
 			if obj.R == nil {
 				obj.R = &tblUserR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -889,8 +1217,8 @@ func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextEx
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_schedules`),
-		qm.WhereIn(`tbl_schedules.create_user in ?`, argsSlice...),
+		qm.From(`tbl_user_two_factors`),
+		qm.WhereIn(`tbl_user_two_factors.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -898,47 +1226,50 @@ func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextEx
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_schedules")
+		return errors.Wrap(err, "failed to eager load TBLUserTwoFactor")
 	}
 
-	var resultSlice []*TBLSchedule
+	var resultSlice []*TBLUserTwoFactor
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+		return errors.Wrap(err, "failed to bind eager loaded slice TBLUserTwoFactor")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+		return errors.Wrap(err, "failed to close results of eager load for tbl_user_two_factors")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_two_factors")
 	}
 
-	if len(tblScheduleAfterSelectHooks) != 0 {
+	if len(tblUserTwoFactorAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.CreateUserTBLSchedules = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &tblScheduleR{}
-			}
-			foreign.R.CreateUserTBLUser = object
+		foreign := resultSlice[0]
+		object.R.UserTBLUserTwoFactor = foreign
+		if foreign.R == nil {
+			foreign.R = &tblUserTwoFactorR{}
 		}
-		return nil
+		foreign.R.User = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CreateUser {
-				local.R.CreateUserTBLSchedules = append(local.R.CreateUserTBLSchedules, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLUserTwoFactor = foreign
 				if foreign.R == nil {
-					foreign.R = &tblScheduleR{}
+					foreign.R = &tblUserTwoFactorR{}
 				}
-				foreign.R.CreateUserTBLUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -947,9 +1278,9 @@ func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
-// LoadLastUpdateUserTBLSchedules allows an eager lookup of values, cached into the
+// LoadUserTBLLoginAttempts allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+func (tblUserL) LoadUserTBLLoginAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
 	var slice []*TBLUser
 	var object *TBLUser
 
@@ -1002,8 +1333,8 @@ func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.Conte
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_schedules`),
-		qm.WhereIn(`tbl_schedules.last_update_user in ?`, argsSlice...),
+		qm.From(`tbl_login_attempts`),
+		qm.WhereIn(`tbl_login_attempts.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1011,22 +1342,22 @@ func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.Conte
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_schedules")
+		return errors.Wrap(err, "failed to eager load tbl_login_attempts")
 	}
 
-	var resultSlice []*TBLSchedule
+	var resultSlice []*TBLLoginAttempt
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_login_attempts")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+		return errors.Wrap(err, "failed to close results in eager load on tbl_login_attempts")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_login_attempts")
 	}
 
-	if len(tblScheduleAfterSelectHooks) != 0 {
+	if len(tblLoginAttemptAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1034,24 +1365,24 @@ func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.Conte
 		}
 	}
 	if singular {
-		object.R.LastUpdateUserTBLSchedules = resultSlice
+		object.R.UserTBLLoginAttempts = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tblScheduleR{}
+				foreign.R = &tblLoginAttemptR{}
 			}
-			foreign.R.LastUpdateUserTBLUser = object
+			foreign.R.User = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.LastUpdateUser {
-				local.R.LastUpdateUserTBLSchedules = append(local.R.LastUpdateUserTBLSchedules, foreign)
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.UserTBLLoginAttempts = append(local.R.UserTBLLoginAttempts, foreign)
 				if foreign.R == nil {
-					foreign.R = &tblScheduleR{}
+					foreign.R = &tblLoginAttemptR{}
 				}
-				foreign.R.LastUpdateUserTBLUser = local
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1060,9 +1391,9 @@ func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.Conte
 	return nil
 }
 
-// LoadUpdateUserTBLUsers allows an eager lookup of values, cached into the
+// LoadRequestUserTBLScheduleChangeRequests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+func (tblUserL) LoadRequestUserTBLScheduleChangeRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
 	var slice []*TBLUser
 	var object *TBLUser
 
@@ -1115,8 +1446,8 @@ func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecut
 	}
 
 	query := NewQuery(
-		qm.From(`tbl_users`),
-		qm.WhereIn(`tbl_users.update_user_id in ?`, argsSlice...),
+		qm.From(`tbl_schedule_change_requests`),
+		qm.WhereIn(`tbl_schedule_change_requests.request_user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1124,22 +1455,22 @@ func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecut
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tbl_users")
+		return errors.Wrap(err, "failed to eager load tbl_schedule_change_requests")
 	}
 
-	var resultSlice []*TBLUser
+	var resultSlice []*TBLScheduleChangeRequest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tbl_users")
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_change_requests")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tbl_users")
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_change_requests")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_change_requests")
 	}
 
-	if len(tblUserAfterSelectHooks) != 0 {
+	if len(tblScheduleChangeRequestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1147,24 +1478,24 @@ func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecut
 		}
 	}
 	if singular {
-		object.R.UpdateUserTBLUsers = resultSlice
+		object.R.RequestUserTBLScheduleChangeRequests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tblUserR{}
+				foreign.R = &tblScheduleChangeRequestR{}
 			}
-			foreign.R.UpdateUser = object
+			foreign.R.RequestUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UpdateUserID {
-				local.R.UpdateUserTBLUsers = append(local.R.UpdateUserTBLUsers, foreign)
+			if local.ID == foreign.RequestUserID {
+				local.R.RequestUserTBLScheduleChangeRequests = append(local.R.RequestUserTBLScheduleChangeRequests, foreign)
 				if foreign.R == nil {
-					foreign.R = &tblUserR{}
+					foreign.R = &tblScheduleChangeRequestR{}
 				}
-				foreign.R.UpdateUser = local
+				foreign.R.RequestUser = local
 				break
 			}
 		}
@@ -1173,187 +1504,1706 @@ func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// SetRoleKeyDataRole of the tblUser to the related item.
-// Sets o.R.RoleKeyDataRole to related.
-// Adds o to related.R.RoleKeyTBLUsers.
-func (o *TBLUser) SetRoleKeyDataRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataRole) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `tbl_users` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
-		strmangle.WhereClause("`", "`", 0, tblUserPrimaryKeyColumns),
-	)
-	values := []interface{}{related.RoleKey, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadUserTBLScheduleCollaborators allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUserTBLScheduleCollaborators(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
 
-	o.RoleKey = related.RoleKey
-	if o.R == nil {
-		o.R = &tblUserR{
-			RoleKeyDataRole: related,
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
 		}
 	} else {
-		o.R.RoleKeyDataRole = related
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &dataRoleR{
-			RoleKeyTBLUsers: TBLUserSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		related.R.RoleKeyTBLUsers = append(related.R.RoleKeyTBLUsers, o)
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	return nil
-}
+	if len(args) == 0 {
+		return nil
+	}
 
-// SetUpdateUser of the tblUser to the related item.
-// Sets o.R.UpdateUser to related.
-// Adds o to related.R.UpdateUserTBLUsers.
-func (o *TBLUser) SetUpdateUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE `tbl_users` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"update_user_id"}),
-		strmangle.WhereClause("`", "`", 0, tblUserPrimaryKeyColumns),
+	query := NewQuery(
+		qm.From(`tbl_schedule_collaborators`),
+		qm.WhereIn(`tbl_schedule_collaborators.user_id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	o.UpdateUserID = related.ID
-	if o.R == nil {
-		o.R = &tblUserR{
-			UpdateUser: related,
-		}
-	} else {
-		o.R.UpdateUser = related
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_collaborators")
 	}
 
-	if related.R == nil {
-		related.R = &tblUserR{
-			UpdateUserTBLUsers: TBLUserSlice{o},
-		}
-	} else {
-		related.R.UpdateUserTBLUsers = append(related.R.UpdateUserTBLUsers, o)
+	var resultSlice []*TBLScheduleCollaborator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_collaborators")
 	}
 
-	return nil
-}
-
-// AddCreateUserTBLSchedules adds the given related objects to the existing relationships
-// of the tbl_user, optionally inserting them as new records.
-// Appends related to o.R.CreateUserTBLSchedules.
-// Sets related.R.CreateUserTBLUser appropriately.
-func (o *TBLUser) AddCreateUserTBLSchedules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLSchedule) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.CreateUser = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `tbl_schedules` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"create_user"}),
-				strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_collaborators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_collaborators")
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+	if len(tblScheduleCollaboratorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
-
-			rel.CreateUser = o.ID
 		}
 	}
-
-	if o.R == nil {
-		o.R = &tblUserR{
-			CreateUserTBLSchedules: related,
+	if singular {
+		object.R.UserTBLScheduleCollaborators = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleCollaboratorR{}
+			}
+			foreign.R.User = object
 		}
-	} else {
-		o.R.CreateUserTBLSchedules = append(o.R.CreateUserTBLSchedules, related...)
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &tblScheduleR{
-				CreateUserTBLUser: o,
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLScheduleCollaborators = append(local.R.UserTBLScheduleCollaborators, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleCollaboratorR{}
+				}
+				foreign.R.User = local
+				break
 			}
-		} else {
-			rel.R.CreateUserTBLUser = o
 		}
 	}
+
 	return nil
 }
 
-// AddLastUpdateUserTBLSchedules adds the given related objects to the existing relationships
-// of the tbl_user, optionally inserting them as new records.
-// Appends related to o.R.LastUpdateUserTBLSchedules.
-// Sets related.R.LastUpdateUserTBLUser appropriately.
-func (o *TBLUser) AddLastUpdateUserTBLSchedules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLSchedule) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.LastUpdateUser = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `tbl_schedules` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"last_update_user"}),
-				strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadAuthorUserTBLScheduleComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadAuthorUserTBLScheduleComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
 			}
-
-			rel.LastUpdateUser = o.ID
 		}
 	}
 
-	if o.R == nil {
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_comments`),
+		qm.WhereIn(`tbl_schedule_comments.author_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_comments")
+	}
+
+	var resultSlice []*TBLScheduleComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_comments")
+	}
+
+	if len(tblScheduleCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthorUserTBLScheduleComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleCommentR{}
+			}
+			foreign.R.AuthorUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AuthorUserID {
+				local.R.AuthorUserTBLScheduleComments = append(local.R.AuthorUserTBLScheduleComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleCommentR{}
+				}
+				foreign.R.AuthorUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreateUserTBLScheduleDayBundles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadCreateUserTBLScheduleDayBundles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedule_day_bundles`),
+		qm.WhereIn(`tbl_schedule_day_bundles.create_user in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedule_day_bundles")
+	}
+
+	var resultSlice []*TBLScheduleDayBundle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedule_day_bundles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedule_day_bundles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedule_day_bundles")
+	}
+
+	if len(tblScheduleDayBundleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreateUserTBLScheduleDayBundles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleDayBundleR{}
+			}
+			foreign.R.CreateUserTBLUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreateUser {
+				local.R.CreateUserTBLScheduleDayBundles = append(local.R.CreateUserTBLScheduleDayBundles, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleDayBundleR{}
+				}
+				foreign.R.CreateUserTBLUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreateUserTBLSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadCreateUserTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.create_user in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedules")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreateUserTBLSchedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleR{}
+			}
+			foreign.R.CreateUserTBLUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreateUser {
+				local.R.CreateUserTBLSchedules = append(local.R.CreateUserTBLSchedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.CreateUserTBLUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLastUpdateUserTBLSchedules allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadLastUpdateUserTBLSchedules(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_schedules`),
+		qm.WhereIn(`tbl_schedules.last_update_user in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_schedules")
+	}
+
+	var resultSlice []*TBLSchedule
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_schedules")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_schedules")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_schedules")
+	}
+
+	if len(tblScheduleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LastUpdateUserTBLSchedules = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblScheduleR{}
+			}
+			foreign.R.LastUpdateUserTBLUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LastUpdateUser {
+				local.R.LastUpdateUserTBLSchedules = append(local.R.LastUpdateUserTBLSchedules, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblScheduleR{}
+				}
+				foreign.R.LastUpdateUserTBLUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTBLUserAPITokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUserTBLUserAPITokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_api_tokens`),
+		qm.WhereIn(`tbl_user_api_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_api_tokens")
+	}
+
+	var resultSlice []*TBLUserAPIToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_api_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_api_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_api_tokens")
+	}
+
+	if len(tblUserAPITokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTBLUserAPITokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserAPITokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLUserAPITokens = append(local.R.UserTBLUserAPITokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserAPITokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTBLUserCampusRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUserTBLUserCampusRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_campus_roles`),
+		qm.WhereIn(`tbl_user_campus_roles.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_campus_roles")
+	}
+
+	var resultSlice []*TBLUserCampusRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_campus_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_campus_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_campus_roles")
+	}
+
+	if len(tblUserCampusRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTBLUserCampusRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserCampusRoleR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLUserCampusRoles = append(local.R.UserTBLUserCampusRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserCampusRoleR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTBLUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUserTBLUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_identities`),
+		qm.WhereIn(`tbl_user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_identities")
+	}
+
+	var resultSlice []*TBLUserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_identities")
+	}
+
+	if len(tblUserIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTBLUserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLUserIdentities = append(local.R.UserTBLUserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTBLUserRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUserTBLUserRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_user_recovery_codes`),
+		qm.WhereIn(`tbl_user_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_user_recovery_codes")
+	}
+
+	var resultSlice []*TBLUserRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_user_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_user_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_user_recovery_codes")
+	}
+
+	if len(tblUserRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserTBLUserRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserTBLUserRecoveryCodes = append(local.R.UserTBLUserRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUpdateUserTBLUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (tblUserL) LoadUpdateUserTBLUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTBLUser interface{}, mods queries.Applicator) error {
+	var slice []*TBLUser
+	var object *TBLUser
+
+	if singular {
+		var ok bool
+		object, ok = maybeTBLUser.(*TBLUser)
+		if !ok {
+			object = new(TBLUser)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTBLUser))
+			}
+		}
+	} else {
+		s, ok := maybeTBLUser.(*[]*TBLUser)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTBLUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTBLUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &tblUserR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &tblUserR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`tbl_users`),
+		qm.WhereIn(`tbl_users.update_user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tbl_users")
+	}
+
+	var resultSlice []*TBLUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tbl_users")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tbl_users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tbl_users")
+	}
+
+	if len(tblUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UpdateUserTBLUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tblUserR{}
+			}
+			foreign.R.UpdateUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UpdateUserID {
+				local.R.UpdateUserTBLUsers = append(local.R.UpdateUserTBLUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &tblUserR{}
+				}
+				foreign.R.UpdateUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRoleKeyDataRole of the tblUser to the related item.
+// Sets o.R.RoleKeyDataRole to related.
+// Adds o to related.R.RoleKeyTBLUsers.
+func (o *TBLUser) SetRoleKeyDataRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataRole) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_users` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"role_key"}),
+		strmangle.WhereClause("`", "`", 0, tblUserPrimaryKeyColumns),
+	)
+	values := []interface{}{related.RoleKey, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleKey = related.RoleKey
+	if o.R == nil {
+		o.R = &tblUserR{
+			RoleKeyDataRole: related,
+		}
+	} else {
+		o.R.RoleKeyDataRole = related
+	}
+
+	if related.R == nil {
+		related.R = &dataRoleR{
+			RoleKeyTBLUsers: TBLUserSlice{o},
+		}
+	} else {
+		related.R.RoleKeyTBLUsers = append(related.R.RoleKeyTBLUsers, o)
+	}
+
+	return nil
+}
+
+// SetUpdateUser of the tblUser to the related item.
+// Sets o.R.UpdateUser to related.
+// Adds o to related.R.UpdateUserTBLUsers.
+func (o *TBLUser) SetUpdateUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUser) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `tbl_users` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"update_user_id"}),
+		strmangle.WhereClause("`", "`", 0, tblUserPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UpdateUserID = related.ID
+	if o.R == nil {
+		o.R = &tblUserR{
+			UpdateUser: related,
+		}
+	} else {
+		o.R.UpdateUser = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserR{
+			UpdateUserTBLUsers: TBLUserSlice{o},
+		}
+	} else {
+		related.R.UpdateUserTBLUsers = append(related.R.UpdateUserTBLUsers, o)
+	}
+
+	return nil
+}
+
+// SetUserTBLUserTwoFactor of the tblUser to the related item.
+// Sets o.R.UserTBLUserTwoFactor to related.
+// Adds o to related.R.User.
+func (o *TBLUser) SetUserTBLUserTwoFactor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TBLUserTwoFactor) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `tbl_user_two_factors` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+			strmangle.WhereClause("`", "`", 0, tblUserTwoFactorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLUserTwoFactor: related,
+		}
+	} else {
+		o.R.UserTBLUserTwoFactor = related
+	}
+
+	if related.R == nil {
+		related.R = &tblUserTwoFactorR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddUserTBLLoginAttempts adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLLoginAttempts.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLLoginAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLLoginAttempt) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_login_attempts` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblLoginAttemptPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLLoginAttempts: related,
+		}
+	} else {
+		o.R.UserTBLLoginAttempts = append(o.R.UserTBLLoginAttempts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblLoginAttemptR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetUserTBLLoginAttempts removes all previously related items of the
+// tbl_user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's UserTBLLoginAttempts accordingly.
+// Replaces o.R.UserTBLLoginAttempts with related.
+// Sets related.R.User's UserTBLLoginAttempts accordingly.
+func (o *TBLUser) SetUserTBLLoginAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLLoginAttempt) error {
+	query := "update `tbl_login_attempts` set `user_id` = null where `user_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.UserTBLLoginAttempts {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.UserTBLLoginAttempts = nil
+	}
+
+	return o.AddUserTBLLoginAttempts(ctx, exec, insert, related...)
+}
+
+// RemoveUserTBLLoginAttempts relationships from objects passed in.
+// Removes related items from R.UserTBLLoginAttempts (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *TBLUser) RemoveUserTBLLoginAttempts(ctx context.Context, exec boil.ContextExecutor, related ...*TBLLoginAttempt) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.UserTBLLoginAttempts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.UserTBLLoginAttempts)
+			if ln > 1 && i < ln-1 {
+				o.R.UserTBLLoginAttempts[i] = o.R.UserTBLLoginAttempts[ln-1]
+			}
+			o.R.UserTBLLoginAttempts = o.R.UserTBLLoginAttempts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRequestUserTBLScheduleChangeRequests adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.RequestUserTBLScheduleChangeRequests.
+// Sets related.R.RequestUser appropriately.
+func (o *TBLUser) AddRequestUserTBLScheduleChangeRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleChangeRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RequestUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_change_requests` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"request_user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleChangeRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RequestUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			RequestUserTBLScheduleChangeRequests: related,
+		}
+	} else {
+		o.R.RequestUserTBLScheduleChangeRequests = append(o.R.RequestUserTBLScheduleChangeRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleChangeRequestR{
+				RequestUser: o,
+			}
+		} else {
+			rel.R.RequestUser = o
+		}
+	}
+	return nil
+}
+
+// AddUserTBLScheduleCollaborators adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLScheduleCollaborators.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLScheduleCollaborators(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleCollaborator) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_collaborators` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleCollaboratorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLScheduleCollaborators: related,
+		}
+	} else {
+		o.R.UserTBLScheduleCollaborators = append(o.R.UserTBLScheduleCollaborators, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleCollaboratorR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorUserTBLScheduleComments adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.AuthorUserTBLScheduleComments.
+// Sets related.R.AuthorUser appropriately.
+func (o *TBLUser) AddAuthorUserTBLScheduleComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AuthorUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_comments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"author_user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AuthorUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			AuthorUserTBLScheduleComments: related,
+		}
+	} else {
+		o.R.AuthorUserTBLScheduleComments = append(o.R.AuthorUserTBLScheduleComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleCommentR{
+				AuthorUser: o,
+			}
+		} else {
+			rel.R.AuthorUser = o
+		}
+	}
+	return nil
+}
+
+// AddCreateUserTBLScheduleDayBundles adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.CreateUserTBLScheduleDayBundles.
+// Sets related.R.CreateUserTBLUser appropriately.
+func (o *TBLUser) AddCreateUserTBLScheduleDayBundles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLScheduleDayBundle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreateUser = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedule_day_bundles` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"create_user"}),
+				strmangle.WhereClause("`", "`", 0, tblScheduleDayBundlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreateUser = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			CreateUserTBLScheduleDayBundles: related,
+		}
+	} else {
+		o.R.CreateUserTBLScheduleDayBundles = append(o.R.CreateUserTBLScheduleDayBundles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleDayBundleR{
+				CreateUserTBLUser: o,
+			}
+		} else {
+			rel.R.CreateUserTBLUser = o
+		}
+	}
+	return nil
+}
+
+// AddCreateUserTBLSchedules adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.CreateUserTBLSchedules.
+// Sets related.R.CreateUserTBLUser appropriately.
+func (o *TBLUser) AddCreateUserTBLSchedules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLSchedule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreateUser = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedules` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"create_user"}),
+				strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreateUser = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			CreateUserTBLSchedules: related,
+		}
+	} else {
+		o.R.CreateUserTBLSchedules = append(o.R.CreateUserTBLSchedules, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblScheduleR{
+				CreateUserTBLUser: o,
+			}
+		} else {
+			rel.R.CreateUserTBLUser = o
+		}
+	}
+	return nil
+}
+
+// AddLastUpdateUserTBLSchedules adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.LastUpdateUserTBLSchedules.
+// Sets related.R.LastUpdateUserTBLUser appropriately.
+func (o *TBLUser) AddLastUpdateUserTBLSchedules(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLSchedule) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LastUpdateUser = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_schedules` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"last_update_user"}),
+				strmangle.WhereClause("`", "`", 0, tblSchedulePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LastUpdateUser = o.ID
+		}
+	}
+
+	if o.R == nil {
 		o.R = &tblUserR{
 			LastUpdateUserTBLSchedules: related,
 		}
@@ -1373,6 +3223,218 @@ func (o *TBLUser) AddLastUpdateUserTBLSchedules(ctx context.Context, exec boil.C
 	return nil
 }
 
+// AddUserTBLUserAPITokens adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLUserAPITokens.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLUserAPITokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserAPIToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_api_tokens` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblUserAPITokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLUserAPITokens: related,
+		}
+	} else {
+		o.R.UserTBLUserAPITokens = append(o.R.UserTBLUserAPITokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserAPITokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserTBLUserCampusRoles adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLUserCampusRoles.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLUserCampusRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserCampusRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_campus_roles` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblUserCampusRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLUserCampusRoles: related,
+		}
+	} else {
+		o.R.UserTBLUserCampusRoles = append(o.R.UserTBLUserCampusRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserCampusRoleR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserTBLUserIdentities adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLUserIdentities.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_identities` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblUserIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLUserIdentities: related,
+		}
+	} else {
+		o.R.UserTBLUserIdentities = append(o.R.UserTBLUserIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserIdentityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddUserTBLUserRecoveryCodes adds the given related objects to the existing relationships
+// of the tbl_user, optionally inserting them as new records.
+// Appends related to o.R.UserTBLUserRecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *TBLUser) AddUserTBLUserRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TBLUserRecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `tbl_user_recovery_codes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, tblUserRecoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &tblUserR{
+			UserTBLUserRecoveryCodes: related,
+		}
+	} else {
+		o.R.UserTBLUserRecoveryCodes = append(o.R.UserTBLUserRecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tblUserRecoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
 // AddUpdateUserTBLUsers adds the given related objects to the existing relationships
 // of the tbl_user, optionally inserting them as new records.
 // Appends related to o.R.UpdateUserTBLUsers.
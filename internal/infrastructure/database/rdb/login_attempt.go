@@ -0,0 +1,84 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type LoginAttempt struct {
+	c *sql.DB
+}
+
+func NewLoginAttemptRepository(c IMySQL) repository.LoginAttemptRepository {
+	return &LoginAttempt{c: c.GetConn()}
+}
+
+func (f *LoginAttempt) Save(ctx context.Context, tx *sql.Tx, model *loginattempt.RootLoginAttemptModel) error {
+
+	record := &dto.TBLLoginAttempt{
+		UserName:  model.UserName(),
+		UserID:    null.NewInt(model.UserID().Value(), model.UserID().IsValid()),
+		IPAddress: model.IPAddress(),
+		UserAgent: model.UserAgent(),
+		Result:    model.Result().Value(),
+		CreatedAt: model.CreatedAt(),
+	}
+
+	if err := record.Insert(ctx, tx, boil.Infer()); err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *LoginAttempt) FindRecentByUser(ctx context.Context, userID vo.UserID, userName string, limit int) ([]*loginattempt.RootLoginAttemptModel, error) {
+
+	records, err := dto.TBLLoginAttempts(
+		dto.TBLLoginAttemptWhere.UserID.EQ(null.IntFrom(userID.Value())),
+		qm.Or2(dto.TBLLoginAttemptWhere.UserName.EQ(userName)),
+		qm.OrderBy(dto.TBLLoginAttemptColumns.ID+" DESC"),
+		qm.Limit(limit),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make([]*loginattempt.RootLoginAttemptModel, 0, len(records))
+	for _, record := range records {
+
+		result, err := vo.NewLoginAttemptResult(record.Result)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+		attemptUserID := vo.USER_ID_INITIAL
+		if record.UserID.Valid {
+			attemptUserID, err = vo.NewUserID(record.UserID.Int)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+			}
+		}
+
+		models = append(models, loginattempt.NewRootLoginAttemptModel(
+			record.ID,
+			record.UserName,
+			attemptUserID,
+			record.IPAddress,
+			record.UserAgent,
+			result,
+			record.CreatedAt,
+		))
+	}
+
+	return models, nil
+}
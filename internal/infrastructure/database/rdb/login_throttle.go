@@ -0,0 +1,122 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type LoginThrottle struct {
+	c *sql.DB
+}
+
+func NewLoginThrottleRepository(c IMySQL) repository.LoginThrottleRepository {
+	return &LoginThrottle{c: c.GetConn()}
+}
+
+func (f *LoginThrottle) CreateIfNotExists(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error {
+
+	// 一意制約に重複した場合は更新せずに既存の行を残す
+	query := `
+	INSERT INTO tbl_login_throttles(
+		throttle_type,
+		throttle_key,
+		failure_count,
+		last_failed_at
+	)
+	VALUES (?, ?, ?, ?)
+	ON DUPLICATE KEY UPDATE id = id`
+
+	_, err := tx.ExecContext(ctx, query,
+		string(model.ThrottleType()),
+		model.Key(),
+		model.FailureCount(),
+		model.LastFailedAt(),
+	)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *LoginThrottle) Save(ctx context.Context, tx *sql.Tx, model *loginthrottle.RootLoginThrottleModel) error {
+
+	record := &dto.TBLLoginThrottle{
+		ThrottleType: string(model.ThrottleType()),
+		ThrottleKey:  model.Key(),
+		FailureCount: model.FailureCount(),
+		LastFailedAt: model.LastFailedAt(),
+		LockedUntil:  null.NewTime(model.LockedUntil(), !model.LockedUntil().IsZero()),
+	}
+
+	err := record.Upsert(ctx, tx,
+		boil.Whitelist(
+			dto.TBLLoginThrottleColumns.FailureCount,
+			dto.TBLLoginThrottleColumns.LastFailedAt,
+			dto.TBLLoginThrottleColumns.LockedUntil,
+			dto.TBLLoginThrottleColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *LoginThrottle) Delete(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) error {
+
+	_, err := dto.TBLLoginThrottles(
+		dto.TBLLoginThrottleWhere.ThrottleType.EQ(string(throttleType)),
+		dto.TBLLoginThrottleWhere.ThrottleKey.EQ(key),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *LoginThrottle) FindByKey(ctx context.Context, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error) {
+
+	return f.findOne(ctx, f.c, throttleType, key)
+}
+
+func (f *LoginThrottle) FindByKeyWithLock(ctx context.Context, tx *sql.Tx, throttleType loginthrottle.ThrottleType, key string) (*loginthrottle.RootLoginThrottleModel, error) {
+
+	return f.findOne(ctx, tx, throttleType, key, qm.For("UPDATE"))
+}
+
+func (f *LoginThrottle) findOne(ctx context.Context, exec boil.ContextExecutor, throttleType loginthrottle.ThrottleType, key string, mods ...qm.QueryMod) (*loginthrottle.RootLoginThrottleModel, error) {
+
+	mods = append(mods,
+		dto.TBLLoginThrottleWhere.ThrottleType.EQ(string(throttleType)),
+		dto.TBLLoginThrottleWhere.ThrottleKey.EQ(key),
+	)
+
+	record, err := dto.TBLLoginThrottles(mods...).One(ctx, exec)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return loginthrottle.NewRootLoginThrottleModel(
+		throttleType,
+		record.ThrottleKey,
+		record.FailureCount,
+		record.LastFailedAt,
+		record.LockedUntil.Time,
+	), nil
+}
@@ -0,0 +1,42 @@
+package permission
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	permissionRepository "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/permission"
+)
+
+type PermissionQuery struct {
+	c *sql.DB
+}
+
+func NewPermissionQueryRepository(c rdb.IMySQL) permissionRepository.PermissionQueryRepository {
+	return &PermissionQuery{c: c.GetConn()}
+}
+
+func (f *PermissionQuery) FindAll(ctx context.Context) ([]*permissionRepository.QueryPermissionDTO, error) {
+
+	records, err := dto.DataPermissions(
+		qm.OrderBy(dto.DataPermissionColumns.OrderIndex),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	result := make([]*permissionRepository.QueryPermissionDTO, 0, len(records))
+	for _, record := range records {
+		result = append(result, &permissionRepository.QueryPermissionDTO{
+			PermissionKey:  record.PermissionKey,
+			PermissionName: record.PermissionName,
+			OrderIndex:     record.OrderIndex,
+		})
+	}
+
+	return result, nil
+}
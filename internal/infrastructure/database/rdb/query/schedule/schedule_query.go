@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
@@ -19,13 +20,20 @@ func NewScheduleQueryRepository(c rdb.IMySQL) schedulelist.ScheduleListQueryRepo
 	return &ScheduleQuery{c: c.GetConn()}
 }
 
-func (f *ScheduleQuery) GetListByCampus(ctx context.Context, campus string) ([]*schedulelist.QueryScheduleDTO, error) {
+func (f *ScheduleQuery) GetListByCampus(ctx context.Context, campus string, statuses []string) ([]*schedulelist.QueryScheduleDTO, error) {
 
-	scheduleDTOs, err := dto.TBLSchedules(
+	mods := []qm.QueryMod{
 		dto.TBLScheduleWhere.Campus.EQ(campus),
 		qm.Load(dto.TBLScheduleRels.CreateUserTBLUser),
 		qm.Load(dto.TBLScheduleRels.LastUpdateUserTBLUser),
-	).All(ctx, f.c)
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
+	}
+
+	if len(statuses) > 0 {
+		mods = append(mods, dto.TBLScheduleWhere.Status.IN(statuses))
+	}
+
+	scheduleDTOs, err := dto.TBLSchedules(mods...).All(ctx, f.c)
 
 	if err != nil {
 		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
@@ -41,14 +49,17 @@ func (f *ScheduleQuery) toList(scheduleDTOs []*dto.TBLSchedule) []*schedulelist.
 	for _, scheduleDTO := range scheduleDTOs {
 
 		schedule := &schedulelist.QueryScheduleDTO{
-			ID:                 scheduleDTO.ID,
-			Campus:             scheduleDTO.Campus,
-			Title:              scheduleDTO.Title,
-			CreateUserName:     "不明なユーザー",
-			LastUpdateUserName: "不明なユーザー",
-			CreateUser:         scheduleDTO.CreateUser,
-			UpdatedAt:          scheduleDTO.UpdatedAt,
-			CreatedAt:          scheduleDTO.CreatedAt,
+			ID:                  scheduleDTO.ID,
+			Campus:              scheduleDTO.Campus,
+			Title:               scheduleDTO.Title,
+			Status:              scheduleDTO.Status,
+			ReviewComment:       scheduleDTO.ReviewComment,
+			CreateUserName:      "不明なユーザー",
+			LastUpdateUserName:  "不明なユーザー",
+			CreateUser:          scheduleDTO.CreateUser,
+			CollaboratorUserIDs: []int{},
+			UpdatedAt:           scheduleDTO.UpdatedAt,
+			CreatedAt:           scheduleDTO.CreatedAt,
 		}
 
 		if scheduleDTO.R != nil {
@@ -62,6 +73,10 @@ func (f *ScheduleQuery) toList(scheduleDTOs []*dto.TBLSchedule) []*schedulelist.
 			if rel.LastUpdateUserTBLUser != nil {
 				schedule.LastUpdateUserName = rel.LastUpdateUserTBLUser.Name
 			}
+
+			schedule.CollaboratorUserIDs = lo.Map(rel.ScheduleTBLScheduleCollaborators, func(item *dto.TBLScheduleCollaborator, _ int) int {
+				return item.UserID
+			})
 		}
 
 		scheduleList = append(scheduleList, schedule)
@@ -5,6 +5,9 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/role"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
@@ -22,7 +25,9 @@ func NewRoleRepository(c IMySQL) repository.RoleRepository {
 
 func (c *Role) FindAll(ctx context.Context) (role.RootRoleModelSlice, error) {
 
-	roleDTOs, err := dto.DataRoles().All(ctx, c.c)
+	roleDTOs, err := dto.DataRoles(
+		qm.Load(dto.DataRoleRels.RoleKeyDataRolePermissions),
+	).All(ctx, c.c)
 
 	if err != nil {
 		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
@@ -44,6 +49,75 @@ func (c *Role) FindAll(ctx context.Context) (role.RootRoleModelSlice, error) {
 	return models, nil
 }
 
+func (c *Role) FindByRoleKey(ctx context.Context, roleKey vo.RoleKey) (*role.RootRoleModel, error) {
+
+	roleDTO, err := dto.DataRoles(
+		dto.DataRoleWhere.RoleKey.EQ(roleKey.Value()),
+		qm.Load(dto.DataRoleRels.RoleKeyDataRolePermissions),
+	).One(ctx, c.c)
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	if roleDTO == nil {
+		return nil, nil
+	}
+
+	model, err := c.toModel(roleDTO)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return model, nil
+}
+
+func (c *Role) Save(ctx context.Context, tx *sql.Tx, rootModel *role.RootRoleModel) error {
+
+	roleDTO := &dto.DataRole{
+		ID:       rootModel.ID().Value(),
+		RoleKey:  rootModel.RoleKey().Value(),
+		RoleName: rootModel.RoleName().Value(),
+		Builtin:  lo.Ternary(rootModel.IsBuiltin(), 1, 0),
+	}
+
+	var err error
+
+	// 新規登録の場合
+	if rootModel.ID() == vo.ROLE_ID_INITIAL {
+		err = roleDTO.Insert(ctx, tx, boil.Infer())
+	} else {
+		_, err = roleDTO.Update(ctx, tx, boil.Whitelist(
+			dto.DataRoleColumns.RoleName,
+		))
+	}
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.DataRolePermissions(
+		dto.DataRolePermissionWhere.RoleKey.EQ(rootModel.RoleKey().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, permission := range rootModel.Permissions() {
+
+		permissionDTO := &dto.DataRolePermission{
+			RoleKey:       rootModel.RoleKey().Value(),
+			PermissionKey: permission.Value(),
+		}
+
+		err = permissionDTO.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
 func (d *Role) toModel(record *dto.DataRole) (*role.RootRoleModel, error) {
 
 	var id vo.RoleID
@@ -55,6 +129,9 @@ func (d *Role) toModel(record *dto.DataRole) (*role.RootRoleModel, error) {
 	errs = errors.Join(errs, vo.SetVOConstructor(&roleKey, vo.NewRoleKey, record.RoleKey))
 	errs = errors.Join(errs, vo.SetVOConstructor(&roleName, vo.NewRoleName, record.RoleName))
 
+	permissions, err := toPermissions(record)
+	errs = errors.Join(errs, err)
+
 	if errs != nil {
 		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
 	}
@@ -63,5 +140,27 @@ func (d *Role) toModel(record *dto.DataRole) (*role.RootRoleModel, error) {
 		id,
 		roleKey,
 		roleName,
+		record.Builtin == 1,
+		permissions,
 	), nil
 }
+
+// ロールに割り当てられた権限 権限が読み込まれていない場合は空
+func toPermissions(record *dto.DataRole) (vo.Permissions, error) {
+
+	permissions := make(vo.Permissions, 0)
+	if record == nil || record.R == nil {
+		return permissions, nil
+	}
+
+	var errs error
+	for _, permissionDTO := range record.R.RoleKeyDataRolePermissions {
+
+		var permission vo.Permission
+		errs = errors.Join(errs, vo.SetVOConstructor(&permission, vo.NewPermission, permissionDTO.PermissionKey))
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, errs
+}
@@ -0,0 +1,105 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/itemtype"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type RoomItemType struct {
+	c *sql.DB
+}
+
+func NewRoomItemTypeRepository(c IMySQL) repository.RoomItemTypeRepository {
+	return &RoomItemType{c: c.GetConn()}
+}
+
+func (f *RoomItemType) Save(ctx context.Context, tx *sql.Tx, campus vo.Campus, slice itemtype.RootRoomItemTypeModelSlice) error {
+
+	// 全て削除してから登録し直す
+	_, err := dto.DataCampusRoomItemTypes(
+		dto.DataCampusRoomItemTypeWhere.Campus.EQ(campus.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, model := range slice {
+
+		err := f.toDTO(model).Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (f *RoomItemType) FindByCampus(ctx context.Context, campus vo.Campus) (itemtype.RootRoomItemTypeModelSlice, error) {
+
+	records, err := dto.DataCampusRoomItemTypes(
+		dto.DataCampusRoomItemTypeWhere.Campus.EQ(campus.Value()),
+		qm.OrderBy(dto.DataCampusRoomItemTypeColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make([]*itemtype.RootRoomItemTypeModel, 0, len(records))
+	for _, record := range records {
+
+		model, err := f.toModel(record)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
+func (f *RoomItemType) toModel(record *dto.DataCampusRoomItemType) (*itemtype.RootRoomItemTypeModel, error) {
+
+	var errs error
+
+	var campus vo.Campus
+	var itemTag vo.RoomItemTag
+	var label vo.RoomItemTypeLabel
+	var color vo.RoomItemTypeColor
+
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, record.Campus))
+	errs = errors.Join(errs, vo.SetVOConstructor(&itemTag, vo.NewRoomItemTag, record.ItemTag))
+	errs = errors.Join(errs, vo.SetVOConstructor(&label, vo.NewRoomItemTypeLabel, record.Label))
+	errs = errors.Join(errs, vo.SetVOConstructor(&color, vo.NewRoomItemTypeColor, record.Color))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+	}
+
+	model, err := itemtype.NewRootRoomItemTypeModel(campus, itemTag, label, color)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return model, nil
+}
+
+func (f *RoomItemType) toDTO(model *itemtype.RootRoomItemTypeModel) *dto.DataCampusRoomItemType {
+
+	return &dto.DataCampusRoomItemType{
+		Campus:  model.Campus().Value(),
+		ItemTag: model.ItemTag().Value(),
+		Label:   model.Label().Value(),
+		Color:   model.Color().Value(),
+	}
+}
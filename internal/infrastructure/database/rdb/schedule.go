@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
@@ -54,6 +56,16 @@ func (f *Schedule) Save(ctx context.Context, tx *sql.Tx, rootModel *schedule.Roo
 			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
 		}
 
+		err = f.toItemGroupBulkInsert(ctx, tx, scheduleID, rootModel.HistoryIndex(), rootModel.ItemGroups())
+		if err != nil {
+			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+		err = f.toHistoryInsert(ctx, tx, scheduleID, rootModel)
+		if err != nil {
+			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
 	} else {
 
 		// 既存のデータを取得
@@ -70,9 +82,14 @@ func (f *Schedule) Save(ctx context.Context, tx *sql.Tx, rootModel *schedule.Roo
 		scheduleDTO := f.toScheduleDTO(rootModel)
 		_, err = scheduleDTO.Update(ctx, tx, boil.Whitelist(
 			dto.TBLScheduleColumns.Title,
+			dto.TBLScheduleColumns.TargetDate,
 			dto.TBLScheduleColumns.HistoryIndex,
-			dto.TBLScheduleColumns.StartTime,
-			dto.TBLScheduleColumns.EndTime,
+			dto.TBLScheduleColumns.StartTimeHour,
+			dto.TBLScheduleColumns.StartTimeMinutes,
+			dto.TBLScheduleColumns.EndTimeHour,
+			dto.TBLScheduleColumns.EndTimeMinutes,
+			dto.TBLScheduleColumns.Status,
+			dto.TBLScheduleColumns.ReviewComment,
 			dto.TBLScheduleColumns.LastUpdateUser,
 			dto.TBLScheduleColumns.UpdatedAt,
 		))
@@ -101,6 +118,24 @@ func (f *Schedule) Save(ctx context.Context, tx *sql.Tx, rootModel *schedule.Roo
 			if err != nil {
 				return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
 			}
+
+			_, err = tx.Exec(
+				"DELETE FROM tbl_schedule_item_groups WHERE schedule_id = ? AND history_index >= ? ",
+				scheduleDTO.ID,
+				scheduleDTO.HistoryIndex,
+			)
+			if err != nil {
+				return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+			}
+
+			_, err = tx.Exec(
+				"DELETE FROM tbl_schedule_histories WHERE schedule_id = ? AND history_index >= ? ",
+				scheduleDTO.ID,
+				scheduleDTO.HistoryIndex,
+			)
+			if err != nil {
+				return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+			}
 		}
 
 		err = f.toItemBulkInsert(ctx, tx, rootModel.ID(), rootModel.HistoryIndex(), rootModel.Items())
@@ -113,12 +148,61 @@ func (f *Schedule) Save(ctx context.Context, tx *sql.Tx, rootModel *schedule.Roo
 			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
 		}
 
+		err = f.toItemGroupBulkInsert(ctx, tx, rootModel.ID(), rootModel.HistoryIndex(), rootModel.ItemGroups())
+		if err != nil {
+			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
+		err = f.toHistoryInsert(ctx, tx, rootModel.ID(), rootModel)
+		if err != nil {
+			return vo.SCHEDULE_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+
 		scheduleID = rootModel.ID()
 	}
 
 	return scheduleID, nil
 }
 
+// 所有者と共同編集者を保存する。アイテムの履歴には影響しない
+func (f *Schedule) SaveAccessControl(ctx context.Context, tx *sql.Tx, rootModel *schedule.RootScheduleModel) error {
+
+	scheduleDTO := &dto.TBLSchedule{
+		ID:         rootModel.ID().Value(),
+		CreateUser: rootModel.CreateUser().Value(),
+	}
+
+	_, err := scheduleDTO.Update(ctx, tx, boil.Whitelist(
+		dto.TBLScheduleColumns.CreateUser,
+	))
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.TBLScheduleCollaborators(
+		dto.TBLScheduleCollaboratorWhere.ScheduleID.EQ(rootModel.ID().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, collaborator := range rootModel.Collaborators() {
+
+		collaboratorDTO := &dto.TBLScheduleCollaborator{
+			ScheduleID: rootModel.ID().Value(),
+			UserID:     collaborator.UserID().Value(),
+			Permission: collaborator.Permission().Value(),
+		}
+
+		err = collaboratorDTO.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
 func (f *Schedule) Delete(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, deleteUserID vo.UserID) error {
 
 	// 既存のデータを取得
@@ -138,6 +222,58 @@ func (f *Schedule) Delete(ctx context.Context, tx *sql.Tx, scheduleID vo.Schedul
 		if err != nil {
 			return log.WrapErrorWithStackTraceInternalServerError(err)
 		}
+
+		_, err = existsRecord.R.ScheduleTBLScheduleItemGroups.DeleteAll(ctx, tx)
+		if err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	_, err = dto.TBLScheduleHistories(
+		dto.TBLScheduleHistoryWhere.ScheduleID.EQ(scheduleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	// スケジュールに付けられたコメントも削除する
+	_, err = dto.TBLScheduleComments(
+		dto.TBLScheduleCommentWhere.ScheduleID.EQ(scheduleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	// 変更提案も削除する
+	_, err = tx.Exec(
+		"DELETE o FROM tbl_schedule_change_request_operations o INNER JOIN tbl_schedule_change_requests r ON o.change_request_id = r.id WHERE r.schedule_id = ? ",
+		scheduleID.Value(),
+	)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.TBLScheduleChangeRequests(
+		dto.TBLScheduleChangeRequestWhere.ScheduleID.EQ(scheduleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	// 共同編集者も削除する
+	_, err = dto.TBLScheduleCollaborators(
+		dto.TBLScheduleCollaboratorWhere.ScheduleID.EQ(scheduleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	// 同日スケジュールのまとまりからも外す
+	_, err = dto.TBLScheduleDayBundleMembers(
+		dto.TBLScheduleDayBundleMemberWhere.ScheduleID.EQ(scheduleID.Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
 	}
 
 	// スケジュールを更新
@@ -155,6 +291,9 @@ func (f *Schedule) findByIDWithHistoryIndex(ctx context.Context, tx *sql.Tx, sch
 		dto.TBLScheduleWhere.ID.EQ(scheduleID.Value()),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItems, dto.TBLScheduleItemWhere.HistoryIndex.EQ(historyIndex.Value())),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleRoomItems, dto.TBLScheduleRoomItemWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItemGroups, dto.TBLScheduleItemGroupWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleHistories, dto.TBLScheduleHistoryWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
 	)
 
 	var scheduleRecords *dto.TBLSchedule
@@ -178,6 +317,8 @@ func (f *Schedule) findByID(ctx context.Context, scheduleID vo.ScheduleID) (*dto
 		dto.TBLScheduleWhere.ID.EQ(scheduleID.Value()),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItems),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleRoomItems),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItemGroups),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
 	).One(ctx, f.c)
 
 	if err != nil && err != sql.ErrNoRows {
@@ -193,6 +334,8 @@ func (f *Schedule) FindByIDWithLock(ctx context.Context, tx *sql.Tx, scheduleID
 		dto.TBLScheduleWhere.ID.EQ(scheduleID.Value()),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItems),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleRoomItems),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItemGroups),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
 		qm.For("UPDATE"),
 	).One(ctx, tx)
 
@@ -218,6 +361,9 @@ func (f *Schedule) FindByIDWithLockHistoryIndex(ctx context.Context, tx *sql.Tx,
 		dto.TBLScheduleWhere.ID.EQ(scheduleID.Value()),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItems, dto.TBLScheduleItemWhere.HistoryIndex.EQ(historyIndex.Value())),
 		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleRoomItems, dto.TBLScheduleRoomItemWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleItemGroups, dto.TBLScheduleItemGroupWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleHistories, dto.TBLScheduleHistoryWhere.HistoryIndex.EQ(historyIndex.Value())),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
 		qm.For("UPDATE"),
 	).One(ctx, tx)
 
@@ -285,21 +431,82 @@ func (f *Schedule) FindByID(ctx context.Context, scheduleID vo.ScheduleID) (*sch
 	return latestModel, nil
 }
 
+func (f *Schedule) FindAccessControlByUserIDWithLock(ctx context.Context, tx *sql.Tx, userID vo.UserID) ([]*schedule.RootScheduleModel, error) {
+
+	collaboratorRecords, err := dto.TBLScheduleCollaborators(
+		dto.TBLScheduleCollaboratorWhere.UserID.EQ(userID.Value()),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	ownedRecords, err := dto.TBLSchedules(
+		qm.Select(dto.TBLScheduleColumns.ID),
+		dto.TBLScheduleWhere.CreateUser.EQ(userID.Value()),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	scheduleIDs := lo.Uniq(append(
+		lo.Map(ownedRecords, func(item *dto.TBLSchedule, _ int) int {
+			return item.ID
+		}),
+		lo.Map(collaboratorRecords, func(item *dto.TBLScheduleCollaborator, _ int) int {
+			return item.ScheduleID
+		})...,
+	))
+
+	if len(scheduleIDs) == 0 {
+		return []*schedule.RootScheduleModel{}, nil
+	}
+
+	scheduleRecords, err := dto.TBLSchedules(
+		dto.TBLScheduleWhere.ID.IN(scheduleIDs),
+		qm.Load(dto.TBLScheduleRels.ScheduleTBLScheduleCollaborators),
+		qm.OrderBy(dto.TBLScheduleColumns.ID),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	models := make([]*schedule.RootScheduleModel, 0, len(scheduleRecords))
+	for _, scheduleRecord := range scheduleRecords {
+
+		model, err := f.toModel(scheduleRecord)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		models = append(models, model)
+	}
+
+	return models, nil
+}
+
 func (f *Schedule) toScheduleDTO(root *schedule.RootScheduleModel) *dto.TBLSchedule {
 
-	startTime, endTime := root.ScheduleTime().Value()
+	startTimeHour, startTimeMinutes := root.ScheduleTime().StartTimeValue()
+	endTimeHour, endTimeMinutes := root.ScheduleTime().EndTimeValue()
+	targetDate, targetDateValid := root.TargetDate().Time()
 
 	return &dto.TBLSchedule{
-		ID:             root.ID().Value(),
-		Campus:         root.Campus().Value(),
-		Title:          root.Title().Value(),
-		HistoryIndex:   root.HistoryIndex().Value(),
-		StartTime:      startTime,
-		EndTime:        endTime,
-		CreateUser:     root.CreateUser().Value(),
-		LastUpdateUser: root.LastUpdateUser().Value(),
-		UpdatedAt:      time.Now(),
-		CreatedAt:      time.Now(),
+		ID:               root.ID().Value(),
+		Campus:           root.Campus().Value(),
+		Title:            root.Title().Value(),
+		TargetDate:       null.NewTime(targetDate, targetDateValid),
+		HistoryIndex:     root.HistoryIndex().Value(),
+		StartTimeHour:    startTimeHour,
+		StartTimeMinutes: startTimeMinutes,
+		EndTimeHour:      endTimeHour,
+		EndTimeMinutes:   endTimeMinutes,
+		Status:           root.Status().Value(),
+		ReviewComment:    root.ReviewComment().Value(),
+		CreateUser:       root.CreateUser().Value(),
+		LastUpdateUser:   root.LastUpdateUser().Value(),
+		UpdatedAt:        time.Now(),
+		CreatedAt:        time.Now(),
 	}
 }
 
@@ -354,14 +561,14 @@ func (f *Schedule) toRoomItemBulkInsert(ctx context.Context, tx *sql.Tx, shedule
 		return nil
 	}
 
-	const COLUMUN_COUNT = 11
+	const COLUMUN_COUNT = 13
 
 	placeholders := make([]string, len(roomItems))
 	values := make([]any, len(roomItems)*COLUMUN_COUNT)
 
 	for index, roomItem := range roomItems {
 
-		placeholders[index] = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+		placeholders[index] = "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 
 		startTimeHoue, startTimeMinute := roomItem.StartTime().Value()
 		endTimeHoue, endTimeMinute := roomItem.EndTime().Value()
@@ -377,6 +584,8 @@ func (f *Schedule) toRoomItemBulkInsert(ctx context.Context, tx *sql.Tx, shedule
 		counter++
 		values[index*COLUMUN_COUNT+counter] = roomItem.Identifier().Value()
 		counter++
+		values[index*COLUMUN_COUNT+counter] = roomItem.Title().Value()
+		counter++
 		values[index*COLUMUN_COUNT+counter] = roomItem.Duration().Value()
 		counter++
 		values[index*COLUMUN_COUNT+counter] = startTimeHoue
@@ -388,6 +597,8 @@ func (f *Schedule) toRoomItemBulkInsert(ctx context.Context, tx *sql.Tx, shedule
 		values[index*COLUMUN_COUNT+counter] = endTimeMinute
 		counter++
 		values[index*COLUMUN_COUNT+counter] = roomItem.RoomIndex().Value()
+		counter++
+		values[index*COLUMUN_COUNT+counter] = lo.Ternary(roomItem.Pinned(), PINNED, UN_PINNED)
 	}
 
 	query := fmt.Sprintf(`
@@ -397,12 +608,68 @@ func (f *Schedule) toRoomItemBulkInsert(ctx context.Context, tx *sql.Tx, shedule
 		item_tag,
 		lesson_id,
 		identifier,
+		title,
 		duration,
 		start_time_hour,
 		start_time_minutes,
 		end_time_hour,
 		end_time_minutes,
-		room_index
+		room_index,
+		pinned
+	)
+	VALUES %s `, strings.Join(placeholders, ","))
+
+	_, err := tx.ExecContext(ctx, query, values...)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *Schedule) toItemGroupBulkInsert(ctx context.Context, tx *sql.Tx, sheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, itemGroups schedule.ScheduleItemGroupModelSlice) error {
+
+	memberCount := lo.SumBy(itemGroups, func(group *schedule.ScheduleItemGroupModel) int {
+		return len(group.Members())
+	})
+
+	if memberCount == 0 {
+		return nil
+	}
+
+	const COLUMUN_COUNT = 5
+
+	placeholders := make([]string, memberCount)
+	values := make([]any, memberCount*COLUMUN_COUNT)
+
+	index := 0
+	for _, group := range itemGroups {
+		for _, member := range group.Members() {
+
+			placeholders[index] = "(?, ?, ?, ?, ?)"
+
+			counter := 0
+			values[index*COLUMUN_COUNT+counter] = sheduleID.Value()
+			counter++
+			values[index*COLUMUN_COUNT+counter] = historyIndex.Value()
+			counter++
+			values[index*COLUMUN_COUNT+counter] = group.GroupIdentifier().Value()
+			counter++
+			values[index*COLUMUN_COUNT+counter] = member.Identifier().Value()
+			counter++
+			values[index*COLUMUN_COUNT+counter] = member.OffsetMinutes().Value()
+
+			index++
+		}
+	}
+
+	query := fmt.Sprintf(`
+	INSERT INTO tbl_schedule_item_groups(
+		schedule_id,
+		history_index,
+		group_identifier,
+		identifier,
+		offset_minutes
 	)
 	VALUES %s `, strings.Join(placeholders, ","))
 
@@ -414,6 +681,29 @@ func (f *Schedule) toRoomItemBulkInsert(ctx context.Context, tx *sql.Tx, shedule
 	return nil
 }
 
+// スケジュール時間は履歴ごとに保存し、履歴を戻したときに当時の時間に戻せるようにする
+func (f *Schedule) toHistoryInsert(ctx context.Context, tx *sql.Tx, sheduleID vo.ScheduleID, rootModel *schedule.RootScheduleModel) error {
+
+	startTimeHour, startTimeMinutes := rootModel.ScheduleTime().StartTimeValue()
+	endTimeHour, endTimeMinutes := rootModel.ScheduleTime().EndTimeValue()
+
+	historyDTO := &dto.TBLScheduleHistory{
+		ScheduleID:       sheduleID.Value(),
+		HistoryIndex:     rootModel.HistoryIndex().Value(),
+		StartTimeHour:    startTimeHour,
+		StartTimeMinutes: startTimeMinutes,
+		EndTimeHour:      endTimeHour,
+		EndTimeMinutes:   endTimeMinutes,
+	}
+
+	err := historyDTO.Insert(ctx, tx, boil.Infer())
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
 func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel, error) {
 
 	var id vo.ScheduleID
@@ -422,8 +712,12 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 	var historyIndex vo.HistoryIndex
 	var createUser vo.UserID
 	var lastUpdateUser vo.UserID
+	var status vo.ScheduleStatus
+	var reviewComment vo.ScheduleReviewComment
 	items := []*schedule.ScheduleItemModel{}
 	roomItems := []*schedule.ScheduleRoomItemModel{}
+	itemGroups := []*schedule.ScheduleItemGroupModel{}
+	collaborators := []*schedule.ScheduleCollaboratorModel{}
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&id, vo.NewScheduleID, record.ID))
@@ -432,10 +726,24 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, record.HistoryIndex))
 	errs = errors.Join(errs, vo.SetVOConstructor(&createUser, vo.NewUserID, record.CreateUser))
 	errs = errors.Join(errs, vo.SetVOConstructor(&lastUpdateUser, vo.NewUserID, record.LastUpdateUser))
+	errs = errors.Join(errs, vo.SetVOConstructor(&status, vo.NewScheduleStatus, record.Status))
+	errs = errors.Join(errs, vo.SetVOConstructor(&reviewComment, vo.NewScheduleReviewComment, record.ReviewComment))
+
+	// 履歴を指定して取得した場合はその履歴の時間を用いる。履歴ごとの時間がない場合はスケジュールの時間を用いる
+	startTimeHour, startTimeMinutes, endTimeHour, endTimeMinutes := record.StartTimeHour, record.StartTimeMinutes, record.EndTimeHour, record.EndTimeMinutes
+	if record.R != nil && len(record.R.ScheduleTBLScheduleHistories) > 0 {
+		history := record.R.ScheduleTBLScheduleHistories[0]
+		startTimeHour, startTimeMinutes, endTimeHour, endTimeMinutes = history.StartTimeHour, history.StartTimeMinutes, history.EndTimeHour, history.EndTimeMinutes
+	}
 
-	scheduleTime, err := vo.NewScheduleTime(record.StartTime, record.EndTime)
+	scheduleTime, err := vo.NewScheduleTime(startTimeHour, startTimeMinutes, endTimeHour, endTimeMinutes)
 	errs = errors.Join(errs, err)
 
+	targetDate := vo.SCHEDULE_TARGET_DATE_NONE
+	if record.TargetDate.Valid {
+		targetDate = vo.NewScheduleTargetDateFromTime(record.TargetDate.Time)
+	}
+
 	if errs != nil {
 		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
 	}
@@ -471,6 +779,7 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 			var itemTag vo.RoomItemTag
 			var lessonID vo.LessonID
 			var identifier vo.Identifier
+			var title vo.RoomItemTitle
 			var duration vo.LessonDuration
 			var roomIndex vo.RoomIndex
 
@@ -478,6 +787,7 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 			errs = errors.Join(errs, vo.SetVOConstructor(&itemTag, vo.NewRoomItemTag, recordItem.ItemTag))
 			errs = errors.Join(errs, vo.SetVOConstructor(&lessonID, vo.NewLessonID, recordItem.LessonID))
 			errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, recordItem.Identifier))
+			errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewRoomItemTitle, recordItem.Title))
 			errs = errors.Join(errs, vo.SetVOConstructor(&duration, vo.NewLessonDuration, recordItem.Duration))
 
 			startTime, err := vo.NewScheduleLessonTime(recordItem.StartTimeHour, recordItem.StartTimeMinutes)
@@ -496,10 +806,63 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 				itemTag,
 				lessonID,
 				identifier,
+				title,
 				duration,
 				startTime,
 				endTime,
 				roomIndex,
+				recordItem.Pinned == PINNED,
+			))
+		}
+
+		groupMembers := map[vo.Identifier][]*schedule.ScheduleItemGroupMemberModel{}
+		groupIdentifiers := []vo.Identifier{}
+		for _, recordItem := range record.R.ScheduleTBLScheduleItemGroups {
+
+			var groupIdentifier vo.Identifier
+			var identifier vo.Identifier
+			var offsetMinutes vo.ItemGroupOffsetMinutes
+
+			var errs error
+			errs = errors.Join(errs, vo.SetVOConstructor(&groupIdentifier, vo.NewIdentifier, recordItem.GroupIdentifier))
+			errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, recordItem.Identifier))
+			errs = errors.Join(errs, vo.SetVOConstructor(&offsetMinutes, vo.NewItemGroupOffsetMinutes, recordItem.OffsetMinutes))
+
+			if errs != nil {
+				return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+			}
+
+			if _, found := groupMembers[groupIdentifier]; !found {
+				groupIdentifiers = append(groupIdentifiers, groupIdentifier)
+			}
+
+			groupMembers[groupIdentifier] = append(groupMembers[groupIdentifier], schedule.NewScheduleItemGroupMemberModel(
+				identifier,
+				offsetMinutes,
+			))
+		}
+
+		itemGroups = lo.Map(groupIdentifiers, func(groupIdentifier vo.Identifier, _ int) *schedule.ScheduleItemGroupModel {
+			return schedule.NewScheduleItemGroupModel(groupIdentifier, groupMembers[groupIdentifier])
+		})
+
+		collaborators = make([]*schedule.ScheduleCollaboratorModel, 0, len(record.R.ScheduleTBLScheduleCollaborators))
+		for _, recordItem := range record.R.ScheduleTBLScheduleCollaborators {
+
+			var userID vo.UserID
+			var permission vo.CollaboratorPermission
+
+			var errs error
+			errs = errors.Join(errs, vo.SetVOConstructor(&userID, vo.NewUserID, recordItem.UserID))
+			errs = errors.Join(errs, vo.SetVOConstructor(&permission, vo.NewCollaboratorPermission, recordItem.Permission))
+
+			if errs != nil {
+				return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+			}
+
+			collaborators = append(collaborators, schedule.NewScheduleCollaboratorModel(
+				userID,
+				permission,
 			))
 		}
 	}
@@ -508,12 +871,17 @@ func (f *Schedule) toModel(record *dto.TBLSchedule) (*schedule.RootScheduleModel
 		id,
 		campus,
 		title,
+		targetDate,
 		historyIndex,
 		createUser,
 		lastUpdateUser,
 		items,
 		roomItems,
+		itemGroups,
 		scheduleTime,
+		status,
+		reviewComment,
+		collaborators,
 		record.CreatedAt,
 		record.UpdatedAt,
 	), nil
@@ -0,0 +1,231 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type SchedulingPolicy struct {
+	c *sql.DB
+}
+
+func NewSchedulingPolicyRepository(c IMySQL) repository.SchedulingPolicyRepository {
+	return &SchedulingPolicy{c: c.GetConn()}
+}
+
+func (f *SchedulingPolicy) Save(ctx context.Context, tx *sql.Tx, model *policy.RootSchedulingPolicyModel) error {
+
+	// 全て削除してから登録し直す
+	_, err := dto.DataCampusSchedulingPolicies(
+		dto.DataCampusSchedulingPolicyWhere.Campus.EQ(model.Campus().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.DataCampusOpeningHours(
+		dto.DataCampusOpeningHourWhere.Campus.EQ(model.Campus().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	_, err = dto.DataCampusBlockedPeriods(
+		dto.DataCampusBlockedPeriodWhere.Campus.EQ(model.Campus().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	policyDTO := &dto.DataCampusSchedulingPolicy{
+		Campus:            model.Campus().Value(),
+		GridMinutes:       model.GridMinutes().Value(),
+		MinLessonDuration: model.MinLessonDuration().Value(),
+		MaxLessonDuration: model.MaxLessonDuration().Value(),
+		MinGapMinutes:     model.MinGapMinutes().Value(),
+	}
+
+	if err := policyDTO.Insert(ctx, tx, boil.Infer()); err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, openingHour := range model.OpeningHours() {
+
+		openTimeHour, openTimeMinutes := openingHour.Hours().StartTimeValue()
+		closeTimeHour, closeTimeMinutes := openingHour.Hours().EndTimeValue()
+
+		openingHourDTO := &dto.DataCampusOpeningHour{
+			Campus:           model.Campus().Value(),
+			Weekday:          openingHour.Weekday().Value(),
+			OpenTimeHour:     openTimeHour,
+			OpenTimeMinutes:  openTimeMinutes,
+			CloseTimeHour:    closeTimeHour,
+			CloseTimeMinutes: closeTimeMinutes,
+		}
+
+		if err := openingHourDTO.Insert(ctx, tx, boil.Infer()); err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	for _, blockedPeriod := range model.BlockedPeriods() {
+
+		startTimeHour, startTimeMinutes := blockedPeriod.Period().StartTimeValue()
+		endTimeHour, endTimeMinutes := blockedPeriod.Period().EndTimeValue()
+
+		blockedPeriodDTO := &dto.DataCampusBlockedPeriod{
+			Campus:           model.Campus().Value(),
+			Title:            blockedPeriod.Title().Value(),
+			RoomIndex:        blockedPeriod.RoomIndex().Value(),
+			Recurrence:       blockedPeriod.Recurrence().Value(),
+			StartTimeHour:    startTimeHour,
+			StartTimeMinutes: startTimeMinutes,
+			EndTimeHour:      endTimeHour,
+			EndTimeMinutes:   endTimeMinutes,
+		}
+
+		if blockedPeriod.Recurrence().IsWeekly() {
+			blockedPeriodDTO.Weekday = null.NewInt(blockedPeriod.Weekday().Value(), true)
+		}
+
+		if targetDate, ok := blockedPeriod.TargetDate().Time(); ok {
+			blockedPeriodDTO.TargetDate = null.NewTime(targetDate, true)
+		}
+
+		if err := blockedPeriodDTO.Insert(ctx, tx, boil.Infer()); err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+// 未登録の校舎には既定のポリシーを返す
+func (f *SchedulingPolicy) FindByCampus(ctx context.Context, campus vo.Campus) (*policy.RootSchedulingPolicyModel, error) {
+
+	policyDTO, err := dto.DataCampusSchedulingPolicies(
+		dto.DataCampusSchedulingPolicyWhere.Campus.EQ(campus.Value()),
+	).One(ctx, f.c)
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	openingHourDTOs, err := dto.DataCampusOpeningHours(
+		dto.DataCampusOpeningHourWhere.Campus.EQ(campus.Value()),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	blockedPeriodDTOs, err := dto.DataCampusBlockedPeriods(
+		dto.DataCampusBlockedPeriodWhere.Campus.EQ(campus.Value()),
+		qm.OrderBy(dto.DataCampusBlockedPeriodColumns.ID),
+	).All(ctx, f.c)
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	if policyDTO == nil && len(openingHourDTOs) == 0 && len(blockedPeriodDTOs) == 0 {
+		return policy.NewDefaultSchedulingPolicyModel(campus), nil
+	}
+
+	return f.toModel(campus, policyDTO, openingHourDTOs, blockedPeriodDTOs)
+}
+
+func (f *SchedulingPolicy) toModel(campus vo.Campus, policyRecord *dto.DataCampusSchedulingPolicy, openingHourRecords dto.DataCampusOpeningHourSlice, blockedPeriodRecords dto.DataCampusBlockedPeriodSlice) (*policy.RootSchedulingPolicyModel, error) {
+
+	defaultPolicy := policy.NewDefaultSchedulingPolicyModel(campus)
+
+	gridMinutes := defaultPolicy.GridMinutes()
+	minLessonDuration := defaultPolicy.MinLessonDuration()
+	maxLessonDuration := defaultPolicy.MaxLessonDuration()
+	minGapMinutes := defaultPolicy.MinGapMinutes()
+
+	var errs error
+
+	if policyRecord != nil {
+		errs = errors.Join(errs, vo.SetVOConstructor(&gridMinutes, vo.NewPolicyGridMinutes, policyRecord.GridMinutes))
+		errs = errors.Join(errs, vo.SetVOConstructor(&minLessonDuration, vo.NewLessonDuration, policyRecord.MinLessonDuration))
+		errs = errors.Join(errs, vo.SetVOConstructor(&maxLessonDuration, vo.NewLessonDuration, policyRecord.MaxLessonDuration))
+		errs = errors.Join(errs, vo.SetVOConstructor(&minGapMinutes, vo.NewPolicyGapMinutes, policyRecord.MinGapMinutes))
+	}
+
+	openingHours := make([]*policy.OpeningHourModel, 0, len(openingHourRecords))
+	for _, record := range openingHourRecords {
+
+		var weekday vo.Weekday
+		errs = errors.Join(errs, vo.SetVOConstructor(&weekday, vo.NewWeekday, record.Weekday))
+
+		hours, err := vo.NewScheduleTime(record.OpenTimeHour, record.OpenTimeMinutes, record.CloseTimeHour, record.CloseTimeMinutes)
+		errs = errors.Join(errs, err)
+
+		openingHours = append(openingHours, policy.NewOpeningHourModel(weekday, hours))
+	}
+
+	blockedPeriods := make(policy.BlockedPeriodModelSlice, 0, len(blockedPeriodRecords))
+	for _, record := range blockedPeriodRecords {
+
+		var title vo.BlockedPeriodTitle
+		var roomIndex vo.RoomIndex
+		var recurrence vo.BlockedPeriodRecurrence
+		errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewBlockedPeriodTitle, record.Title))
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndexOrAll, record.RoomIndex))
+		errs = errors.Join(errs, vo.SetVOConstructor(&recurrence, vo.NewBlockedPeriodRecurrence, record.Recurrence))
+
+		weekday := vo.WEEKDAY_INVALID
+		if record.Weekday.Valid {
+			errs = errors.Join(errs, vo.SetVOConstructor(&weekday, vo.NewWeekday, record.Weekday.Int))
+		}
+
+		targetDate := vo.SCHEDULE_TARGET_DATE_NONE
+		if record.TargetDate.Valid {
+			targetDate = vo.NewScheduleTargetDateFromTime(record.TargetDate.Time)
+		}
+
+		period, err := vo.NewScheduleTime(record.StartTimeHour, record.StartTimeMinutes, record.EndTimeHour, record.EndTimeMinutes)
+		errs = errors.Join(errs, err)
+		if err != nil {
+			continue
+		}
+
+		blockedPeriod, err := policy.NewBlockedPeriodModel(title, roomIndex, recurrence, weekday, targetDate, period)
+		errs = errors.Join(errs, err)
+		if err != nil {
+			continue
+		}
+
+		blockedPeriods = append(blockedPeriods, blockedPeriod)
+	}
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
+	}
+
+	model, err := policy.NewRootSchedulingPolicyModel(
+		campus,
+		gridMinutes,
+		minLessonDuration,
+		maxLessonDuration,
+		minGapMinutes,
+		openingHours,
+		blockedPeriods,
+	)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return model, nil
+}
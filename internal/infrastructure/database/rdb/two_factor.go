@@ -0,0 +1,122 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/twofactor"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type TwoFactor struct {
+	c *sql.DB
+}
+
+func NewTwoFactorRepository(c IMySQL) repository.TwoFactorRepository {
+	return &TwoFactor{c: c.GetConn()}
+}
+
+func (f *TwoFactor) Save(ctx context.Context, tx *sql.Tx, model *twofactor.RootTwoFactorModel) error {
+
+	record := &dto.TBLUserTwoFactor{
+		ID:           model.ID(),
+		UserID:       model.UserID().Value(),
+		Secret:       model.Secret(),
+		Enabled:      lo.Ternary(model.IsEnabled(), 1, 0),
+		Required:     lo.Ternary(model.IsRequired(), 1, 0),
+		LastUsedStep: model.LastUsedStep(),
+	}
+
+	var err error
+	if model.IsInitial() {
+		err = record.Insert(ctx, tx, boil.Infer())
+	} else {
+		_, err = record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLUserTwoFactorColumns.Secret,
+			dto.TBLUserTwoFactorColumns.Enabled,
+			dto.TBLUserTwoFactorColumns.Required,
+			dto.TBLUserTwoFactorColumns.LastUsedStep,
+			dto.TBLUserTwoFactorColumns.UpdatedAt,
+		))
+	}
+
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	// リカバリーコードは使用状況を含めて入れ替える
+	_, err = dto.TBLUserRecoveryCodes(
+		dto.TBLUserRecoveryCodeWhere.UserID.EQ(model.UserID().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, recoveryCode := range model.RecoveryCodes() {
+
+		codeRecord := &dto.TBLUserRecoveryCode{
+			UserID:   model.UserID().Value(),
+			CodeHash: recoveryCode.CodeHash(),
+			Used:     lo.Ternary(recoveryCode.IsUsed(), 1, 0),
+		}
+
+		if err := codeRecord.Insert(ctx, tx, boil.Infer()); err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
+func (f *TwoFactor) FindByUserID(ctx context.Context, userID vo.UserID) (*twofactor.RootTwoFactorModel, error) {
+
+	return f.findOne(ctx, f.c, userID)
+}
+
+func (f *TwoFactor) FindByUserIDWithLock(ctx context.Context, tx *sql.Tx, userID vo.UserID) (*twofactor.RootTwoFactorModel, error) {
+
+	return f.findOne(ctx, tx, userID, qm.For("UPDATE"))
+}
+
+func (f *TwoFactor) findOne(ctx context.Context, exec boil.ContextExecutor, userID vo.UserID, mods ...qm.QueryMod) (*twofactor.RootTwoFactorModel, error) {
+
+	mods = append(mods, dto.TBLUserTwoFactorWhere.UserID.EQ(userID.Value()))
+
+	record, err := dto.TBLUserTwoFactors(mods...).One(ctx, exec)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	codeRecords, err := dto.TBLUserRecoveryCodes(
+		dto.TBLUserRecoveryCodeWhere.UserID.EQ(userID.Value()),
+		qm.OrderBy(dto.TBLUserRecoveryCodeColumns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	recoveryCodes := make([]*twofactor.RecoveryCodeModel, 0, len(codeRecords))
+	for _, codeRecord := range codeRecords {
+		recoveryCodes = append(recoveryCodes, twofactor.NewRecoveryCodeModel(codeRecord.CodeHash, codeRecord.Used == 1))
+	}
+
+	return twofactor.NewRootTwoFactorModel(
+		record.ID,
+		userID,
+		record.Secret,
+		record.Enabled == 1,
+		record.Required == 1,
+		record.LastUsedStep,
+		recoveryCodes,
+	), nil
+}
@@ -49,6 +49,62 @@ func (c *User) Save(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, u
 	return nil
 }
 
+// 外部のIDプロバイダーから自動作成するユーザー。登録操作を行うユーザーがいないため、自身を更新者として登録する
+func (c *User) SaveProvisioned(ctx context.Context, tx *sql.Tx, user *user.RootUserModel) (vo.UserID, error) {
+
+	// 更新者は登録済みのユーザーを参照する必要があるため、既存のユーザーを仮の更新者として登録し、採番されたIDで更新する
+	firstUserDTO, err := dto.TBLUsers(
+		qm.OrderBy(dto.TBLUserColumns.ID),
+	).One(ctx, tx)
+	if err != nil {
+		return vo.USER_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	dtoObject := c.toDTO(user, vo.UserID(firstUserDTO.ID), ACTIVE)
+
+	if err := dtoObject.Insert(ctx, tx, boil.Infer()); err != nil {
+		return vo.USER_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	userID, err := vo.NewUserID(dtoObject.ID)
+	if err != nil {
+		return vo.USER_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	dtoObject.UpdateUserID = userID.Value()
+	if _, err := dtoObject.Update(ctx, tx, boil.Whitelist(dto.TBLUserColumns.UpdateUserID)); err != nil {
+		return vo.USER_ID_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return userID, nil
+}
+
+func (c *User) SaveCampusRoles(ctx context.Context, tx *sql.Tx, user *user.RootUserModel) error {
+
+	_, err := dto.TBLUserCampusRoles(
+		dto.TBLUserCampusRoleWhere.UserID.EQ(user.ID().Value()),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	for _, campusRole := range user.CampusRoles() {
+
+		campusRoleDTO := &dto.TBLUserCampusRole{
+			UserID:  user.ID().Value(),
+			Campus:  campusRole.Campus().Value(),
+			RoleKey: campusRole.RoleKey().Value(),
+		}
+
+		err = campusRoleDTO.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return log.WrapErrorWithStackTraceInternalServerError(err)
+		}
+	}
+
+	return nil
+}
+
 func (c *User) Delete(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, deleteFromUserID vo.UserID) error {
 
 	dtoObject := c.toDTO(user, deleteFromUserID, IN_ACTIVE)
@@ -65,10 +121,36 @@ func (c *User) Delete(ctx context.Context, tx *sql.Tx, user *user.RootUserModel,
 	return nil
 }
 
+func (c *User) ChangeStatus(ctx context.Context, tx *sql.Tx, user *user.RootUserModel, status vo.UserStatus, changeFromUserID vo.UserID) error {
+
+	deleteFlag := ACTIVE
+	switch {
+	case status.IsDeactivated():
+		deleteFlag = DEACTIVATED
+	case status.IsDeleted():
+		deleteFlag = IN_ACTIVE
+	}
+
+	dtoObject := c.toDTO(user, changeFromUserID, deleteFlag)
+
+	_, err := dtoObject.Update(ctx, tx, boil.Whitelist(
+		dto.TBLUserColumns.UpdateUserID,
+		dto.TBLUserColumns.DeleteFlag,
+	))
+
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
 func (c *User) FindAll(ctx context.Context) (user.RootUserModelSlice, error) {
 
 	usersDTOs, err := dto.TBLUsers(
 		dto.TBLUserWhere.DeleteFlag.EQ(ACTIVE),
+		qm.Load(qm.Rels(dto.TBLUserRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+		qm.Load(qm.Rels(dto.TBLUserRels.UserTBLUserCampusRoles, dto.TBLUserCampusRoleRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
 		qm.OrderBy(dto.TBLUserColumns.ID+" desc"),
 	).All(ctx, c.c)
 
@@ -97,6 +179,8 @@ func (c *User) FindByUserName(ctx context.Context, userName string) (*user.RootU
 	usersDTO, err := dto.TBLUsers(
 		dto.TBLUserWhere.UserName.EQ(userName),
 		dto.TBLUserWhere.DeleteFlag.EQ(ACTIVE),
+		qm.Load(qm.Rels(dto.TBLUserRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+		qm.Load(qm.Rels(dto.TBLUserRels.UserTBLUserCampusRoles, dto.TBLUserCampusRoleRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
 	).All(ctx, c.c)
 
 	if err != nil {
@@ -122,6 +206,8 @@ func (c *User) FindByUserID(ctx context.Context, userId vo.UserID) (*user.RootUs
 	usersDTOs, err := dto.TBLUsers(
 		dto.TBLUserWhere.ID.EQ(userId.Value()),
 		dto.TBLUserWhere.DeleteFlag.EQ(ACTIVE),
+		qm.Load(qm.Rels(dto.TBLUserRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+		qm.Load(qm.Rels(dto.TBLUserRels.UserTBLUserCampusRoles, dto.TBLUserCampusRoleRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
 	).All(ctx, c.c)
 
 	if err != nil {
@@ -142,6 +228,38 @@ func (c *User) FindByUserID(ctx context.Context, userId vo.UserID) (*user.RootUs
 
 }
 
+func (c *User) FindByUserIDWithStatus(ctx context.Context, userId vo.UserID) (*user.RootUserModel, vo.UserStatus, error) {
+
+	userDTO, err := dto.TBLUsers(
+		dto.TBLUserWhere.ID.EQ(userId.Value()),
+		qm.Load(qm.Rels(dto.TBLUserRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+		qm.Load(qm.Rels(dto.TBLUserRels.UserTBLUserCampusRoles, dto.TBLUserCampusRoleRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+	).One(ctx, c.c)
+
+	if err != nil && err != sql.ErrNoRows {
+		return nil, vo.USER_STATUS_INVALID, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	if userDTO == nil {
+		return nil, vo.USER_STATUS_INVALID, nil
+	}
+
+	model, err := c.toModel(userDTO)
+	if err != nil {
+		return nil, vo.USER_STATUS_INVALID, log.WrapErrorWithStackTrace(err)
+	}
+
+	status := vo.USER_STATUS_ACTIVE
+	switch userDTO.DeleteFlag {
+	case DEACTIVATED:
+		status = vo.USER_STATUS_DEACTIVATED
+	case IN_ACTIVE:
+		status = vo.USER_STATUS_DELETED
+	}
+
+	return model, status, nil
+}
+
 func (c *User) FindByUserIDs(ctx context.Context, userIds []vo.UserID) (user.RootUserModelSlice, error) {
 
 	ids := lo.Map(userIds, func(item vo.UserID, _ int) int {
@@ -151,6 +269,8 @@ func (c *User) FindByUserIDs(ctx context.Context, userIds []vo.UserID) (user.Roo
 	usersDTOs, err := dto.TBLUsers(
 		dto.TBLUserWhere.ID.IN(ids),
 		dto.TBLUserWhere.DeleteFlag.EQ(ACTIVE),
+		qm.Load(qm.Rels(dto.TBLUserRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
+		qm.Load(qm.Rels(dto.TBLUserRels.UserTBLUserCampusRoles, dto.TBLUserCampusRoleRels.RoleKeyDataRole, dto.DataRoleRels.RoleKeyDataRolePermissions)),
 	).All(ctx, c.c)
 
 	if err != nil {
@@ -187,6 +307,32 @@ func (d *User) toModel(record *dto.TBLUser) (*user.RootUserModel, error) {
 	errs = errors.Join(errs, vo.SetVOConstructor(&password, vo.ReconstructHashedPassword, record.Password))
 	errs = errors.Join(errs, vo.SetVOConstructor(&displayName, vo.NewUserDisplayName, record.Name))
 
+	permissions := make(vo.Permissions, 0)
+	campusRoles := make(user.UserCampusRoleModelSlice, 0)
+	if record.R != nil {
+
+		var err error
+		permissions, err = toPermissions(record.R.RoleKeyDataRole)
+		errs = errors.Join(errs, err)
+
+		for _, campusRoleDTO := range record.R.UserTBLUserCampusRoles {
+
+			var campus vo.Campus
+			var campusRoleKey vo.RoleKey
+
+			errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, campusRoleDTO.Campus))
+			errs = errors.Join(errs, vo.SetVOConstructor(&campusRoleKey, vo.NewRoleKey, campusRoleDTO.RoleKey))
+
+			campusPermissions := make(vo.Permissions, 0)
+			if campusRoleDTO.R != nil {
+				campusPermissions, err = toPermissions(campusRoleDTO.R.RoleKeyDataRole)
+				errs = errors.Join(errs, err)
+			}
+
+			campusRoles = append(campusRoles, user.NewUserCampusRoleModel(campus, campusRoleKey, campusPermissions))
+		}
+	}
+
 	if errs != nil {
 		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("%v", errs.Error()))
 	}
@@ -197,6 +343,8 @@ func (d *User) toModel(record *dto.TBLUser) (*user.RootUserModel, error) {
 		userName,
 		password,
 		displayName,
+		permissions,
+		campusRoles,
 	), nil
 }
 
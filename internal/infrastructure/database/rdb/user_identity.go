@@ -0,0 +1,78 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/identity"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/dto"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type UserIdentity struct {
+	c *sql.DB
+}
+
+func NewUserIdentityRepository(c IMySQL) repository.UserIdentityRepository {
+	return &UserIdentity{c: c.GetConn()}
+}
+
+func (f *UserIdentity) Save(ctx context.Context, tx *sql.Tx, model *identity.RootUserIdentityModel) error {
+
+	record := &dto.TBLUserIdentity{
+		ID:          model.ID(),
+		UserID:      model.UserID().Value(),
+		Issuer:      model.Issuer(),
+		Subject:     model.Subject(),
+		Email:       model.Email(),
+		LastLoginAt: model.LastLoginAt(),
+	}
+
+	var err error
+	if model.IsInitial() {
+		err = record.Insert(ctx, tx, boil.Infer())
+	} else {
+		_, err = record.Update(ctx, tx, boil.Whitelist(
+			dto.TBLUserIdentityColumns.Email,
+			dto.TBLUserIdentityColumns.LastLoginAt,
+		))
+	}
+
+	if err != nil {
+		return log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return nil
+}
+
+func (f *UserIdentity) FindByIssuerAndSubject(ctx context.Context, issuer string, subject string) (*identity.RootUserIdentityModel, error) {
+
+	record, err := dto.TBLUserIdentities(
+		dto.TBLUserIdentityWhere.Issuer.EQ(issuer),
+		dto.TBLUserIdentityWhere.Subject.EQ(subject),
+	).One(ctx, f.c)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	userID, err := vo.NewUserID(record.UserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	return identity.NewRootUserIdentityModel(
+		record.ID,
+		userID,
+		record.Issuer,
+		record.Subject,
+		record.Email,
+		record.LastLoginAt,
+	), nil
+}
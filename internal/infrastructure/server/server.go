@@ -18,6 +18,12 @@ func NewServer(env configs.EnvConfig) *Server {
 	e := echo.New()
 	e.Use(session.Middleware(createStore(env)))
 
+	// ログイン試行の制限に用いるため、詐称されたヘッダーから接続元IPアドレスを取得しない
+	e.IPExtractor = echo.ExtractIPDirect()
+	if env.TrustXForwardedFor {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	}
+
 	origins := []string{
 		"https://lessonlink-frontend-382133459414.asia-northeast1.run.app", // GCP cloud run 環境
 	}
@@ -17,9 +17,11 @@ import (
 	"github.com/typedef-tokyo/lessonlink-backend/internal/adapter/presenter"
 	envcfg "github.com/typedef-tokyo/lessonlink-backend/internal/configs"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/client/oidc"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb"
 	campusRepo "github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/query/campus"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/query/lesson"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/query/permission"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/query/room"
 	scheduleQueryRepository "github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/database/rdb/query/schedule"
 	logger "github.com/typedef-tokyo/lessonlink-backend/internal/infrastructure/logger"
@@ -47,6 +49,7 @@ func init() {
 		rdb.NewConfig,
 		rdb.NewMySQL,
 		rdb.NewTxManager,
+		oidc.NewOIDCClient,
 	}
 
 	for _, config := range configs {
@@ -71,14 +74,25 @@ func init() {
 		room.NewRoomQueryRepository,
 		scheduleQueryRepository.NewScheduleQueryRepository,
 		lesson.NewLessonQueryRepository,
+		permission.NewPermissionQueryRepository,
 		campusRepo.NewCampusQueryRepository,
+		rdb.NewAPITokenRepository,
 		rdb.NewCampusRepository,
+		rdb.NewChangeRequestRepository,
+		rdb.NewCommentRepository,
+		rdb.NewDayBundleRepository,
 		rdb.NewLessonRepository,
+		rdb.NewLoginAttemptRepository,
+		rdb.NewLoginThrottleRepository,
 		rdb.NewRoleRepository,
+		rdb.NewRoomItemTypeRepository,
 		rdb.NewRoomRepository,
 		rdb.NewScheduleInvisibleRoomRepository,
 		rdb.NewScheduleRepository,
+		rdb.NewSchedulingPolicyRepository,
 		rdb.NewSessionRepository,
+		rdb.NewTwoFactorRepository,
+		rdb.NewUserIdentityRepository,
 		rdb.NewUserRepository,
 	}
 
@@ -91,7 +105,12 @@ func init() {
 
 	// --- Service --- //
 	services := []any{
+		service.NewAuthorizationService,
+		service.NewLoginAttemptService,
+		service.NewScheduleCollisionService,
 		service.NewScheduleEditPermissionService,
+		service.NewScheduleStatusPermissionService,
+		service.NewScheduleVisibleRoomService,
 	}
 
 	for _, service := range services {
@@ -107,28 +126,85 @@ func init() {
 		roomlist.NewRoomListQueryInteractor,
 		schedulelist.NewScheduleListQueryInteractor,
 		mapper.NewScheduleItemEditOutputMapper,
+		usecase.NewAPITokenListInteractor,
+		usecase.NewAPITokenAddInteractor,
+		usecase.NewAPITokenRevokeInteractor,
+		usecase.NewAPITokenAuthenticateInteractor,
 		usecase.NewCampusListInteractor,
+		usecase.NewCampusBlockedPeriodEditInteractor,
+		usecase.NewCampusBlockedPeriodGetInteractor,
+		usecase.NewCampusPolicyEditInteractor,
+		usecase.NewCampusPolicyGetInteractor,
+		usecase.NewCampusRoomItemTypeEditInteractor,
+		usecase.NewCampusRoomItemTypeGetInteractor,
+		usecase.NewDayBundleAddInteractor,
+		usecase.NewDayBundleCollisionGetInteractor,
+		usecase.NewDayBundleDeleteInteractor,
+		usecase.NewDayBundleEditInteractor,
+		usecase.NewDayBundleListInteractor,
 		usecase.NewInvisibleRoomSaveInteractor,
 		usecase.NewLessonAddInteractor,
 		usecase.NewLessonEditInteractor,
+		usecase.NewRoleAddInteractor,
+		usecase.NewRoleEditInteractor,
+		usecase.NewRoleListInteractor,
 		usecase.NewRoomEditInteractor,
+		usecase.NewScheduleChangeRequestAddInteractor,
+		usecase.NewScheduleChangeRequestApproveInteractor,
+		usecase.NewScheduleChangeRequestDiffInteractor,
+		usecase.NewScheduleChangeRequestListInteractor,
+		usecase.NewScheduleChangeRequestRejectInteractor,
+		usecase.NewScheduleCollaboratorDeleteInteractor,
+		usecase.NewScheduleCollaboratorEditInteractor,
+		usecase.NewScheduleCollaboratorListInteractor,
+		usecase.NewScheduleCommentAddInteractor,
+		usecase.NewScheduleCommentDeleteInteractor,
+		usecase.NewScheduleCommentEditInteractor,
+		usecase.NewScheduleCommentListInteractor,
+		usecase.NewScheduleCommentResolveInteractor,
 		usecase.NewScheduleCreateInteractor,
 		usecase.NewScheduleDeleteInteractor,
 		usecase.NewScheduleDuplicateInteractor,
 		usecase.NewScheduleGetInteractor,
 		usecase.NewScheduleItemDivideInteractor,
+		usecase.NewScheduleItemGroupInteractor,
 		usecase.NewScheduleItemJoinInteractor,
 		usecase.NewScheduleItemMoveInteractor,
+		usecase.NewScheduleItemPinInteractor,
 		usecase.NewScheduleItemReturnListInteractor,
 		usecase.NewScheduleItemShiftInteractor,
+		usecase.NewScheduleItemSuggestionInteractor,
+		usecase.NewScheduleItemTimeShiftInteractor,
+		usecase.NewScheduleItemUngroupInteractor,
+		usecase.NewScheduleOwnerTransferInteractor,
+		usecase.NewScheduleRoomClearInteractor,
+		usecase.NewScheduleRoomCopyInteractor,
+		usecase.NewScheduleRoomSwapInteractor,
 		usecase.NewScheduleSaveTitleInteractor,
 		usecase.NewScheduleSaveInteractor,
+		usecase.NewScheduleStatusChangeInteractor,
 		usecase.NewScheduleTimeEditEditInteractor,
+		usecase.NewTwoFactorGetInteractor,
+		usecase.NewTwoFactorEnrollInteractor,
+		usecase.NewTwoFactorConfirmInteractor,
+		usecase.NewTwoFactorDisableInteractor,
 		usecase.NewUserAddInteractor,
+		usecase.NewUserCampusRoleEditInteractor,
+		usecase.NewUserCampusRoleListInteractor,
+		usecase.NewUserDeactivateInteractor,
 		usecase.NewUserDeleteInteractor,
 		usecase.NewUserGetInteractor,
 		usecase.NewUserListInteractor,
 		usecase.NewUserLoginInteractor,
+		usecase.NewUserLoginTwoFactorInteractor,
+		usecase.NewUserLoginTwoFactorEnrollInteractor,
+		usecase.NewOIDCLoginStartInteractor,
+		usecase.NewOIDCLoginCallbackInteractor,
+		usecase.NewUserRestoreInteractor,
+		usecase.NewUserTwoFactorRequiredEditInteractor,
+		usecase.NewUserTwoFactorResetInteractor,
+		usecase.NewUserLoginAttemptListInteractor,
+		usecase.NewUserUnlockInteractor,
 		usecase.NewUserUpdateInteractor,
 	}
 
@@ -141,33 +217,89 @@ func init() {
 
 	// --- Controller --- //
 	controllers := []any{
+		controller.NewAPITokenListController,
+		controller.NewAPITokenAddController,
+		controller.NewAPITokenRevokeController,
 		controller.NewCampusListController,
+		controller.NewCampusBlockedPeriodEditController,
+		controller.NewCampusBlockedPeriodGetController,
+		controller.NewCampusPolicyEditController,
+		controller.NewCampusPolicyGetController,
+		controller.NewCampusRoomItemTypeEditController,
+		controller.NewCampusRoomItemTypeGetController,
+		controller.NewDayBundleAddController,
+		controller.NewDayBundleCollisionGetController,
+		controller.NewDayBundleDeleteController,
+		controller.NewDayBundleEditController,
+		controller.NewDayBundleListController,
 		controller.NewInvisibleRoomController,
 		controller.NewLessonAddController,
 		controller.NewLessonEditController,
 		controller.NewLessonListController,
 		controller.NewLoginUserGetController,
+		controller.NewRoleAddController,
+		controller.NewRoleEditController,
+		controller.NewRoleListController,
 		controller.NewRoomEditController,
 		controller.NewRoomListController,
+		controller.NewScheduleChangeRequestAddController,
+		controller.NewScheduleChangeRequestApproveController,
+		controller.NewScheduleChangeRequestDiffController,
+		controller.NewScheduleChangeRequestListController,
+		controller.NewScheduleChangeRequestRejectController,
+		controller.NewScheduleCollaboratorDeleteController,
+		controller.NewScheduleCollaboratorEditController,
+		controller.NewScheduleCollaboratorListController,
+		controller.NewScheduleCommentAddController,
+		controller.NewScheduleCommentDeleteController,
+		controller.NewScheduleCommentEditController,
+		controller.NewScheduleCommentListController,
+		controller.NewScheduleCommentResolveController,
 		controller.NewScheduleCreateController,
 		controller.NewScheduleDeleteController,
 		controller.NewScheduleDuplicateController,
 		controller.NewScheduleGetController,
 		controller.NewScheduleItemDivideController,
+		controller.NewScheduleItemGroupController,
 		controller.NewScheduleItemJoinController,
 		controller.NewScheduleItemMoveController,
+		controller.NewScheduleItemPinController,
 		controller.NewScheduleItemReturnListController,
 		controller.NewScheduleItemShiftController,
+		controller.NewScheduleItemSuggestionController,
+		controller.NewScheduleItemTimeShiftController,
+		controller.NewScheduleItemUngroupController,
 		controller.NewScheduleListController,
+		controller.NewScheduleOwnerTransferController,
+		controller.NewScheduleRoomClearController,
+		controller.NewScheduleRoomCopyController,
+		controller.NewScheduleRoomSwapController,
 		controller.NewScheduleSaveController,
 		controller.NewScheduleSaveTitleController,
+		controller.NewScheduleStatusChangeController,
 		controller.NewScheduleTimeEditController,
+		controller.NewTwoFactorGetController,
+		controller.NewTwoFactorEnrollController,
+		controller.NewTwoFactorConfirmController,
+		controller.NewTwoFactorDisableController,
 		controller.NewUserAddController,
+		controller.NewUserCampusRoleEditController,
+		controller.NewUserCampusRoleListController,
+		controller.NewUserDeactivateController,
 		controller.NewUserDeleteController,
 		controller.NewUserGetController,
 		controller.NewUserListController,
 		controller.NewUserLoginController,
+		controller.NewUserLoginTwoFactorController,
+		controller.NewUserLoginTwoFactorEnrollController,
+		controller.NewOIDCLoginStartController,
+		controller.NewOIDCLoginCallbackController,
 		controller.NewUserLogoutController,
+		controller.NewUserRestoreController,
+		controller.NewUserTwoFactorRequiredEditController,
+		controller.NewUserTwoFactorResetController,
+		controller.NewUserLoginAttemptListController,
+		controller.NewUserUnlockController,
 		controller.NewUserUpdateController,
 	}
 
@@ -183,21 +315,56 @@ func init() {
 		presenter.NewLessonListPresenter,
 		presenter.NewRoomListPresenter,
 		presenter.NewScheduleListPresenter,
+		presenter.NewAPITokenListPresenter,
+		presenter.NewAPITokenAddPresenter,
+		presenter.NewAPITokenRevokePresenter,
 		presenter.NewCampusListPresenter,
+		presenter.NewCampusBlockedPeriodEditPresenter,
+		presenter.NewCampusBlockedPeriodGetPresenter,
+		presenter.NewCampusPolicyEditPresenter,
+		presenter.NewCampusPolicyGetPresenter,
+		presenter.NewCampusRoomItemTypeEditPresenter,
+		presenter.NewCampusRoomItemTypeGetPresenter,
+		presenter.NewDayBundleCollisionGetPresenter,
+		presenter.NewDayBundleListPresenter,
+		presenter.NewDayBundlePresenter,
 		presenter.NewInvisibleRoom,
 		presenter.NewLessonAddPresenter,
 		presenter.NewLessonEditPresenter,
+		presenter.NewRoleAddPresenter,
+		presenter.NewRoleEditPresenter,
+		presenter.NewRoleListPresenter,
 		presenter.NewRoomEditPresenter,
+		presenter.NewScheduleChangeRequestDiffPresenter,
+		presenter.NewScheduleChangeRequestListPresenter,
+		presenter.NewScheduleChangeRequestPresenter,
+		presenter.NewScheduleCollaboratorListPresenter,
+		presenter.NewScheduleCommentListPresenter,
 		presenter.NewScheduleCreatePresenter,
 		presenter.NewScheduleGet,
 		presenter.NewScheduleItemEditPresenter,
+		presenter.NewScheduleItemSuggestionPresenter,
 		presenter.NewScheduleSaveTitlePresenter,
 		presenter.NewScheduleSavePresenter,
+		presenter.NewScheduleStatusChangePresenter,
+		presenter.NewTwoFactorGetPresenter,
+		presenter.NewTwoFactorEnrollPresenter,
+		presenter.NewTwoFactorConfirmPresenter,
+		presenter.NewTwoFactorDisablePresenter,
 		presenter.NewUserAddPresenter,
+		presenter.NewUserCampusRoleEditPresenter,
+		presenter.NewUserCampusRoleListPresenter,
+		presenter.NewUserDeactivatePresenter,
 		presenter.NewUserDeletePresenter,
 		presenter.NewUserGetPresenter,
 		presenter.NewUserListPresenter,
 		presenter.NewUserLoginPresenter,
+		presenter.NewUserLoginTwoFactorPresenter,
+		presenter.NewUserRestorePresenter,
+		presenter.NewUserTwoFactorRequiredEditPresenter,
+		presenter.NewUserTwoFactorResetPresenter,
+		presenter.NewUserLoginAttemptListPresenter,
+		presenter.NewUserUnlockPresenter,
 		presenter.NewUpdateUserPresenter,
 	}
 
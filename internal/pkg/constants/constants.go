@@ -1,4 +1,4 @@
 package constants
 
 const USER_IDENTIFIER = "user_id"
-const ROLE_IDENTIFIER = "role_key"
+const API_TOKEN_AUTH_IDENTIFIER = "api_token_auth"
@@ -0,0 +1,15 @@
+package hash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// APIトークンなど十分な長さを持つランダムな値のハッシュ化
+// ソルトを用いないため、ハッシュ値で検索できる
+func HashToken(token string) string {
+
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -12,31 +12,34 @@ import (
 const MUDULE_NAME = "github.com/typedef-tokyo/lessonlink-backend/internal"
 
 const (
-	INTERNAL_ERROR = http.StatusInternalServerError
-	BAD_REQUEST    = http.StatusBadRequest
-	NOT_FOUND      = http.StatusNotFound
-	UNAUTHORIZED   = http.StatusUnauthorized
-	CONFLICT       = http.StatusConflict
-	FORBIDDEN      = http.StatusForbidden
+	INTERNAL_ERROR    = http.StatusInternalServerError
+	BAD_REQUEST       = http.StatusBadRequest
+	NOT_FOUND         = http.StatusNotFound
+	UNAUTHORIZED      = http.StatusUnauthorized
+	CONFLICT          = http.StatusConflict
+	FORBIDDEN         = http.StatusForbidden
+	TOO_MANY_REQUESTS = http.StatusTooManyRequests
 )
 
 var (
 	LogLevelMap = map[int]slog.Level{
-		INTERNAL_ERROR: slog.LevelError,
-		BAD_REQUEST:    slog.LevelWarn,
-		NOT_FOUND:      slog.LevelWarn,
-		UNAUTHORIZED:   slog.LevelWarn,
-		CONFLICT:       slog.LevelWarn,
-		FORBIDDEN:      slog.LevelWarn,
+		INTERNAL_ERROR:    slog.LevelError,
+		BAD_REQUEST:       slog.LevelWarn,
+		NOT_FOUND:         slog.LevelWarn,
+		UNAUTHORIZED:      slog.LevelWarn,
+		CONFLICT:          slog.LevelWarn,
+		FORBIDDEN:         slog.LevelWarn,
+		TOO_MANY_REQUESTS: slog.LevelWarn,
 	}
 
 	LogSeverityMap = map[int]string{
-		INTERNAL_ERROR: "ERROR",
-		BAD_REQUEST:    "WARNING",
-		NOT_FOUND:      "WARNING",
-		UNAUTHORIZED:   "WARNING",
-		CONFLICT:       "WARNING",
-		FORBIDDEN:      "WARNING",
+		INTERNAL_ERROR:    "ERROR",
+		BAD_REQUEST:       "WARNING",
+		NOT_FOUND:         "WARNING",
+		UNAUTHORIZED:      "WARNING",
+		CONFLICT:          "WARNING",
+		FORBIDDEN:         "WARNING",
+		TOO_MANY_REQUESTS: "WARNING",
 	}
 )
 
@@ -111,3 +114,6 @@ func WrapErrorWithStackTraceNotFound(err error) error     { return wrapError(err
 func WrapErrorWithStackTraceUnauthorized(err error) error { return wrapError(err, UNAUTHORIZED) }
 func WrapErrorWithStackTraceConflict(err error) error     { return wrapError(err, CONFLICT) }
 func WrapErrorWithStackTraceForbidden(err error) error    { return wrapError(err, FORBIDDEN) }
+func WrapErrorWithStackTraceTooManyRequests(err error) error {
+	return wrapError(err, TOO_MANY_REQUESTS)
+}
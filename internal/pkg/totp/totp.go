@@ -0,0 +1,98 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 の時間ベースのワンタイムパスワード
+// 認証アプリの既定値に合わせ、SHA1・6桁・30秒間隔とする
+const (
+	DIGITS      = 6
+	PERIOD      = 30
+	SECRET_SIZE = 20
+	// 端末の時刻のずれを前後何ステップまで許容するか
+	ALLOWED_SKEW = 1
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// 認証アプリに登録するシークレット(Base32)を生成する
+func GenerateSecret() (string, error) {
+
+	secret := make([]byte, SECRET_SIZE)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(secret), nil
+}
+
+// 認証アプリに読み込ませる otpauth:// 形式のURI
+func ProvisioningURI(issuer string, accountName string, secret string) string {
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(DIGITS))
+	query.Set("period", fmt.Sprint(PERIOD))
+
+	label := url.PathEscape(issuer + ":" + accountName)
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// 時刻に対応するステップ(UNIX時間を間隔で割った値)
+func Step(t time.Time) int64 {
+	return t.Unix() / PERIOD
+}
+
+// コードを検証し、一致したステップを返す
+// 再利用を防ぐため、呼び出し側で前回利用したステップ以前のものは拒否すること
+func Verify(secret string, code string, t time.Time) (int64, bool) {
+
+	if len(code) != DIGITS {
+		return 0, false
+	}
+
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	current := Step(t)
+	for skew := int64(-ALLOWED_SKEW); skew <= ALLOWED_SKEW; skew++ {
+
+		step := current + skew
+		if subtle.ConstantTimeCompare([]byte(generate(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// RFC 4226 のHOTP
+func generate(key []byte, counter int64) string {
+
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", DIGITS, value%1000000)
+}
@@ -0,0 +1,124 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 付録Bのシークレット("12345678901234567890")をBase32にしたもの
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestVerify(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		now      time.Time
+		wantStep int64
+		wantOK   bool
+	}{
+		// RFC 6238 付録BのSHA1のテストベクター(8桁)の下6桁
+		{name: "RFC 6238 T=59", secret: rfcSecret, code: "287082", now: time.Unix(59, 0), wantStep: 1, wantOK: true},
+		{name: "RFC 6238 T=1111111109", secret: rfcSecret, code: "081804", now: time.Unix(1111111109, 0), wantStep: 37037036, wantOK: true},
+		{name: "RFC 6238 T=1111111111", secret: rfcSecret, code: "050471", now: time.Unix(1111111111, 0), wantStep: 37037037, wantOK: true},
+		{name: "RFC 6238 T=1234567890", secret: rfcSecret, code: "005924", now: time.Unix(1234567890, 0), wantStep: 41152263, wantOK: true},
+		{name: "RFC 6238 T=2000000000", secret: rfcSecret, code: "279037", now: time.Unix(2000000000, 0), wantStep: 66666666, wantOK: true},
+		{
+			name:   "小文字のシークレット",
+			secret: strings.ToLower(rfcSecret),
+			code:   "287082", now: time.Unix(59, 0), wantStep: 1, wantOK: true,
+		},
+		{
+			name:   "1ステップ前のコードは時刻のずれとして許容する",
+			secret: rfcSecret,
+			code:   "081804", now: time.Unix(1111111109+PERIOD, 0), wantStep: 37037036, wantOK: true,
+		},
+		{
+			name:   "1ステップ後のコードは時刻のずれとして許容する",
+			secret: rfcSecret,
+			code:   "050471", now: time.Unix(1111111109, 0), wantStep: 37037037, wantOK: true,
+		},
+		{
+			name:   "2ステップ以上ずれたコード",
+			secret: rfcSecret,
+			code:   "081804", now: time.Unix(1111111109+2*PERIOD, 0),
+		},
+		{
+			name:   "誤ったコード",
+			secret: rfcSecret,
+			code:   "287083", now: time.Unix(59, 0),
+		},
+		{
+			name:   "桁数が異なる",
+			secret: rfcSecret,
+			code:   "94287082", now: time.Unix(59, 0),
+		},
+		{
+			name:   "シークレットが不正",
+			secret: "not-base32!",
+			code:   "287082", now: time.Unix(59, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			step, ok := Verify(tt.secret, tt.code, tt.now)
+
+			if ok != tt.wantOK {
+				t.Fatalf("got ok %t, want %t", ok, tt.wantOK)
+			}
+
+			if step != tt.wantStep {
+				t.Errorf("got step %d, want %d", step, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestStep(t *testing.T) {
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want int64
+	}{
+		{name: "間隔の始まり", now: time.Unix(30, 0), want: 1},
+		{name: "間隔の終わり", now: time.Unix(59, 0), want: 1},
+		{name: "次の間隔", now: time.Unix(60, 0), want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			if got := Step(tt.now); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := encoding.DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(key) != SECRET_SIZE {
+		t.Errorf("got %d bytes, want %d", len(key), SECRET_SIZE)
+	}
+
+	// 生成したシークレットで発行したコードを検証できる
+	now := time.Now()
+	if _, ok := Verify(secret, generate(key, Step(now)), now); !ok {
+		t.Error("expected generated code to be verified")
+	}
+}
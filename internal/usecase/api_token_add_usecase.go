@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/apitoken"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IAPITokenAddInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, input APITokenAddInput) (*APITokenAddOutput, error)
+	}
+)
+
+type (
+	APITokenAddInput struct {
+		Name          string
+		Scope         string
+		ExpiresInDays int
+	}
+
+	APITokenAddOutput struct {
+		// 平文のトークン。発行時のみ返す
+		Token    string
+		APIToken APITokenDTO
+	}
+)
+
+type (
+	APITokenAddInteractor struct {
+		txManager          util.TxManager
+		repositoryAPIToken repository.APITokenRepository
+	}
+)
+
+func NewAPITokenAddInteractor(
+	txManager util.TxManager,
+	repositoryAPIToken repository.APITokenRepository,
+) IAPITokenAddInputPort {
+	return &APITokenAddInteractor{
+		txManager:          txManager,
+		repositoryAPIToken: repositoryAPIToken,
+	}
+}
+
+func (r APITokenAddInteractor) Execute(ctx context.Context, user vo.UserID, input APITokenAddInput) (*APITokenAddOutput, error) {
+
+	var name vo.APITokenName
+	var scope vo.APITokenScope
+	var lifetimeDays vo.APITokenLifetimeDays
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&name, vo.NewAPITokenName, input.Name))
+	errs = errors.Join(errs, vo.SetVOConstructor(&scope, vo.NewAPITokenScope, input.Scope))
+	errs = errors.Join(errs, vo.SetVOConstructor(&lifetimeDays, vo.NewAPITokenLifetimeDays, input.ExpiresInDays))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	apiToken, token, err := apitoken.NewCreateRootAPITokenModel(user, name, scope, lifetimeDays)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var tokenID vo.APITokenID
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		tokenID, err = r.repositoryAPIToken.Save(ctx, tx, apiToken)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	savedAPIToken, err := r.repositoryAPIToken.FindByID(ctx, tokenID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if savedAPIToken == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("発行したAPIトークンが取得できません:%d", tokenID.Value()))
+	}
+
+	return &APITokenAddOutput{
+		Token:    token,
+		APIToken: toAPITokenDTO(savedAPIToken, time.Now()),
+	}, nil
+}
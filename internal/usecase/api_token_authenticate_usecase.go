@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/entity"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IAPITokenAuthenticateInputPort interface {
+		Execute(ctx context.Context, token string) (*APITokenAuthenticateOutput, error)
+	}
+)
+
+type (
+	APITokenAuthenticateOutput struct {
+		// Cookieのセッションと同じ形でユーザー情報を返す
+		Session entity.SessionEntity
+		Scope   vo.APITokenScope
+	}
+)
+
+type (
+	APITokenAuthenticateInteractor struct {
+		txManager          util.TxManager
+		repositoryAPIToken repository.APITokenRepository
+		repositoryUser     repository.UserRepository
+	}
+)
+
+func NewAPITokenAuthenticateInteractor(
+	txManager util.TxManager,
+	repositoryAPIToken repository.APITokenRepository,
+	repositoryUser repository.UserRepository,
+) IAPITokenAuthenticateInputPort {
+	return &APITokenAuthenticateInteractor{
+		txManager:          txManager,
+		repositoryAPIToken: repositoryAPIToken,
+		repositoryUser:     repositoryUser,
+	}
+}
+
+// トークンが無効な場合はnilを返す
+func (r APITokenAuthenticateInteractor) Execute(ctx context.Context, token string) (*APITokenAuthenticateOutput, error) {
+
+	apiToken, err := r.repositoryAPIToken.FindByTokenHash(ctx, hash.HashToken(token))
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	now := time.Now()
+	if apiToken == nil || apiToken.IsExpiredAt(now) {
+		return nil, nil
+	}
+
+	// 無効化・削除されたユーザーのトークンは利用できない
+	user, err := r.repositoryUser.FindByUserID(ctx, apiToken.UserID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if user == nil {
+		return nil, nil
+	}
+
+	if apiToken.MarkUsedAt(now) {
+
+		err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+			if _, err := r.repositoryAPIToken.Save(ctx, tx, apiToken); err != nil {
+				return log.WrapErrorWithStackTrace(err)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	return &APITokenAuthenticateOutput{
+		Session: entity.SessionEntity{
+			UserID:    user.ID(),
+			RoleKey:   user.RoleKey(),
+			ExpiresAt: apiToken.ExpiresAt(),
+		},
+		Scope: apiToken.Scope(),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/apitoken"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IAPITokenListInputPort interface {
+		Execute(ctx context.Context, user vo.UserID) (*APITokenListOutput, error)
+	}
+)
+
+type (
+	APITokenListOutput struct {
+		APITokens []APITokenDTO
+	}
+
+	APITokenDTO struct {
+		ID          int
+		Name        string
+		TokenPrefix string
+		Scope       string
+		ExpiresAt   time.Time
+		// 一度も利用されていない場合はnil
+		LastUsedAt *time.Time
+		Expired    bool
+		CreatedAt  time.Time
+	}
+)
+
+type (
+	APITokenListInteractor struct {
+		repositoryAPIToken repository.APITokenRepository
+	}
+)
+
+func NewAPITokenListInteractor(
+	repositoryAPIToken repository.APITokenRepository,
+) IAPITokenListInputPort {
+	return &APITokenListInteractor{
+		repositoryAPIToken: repositoryAPIToken,
+	}
+}
+
+func (r APITokenListInteractor) Execute(ctx context.Context, user vo.UserID) (*APITokenListOutput, error) {
+
+	return findAPITokenListOutput(ctx, r.repositoryAPIToken, user)
+}
+
+func findAPITokenListOutput(ctx context.Context, repositoryAPIToken repository.APITokenRepository, user vo.UserID) (*APITokenListOutput, error) {
+
+	apiTokens, err := repositoryAPIToken.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	now := time.Now()
+
+	return &APITokenListOutput{
+		APITokens: lo.Map(apiTokens, func(item *apitoken.RootAPITokenModel, _ int) APITokenDTO {
+			return toAPITokenDTO(item, now)
+		}),
+	}, nil
+}
+
+func toAPITokenDTO(apiToken *apitoken.RootAPITokenModel, now time.Time) APITokenDTO {
+
+	var lastUsedAt *time.Time
+	if !apiToken.LastUsedAt().IsZero() {
+		lastUsedAt = lo.ToPtr(apiToken.LastUsedAt())
+	}
+
+	return APITokenDTO{
+		ID:          apiToken.ID().Value(),
+		Name:        apiToken.Name().Value(),
+		TokenPrefix: apiToken.TokenPrefix(),
+		Scope:       apiToken.Scope().Value(),
+		ExpiresAt:   apiToken.ExpiresAt(),
+		LastUsedAt:  lastUsedAt,
+		Expired:     apiToken.IsExpiredAt(now),
+		CreatedAt:   apiToken.CreatedAt(),
+	}
+}
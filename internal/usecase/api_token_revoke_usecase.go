@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IAPITokenRevokeInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputTokenID int) (*APITokenListOutput, error)
+	}
+)
+
+type (
+	APITokenRevokeInteractor struct {
+		txManager          util.TxManager
+		repositoryAPIToken repository.APITokenRepository
+	}
+)
+
+func NewAPITokenRevokeInteractor(
+	txManager util.TxManager,
+	repositoryAPIToken repository.APITokenRepository,
+) IAPITokenRevokeInputPort {
+	return &APITokenRevokeInteractor{
+		txManager:          txManager,
+		repositoryAPIToken: repositoryAPIToken,
+	}
+}
+
+func (r APITokenRevokeInteractor) Execute(ctx context.Context, user vo.UserID, inputTokenID int) (*APITokenListOutput, error) {
+
+	tokenID, err := vo.NewAPITokenID(inputTokenID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	apiToken, err := r.repositoryAPIToken.FindByID(ctx, tokenID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 他のユーザーのトークンは存在を明かさない
+	if apiToken == nil || !apiToken.IsOwnedBy(user) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのAPIトークンは存在しません:%d", tokenID.Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		err := r.repositoryAPIToken.Delete(ctx, tx, tokenID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findAPITokenListOutput(ctx, r.repositoryAPIToken, user)
+}
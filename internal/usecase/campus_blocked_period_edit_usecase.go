@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ICampusBlockedPeriodEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputCampus string, input []CampusBlockedPeriodInput) error
+	}
+)
+
+type (
+	CampusBlockedPeriodInput struct {
+		Title            string
+		RoomIndex        int
+		Recurrence       string
+		Weekday          int
+		TargetDate       string
+		StartTimeHour    int
+		StartTimeMinutes int
+		EndTimeHour      int
+		EndTimeMinutes   int
+	}
+)
+
+type (
+	CampusBlockedPeriodEditInteractor struct {
+		txManager                  util.TxManager
+		repositoryCampus           repository.CampusRepository
+		repositorySchedulingPolicy repository.SchedulingPolicyRepository
+		serviceAuthorization       service.IAuthorizationService
+	}
+)
+
+func NewCampusBlockedPeriodEditInteractor(
+	txManager util.TxManager,
+	repositoryCampus repository.CampusRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	serviceAuthorization service.IAuthorizationService,
+) ICampusBlockedPeriodEditInputPort {
+	return &CampusBlockedPeriodEditInteractor{
+		txManager:                  txManager,
+		repositoryCampus:           repositoryCampus,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+		serviceAuthorization:       serviceAuthorization,
+	}
+}
+
+func (r CampusBlockedPeriodEditInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, input []CampusBlockedPeriodInput) error {
+
+	campus, blockedPeriods, err := r.createModel(inputCampus, input)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, campus, vo.PERMISSION_CAMPUS_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, campus)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		schedulingPolicy.ReplaceBlockedPeriods(blockedPeriods)
+
+		if err = r.repositorySchedulingPolicy.Save(ctx, tx, schedulingPolicy); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (r CampusBlockedPeriodEditInteractor) createModel(inputCampus string, input []CampusBlockedPeriodInput) (vo.Campus, policy.BlockedPeriodModelSlice, error) {
+
+	var campus vo.Campus
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, inputCampus))
+
+	blockedPeriods := make(policy.BlockedPeriodModelSlice, 0, len(input))
+	for _, item := range input {
+
+		var title vo.BlockedPeriodTitle
+		var roomIndex vo.RoomIndex
+		var recurrence vo.BlockedPeriodRecurrence
+		errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewBlockedPeriodTitle, item.Title))
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndexOrAll, item.RoomIndex))
+		errs = errors.Join(errs, vo.SetVOConstructor(&recurrence, vo.NewBlockedPeriodRecurrence, item.Recurrence))
+
+		// 曜日と日付は繰り返しの指定に応じて使用する
+		weekday := vo.WEEKDAY_INVALID
+		if recurrence.IsWeekly() {
+			errs = errors.Join(errs, vo.SetVOConstructor(&weekday, vo.NewWeekday, item.Weekday))
+		}
+
+		targetDate := vo.SCHEDULE_TARGET_DATE_NONE
+		if recurrence.IsDate() {
+			errs = errors.Join(errs, vo.SetVOConstructor(&targetDate, vo.NewScheduleTargetDate, item.TargetDate))
+		}
+
+		period, err := vo.NewScheduleTime(item.StartTimeHour, item.StartTimeMinutes, item.EndTimeHour, item.EndTimeMinutes)
+		errs = errors.Join(errs, err)
+
+		if errs != nil {
+			continue
+		}
+
+		blockedPeriod, err := policy.NewBlockedPeriodModel(title, roomIndex, recurrence, weekday, targetDate, period)
+		errs = errors.Join(errs, err)
+		if err != nil {
+			continue
+		}
+
+		blockedPeriods = append(blockedPeriods, blockedPeriod)
+	}
+
+	if errs != nil {
+		return campus, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return campus, blockedPeriods, nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	ICampusBlockedPeriodGetInputPort interface {
+		Execute(ctx context.Context, inputCampus string) (*CampusBlockedPeriodGetOutput, error)
+	}
+)
+
+type (
+	CampusBlockedPeriodGetOutput struct {
+		Campus         string
+		BlockedPeriods []*CampusBlockedPeriodDTO
+	}
+
+	CampusBlockedPeriodDTO struct {
+		Title            string
+		RoomIndex        int
+		Recurrence       string
+		Weekday          int
+		TargetDate       string
+		StartTimeHour    int
+		StartTimeMinutes int
+		EndTimeHour      int
+		EndTimeMinutes   int
+	}
+)
+
+type CampusBlockedPeriodGetInteractor struct {
+	repositoryCampus           repository.CampusRepository
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository
+}
+
+func NewCampusBlockedPeriodGetInteractor(
+	repositoryCampus repository.CampusRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+) ICampusBlockedPeriodGetInputPort {
+	return &CampusBlockedPeriodGetInteractor{
+		repositoryCampus:           repositoryCampus,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+	}
+}
+
+func (r *CampusBlockedPeriodGetInteractor) Execute(ctx context.Context, inputCampus string) (*CampusBlockedPeriodGetOutput, error) {
+
+	campus, err := vo.NewCampus(inputCampus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, campus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &CampusBlockedPeriodGetOutput{
+		Campus:         campus.Value(),
+		BlockedPeriods: ToCampusBlockedPeriodDTOs(schedulingPolicy.BlockedPeriods()),
+	}, nil
+}
+
+func ToCampusBlockedPeriodDTOs(blockedPeriods policy.BlockedPeriodModelSlice) []*CampusBlockedPeriodDTO {
+
+	return lo.Map(blockedPeriods, func(item *policy.BlockedPeriodModel, _ int) *CampusBlockedPeriodDTO {
+
+		startTimeHour, startTimeMinutes := item.Period().StartTimeValue()
+		endTimeHour, endTimeMinutes := item.Period().EndTimeValue()
+
+		return &CampusBlockedPeriodDTO{
+			Title:            item.Title().Value(),
+			RoomIndex:        item.RoomIndex().Value(),
+			Recurrence:       item.Recurrence().Value(),
+			Weekday:          item.Weekday().Value(),
+			TargetDate:       item.TargetDate().Value(),
+			StartTimeHour:    startTimeHour,
+			StartTimeMinutes: startTimeMinutes,
+			EndTimeHour:      endTimeHour,
+			EndTimeMinutes:   endTimeMinutes,
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ICampusPolicyEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputCampus string, input CampusPolicyEditInput) error
+	}
+)
+
+type (
+	CampusPolicyEditInput struct {
+		GridMinutes       int
+		MinLessonDuration int
+		MaxLessonDuration int
+		MinGapMinutes     int
+		OpeningHours      []CampusOpeningHourInput
+	}
+
+	CampusOpeningHourInput struct {
+		Weekday          int
+		OpenTimeHour     int
+		OpenTimeMinutes  int
+		CloseTimeHour    int
+		CloseTimeMinutes int
+	}
+)
+
+type (
+	CampusPolicyEditInteractor struct {
+		txManager                  util.TxManager
+		repositoryCampus           repository.CampusRepository
+		repositorySchedulingPolicy repository.SchedulingPolicyRepository
+		serviceAuthorization       service.IAuthorizationService
+	}
+)
+
+func NewCampusPolicyEditInteractor(
+	txManager util.TxManager,
+	repositoryCampus repository.CampusRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	serviceAuthorization service.IAuthorizationService,
+) ICampusPolicyEditInputPort {
+	return &CampusPolicyEditInteractor{
+		txManager:                  txManager,
+		repositoryCampus:           repositoryCampus,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+		serviceAuthorization:       serviceAuthorization,
+	}
+}
+
+func (r CampusPolicyEditInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, input CampusPolicyEditInput) error {
+
+	schedulingPolicy, err := r.createModel(inputCampus, input)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, schedulingPolicy.Campus(), vo.PERMISSION_CAMPUS_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(schedulingPolicy.Campus()) {
+		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", schedulingPolicy.Campus().Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		// 利用不可の時間帯は別の画面で編集するため、登録済みのものを引き継ぐ
+		existing, err := r.repositorySchedulingPolicy.FindByCampus(ctx, schedulingPolicy.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+		schedulingPolicy.ReplaceBlockedPeriods(existing.BlockedPeriods())
+
+		if err = r.repositorySchedulingPolicy.Save(ctx, tx, schedulingPolicy); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (r CampusPolicyEditInteractor) createModel(inputCampus string, input CampusPolicyEditInput) (*policy.RootSchedulingPolicyModel, error) {
+
+	var campus vo.Campus
+	var gridMinutes vo.PolicyGridMinutes
+	var minLessonDuration vo.LessonDuration
+	var maxLessonDuration vo.LessonDuration
+	var minGapMinutes vo.PolicyGapMinutes
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, inputCampus))
+	errs = errors.Join(errs, vo.SetVOConstructor(&gridMinutes, vo.NewPolicyGridMinutes, input.GridMinutes))
+	errs = errors.Join(errs, vo.SetVOConstructor(&minLessonDuration, vo.NewLessonDuration, input.MinLessonDuration))
+	errs = errors.Join(errs, vo.SetVOConstructor(&maxLessonDuration, vo.NewLessonDuration, input.MaxLessonDuration))
+	errs = errors.Join(errs, vo.SetVOConstructor(&minGapMinutes, vo.NewPolicyGapMinutes, input.MinGapMinutes))
+
+	openingHours := make([]*policy.OpeningHourModel, 0, len(input.OpeningHours))
+	for _, openingHour := range input.OpeningHours {
+
+		var weekday vo.Weekday
+		errs = errors.Join(errs, vo.SetVOConstructor(&weekday, vo.NewWeekday, openingHour.Weekday))
+
+		hours, err := vo.NewScheduleTime(openingHour.OpenTimeHour, openingHour.OpenTimeMinutes, openingHour.CloseTimeHour, openingHour.CloseTimeMinutes)
+		errs = errors.Join(errs, err)
+
+		openingHours = append(openingHours, policy.NewOpeningHourModel(weekday, hours))
+	}
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	schedulingPolicy, err := policy.NewRootSchedulingPolicyModel(
+		campus,
+		gridMinutes,
+		minLessonDuration,
+		maxLessonDuration,
+		minGapMinutes,
+		openingHours,
+		policy.BlockedPeriodModelSlice{},
+	)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	return schedulingPolicy, nil
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	ICampusPolicyGetInputPort interface {
+		Execute(ctx context.Context, inputCampus string) (*CampusPolicyGetOutput, error)
+	}
+)
+
+type (
+	CampusPolicyGetOutput struct {
+		Campus            string
+		GridMinutes       int
+		MinLessonDuration int
+		MaxLessonDuration int
+		MinGapMinutes     int
+		OpeningHours      []*CampusOpeningHourDTO
+	}
+
+	CampusOpeningHourDTO struct {
+		Weekday          int
+		OpenTimeHour     int
+		OpenTimeMinutes  int
+		CloseTimeHour    int
+		CloseTimeMinutes int
+	}
+)
+
+type CampusPolicyGetInteractor struct {
+	repositoryCampus           repository.CampusRepository
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository
+}
+
+func NewCampusPolicyGetInteractor(
+	repositoryCampus repository.CampusRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+) ICampusPolicyGetInputPort {
+	return &CampusPolicyGetInteractor{
+		repositoryCampus:           repositoryCampus,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+	}
+}
+
+func (r *CampusPolicyGetInteractor) Execute(ctx context.Context, inputCampus string) (*CampusPolicyGetOutput, error) {
+
+	campus, err := vo.NewCampus(inputCampus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, campus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &CampusPolicyGetOutput{
+		Campus:            schedulingPolicy.Campus().Value(),
+		GridMinutes:       schedulingPolicy.GridMinutes().Value(),
+		MinLessonDuration: schedulingPolicy.MinLessonDuration().Value(),
+		MaxLessonDuration: schedulingPolicy.MaxLessonDuration().Value(),
+		MinGapMinutes:     schedulingPolicy.MinGapMinutes().Value(),
+		OpeningHours: lo.Map(schedulingPolicy.OpeningHours(), func(item *policy.OpeningHourModel, _ int) *CampusOpeningHourDTO {
+
+			openTimeHour, openTimeMinutes := item.Hours().StartTimeValue()
+			closeTimeHour, closeTimeMinutes := item.Hours().EndTimeValue()
+
+			return &CampusOpeningHourDTO{
+				Weekday:          item.Weekday().Value(),
+				OpenTimeHour:     openTimeHour,
+				OpenTimeMinutes:  openTimeMinutes,
+				CloseTimeHour:    closeTimeHour,
+				CloseTimeMinutes: closeTimeMinutes,
+			}
+		}),
+	}, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/itemtype"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ICampusRoomItemTypeEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputCampus string, input []CampusRoomItemTypeInput) error
+	}
+)
+
+type (
+	CampusRoomItemTypeInput struct {
+		ItemTag string
+		Label   string
+		Color   string
+	}
+)
+
+type (
+	CampusRoomItemTypeEditInteractor struct {
+		txManager              util.TxManager
+		repositoryCampus       repository.CampusRepository
+		repositoryRoomItemType repository.RoomItemTypeRepository
+		serviceAuthorization   service.IAuthorizationService
+	}
+)
+
+func NewCampusRoomItemTypeEditInteractor(
+	txManager util.TxManager,
+	repositoryCampus repository.CampusRepository,
+	repositoryRoomItemType repository.RoomItemTypeRepository,
+	serviceAuthorization service.IAuthorizationService,
+) ICampusRoomItemTypeEditInputPort {
+	return &CampusRoomItemTypeEditInteractor{
+		txManager:              txManager,
+		repositoryCampus:       repositoryCampus,
+		repositoryRoomItemType: repositoryRoomItemType,
+		serviceAuthorization:   serviceAuthorization,
+	}
+}
+
+func (r CampusRoomItemTypeEditInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, input []CampusRoomItemTypeInput) error {
+
+	campus, itemTypes, err := r.createModel(inputCampus, input)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, campus, vo.PERMISSION_CAMPUS_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		if err = r.repositoryRoomItemType.Save(ctx, tx, campus, itemTypes); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+func (r CampusRoomItemTypeEditInteractor) createModel(inputCampus string, input []CampusRoomItemTypeInput) (vo.Campus, itemtype.RootRoomItemTypeModelSlice, error) {
+
+	var campus vo.Campus
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, inputCampus))
+
+	itemTypes := make(itemtype.RootRoomItemTypeModelSlice, 0, len(input))
+	for _, item := range input {
+
+		var itemTag vo.RoomItemTag
+		var label vo.RoomItemTypeLabel
+		var color vo.RoomItemTypeColor
+
+		var itemErrs error
+		itemErrs = errors.Join(itemErrs, vo.SetVOConstructor(&itemTag, vo.NewRoomItemTag, item.ItemTag))
+		itemErrs = errors.Join(itemErrs, vo.SetVOConstructor(&label, vo.NewRoomItemTypeLabel, item.Label))
+		itemErrs = errors.Join(itemErrs, vo.SetVOConstructor(&color, vo.NewRoomItemTypeColor, item.Color))
+
+		if itemErrs != nil {
+			errs = errors.Join(errs, itemErrs)
+			continue
+		}
+
+		itemType, err := itemtype.NewRootRoomItemTypeModel(campus, itemTag, label, color)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+
+		itemTypes = append(itemTypes, itemType)
+	}
+
+	if errs == nil && !itemTypes.IsUniq() {
+		errs = errors.New("アイテムの種類が重複しています")
+	}
+
+	if errs != nil {
+		return campus, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return campus, itemTypes, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/itemtype"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	ICampusRoomItemTypeGetInputPort interface {
+		Execute(ctx context.Context, inputCampus string) (*CampusRoomItemTypeGetOutput, error)
+	}
+)
+
+type (
+	CampusRoomItemTypeGetOutput struct {
+		Campus        string
+		RoomItemTypes []*CampusRoomItemTypeDTO
+	}
+
+	CampusRoomItemTypeDTO struct {
+		ItemTag string
+		Label   string
+		Color   string
+	}
+)
+
+type CampusRoomItemTypeGetInteractor struct {
+	repositoryCampus       repository.CampusRepository
+	repositoryRoomItemType repository.RoomItemTypeRepository
+}
+
+func NewCampusRoomItemTypeGetInteractor(
+	repositoryCampus repository.CampusRepository,
+	repositoryRoomItemType repository.RoomItemTypeRepository,
+) ICampusRoomItemTypeGetInputPort {
+	return &CampusRoomItemTypeGetInteractor{
+		repositoryCampus:       repositoryCampus,
+		repositoryRoomItemType: repositoryRoomItemType,
+	}
+}
+
+func (r *CampusRoomItemTypeGetInteractor) Execute(ctx context.Context, inputCampus string) (*CampusRoomItemTypeGetOutput, error) {
+
+	campus, err := vo.NewCampus(inputCampus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	itemTypes, err := r.repositoryRoomItemType.FindByCampus(ctx, campus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &CampusRoomItemTypeGetOutput{
+		Campus:        campus.Value(),
+		RoomItemTypes: ToCampusRoomItemTypeDTOs(itemTypes),
+	}, nil
+}
+
+func ToCampusRoomItemTypeDTOs(itemTypes itemtype.RootRoomItemTypeModelSlice) []*CampusRoomItemTypeDTO {
+
+	return lo.Map(itemTypes, func(item *itemtype.RootRoomItemTypeModel, _ int) *CampusRoomItemTypeDTO {
+		return &CampusRoomItemTypeDTO{
+			ItemTag: item.ItemTag().Value(),
+			Label:   item.Label().Value(),
+			Color:   item.Color().Value(),
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package client
+
+import (
+	"context"
+)
+
+// IDトークンから取り出した外部アカウントの情報
+type OIDCClaims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type OIDCClient interface {
+	// IDプロバイダーの認可エンドポイントへのURLを組み立てる
+	AuthorizationURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error)
+	// 認可コードをトークンと交換し、検証済みのIDトークンのクレームを返す
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*OIDCClaims, error)
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IDayBundleAddInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputCampus string, input DayBundleInput) (*DayBundleDTO, error)
+	}
+)
+
+type (
+	DayBundleInput struct {
+		Title           string
+		ScheduleIDs     []int
+		RefuseCollision bool
+	}
+)
+
+type (
+	DayBundleAddInteractor struct {
+		txManager            util.TxManager
+		repositoryCampus     repository.CampusRepository
+		repositorySchedule   repository.ScheduleRepository
+		repositoryDayBundle  repository.DayBundleRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewDayBundleAddInteractor(
+	txManager util.TxManager,
+	repositoryCampus repository.CampusRepository,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryDayBundle repository.DayBundleRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IDayBundleAddInputPort {
+	return &DayBundleAddInteractor{
+		txManager:            txManager,
+		repositoryCampus:     repositoryCampus,
+		repositorySchedule:   repositorySchedule,
+		repositoryDayBundle:  repositoryDayBundle,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r DayBundleAddInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, input DayBundleInput) (*DayBundleDTO, error) {
+
+	var campus vo.Campus
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, inputCampus))
+
+	title, scheduleIDs, err := createDayBundleVO(input)
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, campus, vo.PERMISSION_BUNDLE_EDIT); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	dayBundle, err := bundle.NewCreateRootDayBundleModel(campus, title, scheduleIDs, input.RefuseCollision, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = validateDayBundleMembers(ctx, r.repositorySchedule, r.repositoryDayBundle, dayBundle)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var dayBundleID vo.DayBundleID
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		var err error
+		dayBundleID, err = r.repositoryDayBundle.Save(ctx, tx, dayBundle)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	saved, err := r.repositoryDayBundle.FindByID(ctx, dayBundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return ToDayBundleDTO(saved), nil
+}
+
+func createDayBundleVO(input DayBundleInput) (vo.DayBundleTitle, []vo.ScheduleID, error) {
+
+	var title vo.DayBundleTitle
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewDayBundleTitle, input.Title))
+
+	scheduleIDs := make([]vo.ScheduleID, 0, len(input.ScheduleIDs))
+	for _, inputScheduleID := range input.ScheduleIDs {
+
+		var scheduleID vo.ScheduleID
+		errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+		scheduleIDs = append(scheduleIDs, scheduleID)
+	}
+
+	return title, scheduleIDs, errs
+}
+
+// 所属するスケジュールは同じ校舎のもので、他のまとまりに含まれていないこと
+func validateDayBundleMembers(
+	ctx context.Context,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryDayBundle repository.DayBundleRepository,
+	dayBundle *bundle.RootDayBundleModel,
+) error {
+
+	for _, scheduleID := range dayBundle.ScheduleIDs() {
+
+		scheduleData, err := repositorySchedule.FindByID(ctx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if scheduleData == nil {
+			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		if scheduleData.Campus() != dayBundle.Campus() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("別の校舎のスケジュールは指定できません:%d", scheduleID.Value()))
+		}
+
+		joined, err := repositoryDayBundle.FindByScheduleID(ctx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if joined != nil && joined.ID() != dayBundle.ID() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("スケジュールは既に別のまとまりに含まれています:%d", scheduleID.Value()))
+		}
+	}
+
+	return nil
+}
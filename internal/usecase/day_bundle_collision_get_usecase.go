@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IDayBundleCollisionGetInputPort interface {
+		Execute(ctx context.Context, inputDayBundleID int) (*DayBundleCollisionGetOutput, error)
+	}
+)
+
+type (
+	DayBundleCollisionGetOutput struct {
+		DayBundle  *DayBundleDTO
+		Collisions []*RoomCollisionDTO
+	}
+
+	RoomCollisionDTO struct {
+		RoomIndex        int
+		StartTimeHour    int
+		StartTimeMinutes int
+		EndTimeHour      int
+		EndTimeMinutes   int
+		ScheduleID       int
+		Identifier       string
+		OtherScheduleID  int
+		OtherIdentifier  string
+	}
+)
+
+type DayBundleCollisionGetInteractor struct {
+	repositoryDayBundle      repository.DayBundleRepository
+	serviceScheduleCollision service.IScheduleCollisionService
+}
+
+func NewDayBundleCollisionGetInteractor(
+	repositoryDayBundle repository.DayBundleRepository,
+	serviceScheduleCollision service.IScheduleCollisionService,
+) IDayBundleCollisionGetInputPort {
+	return &DayBundleCollisionGetInteractor{
+		repositoryDayBundle:      repositoryDayBundle,
+		serviceScheduleCollision: serviceScheduleCollision,
+	}
+}
+
+func (r *DayBundleCollisionGetInteractor) Execute(ctx context.Context, inputDayBundleID int) (*DayBundleCollisionGetOutput, error) {
+
+	dayBundleID, err := vo.NewDayBundleID(inputDayBundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	dayBundle, err := r.repositoryDayBundle.FindByID(ctx, dayBundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if dayBundle == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのまとまりは存在しません:%d", dayBundleID.Value()))
+	}
+
+	schedules, err := r.serviceScheduleCollision.LoadMembers(ctx, dayBundle)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	collisions := r.serviceScheduleCollision.FindCollisions(schedules)
+
+	return &DayBundleCollisionGetOutput{
+		DayBundle:  ToDayBundleDTO(dayBundle),
+		Collisions: ToRoomCollisionDTOs(collisions),
+	}, nil
+}
+
+func ToRoomCollisionDTOs(collisions bundle.RoomCollisionModelSlice) []*RoomCollisionDTO {
+
+	return lo.Map(collisions, func(item *bundle.RoomCollisionModel, _ int) *RoomCollisionDTO {
+
+		startTimeHour, startTimeMinutes := item.StartTime().Value()
+		endTimeHour, endTimeMinutes := item.EndTime().Value()
+
+		return &RoomCollisionDTO{
+			RoomIndex:        item.RoomIndex().Value(),
+			StartTimeHour:    startTimeHour,
+			StartTimeMinutes: startTimeMinutes,
+			EndTimeHour:      endTimeHour,
+			EndTimeMinutes:   endTimeMinutes,
+			ScheduleID:       item.ScheduleID().Value(),
+			Identifier:       item.Identifier().Value(),
+			OtherScheduleID:  item.OtherScheduleID().Value(),
+			OtherIdentifier:  item.OtherIdentifier().Value(),
+		}
+	})
+}
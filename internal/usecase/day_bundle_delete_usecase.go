@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IDayBundleDeleteInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputDayBundleID int) error
+	}
+)
+
+type (
+	DayBundleDeleteInteractor struct {
+		txManager            util.TxManager
+		repositoryDayBundle  repository.DayBundleRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewDayBundleDeleteInteractor(
+	txManager util.TxManager,
+	repositoryDayBundle repository.DayBundleRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IDayBundleDeleteInputPort {
+	return &DayBundleDeleteInteractor{
+		txManager:            txManager,
+		repositoryDayBundle:  repositoryDayBundle,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r DayBundleDeleteInteractor) Execute(ctx context.Context, user vo.UserID, inputDayBundleID int) error {
+
+	dayBundleID, err := vo.NewDayBundleID(inputDayBundleID)
+	if err != nil {
+		return log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	dayBundle, err := r.repositoryDayBundle.FindByID(ctx, dayBundleID)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if dayBundle == nil {
+		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのまとまりは存在しません:%d", dayBundleID.Value()))
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, dayBundle.Campus(), vo.PERMISSION_BUNDLE_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		err := r.repositoryDayBundle.Delete(ctx, tx, dayBundleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IDayBundleEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputDayBundleID int, input DayBundleInput) (*DayBundleDTO, error)
+	}
+)
+
+type (
+	DayBundleEditInteractor struct {
+		txManager            util.TxManager
+		repositorySchedule   repository.ScheduleRepository
+		repositoryDayBundle  repository.DayBundleRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewDayBundleEditInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryDayBundle repository.DayBundleRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IDayBundleEditInputPort {
+	return &DayBundleEditInteractor{
+		txManager:            txManager,
+		repositorySchedule:   repositorySchedule,
+		repositoryDayBundle:  repositoryDayBundle,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r DayBundleEditInteractor) Execute(ctx context.Context, user vo.UserID, inputDayBundleID int, input DayBundleInput) (*DayBundleDTO, error) {
+
+	dayBundleID, err := vo.NewDayBundleID(inputDayBundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	title, scheduleIDs, err := createDayBundleVO(input)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", err.Error()))
+	}
+
+	dayBundle, err := r.repositoryDayBundle.FindByID(ctx, dayBundleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if dayBundle == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのまとまりは存在しません:%d", dayBundleID.Value()))
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, dayBundle.Campus(), vo.PERMISSION_BUNDLE_EDIT); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = dayBundle.Edit(title, scheduleIDs, input.RefuseCollision)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = validateDayBundleMembers(ctx, r.repositorySchedule, r.repositoryDayBundle, dayBundle)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		_, err := r.repositoryDayBundle.Save(ctx, tx, dayBundle)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return ToDayBundleDTO(dayBundle), nil
+}
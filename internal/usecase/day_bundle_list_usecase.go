@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/bundle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IDayBundleListInputPort interface {
+		Execute(ctx context.Context, inputCampus string) (*DayBundleListOutput, error)
+	}
+)
+
+type (
+	DayBundleListOutput struct {
+		Campus     string
+		DayBundles []*DayBundleDTO
+	}
+
+	DayBundleDTO struct {
+		DayBundleID     int
+		Campus          string
+		Title           string
+		ScheduleIDs     []int
+		RefuseCollision bool
+		CreatedUserID   int
+	}
+)
+
+type DayBundleListInteractor struct {
+	repositoryCampus    repository.CampusRepository
+	repositoryDayBundle repository.DayBundleRepository
+}
+
+func NewDayBundleListInteractor(
+	repositoryCampus repository.CampusRepository,
+	repositoryDayBundle repository.DayBundleRepository,
+) IDayBundleListInputPort {
+	return &DayBundleListInteractor{
+		repositoryCampus:    repositoryCampus,
+		repositoryDayBundle: repositoryDayBundle,
+	}
+}
+
+func (r *DayBundleListInteractor) Execute(ctx context.Context, inputCampus string) (*DayBundleListOutput, error) {
+
+	campus, err := vo.NewCampus(inputCampus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !campuses.IsExist(campus) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
+	}
+
+	dayBundles, err := r.repositoryDayBundle.FindByCampus(ctx, campus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &DayBundleListOutput{
+		Campus: campus.Value(),
+		DayBundles: lo.Map(dayBundles, func(item *bundle.RootDayBundleModel, _ int) *DayBundleDTO {
+			return ToDayBundleDTO(item)
+		}),
+	}, nil
+}
+
+func ToDayBundleDTO(dayBundle *bundle.RootDayBundleModel) *DayBundleDTO {
+
+	return &DayBundleDTO{
+		DayBundleID: dayBundle.ID().Value(),
+		Campus:      dayBundle.Campus().Value(),
+		Title:       dayBundle.Title().Value(),
+		ScheduleIDs: lo.Map(dayBundle.ScheduleIDs(), func(item vo.ScheduleID, _ int) int {
+			return item.Value()
+		}),
+		RefuseCollision: dayBundle.RefuseCollision(),
+		CreatedUserID:   dayBundle.CreateUser().Value(),
+	}
+}
@@ -14,7 +14,7 @@ import (
 
 type (
 	IInvisibleRoomSaveInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, inputUserID vo.UserID, inputScheduleID int, roomIndexes []int) error
+		Execute(ctx context.Context, inputUserID vo.UserID, inputScheduleID int, roomIndexes []int) error
 	}
 )
 
@@ -45,7 +45,7 @@ func NewInvisibleRoomSaveInteractor(
 	}
 }
 
-func (r InvisibleRoomSaveInteractor) Execute(ctx context.Context, role vo.RoleKey, inputUserID vo.UserID, inputScheduleID int, inputRoomIndexes []int) error {
+func (r InvisibleRoomSaveInteractor) Execute(ctx context.Context, inputUserID vo.UserID, inputScheduleID int, inputRoomIndexes []int) error {
 
 	scheduleID, err := vo.NewScheduleID(inputScheduleID)
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/lesson"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
@@ -14,7 +15,7 @@ import (
 
 type (
 	ILessonAddInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, inputCampus string, input LessonAddInputDTO) error
+		Execute(ctx context.Context, user vo.UserID, inputCampus string, input LessonAddInputDTO) error
 	}
 )
 
@@ -27,9 +28,11 @@ type (
 
 type (
 	LessonAddInteractor struct {
-		txManager        util.TxManager
-		repositoryCampus repository.CampusRepository
-		repositoryLesson repository.LessonRepository
+		txManager                  util.TxManager
+		repositoryCampus           repository.CampusRepository
+		repositoryLesson           repository.LessonRepository
+		repositorySchedulingPolicy repository.SchedulingPolicyRepository
+		serviceAuthorization       service.IAuthorizationService
 	}
 )
 
@@ -37,25 +40,29 @@ func NewLessonAddInteractor(
 	txManager util.TxManager,
 	repositoryCampus repository.CampusRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) ILessonAddInputPort {
 	return &LessonAddInteractor{
-		txManager:        txManager,
-		repositoryCampus: repositoryCampus,
-		repositoryLesson: repositoryLesson,
+		txManager:                  txManager,
+		repositoryCampus:           repositoryCampus,
+		repositoryLesson:           repositoryLesson,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+		serviceAuthorization:       serviceAuthorization,
 	}
 }
 
-func (r LessonAddInteractor) Execute(ctx context.Context, role vo.RoleKey, inputCampus string, input LessonAddInputDTO) error {
-
-	if !role.IsOwner() {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
-	}
+func (r LessonAddInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, input LessonAddInputDTO) error {
 
 	campus, err := vo.NewCampus(inputCampus)
 	if err != nil {
 		return log.WrapErrorWithStackTraceBadRequest(err)
 	}
 
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, campus, vo.PERMISSION_LESSON_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	campuses, err := r.repositoryCampus.FindAll(ctx)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
@@ -70,6 +77,15 @@ func (r LessonAddInteractor) Execute(ctx context.Context, role vo.RoleKey, input
 		return log.WrapErrorWithStackTraceBadRequest(err)
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, campus)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := schedulingPolicy.ValidateLessonDuration(lessonModel.Duration()); err != nil {
+		return log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
 	lessons, err := r.repositoryLesson.FindByCampus(ctx, campus)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
@@ -13,7 +14,7 @@ import (
 
 type (
 	ILessonEditInputPort interface {
-		Execute(c echo.Context, role vo.RoleKey, userID vo.UserID, input LessonEditInputDTO) error
+		Execute(c echo.Context, userID vo.UserID, input LessonEditInputDTO) error
 	}
 )
 
@@ -27,26 +28,30 @@ type (
 
 type (
 	LessonEditInteractor struct {
-		txManager        util.TxManager
-		repositoryLesson repository.LessonRepository
+		txManager                  util.TxManager
+		repositoryLesson           repository.LessonRepository
+		repositorySchedulingPolicy repository.SchedulingPolicyRepository
+		serviceAuthorization       service.IAuthorizationService
 	}
 )
 
 func NewLessonEditInteractor(
 	txManager util.TxManager,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) ILessonEditInputPort {
 	return &LessonEditInteractor{
-		txManager:        txManager,
-		repositoryLesson: repositoryLesson,
+		txManager:                  txManager,
+		repositoryLesson:           repositoryLesson,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+		serviceAuthorization:       serviceAuthorization,
 	}
 }
 
-func (r LessonEditInteractor) Execute(c echo.Context, role vo.RoleKey, userID vo.UserID, input LessonEditInputDTO) error {
+func (r LessonEditInteractor) Execute(c echo.Context, userID vo.UserID, input LessonEditInputDTO) error {
 
-	if !role.IsOwner() {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
-	}
+	ctx := c.Request().Context()
 
 	var errs error
 
@@ -62,7 +67,6 @@ func (r LessonEditInteractor) Execute(c echo.Context, role vo.RoleKey, userID vo
 		return log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	ctx := c.Request().Context()
 	lessonModel, err := r.repositoryLesson.FindByID(ctx, lessonID)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
@@ -72,8 +76,21 @@ func (r LessonEditInteractor) Execute(c echo.Context, role vo.RoleKey, userID vo
 		return log.WrapErrorWithStackTraceNotFound(log.Errorf("講座が見つかりません: %d", lessonID.Value()))
 	}
 
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, userID, lessonModel.Campus(), vo.PERMISSION_LESSON_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	lessonModel.Revise(lessonName, duration)
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, lessonModel.Campus())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := schedulingPolicy.ValidateLessonDuration(lessonModel.Duration()); err != nil {
+		return log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
 	lessons, err := r.repositoryLesson.FindByCampus(ctx, lessonModel.Campus())
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
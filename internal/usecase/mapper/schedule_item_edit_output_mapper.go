@@ -25,6 +25,7 @@ func (m *ScheduleItemEditOutputMapper) ToScheduleItemEditOutput(
 		HistoryIndex:   scheduleData.HistoryIndex().Value(),
 		LessonItemList: m.BuildScheduleLessonItems(scheduleData, lessons),
 		RoomLessonList: m.BuildScheduleRoomLessonItems(scheduleData, lessons),
+		ItemGroupList:  m.BuildScheduleItemGroups(scheduleData),
 	}
 }
 
@@ -102,7 +103,10 @@ func (r ScheduleItemEditOutputMapper) BuildScheduleRoomLessonItems(
 
 		lessonName := ""
 		lesson := lessons.FindByID(item.LessonID())
-		if lesson != nil {
+		if item.ItemTag().IsCustom() {
+			// 校舎ごとに追加した種類は名称、未設定の場合は種類を表示名にする
+			lessonName = cmp.Or(item.Title().Value(), item.ItemTag().Value())
+		} else if lesson != nil {
 			lessonName = lesson.Name().Value()
 		} else if item.ItemTag().IsCleaning() {
 			lessonName = "清掃"
@@ -113,6 +117,7 @@ func (r ScheduleItemEditOutputMapper) BuildScheduleRoomLessonItems(
 			LessonID:   item.LessonID().Value(),
 			Identifier: item.Identifier().Value(),
 			LessonName: cmp.Or(lessonName, "不明な講座"),
+			Title:      item.Title().Value(),
 			Duration:   item.Duration().Value(),
 			StartTime: port.ScheduleItemEditRoomLessonTime{
 				ScheduleItemTimeHour:    startTimeHour,
@@ -123,6 +128,24 @@ func (r ScheduleItemEditOutputMapper) BuildScheduleRoomLessonItems(
 				ScheduleItemTimeMinutes: endTimeMinutes,
 			},
 			RoomIndex: item.RoomIndex().Value(),
+			Pinned:    item.Pinned(),
+		}
+	})
+}
+
+func (r ScheduleItemEditOutputMapper) BuildScheduleItemGroups(
+	scheduleData *schedule.RootScheduleModel,
+) []port.ScheduleItemGroup {
+
+	return lo.Map(scheduleData.ItemGroups(), func(group *schedule.ScheduleItemGroupModel, _ int) port.ScheduleItemGroup {
+		return port.ScheduleItemGroup{
+			GroupIdentifier: group.GroupIdentifier().Value(),
+			Members: lo.Map(group.Members(), func(member *schedule.ScheduleItemGroupMemberModel, _ int) port.ScheduleItemGroupMember {
+				return port.ScheduleItemGroupMember{
+					Identifier:    member.Identifier().Value(),
+					OffsetMinutes: member.OffsetMinutes().Value(),
+				}
+			}),
 		}
 	})
 }
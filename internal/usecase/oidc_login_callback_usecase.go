@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/identity"
+	userModel "github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/client"
+	session "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IOIDCLoginCallbackInputPort interface {
+	Execute(ctx context.Context, input OIDCLoginCallbackInput) (*UserLoginOutput, error)
+}
+
+type OIDCLoginCallbackInput struct {
+	Code  string
+	State string
+	// ログイン開始時に保持した値
+	SavedState   string
+	Nonce        string
+	CodeVerifier string
+}
+
+type OIDCLoginCallbackInteractor struct {
+	env                    configs.EnvConfig
+	txManager              util.TxManager
+	clientOIDC             client.OIDCClient
+	repositoryUser         repository.UserRepository
+	repositoryUserIdentity repository.UserIdentityRepository
+	repositoryRole         repository.RoleRepository
+	repositoryTwoFactor    repository.TwoFactorRepository
+	repositorySession      session.SessionRepository
+}
+
+func NewOIDCLoginCallbackInteractor(
+	env configs.EnvConfig,
+	txManager util.TxManager,
+	clientOIDC client.OIDCClient,
+	repositoryUser repository.UserRepository,
+	repositoryUserIdentity repository.UserIdentityRepository,
+	repositoryRole repository.RoleRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
+	repositorySession session.SessionRepository,
+) IOIDCLoginCallbackInputPort {
+	return &OIDCLoginCallbackInteractor{
+		env:                    env,
+		txManager:              txManager,
+		clientOIDC:             clientOIDC,
+		repositoryUser:         repositoryUser,
+		repositoryUserIdentity: repositoryUserIdentity,
+		repositoryRole:         repositoryRole,
+		repositoryTwoFactor:    repositoryTwoFactor,
+		repositorySession:      repositorySession,
+	}
+}
+
+func (r *OIDCLoginCallbackInteractor) Execute(ctx context.Context, input OIDCLoginCallbackInput) (*UserLoginOutput, error) {
+
+	if !r.env.OIDCEnabled {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("シングルサインオンは有効になっていません"))
+	}
+
+	if input.SavedState == "" || input.State != input.SavedState {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("ログインリクエストが不正か有効期限切れです。もう一度ログインしてください"))
+	}
+
+	if input.Code == "" {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("認可コードがありません"))
+	}
+
+	claims, err := r.clientOIDC.Exchange(ctx, input.Code, input.CodeVerifier, input.Nonce)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	userIdentity, err := r.repositoryUserIdentity.FindByIssuerAndSubject(ctx, claims.Issuer, claims.Subject)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var user *userModel.RootUserModel
+	if userIdentity != nil {
+
+		user, err = r.repositoryUser.FindByUserID(ctx, userIdentity.UserID())
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		if user == nil {
+			return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("連携しているユーザーは無効化または削除されています"))
+		}
+
+		userIdentity.RecordLogin(lo.CoalesceOrEmpty(claims.Email, userIdentity.Email()), time.Now())
+
+		err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+			return r.repositoryUserIdentity.Save(ctx, tx, userIdentity)
+		})
+
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+	} else {
+
+		user, err = r.linkUser(ctx, claims)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	// パスワードでのログインと同様に、2段階認証を登録したユーザーや必須とされたユーザーには認証コードを求める
+	challenge, err := findTwoFactorChallenge(ctx, r.repositoryTwoFactor, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if challenge != nil {
+		return challenge, nil
+	}
+
+	return createLoginSession(ctx, r.txManager, r.repositorySession, user)
+}
+
+// 初回ログイン時は、設定で許可されていれば確認済みのメールアドレスと同じユーザー名のユーザーに紐づける
+// 該当するユーザーがいない場合、設定で許可されていればユーザーを自動作成する
+// 同じメールアドレスのIDプロバイダーのアカウントによる乗っ取りを防ぐため、いずれも許可したドメインに限る
+func (r *OIDCLoginCallbackInteractor) linkUser(ctx context.Context, claims *client.OIDCClaims) (*userModel.RootUserModel, error) {
+
+	email := strings.ToLower(strings.TrimSpace(claims.Email))
+	if email == "" || !claims.EmailVerified {
+		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("IDプロバイダーで確認済みのメールアドレスが必要です"))
+	}
+
+	if !r.isAllowedEmailDomain(email) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていないドメインのメールアドレスです:%s", email))
+	}
+
+	user, err := r.repositoryUser.FindByUserName(ctx, email)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if user != nil && !r.env.OIDCAutoLinkEnabled {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("外部アカウントがユーザーに連携されていません"))
+	}
+
+	var provisionUser *userModel.RootUserModel
+	if user == nil {
+
+		if !r.env.OIDCProvisioningEnabled {
+			return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("連携するユーザーが登録されていません。管理者にユーザーの登録を依頼してください"))
+		}
+
+		provisionUser, err = r.createProvisionUserModel(ctx, email, claims.Name)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	var userID vo.UserID
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		if provisionUser != nil {
+
+			userID, err = r.repositoryUser.SaveProvisioned(ctx, tx, provisionUser)
+			if err != nil {
+				return log.WrapErrorWithStackTrace(err)
+			}
+
+		} else {
+			userID = user.ID()
+		}
+
+		userIdentity := identity.NewCreateRootUserIdentityModel(userID, claims.Issuer, claims.Subject, email)
+
+		return r.repositoryUserIdentity.Save(ctx, tx, userIdentity)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if provisionUser == nil {
+		return user, nil
+	}
+
+	// 作成したユーザーをロールの権限を含めて取得し直す
+	user, err = r.repositoryUser.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if user == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("作成したユーザーが取得できません:%d", userID.Value()))
+	}
+
+	return user, nil
+}
+
+func (r *OIDCLoginCallbackInteractor) isAllowedEmailDomain(email string) bool {
+
+	// 未設定の場合はすべてのドメインを拒否する
+	_, domain, _ := strings.Cut(email, "@")
+	if domain == "" {
+		return false
+	}
+
+	return slices.ContainsFunc(r.env.OIDCAllowedEmailDomains, func(allowed string) bool {
+		return strings.EqualFold(strings.TrimSpace(allowed), domain)
+	})
+}
+
+// 自動作成するユーザーはパスワードでログインできないよう推測できないパスワードを設定する
+func (r *OIDCLoginCallbackInteractor) createProvisionUserModel(ctx context.Context, email string, name string) (*userModel.RootUserModel, error) {
+
+	roleKey, err := vo.NewRoleKey(r.env.OIDCProvisioningRoleKey)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	if err := existsRole(ctx, r.repositoryRole, roleKey); err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	userName, err := vo.NewUserName(email)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("メールアドレスをユーザー名にできないため、ユーザーを自動作成できません:%v", err))
+	}
+
+	// 表示名が設定できない場合はメールアドレスを用いる
+	displayName, err := vo.NewUserDisplayName(name)
+	if err != nil {
+		displayName, err = vo.NewUserDisplayName(email)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(err)
+		}
+	}
+
+	rawEncryptPassword, err := hash.HashPassword(generateRandomString())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(err)
+	}
+
+	encryptPassword, _ := vo.ReconstructHashedPassword(rawEncryptPassword)
+
+	return userModel.NewCreateUserModel(
+		roleKey,
+		userName,
+		encryptPassword,
+		displayName,
+	), nil
+}
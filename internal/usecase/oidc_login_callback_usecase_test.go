@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/client"
+)
+
+// 認可コードの交換が呼ばれたかを記録する
+type fakeOIDCClient struct {
+	exchanged bool
+}
+
+func (r *fakeOIDCClient) AuthorizationURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	return "", nil
+}
+
+func (r *fakeOIDCClient) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*client.OIDCClaims, error) {
+	r.exchanged = true
+	return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("認可コードが不正です"))
+}
+
+func statusCodeOf(err error) int {
+
+	var logErr *log.Error
+	if !errors.As(err, &logErr) {
+		return 0
+	}
+
+	return logErr.StatusCode
+}
+
+func TestOIDCLoginCallbackInput(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		enabled       bool
+		input         OIDCLoginCallbackInput
+		wantStatus    int
+		wantExchanged bool
+	}{
+		{
+			name:       "シングルサインオンが有効になっていない",
+			input:      OIDCLoginCallbackInput{Code: "code", State: "state", SavedState: "state"},
+			wantStatus: log.NOT_FOUND,
+		},
+		{
+			name:       "ログイン開始時のstateがない",
+			enabled:    true,
+			input:      OIDCLoginCallbackInput{Code: "code", State: "state"},
+			wantStatus: log.UNAUTHORIZED,
+		},
+		{
+			name:       "stateが一致しない",
+			enabled:    true,
+			input:      OIDCLoginCallbackInput{Code: "code", State: "other", SavedState: "state"},
+			wantStatus: log.UNAUTHORIZED,
+		},
+		{
+			name:       "認可コードがない",
+			enabled:    true,
+			input:      OIDCLoginCallbackInput{State: "state", SavedState: "state"},
+			wantStatus: log.UNAUTHORIZED,
+		},
+		{
+			name:          "認可コードの交換に失敗した",
+			enabled:       true,
+			input:         OIDCLoginCallbackInput{Code: "code", State: "state", SavedState: "state"},
+			wantStatus:    log.UNAUTHORIZED,
+			wantExchanged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			clientOIDC := &fakeOIDCClient{}
+			interactor := &OIDCLoginCallbackInteractor{
+				env:        configs.EnvConfig{OIDCEnabled: tt.enabled},
+				clientOIDC: clientOIDC,
+			}
+
+			_, err := interactor.Execute(context.Background(), tt.input)
+
+			if got := statusCodeOf(err); got != tt.wantStatus {
+				t.Errorf("got status %d, want %d (%v)", got, tt.wantStatus, err)
+			}
+
+			if clientOIDC.exchanged != tt.wantExchanged {
+				t.Errorf("got exchanged %t, want %t", clientOIDC.exchanged, tt.wantExchanged)
+			}
+		})
+	}
+}
+
+func TestOIDCLinkUserClaims(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		allowedDomains []string
+		claims         client.OIDCClaims
+		wantStatus     int
+	}{
+		{
+			name:           "メールアドレスがない",
+			allowedDomains: []string{"example.com"},
+			claims:         client.OIDCClaims{EmailVerified: true},
+			wantStatus:     log.UNAUTHORIZED,
+		},
+		{
+			name:           "メールアドレスが確認されていない",
+			allowedDomains: []string{"example.com"},
+			claims:         client.OIDCClaims{Email: "editor@example.com"},
+			wantStatus:     log.UNAUTHORIZED,
+		},
+		{
+			name:       "許可するドメインが設定されていない",
+			claims:     client.OIDCClaims{Email: "editor@example.com", EmailVerified: true},
+			wantStatus: log.FORBIDDEN,
+		},
+		{
+			name:           "許可していないドメイン",
+			allowedDomains: []string{"example.com"},
+			claims:         client.OIDCClaims{Email: "editor@example.net", EmailVerified: true},
+			wantStatus:     log.FORBIDDEN,
+		},
+		{
+			name:           "サブドメインは別のドメインとして扱う",
+			allowedDomains: []string{"example.com"},
+			claims:         client.OIDCClaims{Email: "editor@mail.example.com", EmailVerified: true},
+			wantStatus:     log.FORBIDDEN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			interactor := &OIDCLoginCallbackInteractor{
+				env: configs.EnvConfig{OIDCEnabled: true, OIDCAllowedEmailDomains: tt.allowedDomains},
+			}
+
+			_, err := interactor.linkUser(context.Background(), &tt.claims)
+
+			if got := statusCodeOf(err); got != tt.wantStatus {
+				t.Errorf("got status %d, want %d (%v)", got, tt.wantStatus, err)
+			}
+		})
+	}
+}
+
+func TestIsAllowedEmailDomain(t *testing.T) {
+
+	tests := []struct {
+		name           string
+		allowedDomains []string
+		email          string
+		want           bool
+	}{
+		{
+			name:           "許可したドメイン",
+			allowedDomains: []string{"example.com"},
+			email:          "editor@example.com",
+			want:           true,
+		},
+		{
+			name:           "大文字小文字と前後の空白は区別しない",
+			allowedDomains: []string{" Example.COM "},
+			email:          "editor@example.com",
+			want:           true,
+		},
+		{
+			name:           "複数のドメインのいずれか",
+			allowedDomains: []string{"example.com", "example.org"},
+			email:          "editor@example.org",
+			want:           true,
+		},
+		{
+			name:  "未設定の場合はすべて拒否する",
+			email: "editor@example.com",
+		},
+		{
+			name:           "ドメインがない",
+			allowedDomains: []string{"example.com"},
+			email:          "editor",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			interactor := &OIDCLoginCallbackInteractor{
+				env: configs.EnvConfig{OIDCAllowedEmailDomains: tt.allowedDomains},
+			}
+
+			if got := interactor.isAllowedEmailDomain(tt.email); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/gorilla/securecookie"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/client"
+)
+
+type IOIDCLoginStartInputPort interface {
+	Execute(ctx context.Context) (*OIDCLoginStartOutput, error)
+}
+
+type (
+	// State・Nonce・CodeVerifierはコールバックで検証するまで呼び出し側で保持する
+	OIDCLoginStartOutput struct {
+		AuthorizationURL string
+		State            string
+		Nonce            string
+		CodeVerifier     string
+	}
+)
+
+type OIDCLoginStartInteractor struct {
+	env        configs.EnvConfig
+	clientOIDC client.OIDCClient
+}
+
+func NewOIDCLoginStartInteractor(
+	env configs.EnvConfig,
+	clientOIDC client.OIDCClient,
+) IOIDCLoginStartInputPort {
+	return &OIDCLoginStartInteractor{
+		env:        env,
+		clientOIDC: clientOIDC,
+	}
+}
+
+func (r *OIDCLoginStartInteractor) Execute(ctx context.Context) (*OIDCLoginStartOutput, error) {
+
+	if !r.env.OIDCEnabled {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("シングルサインオンは有効になっていません"))
+	}
+
+	state := generateRandomString()
+	nonce := generateRandomString()
+	codeVerifier := generateRandomString()
+
+	// PKCE(S256)
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	codeChallenge := base64.RawURLEncoding.EncodeToString(challenge[:])
+
+	authorizationURL, err := r.clientOIDC.AuthorizationURL(ctx, state, nonce, codeChallenge)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &OIDCLoginStartOutput{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		Nonce:            nonce,
+		CodeVerifier:     codeVerifier,
+	}, nil
+}
+
+func generateRandomString() string {
+	return base64.RawURLEncoding.EncodeToString(securecookie.GenerateRandomKey(32))
+}
@@ -1,7 +1,12 @@
 package port
 
+const (
+	WARNING_PINNED_ITEM_MOVED = "固定されたアイテムを移動しました"
+)
+
 type ScheduleItemEditOutput struct {
 	ScheduleItem ScheduleItemEditOutputDTO
+	Warnings     []string
 }
 
 type (
@@ -9,6 +14,7 @@ type (
 		HistoryIndex   int
 		LessonItemList []ScheduleLessonItem
 		RoomLessonList []ScheduleRoomLesson
+		ItemGroupList  []ScheduleItemGroup
 	}
 
 	ScheduleLessonItem struct {
@@ -23,10 +29,22 @@ type (
 		LessonID   int
 		LessonName string
 		Identifier string
+		Title      string
 		Duration   int
 		StartTime  ScheduleItemEditRoomLessonTime
 		EndTime    ScheduleItemEditRoomLessonTime
 		RoomIndex  int
+		Pinned     bool
+	}
+
+	ScheduleItemGroup struct {
+		GroupIdentifier string
+		Members         []ScheduleItemGroupMember
+	}
+
+	ScheduleItemGroupMember struct {
+		Identifier    string
+		OffsetMinutes int
 	}
 
 	ScheduleItemEditRoomLessonTime struct {
@@ -0,0 +1,15 @@
+package permission
+
+import (
+	"context"
+)
+
+type QueryPermissionDTO struct {
+	PermissionKey  string
+	PermissionName string
+	OrderIndex     int
+}
+
+type PermissionQueryRepository interface {
+	FindAll(ctx context.Context) ([]*QueryPermissionDTO, error)
+}
@@ -9,13 +9,18 @@ type QueryScheduleDTO struct {
 	ID                 int
 	Campus             string
 	Title              string
+	Status             string
+	ReviewComment      string
 	CreateUserName     string
 	LastUpdateUserName string
 	CreateUser         int
-	UpdatedAt          time.Time
-	CreatedAt          time.Time
+	// 共同編集者として登録されているユーザー
+	CollaboratorUserIDs []int
+	UpdatedAt           time.Time
+	CreatedAt           time.Time
 }
 
 type ScheduleListQueryRepository interface {
-	GetListByCampus(ctx context.Context, campus string) ([]*QueryScheduleDTO, error)
+	// statuses が空の場合は全てのステータスを対象とする
+	GetListByCampus(ctx context.Context, campus string, statuses []string) ([]*QueryScheduleDTO, error)
 }
@@ -3,13 +3,16 @@ package schedulelist
 import (
 	"context"
 
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	campusRepository "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/campus"
 )
 
 type (
 	IScheduleListQueryInputPort interface {
-		Execute(ctx context.Context, campus string) (*ScheduleListQueryOutput, error)
+		Execute(ctx context.Context, user vo.UserID, campus string, inputStatus string) (*ScheduleListQueryOutput, error)
 	}
 )
 
@@ -22,19 +25,34 @@ type (
 type ScheduleListQueryInteractor struct {
 	repositroryCampusQuery  campusRepository.CampusQueryRepository
 	repositoryQuerySchedule ScheduleListQueryRepository
+	serviceStatusPermission service.IScheduleStatusPermissionService
+	serviceAuthorization    service.IAuthorizationService
 }
 
 func NewScheduleListQueryInteractor(
 	repositroryCampusQuery campusRepository.CampusQueryRepository,
 	repositoryQuerySchedule ScheduleListQueryRepository,
+	serviceStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
 ) IScheduleListQueryInputPort {
 	return &ScheduleListQueryInteractor{
 		repositroryCampusQuery:  repositroryCampusQuery,
 		repositoryQuerySchedule: repositoryQuerySchedule,
+		serviceStatusPermission: serviceStatusPermission,
+		serviceAuthorization:    serviceAuthorization,
 	}
 }
 
-func (r *ScheduleListQueryInteractor) Execute(ctx context.Context, campus string) (*ScheduleListQueryOutput, error) {
+func (r *ScheduleListQueryInteractor) Execute(ctx context.Context, user vo.UserID, campus string, inputStatus string) (*ScheduleListQueryOutput, error) {
+
+	statuses := []string{}
+	if inputStatus != "" {
+		status, err := vo.NewScheduleStatus(inputStatus)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(err)
+		}
+		statuses = append(statuses, status.Value())
+	}
 
 	campusModel, err := r.repositroryCampusQuery.GetByCampus(ctx, campus)
 	if err != nil {
@@ -45,11 +63,24 @@ func (r *ScheduleListQueryInteractor) Execute(ctx context.Context, campus string
 		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したキャンパスはありません:%s", campus))
 	}
 
-	scheduleListDTO, err := r.repositoryQuerySchedule.GetListByCampus(ctx, campus)
+	scheduleListDTO, err := r.repositoryQuerySchedule.GetListByCampus(ctx, campus, statuses)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, vo.Campus(campusModel.Campus))
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 閲覧権限のないステータスのスケジュールは除外する。所有者・共同編集者のスケジュールは残す
+	scheduleListDTO = lo.Filter(scheduleListDTO, func(item *QueryScheduleDTO, _ int) bool {
+		if item.CreateUser == user.Value() || lo.Contains(item.CollaboratorUserIDs, user.Value()) {
+			return true
+		}
+		return r.serviceStatusPermission.AllowsViewingBy(vo.ScheduleStatus(item.Status), permissions)
+	})
+
 	return &ScheduleListQueryOutput{
 		ScheduleList: scheduleListDTO,
 	}, nil
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/role"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/permission"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IRoleAddInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, input RoleAddInput) (*RoleListOutput, error)
+}
+
+type RoleAddInput struct {
+	RoleKey     string
+	RoleName    string
+	Permissions []string
+}
+
+type (
+	RoleAddInteractor struct {
+		txManager                 util.TxManager
+		repositoryRole            repository.RoleRepository
+		repositoryPermissionQuery permission.PermissionQueryRepository
+		serviceAuthorization      service.IAuthorizationService
+	}
+)
+
+func NewRoleAddInteractor(
+	txManager util.TxManager,
+	repositoryRole repository.RoleRepository,
+	repositoryPermissionQuery permission.PermissionQueryRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IRoleAddInputPort {
+	return &RoleAddInteractor{
+		txManager:                 txManager,
+		repositoryRole:            repositoryRole,
+		repositoryPermissionQuery: repositoryPermissionQuery,
+		serviceAuthorization:      serviceAuthorization,
+	}
+}
+
+func (r RoleAddInteractor) Execute(ctx context.Context, user vo.UserID, input RoleAddInput) (*RoleListOutput, error) {
+
+	if err := r.serviceAuthorization.Authorize(ctx, user, vo.PERMISSION_ROLE_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var errs error
+	var addRoleKey vo.RoleKey
+	var roleName vo.RoleName
+
+	errs = errors.Join(errs, vo.SetVOConstructor(&addRoleKey, vo.NewRoleKey, input.RoleKey))
+	errs = errors.Join(errs, vo.SetVOConstructor(&roleName, vo.NewRoleName, input.RoleName))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	permissions, err := toPermissions(input.Permissions)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 既に登録済みでないか確認
+	registeredRole, err := r.repositoryRole.FindByRoleKey(ctx, addRoleKey)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if registeredRole != nil {
+		return nil, log.WrapErrorWithStackTraceConflict(log.Errorf("登録済みのロールです:%s", addRoleKey.Value()))
+	}
+
+	addRole := role.NewCreateRoleModel(addRoleKey, roleName, permissions)
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+		return r.repositoryRole.Save(ctx, tx, addRole)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findRoleListOutput(ctx, r.repositoryRole, r.repositoryPermissionQuery)
+}
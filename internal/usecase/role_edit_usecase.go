@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/permission"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IRoleEditInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, input RoleEditInput) (*RoleListOutput, error)
+}
+
+type RoleEditInput struct {
+	RoleKey     string
+	RoleName    string
+	Permissions []string
+}
+
+type (
+	RoleEditInteractor struct {
+		txManager                 util.TxManager
+		repositoryRole            repository.RoleRepository
+		repositoryPermissionQuery permission.PermissionQueryRepository
+		serviceAuthorization      service.IAuthorizationService
+	}
+)
+
+func NewRoleEditInteractor(
+	txManager util.TxManager,
+	repositoryRole repository.RoleRepository,
+	repositoryPermissionQuery permission.PermissionQueryRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IRoleEditInputPort {
+	return &RoleEditInteractor{
+		txManager:                 txManager,
+		repositoryRole:            repositoryRole,
+		repositoryPermissionQuery: repositoryPermissionQuery,
+		serviceAuthorization:      serviceAuthorization,
+	}
+}
+
+func (r RoleEditInteractor) Execute(ctx context.Context, user vo.UserID, input RoleEditInput) (*RoleListOutput, error) {
+
+	if err := r.serviceAuthorization.Authorize(ctx, user, vo.PERMISSION_ROLE_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var errs error
+	var editRoleKey vo.RoleKey
+	var roleName vo.RoleName
+
+	errs = errors.Join(errs, vo.SetVOConstructor(&editRoleKey, vo.NewRoleKey, input.RoleKey))
+	errs = errors.Join(errs, vo.SetVOConstructor(&roleName, vo.NewRoleName, input.RoleName))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	permissions, err := toPermissions(input.Permissions)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	editRole, err := r.repositoryRole.FindByRoleKey(ctx, editRoleKey)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if editRole == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したロールは存在しません:%s", editRoleKey.Value()))
+	}
+
+	err = editRole.Change(roleName, permissions)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+		return r.repositoryRole.Save(ctx, tx, editRole)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findRoleListOutput(ctx, r.repositoryRole, r.repositoryPermissionQuery)
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/role"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/query/permission"
+)
+
+type IRoleListInputPort interface {
+	Execute(ctx context.Context) (*RoleListOutput, error)
+}
+
+type (
+	RoleListOutput struct {
+		Roles       []RoleDTO
+		Permissions []PermissionDTO
+	}
+
+	RoleDTO struct {
+		RoleKey     string
+		RoleName    string
+		Builtin     bool
+		Permissions []string
+	}
+
+	PermissionDTO struct {
+		PermissionKey  string
+		PermissionName string
+	}
+)
+
+type (
+	RoleListInteractor struct {
+		repositoryRole            repository.RoleRepository
+		repositoryPermissionQuery permission.PermissionQueryRepository
+	}
+)
+
+func NewRoleListInteractor(
+	repositoryRole repository.RoleRepository,
+	repositoryPermissionQuery permission.PermissionQueryRepository,
+) IRoleListInputPort {
+	return &RoleListInteractor{
+		repositoryRole:            repositoryRole,
+		repositoryPermissionQuery: repositoryPermissionQuery,
+	}
+}
+
+func (r RoleListInteractor) Execute(ctx context.Context) (*RoleListOutput, error) {
+
+	return findRoleListOutput(ctx, r.repositoryRole, r.repositoryPermissionQuery)
+}
+
+func findRoleListOutput(ctx context.Context, repositoryRole repository.RoleRepository, repositoryPermissionQuery permission.PermissionQueryRepository) (*RoleListOutput, error) {
+
+	roles, err := repositoryRole.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	roles.Sort()
+
+	permissions, err := repositoryPermissionQuery.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &RoleListOutput{
+		Roles: lo.Map(roles, func(item *role.RootRoleModel, _ int) RoleDTO {
+			return RoleDTO{
+				RoleKey:  item.RoleKey().Value(),
+				RoleName: item.RoleName().Value(),
+				Builtin:  item.IsBuiltin(),
+				Permissions: lo.Map(item.Permissions(), func(permission vo.Permission, _ int) string {
+					return permission.Value()
+				}),
+			}
+		}),
+		Permissions: lo.Map(permissions, func(item *permission.QueryPermissionDTO, _ int) PermissionDTO {
+			return PermissionDTO{
+				PermissionKey:  item.PermissionKey,
+				PermissionName: item.PermissionName,
+			}
+		}),
+	}, nil
+}
+
+func toPermissions(inputPermissions []string) (vo.Permissions, error) {
+
+	permissions := make(vo.Permissions, 0, len(inputPermissions))
+	for _, inputPermission := range inputPermissions {
+
+		permission, err := vo.NewPermission(inputPermission)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
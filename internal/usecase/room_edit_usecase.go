@@ -7,6 +7,7 @@ import (
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/room"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
@@ -14,7 +15,7 @@ import (
 
 type (
 	IRoomEditInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, campus string, editRoom RoomsEditInputDTO) error
+		Execute(ctx context.Context, user vo.UserID, campus string, editRoom RoomsEditInputDTO) error
 	}
 )
 
@@ -34,9 +35,10 @@ type (
 
 type (
 	RoomEditInteractor struct {
-		txManager        util.TxManager
-		repositoryRoom   repository.RoomRepository
-		repositoryCampus repository.CampusRepository
+		txManager            util.TxManager
+		repositoryRoom       repository.RoomRepository
+		repositoryCampus     repository.CampusRepository
+		serviceAuthorization service.IAuthorizationService
 	}
 )
 
@@ -44,25 +46,27 @@ func NewRoomEditInteractor(
 	txManager util.TxManager,
 	repositoryRoom repository.RoomRepository,
 	repositoryCampus repository.CampusRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) IRoomEditInputPort {
 	return &RoomEditInteractor{
-		txManager:        txManager,
-		repositoryRoom:   repositoryRoom,
-		repositoryCampus: repositoryCampus,
+		txManager:            txManager,
+		repositoryRoom:       repositoryRoom,
+		repositoryCampus:     repositoryCampus,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
 
-func (r RoomEditInteractor) Execute(ctx context.Context, role vo.RoleKey, inputCampus string, editRoom RoomsEditInputDTO) error {
-
-	if !role.IsOwner() {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
-	}
+func (r RoomEditInteractor) Execute(ctx context.Context, user vo.UserID, inputCampus string, editRoom RoomsEditInputDTO) error {
 
 	campus, roomSlice, err := r.createModel(inputCampus, editRoom)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
 	}
 
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, campus, vo.PERMISSION_ROOM_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	campuses, err := r.repositoryCampus.FindAll(ctx)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
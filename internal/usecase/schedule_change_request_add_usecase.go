@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/changerequest"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleChangeRequestAddInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputData ScheduleChangeRequestAddInput) (*ScheduleChangeRequestDTO, error)
+	}
+)
+
+type (
+	ScheduleChangeRequestAddInput struct {
+		HistoryIndex int
+		Comment      string
+		Operations   []ScheduleItemOperationDTO
+	}
+)
+
+type (
+	ScheduleChangeRequestAddInteractor struct {
+		txManager                       util.TxManager
+		repositorySchedule              repository.ScheduleRepository
+		repositorySchedulingPolicy      repository.SchedulingPolicyRepository
+		repositoryChangeRequest         repository.ChangeRequestRepository
+		repositoryUser                  repository.UserRepository
+		serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+		serviceAuthorization            service.IAuthorizationService
+	}
+)
+
+func NewScheduleChangeRequestAddInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	repositoryChangeRequest repository.ChangeRequestRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleChangeRequestAddInputPort {
+	return &ScheduleChangeRequestAddInteractor{
+		txManager:                       txManager,
+		repositorySchedule:              repositorySchedule,
+		repositorySchedulingPolicy:      repositorySchedulingPolicy,
+		repositoryChangeRequest:         repositoryChangeRequest,
+		repositoryUser:                  repositoryUser,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r ScheduleChangeRequestAddInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputData ScheduleChangeRequestAddInput) (*ScheduleChangeRequestDTO, error) {
+
+	scheduleID, historyIndex, comment, operations, err := r.createVO(inputScheduleID, inputData)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	latestSchedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if latestSchedule == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, latestSchedule.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(latestSchedule, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	if !permissions.Has(vo.PERMISSION_SCHEDULE_PROPOSE) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	// 公開済みのスケジュールは提案を承認できないため、提案も受け付けない
+	if latestSchedule.IsFrozen() {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("公開済み・アーカイブ済みのスケジュールには変更を提案できません"))
+	}
+
+	if historyIndex.IsUseLatest() {
+		historyIndex = latestSchedule.HistoryIndex()
+	}
+
+	err = r.validateOperations(ctx, scheduleID, historyIndex, operations)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	changeRequest, err := changerequest.NewCreateRootChangeRequestModel(scheduleID, historyIndex, user, comment, operations)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	var changeRequestID vo.ChangeRequestID
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		changeRequestID, err = r.repositoryChangeRequest.Save(ctx, tx, changeRequest)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleChangeRequestDTO(ctx, r.repositoryChangeRequest, r.repositoryUser, scheduleID, changeRequestID)
+}
+
+// 提案の基準となる履歴に対して、操作がそのまま適用できることを確認する
+func (r ScheduleChangeRequestAddInteractor) validateOperations(ctx context.Context, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, operations schedule.ScheduleItemOperationModelSlice) error {
+
+	baseSchedule, err := r.repositorySchedule.FindByIDWithHistoryIndex(ctx, scheduleID, historyIndex)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if baseSchedule == nil {
+		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した履歴は存在しません:%d", historyIndex.Value()))
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, baseSchedule.Campus())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	baseSchedule.ApplySchedulingPolicy(schedulingPolicy)
+
+	err = baseSchedule.ApplyItemOperations(operations)
+	if err != nil {
+		return log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = baseSchedule.ValidateSchedulingPolicy()
+	if err != nil {
+		return log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	return nil
+}
+
+func (ScheduleChangeRequestAddInteractor) createVO(inputScheduleID int, inputData ScheduleChangeRequestAddInput) (vo.ScheduleID, vo.HistoryIndex, vo.CommentBody, schedule.ScheduleItemOperationModelSlice, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var comment vo.CommentBody
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputData.HistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&comment, vo.NewCommentBody, inputData.Comment))
+
+	operations := make(schedule.ScheduleItemOperationModelSlice, 0, len(inputData.Operations))
+	for _, inputOperation := range inputData.Operations {
+
+		var operation vo.ItemOperation
+		var identifier vo.Identifier
+		var roomIndex vo.RoomIndex
+
+		errs = errors.Join(errs, vo.SetVOConstructor(&operation, vo.NewItemOperation, inputOperation.Operation))
+		errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, inputOperation.Identifier))
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndexOrAll, inputOperation.RoomIndex))
+
+		startTime, err := vo.NewScheduleLessonTime(inputOperation.StartTimeHour, inputOperation.StartTimeMinutes)
+		errs = errors.Join(errs, err)
+
+		operations = append(operations, schedule.NewScheduleItemOperationModel(operation, identifier, roomIndex, startTime))
+	}
+
+	if errs != nil {
+		return scheduleID, historyIndex, comment, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, comment, operations, nil
+}
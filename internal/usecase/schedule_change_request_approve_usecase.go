@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleChangeRequestApproveInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int) (*ScheduleChangeRequestDTO, error)
+	}
+)
+
+type (
+	ScheduleChangeRequestApproveInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
+		repositoryChangeRequest       repository.ChangeRequestRepository
+		repositoryDayBundle           repository.DayBundleRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+		serviceScheduleCollision      service.IScheduleCollisionService
+	}
+)
+
+func NewScheduleChangeRequestApproveInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	repositoryChangeRequest repository.ChangeRequestRepository,
+	repositoryDayBundle repository.DayBundleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+	serviceScheduleCollision service.IScheduleCollisionService,
+) IScheduleChangeRequestApproveInputPort {
+	return &ScheduleChangeRequestApproveInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
+		repositoryChangeRequest:       repositoryChangeRequest,
+		repositoryDayBundle:           repositoryDayBundle,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+		serviceScheduleCollision:      serviceScheduleCollision,
+	}
+}
+
+// 最新の履歴に提案の操作を適用(リベース)し、新しい履歴として保存する
+func (r ScheduleChangeRequestApproveInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int) (*ScheduleChangeRequestDTO, error) {
+
+	scheduleID, changeRequestID, err := createScheduleChangeRequestVO(inputScheduleID, inputChangeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		changeRequest, err := findScheduleChangeRequest(ctx, r.repositoryChangeRequest, scheduleID, changeRequestID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if !changeRequest.Status().IsPending() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("既にレビュー済みの変更提案です"))
+		}
+
+		latestSchedule, err := r.repositorySchedule.FindByIDWithLock(ctx, tx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if latestSchedule == nil {
+			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		reviewUser, err := r.repositoryUser.FindByUserID(ctx, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if reviewUser == nil {
+			return log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+		}
+
+		if !r.serviceScheduleEditPermission.AllowsReviewingBy(latestSchedule, reviewUser) {
+			return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+		}
+
+		// 公開済み・アーカイブ済みのスケジュールは編集履歴を追加できない
+		if latestSchedule.IsFrozen() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("公開済み・アーカイブ済みのスケジュールには変更提案を反映できません"))
+		}
+
+		scheduleData, err := r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, latestSchedule.ID(), latestSchedule.HistoryIndex())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		bundleMembers, err := findRefuseCollisionMembers(ctx, r.repositoryDayBundle, r.serviceScheduleCollision, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		baseCollisions := r.serviceScheduleCollision.FindCollisionsWith(scheduleData, bundleMembers)
+
+		err = applyChangeRequest(scheduleData, schedulingPolicy, changeRequest)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("現在のスケジュールに変更提案を適用できません: %v", err))
+		}
+
+		newCollisions := r.serviceScheduleCollision.FindCollisionsWith(scheduleData, bundleMembers).Excluding(baseCollisions)
+		if len(newCollisions) > 0 {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("同日の別スケジュール(ID:%d)と教室の使用時間が重なっています", newCollisions[0].OtherScheduleID().Value()))
+		}
+
+		scheduleData.ModifyEditing(latestSchedule.HistoryIndex(), user)
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		err = changeRequest.Approve(user, scheduleData.HistoryIndex())
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		_, err = r.repositoryChangeRequest.Save(ctx, tx, changeRequest)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleChangeRequestDTO(ctx, r.repositoryChangeRequest, r.repositoryUser, scheduleID, changeRequestID)
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/changerequest"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/policy"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleChangeRequestDiffInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int) (*ScheduleChangeRequestDiffOutput, error)
+	}
+)
+
+type (
+	ScheduleChangeRequestDiffOutput struct {
+		ChangeRequestID     int
+		BaseHistoryIndex    int
+		CurrentHistoryIndex int
+		// 現在のスケジュールに適用(リベース)できるか
+		Applicable bool
+		ApplyError string
+		Changes    []ScheduleItemPlacementChangeDTO
+	}
+
+	ScheduleItemPlacementChangeDTO struct {
+		Identifier string
+		// 提案の基準となった履歴から、現在までに配置が変わっているか
+		ChangedSinceBase bool
+		// nil の場合は未配置
+		Current  *ScheduleItemPlacementDTO
+		Proposed *ScheduleItemPlacementDTO
+	}
+
+	ScheduleItemPlacementDTO struct {
+		RoomIndex        int
+		StartTimeHour    int
+		StartTimeMinutes int
+		EndTimeHour      int
+		EndTimeMinutes   int
+	}
+)
+
+type ScheduleChangeRequestDiffInteractor struct {
+	repositorySchedule              repository.ScheduleRepository
+	repositorySchedulingPolicy      repository.SchedulingPolicyRepository
+	repositoryChangeRequest         repository.ChangeRequestRepository
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+	serviceAuthorization            service.IAuthorizationService
+}
+
+func NewScheduleChangeRequestDiffInteractor(
+	repositorySchedule repository.ScheduleRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	repositoryChangeRequest repository.ChangeRequestRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleChangeRequestDiffInputPort {
+	return &ScheduleChangeRequestDiffInteractor{
+		repositorySchedule:              repositorySchedule,
+		repositorySchedulingPolicy:      repositorySchedulingPolicy,
+		repositoryChangeRequest:         repositoryChangeRequest,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r *ScheduleChangeRequestDiffInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int) (*ScheduleChangeRequestDiffOutput, error) {
+
+	scheduleID, changeRequestID, err := createScheduleChangeRequestVO(inputScheduleID, inputChangeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	currentSchedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if currentSchedule == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, currentSchedule.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(currentSchedule, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	changeRequest, err := findScheduleChangeRequest(ctx, r.repositoryChangeRequest, scheduleID, changeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, currentSchedule.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 基準の履歴が後の編集で失われている場合は nil
+	baseSchedule, err := r.repositorySchedule.FindByIDWithHistoryIndex(ctx, scheduleID, changeRequest.HistoryIndex())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	output := &ScheduleChangeRequestDiffOutput{
+		ChangeRequestID:     changeRequest.ID().Value(),
+		BaseHistoryIndex:    changeRequest.HistoryIndex().Value(),
+		CurrentHistoryIndex: currentSchedule.HistoryIndex().Value(),
+		Applicable:          true,
+		Changes:             []ScheduleItemPlacementChangeDTO{},
+	}
+
+	fromSchedule := currentSchedule
+	proposedSchedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	applyErr := applyChangeRequest(proposedSchedule, schedulingPolicy, changeRequest)
+	if applyErr != nil {
+
+		output.Applicable = false
+		output.ApplyError = applyErr.Error()
+
+		if baseSchedule == nil {
+			return output, nil
+		}
+
+		// 現在の状態に適用できない場合は、提案時点の履歴に対する変更内容を示す
+		fromSchedule = baseSchedule
+		proposedSchedule, err = r.repositorySchedule.FindByIDWithHistoryIndex(ctx, scheduleID, changeRequest.HistoryIndex())
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		if applyChangeRequest(proposedSchedule, schedulingPolicy, changeRequest) != nil {
+			return output, nil
+		}
+	}
+
+	for _, identifier := range changedIdentifiers(fromSchedule, proposedSchedule) {
+
+		current := toScheduleItemPlacementDTO(currentSchedule, identifier)
+		output.Changes = append(output.Changes, ScheduleItemPlacementChangeDTO{
+			Identifier:       identifier.Value(),
+			ChangedSinceBase: baseSchedule == nil || !isSamePlacement(toScheduleItemPlacementDTO(baseSchedule, identifier), current),
+			Current:          current,
+			Proposed:         toScheduleItemPlacementDTO(proposedSchedule, identifier),
+		})
+	}
+
+	return output, nil
+}
+
+// 変更提案の操作をスケジュールに適用し、校舎のポリシーを満たすか検証する
+func applyChangeRequest(scheduleData *schedule.RootScheduleModel, schedulingPolicy *policy.RootSchedulingPolicyModel, changeRequest *changerequest.RootChangeRequestModel) error {
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
+	err := scheduleData.ApplyItemOperations(changeRequest.Operations())
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	err = scheduleData.ValidateSchedulingPolicy()
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+// 配置が異なるアイテムの識別子
+func changedIdentifiers(before *schedule.RootScheduleModel, after *schedule.RootScheduleModel) []vo.Identifier {
+
+	identifiers := append(
+		lo.Map(before.Items(), func(item *schedule.ScheduleItemModel, _ int) vo.Identifier { return item.Identifier() }),
+		lo.Map(before.RoomItems(), func(item *schedule.ScheduleRoomItemModel, _ int) vo.Identifier { return item.Identifier() })...,
+	)
+
+	return lo.Filter(identifiers, func(identifier vo.Identifier, _ int) bool {
+		return !isSamePlacement(toScheduleItemPlacementDTO(before, identifier), toScheduleItemPlacementDTO(after, identifier))
+	})
+}
+
+func toScheduleItemPlacementDTO(scheduleData *schedule.RootScheduleModel, identifier vo.Identifier) *ScheduleItemPlacementDTO {
+
+	roomItem, found := scheduleData.FindRoomItem(identifier)
+	if !found {
+		return nil
+	}
+
+	startTimeHour, startTimeMinutes := roomItem.StartTime().Value()
+	endTimeHour, endTimeMinutes := roomItem.EndTime().Value()
+
+	return &ScheduleItemPlacementDTO{
+		RoomIndex:        roomItem.RoomIndex().Value(),
+		StartTimeHour:    startTimeHour,
+		StartTimeMinutes: startTimeMinutes,
+		EndTimeHour:      endTimeHour,
+		EndTimeMinutes:   endTimeMinutes,
+	}
+}
+
+func isSamePlacement(a *ScheduleItemPlacementDTO, b *ScheduleItemPlacementDTO) bool {
+
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func createScheduleChangeRequestVO(inputScheduleID int, inputChangeRequestID int) (vo.ScheduleID, vo.ChangeRequestID, error) {
+
+	var scheduleID vo.ScheduleID
+	var changeRequestID vo.ChangeRequestID
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&changeRequestID, vo.NewChangeRequestID, inputChangeRequestID))
+
+	if errs != nil {
+		return scheduleID, changeRequestID, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, changeRequestID, nil
+}
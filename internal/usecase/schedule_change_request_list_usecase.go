@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/changerequest"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleChangeRequestListInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int) (*ScheduleChangeRequestListOutput, error)
+	}
+)
+
+type (
+	ScheduleChangeRequestListOutput struct {
+		ScheduleID     int
+		ChangeRequests []*ScheduleChangeRequestDTO
+	}
+
+	ScheduleChangeRequestDTO struct {
+		ChangeRequestID     int
+		ScheduleID          int
+		HistoryIndex        int
+		RequestUserID       int
+		RequestUserName     string
+		Comment             string
+		Operations          []ScheduleItemOperationDTO
+		Status              string
+		ReviewUserID        int
+		ReviewUserName      string
+		ReviewComment       string
+		AppliedHistoryIndex int
+		CreatedAt           time.Time
+		UpdatedAt           time.Time
+	}
+
+	ScheduleItemOperationDTO struct {
+		Operation        string
+		Identifier       string
+		RoomIndex        int
+		StartTimeHour    int
+		StartTimeMinutes int
+	}
+)
+
+type ScheduleChangeRequestListInteractor struct {
+	repositorySchedule              repository.ScheduleRepository
+	repositoryChangeRequest         repository.ChangeRequestRepository
+	repositoryUser                  repository.UserRepository
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+	serviceAuthorization            service.IAuthorizationService
+}
+
+func NewScheduleChangeRequestListInteractor(
+	repositorySchedule repository.ScheduleRepository,
+	repositoryChangeRequest repository.ChangeRequestRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleChangeRequestListInputPort {
+	return &ScheduleChangeRequestListInteractor{
+		repositorySchedule:              repositorySchedule,
+		repositoryChangeRequest:         repositoryChangeRequest,
+		repositoryUser:                  repositoryUser,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r *ScheduleChangeRequestListInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int) (*ScheduleChangeRequestListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	scheduleData, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(scheduleData, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	changeRequests, err := r.repositoryChangeRequest.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleChangeRequestListOutput{
+		ScheduleID: scheduleID.Value(),
+		ChangeRequests: lo.Map(changeRequests, func(item *changerequest.RootChangeRequestModel, _ int) *ScheduleChangeRequestDTO {
+			return ToScheduleChangeRequestDTO(item, users)
+		}),
+	}, nil
+}
+
+func ToScheduleChangeRequestDTO(item *changerequest.RootChangeRequestModel, users user.RootUserModelSlice) *ScheduleChangeRequestDTO {
+
+	return &ScheduleChangeRequestDTO{
+		ChangeRequestID: item.ID().Value(),
+		ScheduleID:      item.ScheduleID().Value(),
+		HistoryIndex:    item.HistoryIndex().Value(),
+		RequestUserID:   item.RequestUserID().Value(),
+		RequestUserName: users.GetUserName(item.RequestUserID()).Value(),
+		Comment:         item.Comment().Value(),
+		Operations: lo.Map(item.Operations(), func(operation *schedule.ScheduleItemOperationModel, _ int) ScheduleItemOperationDTO {
+
+			startTimeHour, startTimeMinutes := operation.StartTime().Value()
+
+			return ScheduleItemOperationDTO{
+				Operation:        operation.Operation().Value(),
+				Identifier:       operation.Identifier().Value(),
+				RoomIndex:        operation.RoomIndex().Value(),
+				StartTimeHour:    startTimeHour,
+				StartTimeMinutes: startTimeMinutes,
+			}
+		}),
+		Status:              item.Status().Value(),
+		ReviewUserID:        item.ReviewUserID().Value(),
+		ReviewUserName:      lo.Ternary(item.ReviewUserID().IsValid(), users.GetUserName(item.ReviewUserID()).Value(), ""),
+		ReviewComment:       item.ReviewComment().Value(),
+		AppliedHistoryIndex: item.AppliedHistoryIndex().Value(),
+		CreatedAt:           item.CreatedAt(),
+		UpdatedAt:           item.UpdatedAt(),
+	}
+}
+
+// スケジュールに属する変更提案を取得する
+func findScheduleChangeRequest(ctx context.Context, repositoryChangeRequest repository.ChangeRequestRepository, scheduleID vo.ScheduleID, changeRequestID vo.ChangeRequestID) (*changerequest.RootChangeRequestModel, error) {
+
+	changeRequest, err := repositoryChangeRequest.FindByID(ctx, changeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if changeRequest == nil || !changeRequest.BelongsTo(scheduleID) {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDの変更提案は存在しません:%d", changeRequestID.Value()))
+	}
+
+	return changeRequest, nil
+}
+
+func findScheduleChangeRequestDTO(ctx context.Context, repositoryChangeRequest repository.ChangeRequestRepository, repositoryUser repository.UserRepository, scheduleID vo.ScheduleID, changeRequestID vo.ChangeRequestID) (*ScheduleChangeRequestDTO, error) {
+
+	changeRequest, err := findScheduleChangeRequest(ctx, repositoryChangeRequest, scheduleID, changeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return ToScheduleChangeRequestDTO(changeRequest, users), nil
+}
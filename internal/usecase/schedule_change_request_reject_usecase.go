@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleChangeRequestRejectInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int, inputReason string) (*ScheduleChangeRequestDTO, error)
+	}
+)
+
+type (
+	ScheduleChangeRequestRejectInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryChangeRequest       repository.ChangeRequestRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleChangeRequestRejectInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryChangeRequest repository.ChangeRequestRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleChangeRequestRejectInputPort {
+	return &ScheduleChangeRequestRejectInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryChangeRequest:       repositoryChangeRequest,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleChangeRequestRejectInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputChangeRequestID int, inputReason string) (*ScheduleChangeRequestDTO, error) {
+
+	scheduleID, changeRequestID, err := createScheduleChangeRequestVO(inputScheduleID, inputChangeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	reason, err := vo.NewScheduleReviewComment(inputReason)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	scheduleData, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	reviewUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if reviewUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	if !r.serviceScheduleEditPermission.AllowsReviewingBy(scheduleData, reviewUser) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	changeRequest, err := findScheduleChangeRequest(ctx, r.repositoryChangeRequest, scheduleID, changeRequestID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = changeRequest.Reject(user, reason)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		_, err := r.repositoryChangeRequest.Save(ctx, tx, changeRequest)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleChangeRequestDTO(ctx, r.repositoryChangeRequest, r.repositoryUser, scheduleID, changeRequestID)
+}
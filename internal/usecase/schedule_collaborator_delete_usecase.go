@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCollaboratorDeleteInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTargetUserID int) (*ScheduleCollaboratorListOutput, error)
+	}
+)
+
+type (
+	ScheduleCollaboratorDeleteInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleCollaboratorDeleteInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleCollaboratorDeleteInputPort {
+	return &ScheduleCollaboratorDeleteInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleCollaboratorDeleteInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTargetUserID int) (*ScheduleCollaboratorListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	targetUserID, err := vo.NewUserID(inputTargetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	manageUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if manageUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		scheduleData, err := r.repositorySchedule.FindByIDWithLock(ctx, tx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if scheduleData == nil {
+			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		if !r.serviceScheduleEditPermission.AllowsManagingAccessBy(scheduleData, manageUser) {
+			return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+		}
+
+		err = scheduleData.RevokeCollaborator(targetUserID)
+		if err != nil {
+			return log.WrapErrorWithStackTraceNotFound(err)
+		}
+
+		return r.repositorySchedule.SaveAccessControl(ctx, tx, scheduleData)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleCollaboratorListOutput(ctx, r.repositorySchedule, r.repositoryUser, scheduleID)
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCollaboratorEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTargetUserID int, inputPermission string) (*ScheduleCollaboratorListOutput, error)
+	}
+)
+
+type (
+	ScheduleCollaboratorEditInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleCollaboratorEditInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleCollaboratorEditInputPort {
+	return &ScheduleCollaboratorEditInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleCollaboratorEditInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTargetUserID int, inputPermission string) (*ScheduleCollaboratorListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	targetUserID, err := vo.NewUserID(inputTargetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	permission, err := vo.NewCollaboratorPermission(inputPermission)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	manageUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if manageUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	targetUser, err := r.repositoryUser.FindByUserID(ctx, targetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if targetUser == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのユーザーは存在しません:%d", targetUserID.Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		scheduleData, err := r.repositorySchedule.FindByIDWithLock(ctx, tx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if scheduleData == nil {
+			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		if !r.serviceScheduleEditPermission.AllowsManagingAccessBy(scheduleData, manageUser) {
+			return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+		}
+
+		// スケジュールのキャンパスで編集権限のないロールには編集権限を付与できない
+		if !targetUser.AllowsAt(scheduleData.Campus(), vo.PERMISSION_SCHEDULE_EDIT) && permission.IsEdit() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("編集権限のないユーザーには編集権限を付与できません"))
+		}
+
+		err = scheduleData.GrantCollaborator(targetUserID, permission)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		return r.repositorySchedule.SaveAccessControl(ctx, tx, scheduleData)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleCollaboratorListOutput(ctx, r.repositorySchedule, r.repositoryUser, scheduleID)
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleCollaboratorListInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int) (*ScheduleCollaboratorListOutput, error)
+	}
+)
+
+type (
+	ScheduleCollaboratorListOutput struct {
+		ScheduleID    int
+		OwnerUserID   int
+		OwnerUserName string
+		Collaborators []ScheduleCollaboratorDTO
+	}
+
+	ScheduleCollaboratorDTO struct {
+		UserID     int
+		UserName   string
+		Permission string
+	}
+)
+
+type ScheduleCollaboratorListInteractor struct {
+	repositorySchedule              repository.ScheduleRepository
+	repositoryUser                  repository.UserRepository
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+	serviceAuthorization            service.IAuthorizationService
+}
+
+func NewScheduleCollaboratorListInteractor(
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleCollaboratorListInputPort {
+	return &ScheduleCollaboratorListInteractor{
+		repositorySchedule:              repositorySchedule,
+		repositoryUser:                  repositoryUser,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r *ScheduleCollaboratorListInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int) (*ScheduleCollaboratorListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	scheduleData, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(scheduleData, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return ToScheduleCollaboratorListOutput(scheduleData, users), nil
+}
+
+func ToScheduleCollaboratorListOutput(scheduleData *schedule.RootScheduleModel, users user.RootUserModelSlice) *ScheduleCollaboratorListOutput {
+
+	return &ScheduleCollaboratorListOutput{
+		ScheduleID:    scheduleData.ID().Value(),
+		OwnerUserID:   scheduleData.CreateUser().Value(),
+		OwnerUserName: users.GetUserName(scheduleData.CreateUser()).Value(),
+		Collaborators: lo.Map(scheduleData.Collaborators(), func(item *schedule.ScheduleCollaboratorModel, _ int) ScheduleCollaboratorDTO {
+			return ScheduleCollaboratorDTO{
+				UserID:     item.UserID().Value(),
+				UserName:   users.GetUserName(item.UserID()).Value(),
+				Permission: item.Permission().Value(),
+			}
+		}),
+	}
+}
+
+// 保存後の所有者・共同編集者を取得する
+func findScheduleCollaboratorListOutput(ctx context.Context, repositorySchedule repository.ScheduleRepository, repositoryUser repository.UserRepository, scheduleID vo.ScheduleID) (*ScheduleCollaboratorListOutput, error) {
+
+	scheduleData, err := repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	users, err := repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return ToScheduleCollaboratorListOutput(scheduleData, users), nil
+}
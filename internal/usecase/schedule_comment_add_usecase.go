@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/comment"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCommentAddInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputData ScheduleCommentAddInput) (*ScheduleCommentListOutput, error)
+	}
+)
+
+type (
+	ScheduleCommentAddInput struct {
+		Identifier string
+		ParentID   int
+		Body       string
+	}
+)
+
+type (
+	ScheduleCommentAddInteractor struct {
+		txManager            util.TxManager
+		repositorySchedule   repository.ScheduleRepository
+		repositoryComment    repository.CommentRepository
+		repositoryUser       repository.UserRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewScheduleCommentAddInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleCommentAddInputPort {
+	return &ScheduleCommentAddInteractor{
+		txManager:            txManager,
+		repositorySchedule:   repositorySchedule,
+		repositoryComment:    repositoryComment,
+		repositoryUser:       repositoryUser,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r ScheduleCommentAddInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputData ScheduleCommentAddInput) (*ScheduleCommentListOutput, error) {
+
+	scheduleID, identifier, parentID, body, err := r.createVO(inputScheduleID, inputData)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	schedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if schedule == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, user, schedule.Campus(), vo.PERMISSION_SCHEDULE_COMMENT); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var newComment *comment.RootCommentModel
+	if parentID.IsInitial() {
+
+		if identifier != vo.IDENTIFIER_INITIAL && !schedule.HasItem(identifier) {
+			return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("指定したアイテムはスケジュールに存在しません:%s", identifier.Value()))
+		}
+
+		newComment = comment.NewCreateRootCommentModel(scheduleID, identifier, user, body)
+
+	} else {
+
+		parent, err := r.repositoryComment.FindByID(ctx, parentID)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		if parent == nil || parent.ScheduleID() != scheduleID {
+			return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのコメントは存在しません:%d", parentID.Value()))
+		}
+
+		newComment, err = parent.Reply(user, body)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(err)
+		}
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		_, err := r.repositoryComment.Save(ctx, tx, newComment)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleCommentListOutput{
+		ScheduleID: scheduleID.Value(),
+		Comments:   ToScheduleCommentDTOs(comments, users),
+	}, nil
+}
+
+func (ScheduleCommentAddInteractor) createVO(inputScheduleID int, inputData ScheduleCommentAddInput) (vo.ScheduleID, vo.Identifier, vo.CommentID, vo.CommentBody, error) {
+
+	var scheduleID vo.ScheduleID
+	var identifier vo.Identifier
+	var parentID vo.CommentID
+	var body vo.CommentBody
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifierOrInitial, inputData.Identifier))
+	errs = errors.Join(errs, vo.SetVOConstructor(&parentID, vo.NewCommentID, inputData.ParentID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&body, vo.NewCommentBody, inputData.Body))
+
+	if errs != nil {
+		return scheduleID, identifier, parentID, body, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, identifier, parentID, body, nil
+}
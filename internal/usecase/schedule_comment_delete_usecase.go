@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCommentDeleteInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int) (*ScheduleCommentListOutput, error)
+	}
+)
+
+type (
+	ScheduleCommentDeleteInteractor struct {
+		txManager         util.TxManager
+		repositoryComment repository.CommentRepository
+		repositoryUser    repository.UserRepository
+	}
+)
+
+func NewScheduleCommentDeleteInteractor(
+	txManager util.TxManager,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
+) IScheduleCommentDeleteInputPort {
+	return &ScheduleCommentDeleteInteractor{
+		txManager:         txManager,
+		repositoryComment: repositoryComment,
+		repositoryUser:    repositoryUser,
+	}
+}
+
+func (r ScheduleCommentDeleteInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int) (*ScheduleCommentListOutput, error) {
+
+	var scheduleID vo.ScheduleID
+	var commentID vo.CommentID
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&commentID, vo.NewCommentID, inputCommentID))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	commentData, err := r.repositoryComment.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if commentData == nil || commentData.ScheduleID() != scheduleID {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのコメントは存在しません:%d", commentID.Value()))
+	}
+
+	if !commentData.IsAuthor(user) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("コメントを削除できるのは投稿者のみです"))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		err := r.repositoryComment.Delete(ctx, tx, commentID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleCommentListOutput{
+		ScheduleID: scheduleID.Value(),
+		Comments:   ToScheduleCommentDTOs(comments, users),
+	}, nil
+}
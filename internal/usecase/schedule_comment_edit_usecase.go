@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCommentEditInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int, inputBody string) (*ScheduleCommentListOutput, error)
+	}
+)
+
+type (
+	ScheduleCommentEditInteractor struct {
+		txManager         util.TxManager
+		repositoryComment repository.CommentRepository
+		repositoryUser    repository.UserRepository
+	}
+)
+
+func NewScheduleCommentEditInteractor(
+	txManager util.TxManager,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
+) IScheduleCommentEditInputPort {
+	return &ScheduleCommentEditInteractor{
+		txManager:         txManager,
+		repositoryComment: repositoryComment,
+		repositoryUser:    repositoryUser,
+	}
+}
+
+func (r ScheduleCommentEditInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int, inputBody string) (*ScheduleCommentListOutput, error) {
+
+	var scheduleID vo.ScheduleID
+	var commentID vo.CommentID
+	var body vo.CommentBody
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&commentID, vo.NewCommentID, inputCommentID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&body, vo.NewCommentBody, inputBody))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	commentData, err := r.repositoryComment.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if commentData == nil || commentData.ScheduleID() != scheduleID {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのコメントは存在しません:%d", commentID.Value()))
+	}
+
+	err = commentData.Edit(user, body)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceForbidden(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		_, err := r.repositoryComment.Save(ctx, tx, commentData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleCommentListOutput{
+		ScheduleID: scheduleID.Value(),
+		Comments:   ToScheduleCommentDTOs(comments, users),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/comment"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleCommentListInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputIdentifier string) (*ScheduleCommentListOutput, error)
+	}
+)
+
+type (
+	ScheduleCommentListOutput struct {
+		ScheduleID int
+		Comments   []*ScheduleCommentDTO
+	}
+
+	ScheduleCommentDTO struct {
+		CommentID    int
+		Identifier   string
+		AuthorUserID int
+		AuthorName   string
+		Body         string
+		Resolved     bool
+		CreatedAt    time.Time
+		UpdatedAt    time.Time
+		Replies      []*ScheduleCommentDTO
+	}
+)
+
+type ScheduleCommentListInteractor struct {
+	repositorySchedule              repository.ScheduleRepository
+	repositoryComment               repository.CommentRepository
+	repositoryUser                  repository.UserRepository
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+	serviceAuthorization            service.IAuthorizationService
+}
+
+func NewScheduleCommentListInteractor(
+	repositorySchedule repository.ScheduleRepository,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleCommentListInputPort {
+	return &ScheduleCommentListInteractor{
+		repositorySchedule:              repositorySchedule,
+		repositoryComment:               repositoryComment,
+		repositoryUser:                  repositoryUser,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r *ScheduleCommentListInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputIdentifier string) (*ScheduleCommentListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	// 識別子の指定がない場合はスケジュールのすべてのコメントを返す
+	identifier, err := vo.NewIdentifierOrInitial(inputIdentifier)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	schedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if schedule == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	// コメントにはアイテムの内容が含まれるため、スケジュールを閲覧できるユーザーに限る
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, schedule.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(schedule, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if identifier != vo.IDENTIFIER_INITIAL {
+		comments = comments.FilterByIdentifier(identifier)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleCommentListOutput{
+		ScheduleID: scheduleID.Value(),
+		Comments:   ToScheduleCommentDTOs(comments, users),
+	}, nil
+}
+
+// 起点のコメントごとに返信をまとめたスレッドに変換する
+func ToScheduleCommentDTOs(comments comment.RootCommentModelSlice, users user.RootUserModelSlice) []*ScheduleCommentDTO {
+
+	toDTO := func(item *comment.RootCommentModel) *ScheduleCommentDTO {
+		return &ScheduleCommentDTO{
+			CommentID:    item.ID().Value(),
+			Identifier:   item.Identifier().Value(),
+			AuthorUserID: item.AuthorUserID().Value(),
+			AuthorName:   users.GetUserName(item.AuthorUserID()).Value(),
+			Body:         item.Body().Value(),
+			Resolved:     item.IsResolved(),
+			CreatedAt:    item.CreatedAt(),
+			UpdatedAt:    item.UpdatedAt(),
+			Replies:      []*ScheduleCommentDTO{},
+		}
+	}
+
+	return lo.Map(comments.Threads(), func(thread *comment.RootCommentModel, _ int) *ScheduleCommentDTO {
+
+		threadDTO := toDTO(thread)
+		threadDTO.Replies = lo.Map(comments.Replies(thread.ID()), func(reply *comment.RootCommentModel, _ int) *ScheduleCommentDTO {
+			return toDTO(reply)
+		})
+
+		return threadDTO
+	})
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleCommentResolveInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int, resolved bool) (*ScheduleCommentListOutput, error)
+	}
+)
+
+type (
+	ScheduleCommentResolveInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryComment             repository.CommentRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleCommentResolveInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleCommentResolveInputPort {
+	return &ScheduleCommentResolveInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryComment:             repositoryComment,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleCommentResolveInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputCommentID int, resolved bool) (*ScheduleCommentListOutput, error) {
+
+	var scheduleID vo.ScheduleID
+	var commentID vo.CommentID
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&commentID, vo.NewCommentID, inputCommentID))
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	schedule, err := r.repositorySchedule.FindByID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if schedule == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	commentData, err := r.repositoryComment.FindByID(ctx, commentID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if commentData == nil || commentData.ScheduleID() != scheduleID {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのコメントは存在しません:%d", commentID.Value()))
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	resolveUser := users.FindByUserID(user)
+	if resolveUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	// 解決状態は投稿者のほか、スケジュールを編集できるユーザーも変更できる
+	if !commentData.IsAuthor(user) && !r.serviceScheduleEditPermission.AllowsEditingBy(schedule, resolveUser) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	err = commentData.Resolve(resolved)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		_, err := r.repositoryComment.Save(ctx, tx, commentData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleCommentListOutput{
+		ScheduleID: scheduleID.Value(),
+		Comments:   ToScheduleCommentDTOs(comments, users),
+	}, nil
+}
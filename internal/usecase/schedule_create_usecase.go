@@ -6,6 +6,7 @@ import (
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
@@ -15,15 +16,24 @@ type (
 	IScheduleCreateInputPort interface {
 		Execute(
 			ctx context.Context,
-			role vo.RoleKey,
 			registerUser vo.UserID,
 			inputCampus string,
-			inputStartTime int,
-			inputEndTime int,
+			inputScheduleTime ScheduleTimeInput,
 		) (*ScheduleCreateOutput, error)
 	}
 )
 
+type (
+	// スケジュールの実施日と開始・終了時刻。終了時刻は24時0分まで指定できる
+	ScheduleTimeInput struct {
+		TargetDate       string
+		StartTimeHour    int
+		StartTimeMinutes int
+		EndTimeHour      int
+		EndTimeMinutes   int
+	}
+)
+
 type (
 	ScheduleCreateOutput struct {
 		ScheduleID int
@@ -32,10 +42,12 @@ type (
 
 type (
 	ScheduleCreateInteractor struct {
-		txManager          util.TxManager
-		repositoryCampus   repository.CampusRepository
-		repositorySchedule repository.ScheduleRepository
-		repositoryLesson   repository.LessonRepository
+		txManager                  util.TxManager
+		repositoryCampus           repository.CampusRepository
+		repositorySchedule         repository.ScheduleRepository
+		repositoryLesson           repository.LessonRepository
+		repositorySchedulingPolicy repository.SchedulingPolicyRepository
+		serviceAuthorization       service.IAuthorizationService
 	}
 )
 
@@ -44,43 +56,52 @@ func NewScheduleCreateInteractor(
 	repositoryCampus repository.CampusRepository,
 	repositorySchedule repository.ScheduleRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 
+	serviceAuthorization service.IAuthorizationService,
 ) IScheduleCreateInputPort {
 	return &ScheduleCreateInteractor{
-		txManager:          txManager,
-		repositoryCampus:   repositoryCampus,
-		repositorySchedule: repositorySchedule,
-		repositoryLesson:   repositoryLesson,
+		txManager:                  txManager,
+		repositoryCampus:           repositoryCampus,
+		repositorySchedule:         repositorySchedule,
+		repositoryLesson:           repositoryLesson,
+		repositorySchedulingPolicy: repositorySchedulingPolicy,
+		serviceAuthorization:       serviceAuthorization,
 	}
 }
 
 func (r ScheduleCreateInteractor) Execute(
 	ctx context.Context,
-	role vo.RoleKey,
 	registerUser vo.UserID,
 	inputCampus string,
-	inputStartTime int,
-	inputEndTime int,
+	inputScheduleTime ScheduleTimeInput,
 ) (*ScheduleCreateOutput, error) {
 
-	if role.IsEditor() {
-		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
-	}
-
 	campus, err := vo.NewCampus(inputCampus)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, registerUser, campus, vo.PERMISSION_SCHEDULE_CREATE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
 	scheduleTime, err := vo.NewScheduleTime(
-		inputStartTime,
-		inputEndTime,
+		inputScheduleTime.StartTimeHour,
+		inputScheduleTime.StartTimeMinutes,
+		inputScheduleTime.EndTimeHour,
+		inputScheduleTime.EndTimeMinutes,
 	)
 
 	if err != nil {
 		return nil, log.WrapErrorWithStackTraceBadRequest(err)
 	}
 
+	targetDate, err := vo.NewScheduleTargetDate(inputScheduleTime.TargetDate)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
 	campuses, err := r.repositoryCampus.FindAll(ctx)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -90,12 +111,22 @@ func (r ScheduleCreateInteractor) Execute(
 		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定した校舎はありません:%s", campus.Value()))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, campus)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := schedulingPolicy.ValidateScheduleTime(targetDate, scheduleTime); err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
 	var sheduleID vo.ScheduleID
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
 		rootSchedule := schedule.NewCreateRootScheduleModel(
 			campus,
 			registerUser,
+			targetDate,
 			scheduleTime,
 		)
 
@@ -13,7 +13,7 @@ import (
 
 type (
 	IScheduleDeleteInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, scheduleID int, inputDeleteUserID vo.UserID) error
+		Execute(ctx context.Context, scheduleID int, inputDeleteUserID vo.UserID) error
 	}
 )
 
@@ -40,7 +40,7 @@ func NewScheduleDeleteInteractor(
 	}
 }
 
-func (r ScheduleDeleteInteractor) Execute(ctx context.Context, role vo.RoleKey, inputScheduleID int, inputDeleteUserID vo.UserID) error {
+func (r ScheduleDeleteInteractor) Execute(ctx context.Context, inputScheduleID int, inputDeleteUserID vo.UserID) error {
 
 	scheduleID, err := vo.NewScheduleID(inputScheduleID)
 	if err != nil {
@@ -61,7 +61,7 @@ func (r ScheduleDeleteInteractor) Execute(ctx context.Context, role vo.RoleKey,
 		return log.WrapErrorWithStackTrace(err)
 	}
 
-	isEnable := r.serviceScheduleEditPermission.AllowsEditingBy(schedule, deleteUser)
+	isEnable := r.serviceScheduleEditPermission.AllowsDeletingBy(schedule, deleteUser)
 	if !isEnable {
 		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
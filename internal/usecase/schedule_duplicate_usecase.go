@@ -5,6 +5,7 @@ import (
 	"database/sql"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
@@ -12,32 +13,34 @@ import (
 
 type (
 	IScheduleDuplicatePort interface {
-		Execute(ctx context.Context, roleKey vo.RoleKey, duplicateUser vo.UserID, inputDuplicateScheduleID int) error
+		Execute(ctx context.Context, duplicateUser vo.UserID, inputDuplicateScheduleID int) error
 	}
 )
 
 type (
 	ScheduleDuplicateInteractor struct {
-		txManager          util.TxManager
-		repositorySchedule repository.ScheduleRepository
+		txManager            util.TxManager
+		repositorySchedule   repository.ScheduleRepository
+		repositoryComment    repository.CommentRepository
+		serviceAuthorization service.IAuthorizationService
 	}
 )
 
 func NewScheduleDuplicateInteractor(
 	txManager util.TxManager,
 	repositorySchedule repository.ScheduleRepository,
+	repositoryComment repository.CommentRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) IScheduleDuplicatePort {
 	return &ScheduleDuplicateInteractor{
-		txManager:          txManager,
-		repositorySchedule: repositorySchedule,
+		txManager:            txManager,
+		repositorySchedule:   repositorySchedule,
+		repositoryComment:    repositoryComment,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
 
-func (r ScheduleDuplicateInteractor) Execute(ctx context.Context, roleKey vo.RoleKey, duplicateUser vo.UserID, inputDuplicateScheduleID int) error {
-
-	if roleKey.IsViewer() {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
-	}
+func (r ScheduleDuplicateInteractor) Execute(ctx context.Context, duplicateUser vo.UserID, inputDuplicateScheduleID int) error {
 
 	duplicateScheduleID, err := vo.NewScheduleID(inputDuplicateScheduleID)
 	if err != nil {
@@ -53,15 +56,41 @@ func (r ScheduleDuplicateInteractor) Execute(ctx context.Context, roleKey vo.Rol
 		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", duplicateScheduleID.Value()))
 	}
 
+	if err := r.serviceAuthorization.AuthorizeAt(ctx, duplicateUser, schedule.Campus(), vo.PERMISSION_SCHEDULE_EDIT); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	duplicateSchedule := schedule.Duplicate(duplicateUser)
 
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, duplicateScheduleID)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
-		_, err = r.repositorySchedule.Save(ctx, tx, duplicateSchedule)
+		newScheduleID, err := r.repositorySchedule.Save(ctx, tx, duplicateSchedule)
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
+		// アイテムの識別子は複製後も同じため、コメントはそのまま複製先に付け替える
+		for _, thread := range comments.Threads() {
+
+			newThreadID, err := r.repositoryComment.Save(ctx, tx, thread.Duplicate(newScheduleID, vo.COMMENT_ID_INITIAL))
+			if err != nil {
+				return log.WrapErrorWithStackTrace(err)
+			}
+
+			for _, reply := range comments.Replies(thread.ID()) {
+
+				_, err = r.repositoryComment.Save(ctx, tx, reply.Duplicate(newScheduleID, newThreadID))
+				if err != nil {
+					return log.WrapErrorWithStackTrace(err)
+				}
+			}
+		}
+
 		return nil
 	})
 
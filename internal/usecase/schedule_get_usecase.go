@@ -2,11 +2,14 @@ package usecase
 
 import (
 	"context"
+	"slices"
 
 	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/comment"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/room"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/mapper"
@@ -15,26 +18,42 @@ import (
 
 type (
 	IScheduleGetInputPort interface {
-		Execute(ctx context.Context, inputScheduleID int, intputHistoryIndex int) (*ScheduleGetOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, intputHistoryIndex int) (*ScheduleGetOutput, error)
 	}
 )
 
 type (
 	ScheduleGetOutput struct {
-		ScheduleID     int
-		Campus         string
-		Title          string
-		ScheduleTime   ScheduleTimeDTO
-		HistoryIndex   int
-		Rooms          []ScheduleRoomDTO
-		LessonItemList []port.ScheduleLessonItem
-		RoomLessonList []port.ScheduleRoomLesson
-		CreatedUserID  int
+		ScheduleID        int
+		Campus            string
+		Title             string
+		TargetDate        string
+		Status            string
+		ReviewComment     string
+		ScheduleTime      ScheduleTimeDTO
+		HistoryIndex      int
+		Rooms             []ScheduleRoomDTO
+		LessonItemList    []port.ScheduleLessonItem
+		RoomLessonList    []port.ScheduleRoomLesson
+		ItemGroupList     []port.ScheduleItemGroup
+		BlockedPeriods    []*CampusBlockedPeriodDTO
+		RoomItemTypes     []*CampusRoomItemTypeDTO
+		ItemCommentCounts []ScheduleItemCommentCountDTO
+		CreatedUserID     int
+		Collaborators     []ScheduleCollaboratorDTO
+	}
+
+	ScheduleItemCommentCountDTO struct {
+		Identifier      string
+		Count           int
+		UnresolvedCount int
 	}
 
 	ScheduleTimeDTO struct {
-		StartTime int
-		EndTime   int
+		StartTime        int
+		StartTimeMinutes int
+		EndTime          int
+		EndTimeMinutes   int
 	}
 
 	ScheduleRoomDTO struct {
@@ -57,7 +76,13 @@ type (
 		repositoryRoom                  repository.RoomRepository
 		repositoryScheduleInvisibleRoom repository.ScheduleInvisibleRoomRepository
 		repositoryLesson                repository.LessonRepository
+		repositorySchedulingPolicy      repository.SchedulingPolicyRepository
+		repositoryRoomItemType          repository.RoomItemTypeRepository
+		repositoryComment               repository.CommentRepository
+		repositoryUser                  repository.UserRepository
 		mapperScheduleItemOutput        mapper.ScheduleItemEditOutputMapper
+		serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+		serviceAuthorization            service.IAuthorizationService
 	}
 )
 
@@ -66,18 +91,30 @@ func NewScheduleGetInteractor(
 	repositoryRoom repository.RoomRepository,
 	repositoryScheduleInvisibleRoom repository.ScheduleInvisibleRoomRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	repositoryRoomItemType repository.RoomItemTypeRepository,
+	repositoryComment repository.CommentRepository,
+	repositoryUser repository.UserRepository,
 	mapperScheduleItemOutput mapper.ScheduleItemEditOutputMapper,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
 ) IScheduleGetInputPort {
 	return &ScheduleGetInteractor{
 		repositorySchedule:              repositorySchedule,
 		repositoryRoom:                  repositoryRoom,
 		repositoryScheduleInvisibleRoom: repositoryScheduleInvisibleRoom,
 		repositoryLesson:                repositoryLesson,
+		repositorySchedulingPolicy:      repositorySchedulingPolicy,
+		repositoryRoomItemType:          repositoryRoomItemType,
+		repositoryComment:               repositoryComment,
+		repositoryUser:                  repositoryUser,
 		mapperScheduleItemOutput:        mapperScheduleItemOutput,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
 	}
 }
 
-func (r ScheduleGetInteractor) Execute(ctx context.Context, inputScheduleID int, intputHistoryIndex int) (*ScheduleGetOutput, error) {
+func (r ScheduleGetInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, intputHistoryIndex int) (*ScheduleGetOutput, error) {
 
 	scheduleID, err := vo.NewScheduleID(inputScheduleID)
 	if err != nil {
@@ -115,10 +152,26 @@ func (r ScheduleGetInteractor) Execute(ctx context.Context, inputScheduleID int,
 		setHistoryIndex = historyIndex
 	}
 
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(scheduleData, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	lessons, err := r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -129,25 +182,68 @@ func (r ScheduleGetInteractor) Execute(ctx context.Context, inputScheduleID int,
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
-	startTime, endTime := scheduleData.ScheduleTime().Value()
+	itemTypes, err := r.repositoryRoomItemType.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	comments, err := r.repositoryComment.FindByScheduleID(ctx, scheduleData.ID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	users, err := r.repositoryUser.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	startTime, startTimeMinutes := scheduleData.ScheduleTime().StartTimeValue()
+	endTime, endTimeMinutes := scheduleData.ScheduleTime().EndTimeValue()
 	scheduleTIme := ScheduleTimeDTO{
-		StartTime: startTime,
-		EndTime:   endTime,
+		StartTime:        startTime,
+		StartTimeMinutes: startTimeMinutes,
+		EndTime:          endTime,
+		EndTimeMinutes:   endTimeMinutes,
 	}
 
 	return &ScheduleGetOutput{
-		ScheduleID:     scheduleData.ID().Value(),
-		Campus:         scheduleData.Campus().Value(),
-		Title:          scheduleData.Title().Value(),
-		ScheduleTime:   scheduleTIme,
-		HistoryIndex:   setHistoryIndex.Value(),
-		Rooms:          roomsDTO,
-		LessonItemList: r.mapperScheduleItemOutput.BuildScheduleLessonItems(scheduleData, lessons),
-		RoomLessonList: r.mapperScheduleItemOutput.BuildScheduleRoomLessonItems(scheduleData, lessons),
-		CreatedUserID:  scheduleData.CreateUser().Value(),
+		ScheduleID:        scheduleData.ID().Value(),
+		Campus:            scheduleData.Campus().Value(),
+		Title:             scheduleData.Title().Value(),
+		TargetDate:        scheduleData.TargetDate().Value(),
+		Status:            scheduleData.Status().Value(),
+		ReviewComment:     scheduleData.ReviewComment().Value(),
+		ScheduleTime:      scheduleTIme,
+		HistoryIndex:      setHistoryIndex.Value(),
+		Rooms:             roomsDTO,
+		LessonItemList:    r.mapperScheduleItemOutput.BuildScheduleLessonItems(scheduleData, lessons),
+		RoomLessonList:    r.mapperScheduleItemOutput.BuildScheduleRoomLessonItems(scheduleData, lessons),
+		ItemGroupList:     r.mapperScheduleItemOutput.BuildScheduleItemGroups(scheduleData),
+		BlockedPeriods:    ToCampusBlockedPeriodDTOs(scheduleData.BlockedPeriods()),
+		RoomItemTypes:     ToCampusRoomItemTypeDTOs(itemTypes),
+		ItemCommentCounts: r.getItemCommentCounts(comments),
+		CreatedUserID:     scheduleData.CreateUser().Value(),
+		Collaborators:     ToScheduleCollaboratorListOutput(scheduleData, users).Collaborators,
 	}, nil
 }
 
+// コメントは履歴に依存しないため、どの履歴を表示していても同じ件数を返す
+func (r ScheduleGetInteractor) getItemCommentCounts(comments comment.RootCommentModelSlice) []ScheduleItemCommentCountDTO {
+
+	itemComments := comments.GroupByItemIdentifier()
+
+	identifiers := lo.Keys(itemComments)
+	slices.Sort(identifiers)
+
+	return lo.Map(identifiers, func(identifier vo.Identifier, _ int) ScheduleItemCommentCountDTO {
+		return ScheduleItemCommentCountDTO{
+			Identifier:      identifier.Value(),
+			Count:           len(itemComments[identifier]),
+			UnresolvedCount: itemComments[identifier].CountUnresolved(),
+		}
+	})
+}
+
 func (r ScheduleGetInteractor) getRooms(ctx context.Context, scheduelData *schedule.RootScheduleModel) ([]ScheduleRoomDTO, error) {
 
 	rooms, err := r.repositoryRoom.FindByCampus(ctx, scheduelData.Campus())
@@ -18,15 +18,18 @@ import (
 
 type (
 	IScheduleItemDivideInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputDivide ScheduleItemDivideInput) (*port.ScheduleItemEditOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputDivide ScheduleItemDivideInput) (*port.ScheduleItemEditOutput, error)
 	}
 )
 
 type (
 	ScheduleItemDivideInput struct {
-		LessonID      int
-		Identifier    string
-		DivideMinutes int
+		LessonID          int
+		Identifier        string
+		DivideMinutes     int
+		Parts             int
+		DivideMinutesList []int
+		BreakMinutes      int
 	}
 )
 
@@ -36,6 +39,7 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
 	}
@@ -46,6 +50,7 @@ func NewScheduleItemDivideInteractor(
 	repositorySchedule repository.ScheduleRepository,
 	repositoryUser repository.UserRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
 ) IScheduleItemDivideInputPort {
@@ -54,14 +59,15 @@ func NewScheduleItemDivideInteractor(
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
 
-func (r ScheduleItemDivideInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputDivide ScheduleItemDivideInput) (*port.ScheduleItemEditOutput, error) {
+func (r ScheduleItemDivideInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputDivide ScheduleItemDivideInput) (*port.ScheduleItemEditOutput, error) {
 
-	scheduleID, historyIndex, lessonID, identifier, divideMinutes, err := r.createVO(inputScheduleID, inputHistoryIndex, inputDivide)
+	scheduleID, historyIndex, lessonID, identifier, divideOption, err := r.createVO(inputScheduleID, inputHistoryIndex, inputDivide)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
@@ -76,10 +82,6 @@ func (r ScheduleItemDivideInteractor) Execute(ctx context.Context, role vo.RoleK
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		if scheduleData == nil {
-			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
-		}
-
 		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
@@ -90,7 +92,12 @@ func (r ScheduleItemDivideInteractor) Execute(ctx context.Context, role vo.RoleK
 			return log.WrapErrorWithStackTraceBadRequest(errors.New("分割対象の講座は登録されていません"))
 		}
 
-		err = scheduleData.ItemDivide(lessonID, lesson.Duration(), identifier, divideMinutes)
+		err = scheduleData.ItemDivide(lessonID, lesson.Duration(), identifier, divideOption)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
 			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
@@ -114,26 +121,50 @@ func (r ScheduleItemDivideInteractor) Execute(ctx context.Context, role vo.RoleK
 	}, nil
 }
 
-func (ScheduleItemDivideInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemDivideInput) (vo.ScheduleID, vo.HistoryIndex, vo.LessonID, vo.Identifier, vo.ItemDivideMinutes, error) {
+func (ScheduleItemDivideInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemDivideInput) (vo.ScheduleID, vo.HistoryIndex, vo.LessonID, vo.Identifier, *schedule.ScheduleItemDivideOption, error) {
 
 	var scheduleID vo.ScheduleID
 	var historyIndex vo.HistoryIndex
 	var lessonID vo.LessonID
 	var identifier vo.Identifier
-	var divideMinutes vo.ItemDivideMinutes
+	var breakMinutes vo.ItemDivideBreakMinutes
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
 	errs = errors.Join(errs, vo.SetVOConstructor(&lessonID, vo.NewLessonID, inputData.LessonID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, inputData.Identifier))
-	errs = errors.Join(errs, vo.SetVOConstructor(&divideMinutes, vo.NewItemDivideMinutes, inputData.DivideMinutes))
+	errs = errors.Join(errs, vo.SetVOConstructor(&breakMinutes, vo.NewItemDivideBreakMinutes, inputData.BreakMinutes))
+
+	// 分割時間の並び、分割数、分割時間の順に優先して分割方法を決める
+	var divideOption *schedule.ScheduleItemDivideOption
+	switch {
+	case len(inputData.DivideMinutesList) > 0:
+
+		lengths := make([]vo.ItemDivideMinutes, len(inputData.DivideMinutesList))
+		for i, inputDivideMinutes := range inputData.DivideMinutesList {
+			errs = errors.Join(errs, vo.SetVOConstructor(&lengths[i], vo.NewItemDivideMinutes, inputDivideMinutes))
+		}
+		divideOption = schedule.NewScheduleItemDivideOptionByLengths(lengths, breakMinutes)
+
+	case inputData.Parts != 0:
+
+		var parts vo.ItemDivideParts
+		errs = errors.Join(errs, vo.SetVOConstructor(&parts, vo.NewItemDivideParts, inputData.Parts))
+		divideOption = schedule.NewScheduleItemDivideOptionEqually(parts, breakMinutes)
+
+	default:
+
+		var divideMinutes vo.ItemDivideMinutes
+		errs = errors.Join(errs, vo.SetVOConstructor(&divideMinutes, vo.NewItemDivideMinutes, inputData.DivideMinutes))
+		divideOption = schedule.NewScheduleItemDivideOptionByMinutes(divideMinutes, breakMinutes)
+	}
 
 	if errs != nil {
-		return scheduleID, historyIndex, lessonID, identifier, divideMinutes, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, historyIndex, lessonID, identifier, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, historyIndex, lessonID, identifier, divideMinutes, nil
+	return scheduleID, historyIndex, lessonID, identifier, divideOption, nil
 }
 
 func (r ScheduleItemDivideInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
@@ -143,6 +174,10 @@ func (r ScheduleItemDivideInteractor) getSchedule(ctx context.Context, tx *sql.T
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
 	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -153,5 +188,12 @@ func (r ScheduleItemDivideInteractor) getSchedule(ctx context.Context, tx *sql.T
 		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
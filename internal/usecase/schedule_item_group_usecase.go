@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/lesson"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/mapper"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/port"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleItemGroupInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemGroupInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleItemGroupInput struct {
+		Members []ScheduleItemGroupMemberInput
+	}
+
+	ScheduleItemGroupMemberInput struct {
+		Identifier    string
+		OffsetMinutes int
+	}
+)
+
+type (
+	ScheduleItemGroupInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		repositoryLesson              repository.LessonRepository
+		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleItemGroupInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	repositoryLesson repository.LessonRepository,
+	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleItemGroupInputPort {
+	return &ScheduleItemGroupInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		repositoryLesson:              repositoryLesson,
+		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleItemGroupInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemGroupInput) (*port.ScheduleItemEditOutput, error) {
+
+	scheduleID, historyIndex, members, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData.Members)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var scheduleData *schedule.RootScheduleModel
+	var lessons lesson.RootLessonModelSlice
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		var err error
+		scheduleData, err = r.getSchedule(ctx, tx, scheduleID, historyIndex, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		err = scheduleData.ItemGroupCreate(vo.NewIdentifierGenerate(), members)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		scheduleData.ModifyEditing(historyIndex, user)
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &port.ScheduleItemEditOutput{
+		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+	}, nil
+
+}
+
+func (ScheduleItemGroupInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputMembers []ScheduleItemGroupMemberInput) (vo.ScheduleID, vo.HistoryIndex, []*schedule.ScheduleItemGroupMemberModel, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+
+	members := make([]*schedule.ScheduleItemGroupMemberModel, 0, len(inputMembers))
+	for _, inputMember := range inputMembers {
+
+		var identifier vo.Identifier
+		var offsetMinutes vo.ItemGroupOffsetMinutes
+
+		errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, inputMember.Identifier))
+		errs = errors.Join(errs, vo.SetVOConstructor(&offsetMinutes, vo.NewItemGroupOffsetMinutes, inputMember.OffsetMinutes))
+
+		members = append(members, schedule.NewScheduleItemGroupMemberModel(identifier, offsetMinutes))
+	}
+
+	if errs != nil {
+		return scheduleID, historyIndex, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, members, nil
+}
+
+func (r ScheduleItemGroupInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
+
+	scheduleData, err := r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, scheduleID, historyIndex)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	isEnable := r.serviceScheduleEditPermission.AllowsEditingBy(scheduleData, editUser)
+	if !isEnable {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	return scheduleData, nil
+}
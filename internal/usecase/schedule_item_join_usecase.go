@@ -18,7 +18,15 @@ import (
 
 type (
 	IScheduleItemJoinInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputJoinFromIdentifier string, inputJoinToIdentifier string) (*port.ScheduleItemEditOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemJoinInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleItemJoinInput struct {
+		JoinFromIdentifier string
+		JoinToIdentifier   string
+		Identifiers        []string
 	}
 )
 
@@ -28,6 +36,7 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
 	}
@@ -38,6 +47,7 @@ func NewScheduleItemJoinInteractor(
 	repositorySchedule repository.ScheduleRepository,
 	repositoryUser repository.UserRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
 ) IScheduleItemJoinInputPort {
@@ -46,14 +56,15 @@ func NewScheduleItemJoinInteractor(
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
 
-func (r ScheduleItemJoinInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputJoinFromIdentifier string, inputJoinToIdentifier string) (*port.ScheduleItemEditOutput, error) {
+func (r ScheduleItemJoinInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemJoinInput) (*port.ScheduleItemEditOutput, error) {
 
-	scheduleID, historyIndex, joinFromIdentifier, joinToIdentifier, err := r.createVO(inputScheduleID, inputHistoryIndex, inputJoinFromIdentifier, inputJoinToIdentifier)
+	scheduleID, historyIndex, identifiers, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
@@ -68,16 +79,17 @@ func (r ScheduleItemJoinInteractor) Execute(ctx context.Context, role vo.RoleKey
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		if scheduleData == nil {
-			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
-		}
-
 		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		err = scheduleData.ItemJoin(joinFromIdentifier, joinToIdentifier)
+		err = scheduleData.ItemJoin(identifiers)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
 			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
@@ -108,6 +120,10 @@ func (r ScheduleItemJoinInteractor) getSchedule(ctx context.Context, tx *sql.Tx,
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
 	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -118,25 +134,39 @@ func (r ScheduleItemJoinInteractor) getSchedule(ctx context.Context, tx *sql.Tx,
 		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
 
-func (ScheduleItemJoinInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputJoinFromIdentifier string, inputJoinToIdentifier string) (vo.ScheduleID, vo.HistoryIndex, vo.Identifier, vo.Identifier, error) {
+func (ScheduleItemJoinInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemJoinInput) (vo.ScheduleID, vo.HistoryIndex, []vo.Identifier, error) {
 
 	var scheduleID vo.ScheduleID
 	var historyIndex vo.HistoryIndex
-	var joinFromIdentifier vo.Identifier
-	var joinToIdentifier vo.Identifier
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
-	errs = errors.Join(errs, vo.SetVOConstructor(&joinFromIdentifier, vo.NewIdentifier, inputJoinFromIdentifier))
-	errs = errors.Join(errs, vo.SetVOConstructor(&joinToIdentifier, vo.NewIdentifier, inputJoinToIdentifier))
+
+	// 識別子の一覧が指定されていない場合は、結合先(識別子を引き継ぐ)、結合元の順で結合する
+	inputIdentifiers := inputData.Identifiers
+	if len(inputIdentifiers) <= 0 {
+		inputIdentifiers = []string{inputData.JoinToIdentifier, inputData.JoinFromIdentifier}
+	}
+
+	identifiers := make([]vo.Identifier, len(inputIdentifiers))
+	for i, inputIdentifier := range inputIdentifiers {
+		errs = errors.Join(errs, vo.SetVOConstructor(&identifiers[i], vo.NewIdentifier, inputIdentifier))
+	}
 
 	if errs != nil {
-		return scheduleID, historyIndex, joinFromIdentifier, joinToIdentifier, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, historyIndex, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, historyIndex, joinFromIdentifier, joinToIdentifier, nil
+	return scheduleID, historyIndex, identifiers, nil
 }
@@ -18,7 +18,7 @@ import (
 
 type (
 	IScheduleItemMoveInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemMoveInput) (*port.ScheduleItemEditOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemMoveInput) (*port.ScheduleItemEditOutput, error)
 	}
 )
 
@@ -27,12 +27,14 @@ type (
 		LessonID        int
 		ItemTag         string
 		Identifier      string
+		Title           string
 		Duration        int
 		StartTimeHour   int
 		StartTimeMinute int
 		EndTimeHour     int
 		EndTimeMinutes  int
 		RoomIndex       int
+		Mode            string
 	}
 )
 
@@ -42,8 +44,12 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
+		repositoryRoomItemType        repository.RoomItemTypeRepository
+		repositoryDayBundle           repository.DayBundleRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
+		serviceScheduleCollision      service.IScheduleCollisionService
 	}
 )
 
@@ -51,29 +57,38 @@ func NewScheduleItemMoveInteractor(
 	txManager util.TxManager,
 	repositorySchedule repository.ScheduleRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	repositoryRoomItemType repository.RoomItemTypeRepository,
 	repositoryUser repository.UserRepository,
+	repositoryDayBundle repository.DayBundleRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+	serviceScheduleCollision service.IScheduleCollisionService,
 ) IScheduleItemMoveInputPort {
 	return &ScheduleItemMoveInteractor{
 		txManager:                     txManager,
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
+		repositoryRoomItemType:        repositoryRoomItemType,
+		repositoryDayBundle:           repositoryDayBundle,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
+		serviceScheduleCollision:      serviceScheduleCollision,
 	}
 }
 
-func (r ScheduleItemMoveInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemMoveInput) (*port.ScheduleItemEditOutput, error) {
+func (r ScheduleItemMoveInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemMoveInput) (*port.ScheduleItemEditOutput, error) {
 
-	scheduleID, historyIndex, err := r.createVO(inputScheduleID, inputHistoryIndex)
+	scheduleID, historyIndex, moveMode, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData.Mode)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
 	var scheduleData *schedule.RootScheduleModel
 	var lessons lesson.RootLessonModelSlice
+	var warnings []string
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
 		var err error
@@ -82,10 +97,6 @@ func (r ScheduleItemMoveInteractor) Execute(ctx context.Context, role vo.RoleKey
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		if scheduleData == nil {
-			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
-		}
-
 		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
@@ -96,7 +107,38 @@ func (r ScheduleItemMoveInteractor) Execute(ctx context.Context, role vo.RoleKey
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		err = scheduleData.RoomItemMove(moveItem)
+		itemTypes, err := r.repositoryRoomItemType.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if !itemTypes.IsPlaceable(moveItem.ItemTag()) {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("校舎に登録されていないアイテムの種類です:%s", moveItem.ItemTag().Value()))
+		}
+
+		if scheduleData.IsPinnedRoomItem(moveItem.Identifier()) {
+			warnings = append(warnings, port.WARNING_PINNED_ITEM_MOVED)
+		}
+
+		bundleMembers, err := findRefuseCollisionMembers(ctx, r.repositoryDayBundle, r.serviceScheduleCollision, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		baseCollisions := r.serviceScheduleCollision.FindCollisionsWith(scheduleData, bundleMembers)
+
+		err = scheduleData.RoomItemMove(moveItem, moveMode)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		// 移動によって新たに発生した衝突のみを拒否する
+		newCollisions := r.serviceScheduleCollision.FindCollisionsWith(scheduleData, bundleMembers).Excluding(baseCollisions)
+		if len(newCollisions) > 0 {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("同日の別スケジュール(ID:%d)と教室の使用時間が重なっています", newCollisions[0].OtherScheduleID().Value()))
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
 			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
@@ -117,14 +159,36 @@ func (r ScheduleItemMoveInteractor) Execute(ctx context.Context, role vo.RoleKey
 
 	return &port.ScheduleItemEditOutput{
 		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+		Warnings:     warnings,
 	}, nil
 }
 
+// 衝突を拒否する同日スケジュールのまとまりに含まれる場合のみ、他のスケジュールを返す
+func findRefuseCollisionMembers(ctx context.Context, repositoryDayBundle repository.DayBundleRepository, serviceScheduleCollision service.IScheduleCollisionService, scheduleData *schedule.RootScheduleModel) ([]*schedule.RootScheduleModel, error) {
+
+	dayBundle, err := repositoryDayBundle.FindByScheduleID(ctx, scheduleData.ID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if dayBundle == nil || !dayBundle.RefuseCollision() {
+		return []*schedule.RootScheduleModel{}, nil
+	}
+
+	members, err := serviceScheduleCollision.LoadMembers(ctx, dayBundle)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return members, nil
+}
+
 func (r ScheduleItemMoveInteractor) createNewMoveItem(inputData ScheduleItemMoveInput) (*schedule.ScheduleRoomItemModel, error) {
 
 	var itemTag vo.RoomItemTag
 	var lessonID vo.LessonID
 	var identifier vo.Identifier
+	var title vo.RoomItemTitle
 	var duration vo.LessonDuration
 	var roomIndex vo.RoomIndex
 
@@ -132,6 +196,7 @@ func (r ScheduleItemMoveInteractor) createNewMoveItem(inputData ScheduleItemMove
 	errs = errors.Join(errs, vo.SetVOConstructor(&itemTag, vo.NewRoomItemTag, inputData.ItemTag))
 	errs = errors.Join(errs, vo.SetVOConstructor(&lessonID, vo.NewLessonID, inputData.LessonID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, inputData.Identifier))
+	errs = errors.Join(errs, vo.SetVOConstructor(&title, vo.NewRoomItemTitle, inputData.Title))
 	errs = errors.Join(errs, vo.SetVOConstructor(&duration, vo.NewLessonDuration, inputData.Duration))
 
 	startTime, err := vo.NewScheduleLessonTime(inputData.StartTimeHour, inputData.StartTimeMinute)
@@ -146,14 +211,21 @@ func (r ScheduleItemMoveInteractor) createNewMoveItem(inputData ScheduleItemMove
 		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
+	// 校舎ごとに追加した種類のアイテムは講座に紐づけない
+	if itemTag.IsCustom() {
+		lessonID = vo.LESSON_ID_INITIAL
+	}
+
 	return schedule.NewScheduleRoomItemModel(
 		itemTag,
 		lessonID,
 		identifier,
+		title,
 		duration,
 		startTime,
 		endTime,
 		roomIndex,
+		false,
 	), nil
 
 }
@@ -165,6 +237,10 @@ func (r ScheduleItemMoveInteractor) getSchedule(ctx context.Context, tx *sql.Tx,
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
 	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -175,21 +251,30 @@ func (r ScheduleItemMoveInteractor) getSchedule(ctx context.Context, tx *sql.Tx,
 		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
 
-func (ScheduleItemMoveInteractor) createVO(inputScheduleID int, inputHistoryIndex int) (vo.ScheduleID, vo.HistoryIndex, error) {
+func (ScheduleItemMoveInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputMode string) (vo.ScheduleID, vo.HistoryIndex, vo.ItemMoveMode, error) {
 
 	var scheduleID vo.ScheduleID
 	var historyIndex vo.HistoryIndex
+	var moveMode vo.ItemMoveMode
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&moveMode, vo.NewItemMoveMode, inputMode))
 
 	if errs != nil {
-		return scheduleID, historyIndex, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, historyIndex, moveMode, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, historyIndex, nil
+	return scheduleID, historyIndex, moveMode, nil
 }
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/lesson"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/mapper"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/port"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleItemPinInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemPinInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleItemPinInput struct {
+		Identifier string
+		Pinned     bool
+	}
+)
+
+type (
+	ScheduleItemPinInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		repositoryLesson              repository.LessonRepository
+		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleItemPinInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	repositoryLesson repository.LessonRepository,
+	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleItemPinInputPort {
+	return &ScheduleItemPinInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		repositoryLesson:              repositoryLesson,
+		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleItemPinInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemPinInput) (*port.ScheduleItemEditOutput, error) {
+
+	scheduleID, historyIndex, identifier, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData.Identifier)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var scheduleData *schedule.RootScheduleModel
+	var lessons lesson.RootLessonModelSlice
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		var err error
+		scheduleData, err = r.getSchedule(ctx, tx, scheduleID, historyIndex, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		err = scheduleData.RoomItemPin(identifier, inputData.Pinned)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		scheduleData.ModifyEditing(historyIndex, user)
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &port.ScheduleItemEditOutput{
+		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+	}, nil
+
+}
+
+func (ScheduleItemPinInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputIdentifier string) (vo.ScheduleID, vo.HistoryIndex, vo.Identifier, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var identifier vo.Identifier
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, inputIdentifier))
+
+	if errs != nil {
+		return scheduleID, historyIndex, identifier, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, identifier, nil
+}
+
+func (r ScheduleItemPinInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
+
+	scheduleData, err := r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, scheduleID, historyIndex)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	isEnable := r.serviceScheduleEditPermission.AllowsEditingBy(scheduleData, editUser)
+	if !isEnable {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	return scheduleData, nil
+}
@@ -18,7 +18,7 @@ import (
 
 type (
 	IScheduleItemReturnListInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemReturnListInput) (*port.ScheduleItemEditOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemReturnListInput) (*port.ScheduleItemEditOutput, error)
 	}
 )
 
@@ -27,6 +27,7 @@ type (
 		LessonID   int
 		Identifier string
 		Duration   int
+		Mode       string
 	}
 )
 
@@ -36,6 +37,7 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
 	}
@@ -46,6 +48,7 @@ func NewScheduleItemReturnListInteractor(
 	repositorySchedule repository.ScheduleRepository,
 	repositoryUser repository.UserRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
 
@@ -55,20 +58,22 @@ func NewScheduleItemReturnListInteractor(
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
 
-func (r ScheduleItemReturnListInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemReturnListInput) (*port.ScheduleItemEditOutput, error) {
+func (r ScheduleItemReturnListInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemReturnListInput) (*port.ScheduleItemEditOutput, error) {
 
-	scheduleID, historyIndex, err := r.createVO(inputScheduleID, inputHistoryIndex)
+	scheduleID, historyIndex, returnMode, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData.Mode)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
 	var scheduleData *schedule.RootScheduleModel
 	var lessons lesson.RootLessonModelSlice
+	var warnings []string
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
 		var err error
@@ -77,10 +82,6 @@ func (r ScheduleItemReturnListInteractor) Execute(ctx context.Context, role vo.R
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		if scheduleData == nil {
-			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
-		}
-
 		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
 		if err != nil {
 			return log.WrapErrorWithStackTrace(err)
@@ -91,7 +92,16 @@ func (r ScheduleItemReturnListInteractor) Execute(ctx context.Context, role vo.R
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		err = scheduleData.ItemReturnList(returnItem)
+		if scheduleData.IsPinnedRoomItem(returnItem.Identifier()) {
+			warnings = append(warnings, port.WARNING_PINNED_ITEM_MOVED)
+		}
+
+		err = scheduleData.ItemReturnList(returnItem, returnMode)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
 			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
@@ -112,6 +122,7 @@ func (r ScheduleItemReturnListInteractor) Execute(ctx context.Context, role vo.R
 
 	return &port.ScheduleItemEditOutput{
 		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+		Warnings:     warnings,
 	}, nil
 }
 
@@ -145,6 +156,10 @@ func (r ScheduleItemReturnListInteractor) getSchedule(ctx context.Context, tx *s
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
 	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
@@ -155,21 +170,30 @@ func (r ScheduleItemReturnListInteractor) getSchedule(ctx context.Context, tx *s
 		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
 
-func (ScheduleItemReturnListInteractor) createVO(inputScheduleID int, inputHistoryIndex int) (vo.ScheduleID, vo.HistoryIndex, error) {
+func (ScheduleItemReturnListInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputMode string) (vo.ScheduleID, vo.HistoryIndex, vo.ItemReturnMode, error) {
 
 	var scheduleID vo.ScheduleID
 	var historyIndex vo.HistoryIndex
+	var returnMode vo.ItemReturnMode
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&returnMode, vo.NewItemReturnMode, inputMode))
 
 	if errs != nil {
-		return scheduleID, historyIndex, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, historyIndex, returnMode, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, historyIndex, nil
+	return scheduleID, historyIndex, returnMode, nil
 }
@@ -18,7 +18,18 @@ import (
 
 type (
 	IScheduleItemShiftInputPort interface {
-		Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputRoomIndex int) (*port.ScheduleItemEditOutput, error)
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemShiftInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleItemShiftInput struct {
+		RoomIndex   int
+		RoomIndexes []int
+		AllRooms    bool
+		Strategy    string
+		GapMinutes  int
+		GridMinutes int
 	}
 )
 
@@ -28,6 +39,7 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
 	}
@@ -38,6 +50,7 @@ func NewScheduleItemShiftInteractor(
 	repositorySchedule repository.ScheduleRepository,
 	repositoryUser repository.UserRepository,
 	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
 ) IScheduleItemShiftInputPort {
@@ -46,14 +59,15 @@ func NewScheduleItemShiftInteractor(
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
 
-func (r ScheduleItemShiftInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputRoomIndex int) (*port.ScheduleItemEditOutput, error) {
+func (r ScheduleItemShiftInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemShiftInput) (*port.ScheduleItemEditOutput, error) {
 
-	scheduleID, historyIndex, roomIndex, err := r.createVO(inputScheduleID, inputHistoryIndex, inputRoomIndex)
+	scheduleID, historyIndex, roomIndexes, option, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData)
 	if err != nil {
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
@@ -73,7 +87,16 @@ func (r ScheduleItemShiftInteractor) Execute(ctx context.Context, role vo.RoleKe
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		err = scheduleData.RoomItemShift(roomIndex)
+		if inputData.AllRooms {
+			err = scheduleData.RoomItemShiftAll(option)
+		} else {
+			err = scheduleData.RoomItemShift(roomIndexes, option)
+		}
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
 			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
@@ -98,22 +121,40 @@ func (r ScheduleItemShiftInteractor) Execute(ctx context.Context, role vo.RoleKe
 
 }
 
-func (ScheduleItemShiftInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputRoomIndex int) (vo.ScheduleID, vo.HistoryIndex, vo.RoomIndex, error) {
+func (ScheduleItemShiftInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemShiftInput) (vo.ScheduleID, vo.HistoryIndex, []vo.RoomIndex, *schedule.ScheduleItemShiftOption, error) {
 
 	var scheduleID vo.ScheduleID
 	var historyIndex vo.HistoryIndex
-	var roomIndex vo.RoomIndex
+	var strategy vo.ItemShiftStrategy
+	var gapMinutes vo.ItemShiftGapMinutes
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
 	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
-	errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndex, inputRoomIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&strategy, vo.NewItemShiftStrategy, inputData.Strategy))
+	errs = errors.Join(errs, vo.SetVOConstructor(&gapMinutes, vo.NewItemShiftGapMinutes, inputData.GapMinutes))
+
+	gridMinutes := vo.ITEM_SHIFT_GRID_MINUTES_DEFAULT
+	if inputData.GridMinutes != 0 {
+		errs = errors.Join(errs, vo.SetVOConstructor(&gridMinutes, vo.NewItemShiftGridMinutes, inputData.GridMinutes))
+	}
+
+	// 教室の一覧が指定されていない場合は、単一の教室番号を対象とする
+	inputRoomIndexes := inputData.RoomIndexes
+	if len(inputRoomIndexes) <= 0 && !inputData.AllRooms {
+		inputRoomIndexes = []int{inputData.RoomIndex}
+	}
+
+	roomIndexes := make([]vo.RoomIndex, len(inputRoomIndexes))
+	for i, inputRoomIndex := range inputRoomIndexes {
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndexes[i], vo.NewRoomIndex, inputRoomIndex))
+	}
 
 	if errs != nil {
-		return scheduleID, historyIndex, roomIndex, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, historyIndex, nil, nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, historyIndex, roomIndex, nil
+	return scheduleID, historyIndex, roomIndexes, schedule.NewScheduleItemShiftOption(strategy, gapMinutes, gridMinutes), nil
 }
 
 func (r ScheduleItemShiftInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
@@ -137,5 +178,12 @@ func (r ScheduleItemShiftInteractor) getSchedule(ctx context.Context, tx *sql.Tx
 		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	IScheduleItemSuggestionInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, input ScheduleItemSuggestionInput) (*ScheduleItemSuggestionOutput, error)
+	}
+)
+
+type (
+	ScheduleItemSuggestionInput struct {
+		LessonID           int
+		Identifier         string
+		StepMinutes        int
+		PreferredRoomIndex int
+		Limit              int
+	}
+
+	ScheduleItemSuggestionOutput struct {
+		HistoryIndex int
+		Suggestions  []ScheduleItemSuggestionDTO
+	}
+
+	ScheduleItemSuggestionDTO struct {
+		RoomIndex       int
+		StartTime       ScheduleItemSuggestionTimeDTO
+		EndTime         ScheduleItemSuggestionTimeDTO
+		GapCount        int
+		IsPreferredRoom bool
+	}
+
+	ScheduleItemSuggestionTimeDTO struct {
+		Hour    int
+		Minutes int
+	}
+)
+
+type (
+	ScheduleItemSuggestionInteractor struct {
+		repositorySchedule              repository.ScheduleRepository
+		serviceScheduleVisibleRoom      service.IScheduleVisibleRoomService
+		repositoryLesson                repository.LessonRepository
+		repositorySchedulingPolicy      repository.SchedulingPolicyRepository
+		serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+		serviceAuthorization            service.IAuthorizationService
+	}
+)
+
+func NewScheduleItemSuggestionInteractor(
+	repositorySchedule repository.ScheduleRepository,
+	serviceScheduleVisibleRoom service.IScheduleVisibleRoomService,
+	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+	serviceAuthorization service.IAuthorizationService,
+) IScheduleItemSuggestionInputPort {
+	return &ScheduleItemSuggestionInteractor{
+		repositorySchedule:              repositorySchedule,
+		serviceScheduleVisibleRoom:      serviceScheduleVisibleRoom,
+		repositoryLesson:                repositoryLesson,
+		repositorySchedulingPolicy:      repositorySchedulingPolicy,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+		serviceAuthorization:            serviceAuthorization,
+	}
+}
+
+func (r ScheduleItemSuggestionInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, input ScheduleItemSuggestionInput) (*ScheduleItemSuggestionOutput, error) {
+
+	scheduleID, historyIndex, lessonID, identifier, step, preferredRoomIndex, limit, err := r.createVO(inputScheduleID, inputHistoryIndex, input)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData, setHistoryIndex, err := r.getSchedule(ctx, scheduleID, historyIndex)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 候補からスケジュールの配置がわかるため、スケジュールを閲覧できるユーザーに限る
+	permissions, err := r.serviceAuthorization.PermissionsAt(ctx, user, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if !r.serviceScheduleStatusPermission.AllowsViewingScheduleBy(scheduleData, user, permissions) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
+	lessons, err := r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	lesson := lessons.FindByID(lessonID)
+	if lesson == nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(errors.New("配置対象の講座は登録されていません"))
+	}
+
+	visibleRoomIndexes, err := r.serviceScheduleVisibleRoom.VisibleRoomIndexes(ctx, scheduleData)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	suggestions, err := scheduleData.SuggestSlots(lessonID, lesson.Duration(), identifier, visibleRoomIndexes, step, preferredRoomIndex, limit)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	return &ScheduleItemSuggestionOutput{
+		HistoryIndex: setHistoryIndex.Value(),
+		Suggestions: lo.Map(suggestions, func(suggestion *schedule.ScheduleSlotSuggestionModel, _ int) ScheduleItemSuggestionDTO {
+
+			startTimeHour, startTimeMinutes := suggestion.StartTime().Value()
+			endTimeHour, endTimeMinutes := suggestion.EndTime().Value()
+
+			return ScheduleItemSuggestionDTO{
+				RoomIndex:       suggestion.RoomIndex().Value(),
+				StartTime:       ScheduleItemSuggestionTimeDTO{Hour: startTimeHour, Minutes: startTimeMinutes},
+				EndTime:         ScheduleItemSuggestionTimeDTO{Hour: endTimeHour, Minutes: endTimeMinutes},
+				GapCount:        suggestion.GapCount(),
+				IsPreferredRoom: suggestion.IsPreferredRoom(),
+			}
+		}),
+	}, nil
+}
+
+func (ScheduleItemSuggestionInteractor) createVO(inputScheduleID int, inputHistoryIndex int, input ScheduleItemSuggestionInput) (vo.ScheduleID, vo.HistoryIndex, vo.LessonID, vo.Identifier, vo.SuggestionStepMinutes, vo.RoomIndex, vo.SuggestionLimit, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var lessonID vo.LessonID
+	var identifier vo.Identifier
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&lessonID, vo.NewLessonID, input.LessonID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&identifier, vo.NewIdentifier, input.Identifier))
+
+	// 刻み時間・希望教室・件数は任意指定
+	step := vo.SUGGESTION_STEP_MINUTES_DEFAULT
+	if input.StepMinutes != 0 {
+		errs = errors.Join(errs, vo.SetVOConstructor(&step, vo.NewSuggestionStepMinutes, input.StepMinutes))
+	}
+
+	preferredRoomIndex := vo.ROOM_INDEX_INVALID
+	if input.PreferredRoomIndex != 0 {
+		errs = errors.Join(errs, vo.SetVOConstructor(&preferredRoomIndex, vo.NewRoomIndex, input.PreferredRoomIndex))
+	}
+
+	limit := vo.SUGGESTION_LIMIT_DEFAULT
+	if input.Limit != 0 {
+		errs = errors.Join(errs, vo.SetVOConstructor(&limit, vo.NewSuggestionLimit, input.Limit))
+	}
+
+	if errs != nil {
+		return scheduleID, historyIndex, lessonID, identifier, step, preferredRoomIndex, limit, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, lessonID, identifier, step, preferredRoomIndex, limit, nil
+}
+
+func (r ScheduleItemSuggestionInteractor) getSchedule(ctx context.Context, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex) (*schedule.RootScheduleModel, vo.HistoryIndex, error) {
+
+	var scheduleData *schedule.RootScheduleModel
+	var err error
+	if historyIndex.IsUseLatest() {
+		scheduleData, err = r.repositorySchedule.FindByID(ctx, scheduleID)
+	} else {
+		scheduleData, err = r.repositorySchedule.FindByIDWithHistoryIndex(ctx, scheduleID, historyIndex)
+	}
+
+	if err != nil {
+		return nil, historyIndex, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, historyIndex, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	if historyIndex.IsUseLatest() {
+		return scheduleData, scheduleData.HistoryIndex(), nil
+	}
+
+	return scheduleData, historyIndex, nil
+}
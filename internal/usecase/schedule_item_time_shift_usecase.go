@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/lesson"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/mapper"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/port"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleItemTimeShiftInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemTimeShiftInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleItemTimeShiftInput struct {
+		Identifiers          []string
+		RoomIndexes          []int
+		RangeStartTimeHour   int
+		RangeStartTimeMinute int
+		RangeEndTimeHour     int
+		RangeEndTimeMinutes  int
+		ShiftMinutes         int
+	}
+)
+
+type (
+	ScheduleItemTimeShiftInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
+		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleItemTimeShiftInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	repositoryLesson repository.LessonRepository,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
+	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleItemTimeShiftInputPort {
+	return &ScheduleItemTimeShiftInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
+		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleItemTimeShiftInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemTimeShiftInput) (*port.ScheduleItemEditOutput, error) {
+
+	scheduleID, historyIndex, shiftMinutes, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var scheduleData *schedule.RootScheduleModel
+	var lessons lesson.RootLessonModelSlice
+	var warnings []string
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		var err error
+		scheduleData, err = r.getSchedule(ctx, tx, scheduleID, historyIndex, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		identifiers, err := r.selectIdentifiers(scheduleData, inputData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if lo.SomeBy(identifiers, scheduleData.IsPinnedRoomItem) {
+			warnings = append(warnings, port.WARNING_PINNED_ITEM_MOVED)
+		}
+
+		err = scheduleData.RoomItemTimeShift(identifiers, shiftMinutes)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		scheduleData.ModifyEditing(historyIndex, user)
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &port.ScheduleItemEditOutput{
+		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+		Warnings:     warnings,
+	}, nil
+
+}
+
+func (ScheduleItemTimeShiftInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleItemTimeShiftInput) (vo.ScheduleID, vo.HistoryIndex, vo.ItemTimeShiftMinutes, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var shiftMinutes vo.ItemTimeShiftMinutes
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+	errs = errors.Join(errs, vo.SetVOConstructor(&shiftMinutes, vo.NewItemTimeShiftMinutes, inputData.ShiftMinutes))
+
+	if errs != nil {
+		return scheduleID, historyIndex, shiftMinutes, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, shiftMinutes, nil
+}
+
+// 識別子が指定されていない場合は、教室と時刻の範囲からアイテムを選択する
+func (ScheduleItemTimeShiftInteractor) selectIdentifiers(scheduleData *schedule.RootScheduleModel, inputData ScheduleItemTimeShiftInput) ([]vo.Identifier, error) {
+
+	var errs error
+	if len(inputData.Identifiers) > 0 {
+
+		identifiers := make([]vo.Identifier, len(inputData.Identifiers))
+		for i, inputIdentifier := range inputData.Identifiers {
+			errs = errors.Join(errs, vo.SetVOConstructor(&identifiers[i], vo.NewIdentifier, inputIdentifier))
+		}
+
+		if errs != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		}
+
+		return identifiers, nil
+	}
+
+	if len(inputData.RoomIndexes) <= 0 {
+		return nil, log.WrapErrorWithStackTraceBadRequest(errors.New("アイテムの識別子または教室を指定してください"))
+	}
+
+	roomIndexes := make([]vo.RoomIndex, len(inputData.RoomIndexes))
+	for i, inputRoomIndex := range inputData.RoomIndexes {
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndexes[i], vo.NewRoomIndex, inputRoomIndex))
+	}
+
+	rangeStartTime, err := vo.NewScheduleLessonTime(inputData.RangeStartTimeHour, inputData.RangeStartTimeMinute)
+	errs = errors.Join(errs, err)
+
+	rangeEndTime, err := vo.NewScheduleLessonTime(inputData.RangeEndTimeHour, inputData.RangeEndTimeMinutes)
+	errs = errors.Join(errs, err)
+
+	if errs != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	if rangeStartTime.ValueMinutes() >= rangeEndTime.ValueMinutes() {
+		return nil, log.WrapErrorWithStackTraceBadRequest(errors.New("範囲の開始時刻は終了時刻より前である必要があります"))
+	}
+
+	return scheduleData.RoomItemIdentifiersInRange(roomIndexes, rangeStartTime, rangeEndTime), nil
+}
+
+func (r ScheduleItemTimeShiftInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
+
+	scheduleData, err := r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, scheduleID, historyIndex)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	isEnable := r.serviceScheduleEditPermission.AllowsEditingBy(scheduleData, editUser)
+	if !isEnable {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
+	return scheduleData, nil
+}
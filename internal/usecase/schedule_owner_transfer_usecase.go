@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleOwnerTransferInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputNewOwnerUserID int) (*ScheduleCollaboratorListOutput, error)
+	}
+)
+
+type (
+	ScheduleOwnerTransferInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleOwnerTransferInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleOwnerTransferInputPort {
+	return &ScheduleOwnerTransferInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleOwnerTransferInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputNewOwnerUserID int) (*ScheduleCollaboratorListOutput, error) {
+
+	scheduleID, err := vo.NewScheduleID(inputScheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	newOwnerUserID, err := vo.NewUserID(inputNewOwnerUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	manageUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if manageUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	newOwner, err := r.repositoryUser.FindByUserID(ctx, newOwnerUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if newOwner == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのユーザーは存在しません:%d", newOwnerUserID.Value()))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		scheduleData, err := r.repositorySchedule.FindByIDWithLock(ctx, tx, scheduleID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if scheduleData == nil {
+			return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+		}
+
+		if !r.serviceScheduleEditPermission.AllowsManagingAccessBy(scheduleData, manageUser) {
+			return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+		}
+
+		// スケジュールのキャンパスで編集権限のないユーザーには移譲できない
+		if !newOwner.AllowsAt(scheduleData.Campus(), vo.PERMISSION_SCHEDULE_EDIT) {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("編集権限のないユーザーには所有権を移譲できません"))
+		}
+
+		err = scheduleData.TransferOwnership(newOwnerUserID)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		return r.repositorySchedule.SaveAccessControl(ctx, tx, scheduleData)
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findScheduleCollaboratorListOutput(ctx, r.repositorySchedule, r.repositoryUser, scheduleID)
+}
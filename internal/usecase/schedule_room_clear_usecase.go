@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/lesson"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/mapper"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/port"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	IScheduleRoomClearInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleRoomClearInput) (*port.ScheduleItemEditOutput, error)
+	}
+)
+
+type (
+	ScheduleRoomClearInput struct {
+		RoomIndex int
+		AllRooms  bool
+	}
+)
+
+type (
+	ScheduleRoomClearInteractor struct {
+		txManager                     util.TxManager
+		repositorySchedule            repository.ScheduleRepository
+		repositoryUser                repository.UserRepository
+		repositoryLesson              repository.LessonRepository
+		serviceScheduleVisibleRoom    service.IScheduleVisibleRoomService
+		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
+		serviceScheduleEditPermission service.IScheduleEditPermissionService
+	}
+)
+
+func NewScheduleRoomClearInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	repositoryLesson repository.LessonRepository,
+	serviceScheduleVisibleRoom service.IScheduleVisibleRoomService,
+	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
+	serviceScheduleEditPermission service.IScheduleEditPermissionService,
+) IScheduleRoomClearInputPort {
+	return &ScheduleRoomClearInteractor{
+		txManager:                     txManager,
+		repositorySchedule:            repositorySchedule,
+		repositoryUser:                repositoryUser,
+		repositoryLesson:              repositoryLesson,
+		serviceScheduleVisibleRoom:    serviceScheduleVisibleRoom,
+		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
+		serviceScheduleEditPermission: serviceScheduleEditPermission,
+	}
+}
+
+func (r ScheduleRoomClearInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int, inputData ScheduleRoomClearInput) (*port.ScheduleItemEditOutput, error) {
+
+	scheduleID, historyIndex, roomIndex, err := r.createVO(inputScheduleID, inputHistoryIndex, inputData)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var scheduleData *schedule.RootScheduleModel
+	var lessons lesson.RootLessonModelSlice
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		var err error
+		scheduleData, err = r.getSchedule(ctx, tx, scheduleID, historyIndex, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		lessons, err = r.repositoryLesson.FindByCampus(ctx, scheduleData.Campus())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		visibleRoomIndexes, err := r.serviceScheduleVisibleRoom.VisibleRoomIndexes(ctx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if inputData.AllRooms {
+			err = scheduleData.RoomReturnListAll()
+		} else {
+			err = scheduleData.RoomReturnList(roomIndex, visibleRoomIndexes)
+		}
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		scheduleData.ModifyEditing(historyIndex, user)
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &port.ScheduleItemEditOutput{
+		ScheduleItem: r.mapperScheduleItemEditOutput.ToScheduleItemEditOutput(scheduleData, lessons),
+	}, nil
+}
+
+func (ScheduleRoomClearInteractor) createVO(inputScheduleID int, inputHistoryIndex int, inputData ScheduleRoomClearInput) (vo.ScheduleID, vo.HistoryIndex, vo.RoomIndex, error) {
+
+	var scheduleID vo.ScheduleID
+	var historyIndex vo.HistoryIndex
+	var roomIndex vo.RoomIndex
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&historyIndex, vo.NewHistoryIndex, inputHistoryIndex))
+
+	// 全教室を対象とする場合は教室番号を使用しない
+	roomIndex = vo.ROOM_INDEX_INVALID
+	if !inputData.AllRooms {
+		errs = errors.Join(errs, vo.SetVOConstructor(&roomIndex, vo.NewRoomIndex, inputData.RoomIndex))
+	}
+
+	if errs != nil {
+		return scheduleID, historyIndex, roomIndex, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, historyIndex, roomIndex, nil
+}
+
+func (r ScheduleRoomClearInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, historyIndex vo.HistoryIndex, user vo.UserID) (*schedule.RootScheduleModel, error) {
+
+	scheduleData, err := r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, scheduleID, historyIndex)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	editUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	isEnable := r.serviceScheduleEditPermission.AllowsEditingBy(scheduleData, editUser)
+	if !isEnable {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	return scheduleData, nil
+}
@@ -14,7 +14,7 @@ import (
 )
 
 type IScheduleSaveTitleInputPort interface {
-	Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputTitle string) error
+	Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTitle string) error
 }
 
 type (
@@ -39,7 +39,7 @@ func NewScheduleSaveTitleInteractor(
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
-func (r ScheduleSaveTitleInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputTitle string) error {
+func (r ScheduleSaveTitleInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputTitle string) error {
 
 	scheduleID, title, err := r.createVO(inputScheduleID, inputTitle)
 	if err != nil {
@@ -14,7 +14,7 @@ import (
 )
 
 type IScheduleSaveInputPort interface {
-	Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int) (*ScheduleSaveOutput, error)
+	Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int) (*ScheduleSaveOutput, error)
 }
 
 type ScheduleSaveOutput struct {
@@ -43,7 +43,7 @@ func NewScheduleSaveInteractor(
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
-func (r ScheduleSaveInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputHistoryIndex int) (*ScheduleSaveOutput, error) {
+func (r ScheduleSaveInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputHistoryIndex int) (*ScheduleSaveOutput, error) {
 
 	scheduleID, historyIndex, err := r.createVO(inputScheduleID, inputHistoryIndex)
 	if err != nil {
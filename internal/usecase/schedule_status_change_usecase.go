@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/schedule"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IScheduleStatusChangeInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputAction string, inputReason string) (*ScheduleStatusChangeOutput, error)
+}
+
+type (
+	ScheduleStatusChangeOutput struct {
+		ScheduleID    int
+		Status        string
+		ReviewComment string
+	}
+)
+
+type (
+	ScheduleStatusChangeInteractor struct {
+		txManager                       util.TxManager
+		repositorySchedule              repository.ScheduleRepository
+		repositoryUser                  repository.UserRepository
+		serviceScheduleStatusPermission service.IScheduleStatusPermissionService
+	}
+)
+
+func NewScheduleStatusChangeInteractor(
+	txManager util.TxManager,
+	repositorySchedule repository.ScheduleRepository,
+	repositoryUser repository.UserRepository,
+	serviceScheduleStatusPermission service.IScheduleStatusPermissionService,
+) IScheduleStatusChangeInputPort {
+	return &ScheduleStatusChangeInteractor{
+		txManager:                       txManager,
+		repositorySchedule:              repositorySchedule,
+		repositoryUser:                  repositoryUser,
+		serviceScheduleStatusPermission: serviceScheduleStatusPermission,
+	}
+}
+
+func (r ScheduleStatusChangeInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputAction string, inputReason string) (*ScheduleStatusChangeOutput, error) {
+
+	scheduleID, action, reason, err := r.createVO(inputScheduleID, inputAction, inputReason)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var scheduleData *schedule.RootScheduleModel
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		scheduleData, err = r.getSchedule(ctx, tx, scheduleID, action, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		err = scheduleData.ChangeStatus(action, reason)
+		if err != nil {
+			return log.WrapErrorWithStackTraceBadRequest(err)
+		}
+
+		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &ScheduleStatusChangeOutput{
+		ScheduleID:    scheduleData.ID().Value(),
+		Status:        scheduleData.Status().Value(),
+		ReviewComment: scheduleData.ReviewComment().Value(),
+	}, nil
+}
+
+func (r ScheduleStatusChangeInteractor) getSchedule(ctx context.Context, tx *sql.Tx, scheduleID vo.ScheduleID, action vo.ScheduleStatusAction, user vo.UserID) (*schedule.RootScheduleModel, error) {
+
+	scheduleData, err := r.repositorySchedule.FindByIDWithLock(ctx, tx, scheduleID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if scheduleData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したIDのスケジュールは存在しません:%d", scheduleID.Value()))
+	}
+
+	changeUser, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if changeUser == nil {
+		return nil, log.WrapErrorWithStackTraceInternalServerError(log.Errorf("ユーザーが見つかりません"))
+	}
+
+	isEnable := r.serviceScheduleStatusPermission.AllowsChangingBy(scheduleData, action, changeUser)
+	if !isEnable {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	scheduleData, err = r.repositorySchedule.FindByIDWithLockHistoryIndex(ctx, tx, scheduleData.ID(), scheduleData.HistoryIndex())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return scheduleData, nil
+}
+
+func (ScheduleStatusChangeInteractor) createVO(inputScheduleID int, inputAction string, inputReason string) (vo.ScheduleID, vo.ScheduleStatusAction, vo.ScheduleReviewComment, error) {
+
+	var scheduleID vo.ScheduleID
+	var action vo.ScheduleStatusAction
+	var reason vo.ScheduleReviewComment
+
+	var errs error
+	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
+	errs = errors.Join(errs, vo.SetVOConstructor(&action, vo.NewScheduleStatusAction, inputAction))
+	errs = errors.Join(errs, vo.SetVOConstructor(&reason, vo.NewScheduleReviewComment, inputReason))
+
+	if errs != nil {
+		return scheduleID, action, reason, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+	}
+
+	return scheduleID, action, reason, nil
+}
@@ -15,7 +15,7 @@ import (
 )
 
 type IScheduleTimeEditInputPort interface {
-	Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputScheduleStartTime int, inputScheduleEndTime int) error
+	Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputScheduleTime ScheduleTimeInput, inputMode string) error
 }
 
 type (
@@ -24,6 +24,7 @@ type (
 		repositorySchedule            repository.ScheduleRepository
 		repositoryUser                repository.UserRepository
 		repositoryLesson              repository.LessonRepository
+		repositorySchedulingPolicy    repository.SchedulingPolicyRepository
 		mapperScheduleItemEditOutput  mapper.ScheduleItemEditOutputMapper
 		serviceScheduleEditPermission service.IScheduleEditPermissionService
 	}
@@ -36,20 +37,22 @@ func NewScheduleTimeEditEditInteractor(
 	serviceScheduleEditPermission service.IScheduleEditPermissionService,
 	repositoryLesson repository.LessonRepository,
 	mapperScheduleItemEditOutput mapper.ScheduleItemEditOutputMapper,
+	repositorySchedulingPolicy repository.SchedulingPolicyRepository,
 ) IScheduleTimeEditInputPort {
 	return &ScheduleTimeEditInteractor{
 		txManager:                     txManager,
 		repositorySchedule:            repositorySchedule,
 		repositoryUser:                repositoryUser,
 		repositoryLesson:              repositoryLesson,
+		repositorySchedulingPolicy:    repositorySchedulingPolicy,
 		mapperScheduleItemEditOutput:  mapperScheduleItemEditOutput,
 		serviceScheduleEditPermission: serviceScheduleEditPermission,
 	}
 }
 
-func (r ScheduleTimeEditInteractor) Execute(ctx context.Context, role vo.RoleKey, user vo.UserID, inputScheduleID int, inputScheduleStartTime int, inputScheduleEndTime int) error {
+func (r ScheduleTimeEditInteractor) Execute(ctx context.Context, user vo.UserID, inputScheduleID int, inputScheduleTime ScheduleTimeInput, inputMode string) error {
 
-	scheduleID, scheduleTime, err := r.createVO(inputScheduleID, inputScheduleStartTime, inputScheduleEndTime)
+	scheduleID, targetDate, scheduleTime, changeMode, err := r.createVO(inputScheduleID, inputScheduleTime, inputMode)
 	if err != nil {
 		return log.WrapErrorWithStackTrace(err)
 	}
@@ -63,9 +66,32 @@ func (r ScheduleTimeEditInteractor) Execute(ctx context.Context, role vo.RoleKey
 			return log.WrapErrorWithStackTrace(err)
 		}
 
-		err = scheduleData.ChangeScheduleTime(scheduleTime)
+		// 実施日は指定があった場合のみ変更する
+		if !targetDate.IsNone() {
+			scheduleData.ChangeTargetDate(targetDate)
+		}
+
+		if changeMode.IsStrict() {
+
+			err = scheduleData.ChangeScheduleTime(scheduleTime)
+			if err != nil {
+				return log.WrapErrorWithStackTrace(err)
+			}
+
+		} else {
+
+			// 講座を新しい時間に合わせた結果は、通常の編集と同じく新しい履歴として保存する
+			err = scheduleData.AdjustScheduleTime(scheduleTime, changeMode)
+			if err != nil {
+				return log.WrapErrorWithStackTraceBadRequest(err)
+			}
+
+			scheduleData.ModifyEditing(scheduleData.HistoryIndex(), user)
+		}
+
+		err = scheduleData.ValidateSchedulingPolicy()
 		if err != nil {
-			return log.WrapErrorWithStackTrace(err)
+			return log.WrapErrorWithStackTraceBadRequest(err)
 		}
 
 		_, err = r.repositorySchedule.Save(ctx, tx, scheduleData)
@@ -110,22 +136,38 @@ func (r ScheduleTimeEditInteractor) getSchedule(ctx context.Context, tx *sql.Tx,
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	schedulingPolicy, err := r.repositorySchedulingPolicy.FindByCampus(ctx, scheduleData.Campus())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	scheduleData.ApplySchedulingPolicy(schedulingPolicy)
+
 	return scheduleData, nil
 }
 
-func (ScheduleTimeEditInteractor) createVO(inputScheduleID int, inputScheduleStartTime int, inputScheduleEndTime int) (vo.ScheduleID, vo.ScheduleTime, error) {
+func (ScheduleTimeEditInteractor) createVO(inputScheduleID int, inputScheduleTime ScheduleTimeInput, inputMode string) (vo.ScheduleID, vo.ScheduleTargetDate, vo.ScheduleTime, vo.ScheduleTimeChangeMode, error) {
 
 	var scheduleID vo.ScheduleID
+	var targetDate vo.ScheduleTargetDate
+	var changeMode vo.ScheduleTimeChangeMode
 
 	var errs error
 	errs = errors.Join(errs, vo.SetVOConstructor(&scheduleID, vo.NewScheduleID, inputScheduleID))
-
-	scheduleTime, err := vo.NewScheduleTime(inputScheduleStartTime, inputScheduleEndTime)
+	errs = errors.Join(errs, vo.SetVOConstructor(&targetDate, vo.NewScheduleTargetDate, inputScheduleTime.TargetDate))
+	errs = errors.Join(errs, vo.SetVOConstructor(&changeMode, vo.NewScheduleTimeChangeMode, inputMode))
+
+	scheduleTime, err := vo.NewScheduleTime(
+		inputScheduleTime.StartTimeHour,
+		inputScheduleTime.StartTimeMinutes,
+		inputScheduleTime.EndTimeHour,
+		inputScheduleTime.EndTimeMinutes,
+	)
 	errs = errors.Join(errs, err)
 
 	if errs != nil {
-		return scheduleID, scheduleTime, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		return scheduleID, targetDate, scheduleTime, changeMode, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
 	}
 
-	return scheduleID, scheduleTime, nil
+	return scheduleID, targetDate, scheduleTime, changeMode, nil
 }
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ITwoFactorConfirmInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, code string) (*TwoFactorConfirmOutput, error)
+	}
+)
+
+type (
+	TwoFactorConfirmOutput struct {
+		// 平文のリカバリーコード。登録完了時にのみ返す
+		RecoveryCodes []string
+		Status        *TwoFactorStatusOutput
+	}
+)
+
+type (
+	TwoFactorConfirmInteractor struct {
+		txManager           util.TxManager
+		repositoryTwoFactor repository.TwoFactorRepository
+	}
+)
+
+func NewTwoFactorConfirmInteractor(
+	txManager util.TxManager,
+	repositoryTwoFactor repository.TwoFactorRepository,
+) ITwoFactorConfirmInputPort {
+	return &TwoFactorConfirmInteractor{
+		txManager:           txManager,
+		repositoryTwoFactor: repositoryTwoFactor,
+	}
+}
+
+func (r TwoFactorConfirmInteractor) Execute(ctx context.Context, user vo.UserID, code string) (*TwoFactorConfirmOutput, error) {
+
+	var output *TwoFactorConfirmOutput
+	err := r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		twoFactor, err := r.repositoryTwoFactor.FindByUserIDWithLock(ctx, tx, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if twoFactor == nil {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("2段階認証の登録が開始されていません"))
+		}
+
+		recoveryCodes, err := twoFactor.ConfirmEnrollment(code, time.Now())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if err := r.repositoryTwoFactor.Save(ctx, tx, twoFactor); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		output = &TwoFactorConfirmOutput{
+			RecoveryCodes: recoveryCodes,
+			Status:        toTwoFactorStatusOutput(twoFactor),
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ITwoFactorDisableInputPort interface {
+		Execute(ctx context.Context, user vo.UserID, code string) (*TwoFactorStatusOutput, error)
+	}
+)
+
+type (
+	TwoFactorDisableInteractor struct {
+		txManager           util.TxManager
+		repositoryTwoFactor repository.TwoFactorRepository
+	}
+)
+
+func NewTwoFactorDisableInteractor(
+	txManager util.TxManager,
+	repositoryTwoFactor repository.TwoFactorRepository,
+) ITwoFactorDisableInputPort {
+	return &TwoFactorDisableInteractor{
+		txManager:           txManager,
+		repositoryTwoFactor: repositoryTwoFactor,
+	}
+}
+
+func (r TwoFactorDisableInteractor) Execute(ctx context.Context, user vo.UserID, code string) (*TwoFactorStatusOutput, error) {
+
+	var output *TwoFactorStatusOutput
+	err := r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		twoFactor, err := r.repositoryTwoFactor.FindByUserIDWithLock(ctx, tx, user)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if twoFactor == nil {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("2段階認証は有効になっていません"))
+		}
+
+		// 無効化には本人であることを確認するため認証コードを求める
+		if err := twoFactor.Disable(code, time.Now()); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if err := r.repositoryTwoFactor.Save(ctx, tx, twoFactor); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		output = toTwoFactorStatusOutput(twoFactor)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/twofactor"
+	userModel "github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/totp"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type (
+	ITwoFactorEnrollInputPort interface {
+		Execute(ctx context.Context, user vo.UserID) (*TwoFactorEnrollOutput, error)
+	}
+)
+
+type (
+	TwoFactorEnrollOutput struct {
+		// 認証アプリに手入力する場合のシークレット(Base32)
+		Secret string
+		// 認証アプリに読み込ませる otpauth:// 形式のURI
+		ProvisioningURI string
+	}
+)
+
+type (
+	TwoFactorEnrollInteractor struct {
+		txManager           util.TxManager
+		repositoryUser      repository.UserRepository
+		repositoryTwoFactor repository.TwoFactorRepository
+	}
+)
+
+func NewTwoFactorEnrollInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
+) ITwoFactorEnrollInputPort {
+	return &TwoFactorEnrollInteractor{
+		txManager:           txManager,
+		repositoryUser:      repositoryUser,
+		repositoryTwoFactor: repositoryTwoFactor,
+	}
+}
+
+func (r TwoFactorEnrollInteractor) Execute(ctx context.Context, user vo.UserID) (*TwoFactorEnrollOutput, error) {
+
+	userData, err := r.repositoryUser.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if userData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", user.Value()))
+	}
+
+	return startTwoFactorEnrollment(ctx, r.txManager, r.repositoryTwoFactor, userData)
+}
+
+// 2段階認証の登録を開始し、認証アプリに登録する情報を返す
+func startTwoFactorEnrollment(
+	ctx context.Context,
+	txManager util.TxManager,
+	repositoryTwoFactor repository.TwoFactorRepository,
+	user *userModel.RootUserModel,
+) (*TwoFactorEnrollOutput, error) {
+
+	var secret string
+	err := txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		twoFactor, err := repositoryTwoFactor.FindByUserIDWithLock(ctx, tx, user.ID())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if twoFactor == nil {
+			twoFactor = twofactor.NewCreateRootTwoFactorModel(user.ID())
+		}
+
+		secret, err = twoFactor.StartEnrollment()
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if err := repositoryTwoFactor.Save(ctx, tx, twoFactor); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &TwoFactorEnrollOutput{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(twofactor.TOTP_ISSUER, user.UserName().Value(), secret),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/twofactor"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type (
+	ITwoFactorGetInputPort interface {
+		Execute(ctx context.Context, user vo.UserID) (*TwoFactorStatusOutput, error)
+	}
+)
+
+type (
+	TwoFactorStatusOutput struct {
+		UserID   int
+		Enabled  bool
+		Required bool
+		// 未使用のリカバリーコードの数
+		RemainingRecoveryCodes int
+	}
+)
+
+type (
+	TwoFactorGetInteractor struct {
+		repositoryTwoFactor repository.TwoFactorRepository
+	}
+)
+
+func NewTwoFactorGetInteractor(
+	repositoryTwoFactor repository.TwoFactorRepository,
+) ITwoFactorGetInputPort {
+	return &TwoFactorGetInteractor{
+		repositoryTwoFactor: repositoryTwoFactor,
+	}
+}
+
+func (r TwoFactorGetInteractor) Execute(ctx context.Context, user vo.UserID) (*TwoFactorStatusOutput, error) {
+
+	twoFactor, err := r.repositoryTwoFactor.FindByUserID(ctx, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if twoFactor == nil {
+		twoFactor = twofactor.NewCreateRootTwoFactorModel(user)
+	}
+
+	return toTwoFactorStatusOutput(twoFactor), nil
+}
+
+func toTwoFactorStatusOutput(twoFactor *twofactor.RootTwoFactorModel) *TwoFactorStatusOutput {
+
+	return &TwoFactorStatusOutput{
+		UserID:                 twoFactor.UserID().Value(),
+		Enabled:                twoFactor.IsEnabled(),
+		Required:               twoFactor.IsRequired(),
+		RemainingRecoveryCodes: twoFactor.RemainingRecoveryCodeCount(),
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
@@ -14,7 +15,7 @@ import (
 )
 
 type IUserAddPort interface {
-	Execute(ctx context.Context, inputAddUser UserAddInput, roleKey vo.RoleKey, addFromUserID vo.UserID) error
+	Execute(ctx context.Context, inputAddUser UserAddInput, addFromUserID vo.UserID) error
 }
 
 type UserAddInput struct {
@@ -26,26 +27,32 @@ type UserAddInput struct {
 
 type (
 	UserAddInteractor struct {
-		txManager      util.TxManager
-		repositoryUser repository.UserRepository
+		txManager            util.TxManager
+		repositoryUser       repository.UserRepository
+		repositoryRole       repository.RoleRepository
+		serviceAuthorization service.IAuthorizationService
 	}
 )
 
 func NewUserAddInteractor(
 	txManager util.TxManager,
 	repositoryUser repository.UserRepository,
+	repositoryRole repository.RoleRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) IUserAddPort {
 	return &UserAddInteractor{
-		txManager:      txManager,
-		repositoryUser: repositoryUser,
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		repositoryRole:       repositoryRole,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
 
-func (r UserAddInteractor) Execute(ctx context.Context, inputAddUser UserAddInput, roleKey vo.RoleKey, addFromUserID vo.UserID) error {
+func (r UserAddInteractor) Execute(ctx context.Context, inputAddUser UserAddInput, addFromUserID vo.UserID) error {
 
 	// ロールを確認
-	if !roleKey.IsOwner() {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	if err := r.serviceAuthorization.Authorize(ctx, addFromUserID, vo.PERMISSION_USER_MANAGE); err != nil {
+		return log.WrapErrorWithStackTrace(err)
 	}
 
 	// ユーザーモデルを作成する
@@ -54,6 +61,10 @@ func (r UserAddInteractor) Execute(ctx context.Context, inputAddUser UserAddInpu
 		return log.WrapErrorWithStackTrace(err)
 	}
 
+	if err := existsRole(ctx, r.repositoryRole, addUser.RoleKey()); err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	// 既に登録済みでないか確認
 	{
 		user, err := r.repositoryUser.FindByUserName(ctx, addUser.UserName().Value())
@@ -119,3 +130,18 @@ func (a UserAddInteractor) createUserModel(inputAddUser UserAddInput) (*user.Roo
 		displayName,
 	), nil
 }
+
+// 割り当てるロールが登録済みか確認する
+func existsRole(ctx context.Context, repositoryRole repository.RoleRepository, roleKey vo.RoleKey) error {
+
+	roleData, err := repositoryRole.FindByRoleKey(ctx, roleKey)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	if roleData == nil {
+		return log.WrapErrorWithStackTraceBadRequest(log.Errorf("存在しないロールです:%s", roleKey.Value()))
+	}
+
+	return nil
+}
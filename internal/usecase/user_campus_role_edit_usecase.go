@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	session "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserCampusRoleEditInputPort interface {
+	Execute(ctx context.Context, editUser vo.UserID, userID int, input []UserCampusRoleInput) (*UserCampusRoleListOutput, error)
+}
+
+type UserCampusRoleInput struct {
+	Campus  string
+	RoleKey string
+}
+
+type (
+	UserCampusRoleEditInteractor struct {
+		txManager            util.TxManager
+		repositoryCampus     repository.CampusRepository
+		repositoryRole       repository.RoleRepository
+		repositoryUser       repository.UserRepository
+		repositorySession    session.SessionRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewUserCampusRoleEditInteractor(
+	txManager util.TxManager,
+	repositoryCampus repository.CampusRepository,
+	repositoryRole repository.RoleRepository,
+	repositoryUser repository.UserRepository,
+	repositorySession session.SessionRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserCampusRoleEditInputPort {
+	return &UserCampusRoleEditInteractor{
+		txManager:            txManager,
+		repositoryCampus:     repositoryCampus,
+		repositoryRole:       repositoryRole,
+		repositoryUser:       repositoryUser,
+		repositorySession:    repositorySession,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r UserCampusRoleEditInteractor) Execute(ctx context.Context, editUser vo.UserID, userID int, input []UserCampusRoleInput) (*UserCampusRoleListOutput, error) {
+
+	targetUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	err = r.serviceAuthorization.Authorize(ctx, editUser, vo.PERMISSION_USER_MANAGE)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	roles, err := r.repositoryRole.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	campusRoles := make(user.UserCampusRoleModelSlice, 0, len(input))
+	for _, item := range input {
+
+		var errs error
+		var campus vo.Campus
+		var campusRoleKey vo.RoleKey
+
+		errs = errors.Join(errs, vo.SetVOConstructor(&campus, vo.NewCampus, item.Campus))
+		errs = errors.Join(errs, vo.SetVOConstructor(&campusRoleKey, vo.NewRoleKey, item.RoleKey))
+
+		if errs != nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("%v", errs.Error()))
+		}
+
+		if !campuses.IsExist(campus) {
+			return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("存在しないキャンパスです:%s", campus.Value()))
+		}
+
+		roleData := roles.FindByKey(campusRoleKey)
+		if roleData == nil {
+			return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("存在しないロールです:%s", campusRoleKey.Value()))
+		}
+
+		campusRoles = append(campusRoles, user.NewUserCampusRoleModel(campus, campusRoleKey, roleData.Permissions()))
+	}
+
+	targetUser, err := r.repositoryUser.FindByUserID(ctx, targetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if targetUser == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", userID))
+	}
+
+	err = targetUser.ChangeCampusRoles(campusRoles)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		if err := r.repositoryUser.SaveCampusRoles(ctx, tx, targetUser); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		// セッションに保持しているロールを更新させるため、ログアウトさせる
+		if err := r.repositorySession.Delete(ctx, tx, targetUserID); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return toUserCampusRoleListOutput(targetUser, campuses), nil
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/campus"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+type IUserCampusRoleListInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, userID int) (*UserCampusRoleListOutput, error)
+}
+
+type (
+	UserCampusRoleListOutput struct {
+		UserID int
+		// キャンパスの割り当てがない場合に用いるロール
+		RoleKey     string
+		CampusRoles []UserCampusRoleDTO
+	}
+
+	UserCampusRoleDTO struct {
+		Campus     string
+		CampusName string
+		RoleKey    string
+		Assigned   bool
+	}
+)
+
+type (
+	UserCampusRoleListInteractor struct {
+		repositoryCampus     repository.CampusRepository
+		repositoryUser       repository.UserRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewUserCampusRoleListInteractor(
+	repositoryCampus repository.CampusRepository,
+	repositoryUser repository.UserRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserCampusRoleListInputPort {
+	return &UserCampusRoleListInteractor{
+		repositoryCampus:     repositoryCampus,
+		repositoryUser:       repositoryUser,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r UserCampusRoleListInteractor) Execute(ctx context.Context, user vo.UserID, userID int) (*UserCampusRoleListOutput, error) {
+
+	targetUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	// 自分以外の割り当てはユーザー管理の権限がある場合のみ参照できる
+	if targetUserID != user {
+		if err := r.serviceAuthorization.Authorize(ctx, user, vo.PERMISSION_USER_MANAGE); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+	}
+
+	targetUser, err := r.repositoryUser.FindByUserID(ctx, targetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if targetUser == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", userID))
+	}
+
+	campuses, err := r.repositoryCampus.FindAll(ctx)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return toUserCampusRoleListOutput(targetUser, campuses), nil
+}
+
+func toUserCampusRoleListOutput(targetUser *user.RootUserModel, campuses campus.CampusModelSlice) *UserCampusRoleListOutput {
+
+	campuses.SortByOrder()
+
+	campusRoles := make([]UserCampusRoleDTO, 0, len(campuses))
+	for _, campusData := range campuses {
+
+		_, assigned := targetUser.CampusRoleAt(campusData.Campus())
+
+		campusRoles = append(campusRoles, UserCampusRoleDTO{
+			Campus:     campusData.Campus().Value(),
+			CampusName: campusData.CampusName().Value(),
+			RoleKey:    targetUser.RoleKeyAt(campusData.Campus()).Value(),
+			Assigned:   assigned,
+		})
+	}
+
+	return &UserCampusRoleListOutput{
+		UserID:      targetUser.ID().Value(),
+		RoleKey:     targetUser.RoleKey().Value(),
+		CampusRoles: campusRoles,
+	}
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	session "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserDeactivateInputPort interface {
+	Execute(ctx context.Context, userID int, successorUserID int, deactivateFromUserID vo.UserID) (*UserScheduleHandOverOutput, error)
+}
+
+type (
+	UserDeactivateInteractor struct {
+		txManager            util.TxManager
+		repositoryUser       repository.UserRepository
+		repositorySchedule   repository.ScheduleRepository
+		repositorySession    session.SessionRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewUserDeactivateInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositorySchedule repository.ScheduleRepository,
+	repositorySession session.SessionRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserDeactivateInputPort {
+	return &UserDeactivateInteractor{
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		repositorySchedule:   repositorySchedule,
+		repositorySession:    repositorySession,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r UserDeactivateInteractor) Execute(ctx context.Context, userID int, successorUserID int, deactivateFromUserID vo.UserID) (*UserScheduleHandOverOutput, error) {
+
+	deactivateUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := r.serviceAuthorization.Authorize(ctx, deactivateFromUserID, vo.PERMISSION_USER_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	deactivateUserData, err := r.repositoryUser.FindByUserID(ctx, deactivateUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if deactivateUserData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", deactivateUserID))
+	}
+
+	// 自分自身は無効化できない
+	if deactivateUserData.IsEnableDelete(deactivateFromUserID) {
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	successor, err := findSuccessorUser(ctx, r.repositoryUser, deactivateUserID, successorUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var output *UserScheduleHandOverOutput
+	var sessionDeleteErr error
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		// 復元に備えて共同編集者の権限は残す。引き継ぎ先が指定された場合のみ所有権を移す
+		output, err = handOverUserSchedules(ctx, tx, r.repositorySchedule, deactivateUserID, successor, false)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		err = r.repositoryUser.ChangeStatus(ctx, tx, deactivateUserData, vo.USER_STATUS_DEACTIVATED, deactivateFromUserID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		sessionDeleteErr = r.repositorySession.Delete(ctx, nil, deactivateUserID)
+		if sessionDeleteErr != nil {
+			sessionDeleteErr = log.WrapErrorWithStackTrace(sessionDeleteErr)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, sessionDeleteErr
+}
@@ -5,7 +5,9 @@ import (
 	"database/sql"
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/configs"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	session "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
@@ -13,59 +15,93 @@ import (
 )
 
 type IUserDeleteInputPort interface {
-	Execute(ctx context.Context, role vo.RoleKey, userID int, deleteFromUserID vo.UserID) error
+	Execute(ctx context.Context, userID int, successorUserID int, deleteFromUserID vo.UserID) (*UserScheduleHandOverOutput, error)
 }
 
+type (
+	// 削除・無効化したユーザーのスケジュールの引き継ぎ結果
+	UserScheduleHandOverOutput struct {
+		UserID               int
+		SuccessorUserID      int
+		TransferredSchedules []UserTransferredScheduleDTO
+		RevokedScheduleIDs   []int
+	}
+
+	UserTransferredScheduleDTO struct {
+		ScheduleID int
+		Title      string
+	}
+)
+
 type (
 	UserDeleteInteractor struct {
-		txManager         util.TxManager
-		repositoryUser    repository.UserRepository
-		repositorySession session.SessionRepository
-		env               configs.EnvConfig
+		txManager            util.TxManager
+		repositoryUser       repository.UserRepository
+		repositorySchedule   repository.ScheduleRepository
+		repositorySession    session.SessionRepository
+		env                  configs.EnvConfig
+		serviceAuthorization service.IAuthorizationService
 	}
 )
 
 func NewUserDeleteInteractor(
 	txManager util.TxManager,
 	repositoryUser repository.UserRepository,
+	repositorySchedule repository.ScheduleRepository,
 	repositorySession session.SessionRepository,
 	env configs.EnvConfig,
+	serviceAuthorization service.IAuthorizationService,
 ) IUserDeleteInputPort {
 	return &UserDeleteInteractor{
-		txManager:         txManager,
-		repositoryUser:    repositoryUser,
-		repositorySession: repositorySession,
-		env:               env,
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		repositorySchedule:   repositorySchedule,
+		repositorySession:    repositorySession,
+		env:                  env,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
 
-func (r UserDeleteInteractor) Execute(ctx context.Context, role vo.RoleKey, userID int, deleteFromUserID vo.UserID) error {
+func (r UserDeleteInteractor) Execute(ctx context.Context, userID int, successorUserID int, deleteFromUserID vo.UserID) (*UserScheduleHandOverOutput, error) {
 
 	deleteUserID, err := vo.NewUserID(userID)
 	if err != nil {
-		return log.WrapErrorWithStackTrace(err)
+		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
-	if role != vo.ROLE_KEY_OWNER {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	if err := r.serviceAuthorization.Authorize(ctx, deleteFromUserID, vo.PERMISSION_USER_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
-	deleteUserData, err := r.repositoryUser.FindByUserID(ctx, deleteUserID)
+	// 無効化中のユーザーも削除できる
+	deleteUserData, status, err := r.repositoryUser.FindByUserIDWithStatus(ctx, deleteUserID)
 	if err != nil {
-		return log.WrapErrorWithStackTrace(err)
+		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
-	if deleteUserData == nil {
-		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", deleteUserID))
+	if deleteUserData == nil || status.IsDeleted() {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", deleteUserID))
 	}
 
 	if deleteUserData.IsEnableDelete(deleteFromUserID) {
-		return log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+		return nil, log.WrapErrorWithStackTraceForbidden(log.Errorf("許可されていない操作です"))
+	}
+
+	successor, err := findSuccessorUser(ctx, r.repositoryUser, deleteUserID, successorUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
+	var output *UserScheduleHandOverOutput
 	var sessionDeleteErr error
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
+		// 削除するユーザーの権限は全て取り除き、所有するスケジュールは引き継ぎ先へ移す
+		output, err = handOverUserSchedules(ctx, tx, r.repositorySchedule, deleteUserID, successor, true)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
 		if err = r.repositoryUser.Delete(ctx, tx, deleteUserData, deleteFromUserID); err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
@@ -79,8 +115,113 @@ func (r UserDeleteInteractor) Execute(ctx context.Context, role vo.RoleKey, user
 	})
 
 	if err != nil {
-		return log.WrapErrorWithStackTrace(err)
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, sessionDeleteErr
+}
+
+// 引き継ぎ先のユーザーを検証する。未指定の場合は nil を返す
+func findSuccessorUser(ctx context.Context, repositoryUser repository.UserRepository, fromUserID vo.UserID, inputSuccessorUserID int) (*user.RootUserModel, error) {
+
+	if inputSuccessorUserID == vo.USER_ID_INITIAL.Value() {
+		return nil, nil
+	}
+
+	successorUserID, err := vo.NewUserID(inputSuccessorUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	if successorUserID == fromUserID {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("引き継ぎ先に同じユーザーは指定できません"))
+	}
+
+	successor, err := repositoryUser.FindByUserID(ctx, successorUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if successor == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("引き継ぎ先のユーザーは存在しません:%d", successorUserID.Value()))
+	}
+
+	return successor, nil
+}
+
+// ユーザーが所有するスケジュールを引き継ぎ先へ移す。revoke の場合は共同編集者からも外す
+func handOverUserSchedules(ctx context.Context, tx *sql.Tx, repositorySchedule repository.ScheduleRepository, fromUserID vo.UserID, successor *user.RootUserModel, revoke bool) (*UserScheduleHandOverOutput, error) {
+
+	successorUserID := vo.USER_ID_INITIAL
+	if successor != nil {
+		successorUserID = successor.ID()
+	}
+
+	output := &UserScheduleHandOverOutput{
+		UserID:               fromUserID.Value(),
+		SuccessorUserID:      successorUserID.Value(),
+		TransferredSchedules: []UserTransferredScheduleDTO{},
+		RevokedScheduleIDs:   []int{},
+	}
+
+	schedules, err := repositorySchedule.FindAccessControlByUserIDWithLock(ctx, tx, fromUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	for _, scheduleData := range schedules {
+
+		changed := false
+
+		if scheduleData.IsOwnedBy(fromUserID) {
+
+			if successor == nil {
+				if !revoke {
+					continue
+				}
+				return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("所有するスケジュールがあるため、引き継ぎ先のユーザーを指定してください"))
+			}
+
+			// スケジュールのキャンパスで編集権限のないユーザーには移譲できない
+			if !successor.AllowsAt(scheduleData.Campus(), vo.PERMISSION_SCHEDULE_EDIT) {
+				return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("編集権限のないユーザーには所有権を移譲できません"))
+			}
+
+			err = scheduleData.TransferOwnership(successorUserID)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTraceBadRequest(err)
+			}
+
+			output.TransferredSchedules = append(output.TransferredSchedules, UserTransferredScheduleDTO{
+				ScheduleID: scheduleData.ID().Value(),
+				Title:      scheduleData.Title().Value(),
+			})
+			changed = true
+		}
+
+		if revoke && scheduleData.IsViewableCollaborator(fromUserID) {
+
+			err = scheduleData.RevokeCollaborator(fromUserID)
+			if err != nil {
+				return nil, log.WrapErrorWithStackTrace(err)
+			}
+
+			// 引き継いだスケジュールは移譲の結果として返す
+			if !changed {
+				output.RevokedScheduleIDs = append(output.RevokedScheduleIDs, scheduleData.ID().Value())
+			}
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+
+		err = repositorySchedule.SaveAccessControl(ctx, tx, scheduleData)
+		if err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
 	}
 
-	return sessionDeleteErr
+	return output, nil
 }
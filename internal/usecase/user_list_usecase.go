@@ -6,17 +6,19 @@ import (
 	"github.com/samber/lo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 )
 
 type IUserListInputPort interface {
-	Execute(ctx context.Context, userID vo.UserID, roleKey vo.RoleKey) (*UserListOutput, error)
+	Execute(ctx context.Context, userID vo.UserID) (*UserListOutput, error)
 }
 
 type UserListInteractor struct {
-	repositoryRole repository.RoleRepository
-	repositoryUser repository.UserRepository
+	repositoryRole       repository.RoleRepository
+	repositoryUser       repository.UserRepository
+	serviceAuthorization service.IAuthorizationService
 }
 
 type (
@@ -35,14 +37,16 @@ type (
 func NewUserListInteractor(
 	repositoryRole repository.RoleRepository,
 	repositoryUser repository.UserRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) IUserListInputPort {
 	return &UserListInteractor{
-		repositoryRole: repositoryRole,
-		repositoryUser: repositoryUser,
+		repositoryRole:       repositoryRole,
+		repositoryUser:       repositoryUser,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
 
-func (r UserListInteractor) Execute(ctx context.Context, userID vo.UserID, roleKey vo.RoleKey) (*UserListOutput, error) {
+func (r UserListInteractor) Execute(ctx context.Context, userID vo.UserID) (*UserListOutput, error) {
 
 	var err error
 	roles, err := r.repositoryRole.FindAll(ctx)
@@ -55,8 +59,13 @@ func (r UserListInteractor) Execute(ctx context.Context, userID vo.UserID, roleK
 		return nil, log.WrapErrorWithStackTrace(err)
 	}
 
-	// オーナー以外は自分の情報のみを取得する
-	if !roleKey.IsOwner() {
+	allowsManagingUsers, err := r.serviceAuthorization.Allows(ctx, userID, vo.PERMISSION_USER_MANAGE)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// ユーザー管理の権限がない場合は自分の情報のみを取得する
+	if !allowsManagingUsers {
 
 		mySelf := users.FindByUserID(userID)
 		if mySelf == nil {
@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+	userModel "github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+)
+
+// 一覧で返すログイン試行の件数
+const LOGIN_ATTEMPT_LIST_LIMIT = 100
+
+type IUserLoginAttemptListInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, userID int) (*UserLoginAttemptListOutput, error)
+}
+
+type (
+	UserLoginAttemptListOutput struct {
+		UserID int
+		// ログインの失敗によりロックされているか
+		Locked bool
+		// ロックされていない場合はnil
+		LockedUntil  *time.Time
+		FailureCount int
+		Attempts     []LoginAttemptDTO
+	}
+
+	LoginAttemptDTO struct {
+		ID        int
+		UserName  string
+		IPAddress string
+		UserAgent string
+		Result    string
+		CreatedAt time.Time
+	}
+)
+
+type (
+	UserLoginAttemptListInteractor struct {
+		repositoryUser          repository.UserRepository
+		repositoryLoginAttempt  repository.LoginAttemptRepository
+		repositoryLoginThrottle repository.LoginThrottleRepository
+		serviceAuthorization    service.IAuthorizationService
+	}
+)
+
+func NewUserLoginAttemptListInteractor(
+	repositoryUser repository.UserRepository,
+	repositoryLoginAttempt repository.LoginAttemptRepository,
+	repositoryLoginThrottle repository.LoginThrottleRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserLoginAttemptListInputPort {
+	return &UserLoginAttemptListInteractor{
+		repositoryUser:          repositoryUser,
+		repositoryLoginAttempt:  repositoryLoginAttempt,
+		repositoryLoginThrottle: repositoryLoginThrottle,
+		serviceAuthorization:    serviceAuthorization,
+	}
+}
+
+func (r UserLoginAttemptListInteractor) Execute(ctx context.Context, user vo.UserID, userID int) (*UserLoginAttemptListOutput, error) {
+
+	targetUser, err := findLoginManagedUser(ctx, r.repositoryUser, r.serviceAuthorization, user, userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findUserLoginAttemptListOutput(ctx, r.repositoryLoginAttempt, r.repositoryLoginThrottle, targetUser)
+}
+
+// ログインの状況を管理する対象のユーザー
+func findLoginManagedUser(
+	ctx context.Context,
+	repositoryUser repository.UserRepository,
+	serviceAuthorization service.IAuthorizationService,
+	user vo.UserID,
+	userID int,
+) (*userModel.RootUserModel, error) {
+
+	targetUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	if err := serviceAuthorization.Authorize(ctx, user, vo.PERMISSION_USER_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	targetUser, err := repositoryUser.FindByUserID(ctx, targetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if targetUser == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", userID))
+	}
+
+	return targetUser, nil
+}
+
+func findUserLoginAttemptListOutput(
+	ctx context.Context,
+	repositoryLoginAttempt repository.LoginAttemptRepository,
+	repositoryLoginThrottle repository.LoginThrottleRepository,
+	user *userModel.RootUserModel,
+) (*UserLoginAttemptListOutput, error) {
+
+	userName := user.UserName().Value()
+
+	throttle, err := repositoryLoginThrottle.FindByKey(ctx, loginthrottle.THROTTLE_TYPE_ACCOUNT, loginthrottle.AccountKey(userName))
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	attempts, err := repositoryLoginAttempt.FindRecentByUser(ctx, user.ID(), userName, LOGIN_ATTEMPT_LIST_LIMIT)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	output := &UserLoginAttemptListOutput{
+		UserID: user.ID().Value(),
+		Attempts: lo.Map(attempts, func(item *loginattempt.RootLoginAttemptModel, _ int) LoginAttemptDTO {
+			return LoginAttemptDTO{
+				ID:        item.ID(),
+				UserName:  item.UserName(),
+				IPAddress: item.IPAddress(),
+				UserAgent: item.UserAgent(),
+				Result:    item.Result().Value(),
+				CreatedAt: item.CreatedAt(),
+			}
+		}),
+	}
+
+	if throttle != nil {
+		output.FailureCount = throttle.FailureCount()
+
+		if throttle.IsLockedAt(time.Now()) {
+			output.Locked = true
+			output.LockedUntil = lo.ToPtr(throttle.LockedUntil())
+		}
+	}
+
+	return output, nil
+}
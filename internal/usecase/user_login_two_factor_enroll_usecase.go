@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+
+	userModel "github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserLoginTwoFactorEnrollInputPort interface {
+	Execute(ctx context.Context, userID int) (*TwoFactorEnrollOutput, error)
+}
+
+type UserLoginTwoFactorEnrollInteractor struct {
+	txManager           util.TxManager
+	repositoryUser      repository.UserRepository
+	repositoryTwoFactor repository.TwoFactorRepository
+}
+
+func NewUserLoginTwoFactorEnrollInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
+) IUserLoginTwoFactorEnrollInputPort {
+	return &UserLoginTwoFactorEnrollInteractor{
+		txManager:           txManager,
+		repositoryUser:      repositoryUser,
+		repositoryTwoFactor: repositoryTwoFactor,
+	}
+}
+
+// 2段階認証が必須とされている未登録のユーザーが、ログインの途中で登録する
+func (r *UserLoginTwoFactorEnrollInteractor) Execute(ctx context.Context, userID int) (*TwoFactorEnrollOutput, error) {
+
+	user, err := findTwoFactorPendingUser(ctx, r.repositoryUser, userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	twoFactor, err := r.repositoryTwoFactor.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if twoFactor == nil || !twoFactor.IsRequired() || twoFactor.IsEnabled() {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("2段階認証の登録は不要です"))
+	}
+
+	return startTwoFactorEnrollment(ctx, r.txManager, r.repositoryTwoFactor, user)
+}
+
+// パスワード認証を済ませ、2段階認証を待っているユーザー
+func findTwoFactorPendingUser(ctx context.Context, repositoryUser repository.UserRepository, userID int) (*userModel.RootUserModel, error) {
+
+	unauthorized := log.WrapErrorWithStackTraceUnauthorized(log.Errorf("ログインの有効期限が切れました。再度ログインしてください"))
+
+	pendingUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, unauthorized
+	}
+
+	user, err := repositoryUser.FindByUserID(ctx, pendingUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 無効化・削除されたユーザーはログインできない
+	if user == nil {
+		return nil, unauthorized
+	}
+
+	return user, nil
+}
@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	session "github.com/typedef-tokyo/lessonlink-backend/internal/usecase/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserLoginTwoFactorInputPort interface {
+	Execute(ctx context.Context, input UserLoginTwoFactorInput) (*UserLoginOutput, error)
+}
+
+type UserLoginTwoFactorInput struct {
+	// パスワード認証を済ませたユーザー
+	UserID    int
+	Code      string
+	IPAddress string
+	UserAgent string
+}
+
+type UserLoginTwoFactorInteractor struct {
+	txManager           util.TxManager
+	repositoryUser      repository.UserRepository
+	repositoryTwoFactor repository.TwoFactorRepository
+	repositorySession   session.SessionRepository
+	serviceLoginAttempt service.ILoginAttemptService
+}
+
+func NewUserLoginTwoFactorInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
+	repositorySession session.SessionRepository,
+	serviceLoginAttempt service.ILoginAttemptService,
+) IUserLoginTwoFactorInputPort {
+	return &UserLoginTwoFactorInteractor{
+		txManager:           txManager,
+		repositoryUser:      repositoryUser,
+		repositoryTwoFactor: repositoryTwoFactor,
+		repositorySession:   repositorySession,
+		serviceLoginAttempt: serviceLoginAttempt,
+	}
+}
+
+func (r *UserLoginTwoFactorInteractor) Execute(ctx context.Context, input UserLoginTwoFactorInput) (*UserLoginOutput, error) {
+
+	user, err := findTwoFactorPendingUser(ctx, r.repositoryUser, input.UserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	userName := user.UserName().Value()
+
+	// パスワード認証と同じ制限で、認証コードの総当たりを防ぐ
+	if err := r.serviceLoginAttempt.Check(ctx, userName, input.IPAddress, time.Now()); err != nil {
+
+		attempt := loginattempt.NewCreateRootLoginAttemptModel(userName, user.ID(), input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_BLOCKED)
+		if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.Record, attempt); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	var recoveryCodes []string
+	var verifyErr error
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		twoFactor, err := r.repositoryTwoFactor.FindByUserIDWithLock(ctx, tx, user.ID())
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		switch {
+		case twoFactor == nil || !twoFactor.IsLoginChallengeRequired():
+			// パスワード認証後にオーナーが設定を変更した場合
+			return log.WrapErrorWithStackTraceUnauthorized(log.Errorf("2段階認証の設定が変更されました。再度ログインしてください"))
+
+		case twoFactor.IsEnabled():
+			if !twoFactor.Verify(input.Code, time.Now()) {
+				verifyErr = log.WrapErrorWithStackTraceUnauthorized(log.Errorf("認証コードが正しくありません"))
+				return nil
+			}
+
+		default:
+			// 必須とされているが未登録の場合は、ここで登録を完了する
+			recoveryCodes, verifyErr = twoFactor.ConfirmEnrollment(input.Code, time.Now())
+			if verifyErr != nil {
+				return nil
+			}
+		}
+
+		if err := r.repositoryTwoFactor.Save(ctx, tx, twoFactor); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 認証コードの誤りはパスワードの誤りと同様に失敗回数に数える
+	if verifyErr != nil {
+
+		attempt := loginattempt.NewCreateRootLoginAttemptModel(userName, user.ID(), input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_TWO_FACTOR_FAILURE)
+		if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.RecordFailure, attempt); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil, log.WrapErrorWithStackTrace(verifyErr)
+	}
+
+	output, err := createLoginSession(ctx, r.txManager, r.repositorySession, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	attempt := loginattempt.NewCreateRootLoginAttemptModel(userName, user.ID(), input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_SUCCESS)
+	if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.RecordSuccess, attempt); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	output.RecoveryCodes = recoveryCodes
+
+	return output, nil
+}
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/gorilla/securecookie"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginattempt"
+	userModel "github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/entity"
@@ -22,6 +25,8 @@ type IUserLoginInputPort interface {
 type UserLoginInput struct {
 	UserName        string
 	UserRawPassword string
+	IPAddress       string
+	UserAgent       string
 }
 
 type (
@@ -29,6 +34,12 @@ type (
 		User      *UserLoginOutputDTO
 		Err       error
 		SessionID string
+		// 2段階認証が必要な場合はセッションを作成せず、認証コードの入力を求める
+		TwoFactorRequired bool
+		// 2段階認証が必須だが未登録のため、先に登録が必要
+		TwoFactorEnrollmentRequired bool
+		// 2段階認証の登録を完了した場合に発行したリカバリーコード
+		RecoveryCodes []string
 	}
 
 	UserLoginOutputDTO struct {
@@ -40,25 +51,42 @@ type (
 )
 
 type UserLoginInteractor struct {
-	txManager         util.TxManager
-	repositoryUser    repository.UserRepository
-	repositorySession session.SessionRepository
+	txManager           util.TxManager
+	repositoryUser      repository.UserRepository
+	repositoryTwoFactor repository.TwoFactorRepository
+	repositorySession   session.SessionRepository
+	serviceLoginAttempt service.ILoginAttemptService
 }
 
 func NewUserLoginInteractor(
 	txManager util.TxManager,
 	repositoryUser repository.UserRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
 	repositorySession session.SessionRepository,
+	serviceLoginAttempt service.ILoginAttemptService,
 ) IUserLoginInputPort {
 	return &UserLoginInteractor{
-		txManager:         txManager,
-		repositoryUser:    repositoryUser,
-		repositorySession: repositorySession,
+		txManager:           txManager,
+		repositoryUser:      repositoryUser,
+		repositoryTwoFactor: repositoryTwoFactor,
+		repositorySession:   repositorySession,
+		serviceLoginAttempt: serviceLoginAttempt,
 	}
 }
 
 func (r *UserLoginInteractor) Execute(ctx context.Context, input UserLoginInput) (*UserLoginOutput, error) {
 
+	// 制限中はパスワードの検証を行わない
+	if err := r.serviceLoginAttempt.Check(ctx, input.UserName, input.IPAddress, time.Now()); err != nil {
+
+		attempt := loginattempt.NewCreateRootLoginAttemptModel(input.UserName, vo.USER_ID_INITIAL, input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_BLOCKED)
+		if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.Record, attempt); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
 	user, err := r.repositoryUser.FindByUserName(ctx, input.UserName)
 
 	if err != nil {
@@ -70,11 +98,105 @@ func (r *UserLoginInteractor) Execute(ctx context.Context, input UserLoginInput)
 		return nil, log.WrapErrorWithStackTraceBadRequest(err)
 	}
 
-	if user == nil || !user.AuthenticatePassword(password) {
+	var authenticated bool
+	if user != nil {
+		authenticated = user.AuthenticatePassword(password)
+	} else {
+		authenticated = userModel.AuthenticateDummyPassword(password)
+	}
+
+	if !authenticated {
+
+		userID := vo.USER_ID_INITIAL
+		if user != nil {
+			userID = user.ID()
+		}
+
+		attempt := loginattempt.NewCreateRootLoginAttemptModel(input.UserName, userID, input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_FAILURE)
+		if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.RecordFailure, attempt); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
 		return nil, log.WrapErrorWithStackTraceUnauthorized(log.Errorf("ユーザー名またはパスワードが違います"))
 	}
 
+	challenge, err := findTwoFactorChallenge(ctx, r.repositoryTwoFactor, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// 失敗回数はログインが完了するまで消去しない
+	if challenge != nil {
+
+		attempt := loginattempt.NewCreateRootLoginAttemptModel(input.UserName, user.ID(), input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_TWO_FACTOR_PENDING)
+		if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.Record, attempt); err != nil {
+			return nil, log.WrapErrorWithStackTrace(err)
+		}
+
+		return challenge, nil
+	}
+
+	output, err := createLoginSession(ctx, r.txManager, r.repositorySession, user)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	attempt := loginattempt.NewCreateRootLoginAttemptModel(input.UserName, user.ID(), input.IPAddress, input.UserAgent, vo.LOGIN_ATTEMPT_RESULT_SUCCESS)
+	if err := recordLoginAttempt(ctx, r.txManager, r.serviceLoginAttempt.RecordSuccess, attempt); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, nil
+}
+
+// 2段階認証が必要なユーザーの場合は、認証コードの確認が済むまでセッションを作成せずに返す出力。不要な場合はnil
+func findTwoFactorChallenge(ctx context.Context, repositoryTwoFactor repository.TwoFactorRepository, user *userModel.RootUserModel) (*UserLoginOutput, error) {
+
+	twoFactor, err := repositoryTwoFactor.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if twoFactor == nil || !twoFactor.IsLoginChallengeRequired() {
+		return nil, nil
+	}
+
+	return &UserLoginOutput{
+		User:                        toUserLoginOutputDTO(user),
+		TwoFactorRequired:           true,
+		TwoFactorEnrollmentRequired: !twoFactor.IsEnabled(),
+	}, nil
+}
+
+// ログインの試行を記録する
+func recordLoginAttempt(
+	ctx context.Context,
+	txManager util.TxManager,
+	record func(ctx context.Context, tx *sql.Tx, attempt *loginattempt.RootLoginAttemptModel) error,
+	attempt *loginattempt.RootLoginAttemptModel,
+) error {
+
+	err := txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		if err := record(ctx, tx, attempt); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
+	return nil
+}
+
+// ログインしたユーザーのセッションを作成する
+func createLoginSession(ctx context.Context, txManager util.TxManager, repositorySession session.SessionRepository, user *userModel.RootUserModel) (*UserLoginOutput, error) {
+
 	sessionID := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(securecookie.GenerateRandomKey(32))
+
 	entity := entity.SessionEntity{
 		SessionID: sessionID,
 		UserID:    user.ID(),
@@ -82,9 +204,9 @@ func (r *UserLoginInteractor) Execute(ctx context.Context, input UserLoginInput)
 		ExpiresAt: time.Now().Add(60 * time.Minute),
 	}
 
-	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+	err := txManager.Do(ctx, func(tx *sql.Tx) error {
 
-		if err = r.repositorySession.Save(ctx, tx, entity); err != nil {
+		if err := repositorySession.Save(ctx, tx, entity); err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
@@ -96,12 +218,17 @@ func (r *UserLoginInteractor) Execute(ctx context.Context, input UserLoginInput)
 	}
 
 	return &UserLoginOutput{
-		User: &UserLoginOutputDTO{
-			ID:       user.ID().Value(),
-			Name:     user.DisplayName().Value(),
-			UserName: user.UserName().Value(),
-			RoleKey:  user.RoleKey().Value(),
-		},
+		User:      toUserLoginOutputDTO(user),
 		SessionID: sessionID,
 	}, nil
 }
+
+func toUserLoginOutputDTO(user *userModel.RootUserModel) *UserLoginOutputDTO {
+
+	return &UserLoginOutputDTO{
+		ID:       user.ID().Value(),
+		Name:     user.DisplayName().Value(),
+		UserName: user.UserName().Value(),
+		RoleKey:  user.RoleKey().Value(),
+	}
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserRestoreInputPort interface {
+	Execute(ctx context.Context, userID int, restoreFromUserID vo.UserID) (*UserRestoreOutput, error)
+}
+
+type (
+	UserRestoreOutput struct {
+		ID       int
+		Name     string
+		UserName string
+		RoleKey  string
+	}
+)
+
+type (
+	UserRestoreInteractor struct {
+		txManager            util.TxManager
+		repositoryUser       repository.UserRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewUserRestoreInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserRestoreInputPort {
+	return &UserRestoreInteractor{
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r UserRestoreInteractor) Execute(ctx context.Context, userID int, restoreFromUserID vo.UserID) (*UserRestoreOutput, error) {
+
+	restoreUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if err := r.serviceAuthorization.Authorize(ctx, restoreFromUserID, vo.PERMISSION_USER_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	restoreUserData, status, err := r.repositoryUser.FindByUserIDWithStatus(ctx, restoreUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if restoreUserData == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", restoreUserID))
+	}
+
+	if status.IsActive() {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("指定したユーザーは無効化・削除されていません"))
+	}
+
+	// 削除後に同じユーザー名で登録されている場合は復元できない
+	sameNameUser, err := r.repositoryUser.FindByUserName(ctx, restoreUserData.UserName().Value())
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if sameNameUser != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(log.Errorf("同じユーザー名のユーザーが登録されています"))
+	}
+
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		err := r.repositoryUser.ChangeStatus(ctx, tx, restoreUserData, vo.USER_STATUS_ACTIVE, restoreFromUserID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return &UserRestoreOutput{
+		ID:       restoreUserData.ID().Value(),
+		Name:     restoreUserData.DisplayName().Value(),
+		UserName: restoreUserData.UserName().Value(),
+		RoleKey:  restoreUserData.RoleKey().Value(),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserTwoFactorResetInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, userID int) (*TwoFactorStatusOutput, error)
+}
+
+type (
+	UserTwoFactorResetInteractor struct {
+		txManager            util.TxManager
+		repositoryUser       repository.UserRepository
+		repositoryTwoFactor  repository.TwoFactorRepository
+		serviceAuthorization service.IAuthorizationService
+	}
+)
+
+func NewUserTwoFactorResetInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositoryTwoFactor repository.TwoFactorRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserTwoFactorResetInputPort {
+	return &UserTwoFactorResetInteractor{
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		repositoryTwoFactor:  repositoryTwoFactor,
+		serviceAuthorization: serviceAuthorization,
+	}
+}
+
+func (r UserTwoFactorResetInteractor) Execute(ctx context.Context, user vo.UserID, userID int) (*TwoFactorStatusOutput, error) {
+
+	targetUserID, err := vo.NewUserID(userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTraceBadRequest(err)
+	}
+
+	if err := r.serviceAuthorization.Authorize(ctx, user, vo.PERMISSION_USER_MANAGE); err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	targetUser, err := r.repositoryUser.FindByUserID(ctx, targetUserID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	if targetUser == nil {
+		return nil, log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", userID))
+	}
+
+	var output *TwoFactorStatusOutput
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		twoFactor, err := r.repositoryTwoFactor.FindByUserIDWithLock(ctx, tx, targetUserID)
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if twoFactor == nil || !twoFactor.IsEnabled() {
+			return log.WrapErrorWithStackTraceBadRequest(log.Errorf("指定したユーザーは2段階認証を登録していません:%d", userID))
+		}
+
+		twoFactor.Reset()
+
+		if err := r.repositoryTwoFactor.Save(ctx, tx, twoFactor); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		output = toTwoFactorStatusOutput(twoFactor)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/loginthrottle"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/usecase/util"
+)
+
+type IUserUnlockInputPort interface {
+	Execute(ctx context.Context, user vo.UserID, userID int) (*UserLoginAttemptListOutput, error)
+}
+
+type (
+	UserUnlockInteractor struct {
+		txManager               util.TxManager
+		repositoryUser          repository.UserRepository
+		repositoryLoginAttempt  repository.LoginAttemptRepository
+		repositoryLoginThrottle repository.LoginThrottleRepository
+		serviceAuthorization    service.IAuthorizationService
+	}
+)
+
+func NewUserUnlockInteractor(
+	txManager util.TxManager,
+	repositoryUser repository.UserRepository,
+	repositoryLoginAttempt repository.LoginAttemptRepository,
+	repositoryLoginThrottle repository.LoginThrottleRepository,
+	serviceAuthorization service.IAuthorizationService,
+) IUserUnlockInputPort {
+	return &UserUnlockInteractor{
+		txManager:               txManager,
+		repositoryUser:          repositoryUser,
+		repositoryLoginAttempt:  repositoryLoginAttempt,
+		repositoryLoginThrottle: repositoryLoginThrottle,
+		serviceAuthorization:    serviceAuthorization,
+	}
+}
+
+func (r UserUnlockInteractor) Execute(ctx context.Context, user vo.UserID, userID int) (*UserLoginAttemptListOutput, error) {
+
+	targetUser, err := findLoginManagedUser(ctx, r.repositoryUser, r.serviceAuthorization, user, userID)
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	// ユーザー名の失敗回数を消去する。接続元IPアドレスの制限は期限まで残す
+	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
+
+		err := r.repositoryLoginThrottle.Delete(ctx, tx, loginthrottle.THROTTLE_TYPE_ACCOUNT, loginthrottle.AccountKey(targetUser.UserName().Value()))
+		if err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, log.WrapErrorWithStackTrace(err)
+	}
+
+	return findUserLoginAttemptListOutput(ctx, r.repositoryLoginAttempt, r.repositoryLoginThrottle, targetUser)
+}
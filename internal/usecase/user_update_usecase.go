@@ -8,6 +8,7 @@ import (
 
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/model/user"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/repository"
+	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/service"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/domain/vo"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/hash"
 	"github.com/typedef-tokyo/lessonlink-backend/internal/pkg/log"
@@ -15,7 +16,7 @@ import (
 )
 
 type IUserUpdateInputPort interface {
-	Execute(ctx context.Context, user UserUpdateInput, updateFromUserID vo.UserID, roleKey vo.RoleKey) error
+	Execute(ctx context.Context, user UserUpdateInput, updateFromUserID vo.UserID) error
 }
 
 type UserUpdateInput struct {
@@ -27,20 +28,26 @@ type UserUpdateInput struct {
 }
 
 type UserUpdateInteractor struct {
-	txManager      util.TxManager
-	repositoryUser repository.UserRepository
+	txManager            util.TxManager
+	repositoryUser       repository.UserRepository
+	repositoryRole       repository.RoleRepository
+	serviceAuthorization service.IAuthorizationService
 }
 
 func NewUserUpdateInteractor(
 	txManager util.TxManager,
 	repositoryUser repository.UserRepository,
+	repositoryRole repository.RoleRepository,
+	serviceAuthorization service.IAuthorizationService,
 ) IUserUpdateInputPort {
 	return &UserUpdateInteractor{
-		txManager:      txManager,
-		repositoryUser: repositoryUser,
+		txManager:            txManager,
+		repositoryUser:       repositoryUser,
+		repositoryRole:       repositoryRole,
+		serviceAuthorization: serviceAuthorization,
 	}
 }
-func (r UserUpdateInteractor) Execute(ctx context.Context, inputUser UserUpdateInput, updateFromUserID vo.UserID, roleKey vo.RoleKey) error {
+func (r UserUpdateInteractor) Execute(ctx context.Context, inputUser UserUpdateInput, updateFromUserID vo.UserID) error {
 
 	userID, err := vo.NewUserID(inputUser.UserID)
 	if err != nil {
@@ -57,9 +64,18 @@ func (r UserUpdateInteractor) Execute(ctx context.Context, inputUser UserUpdateI
 		return log.WrapErrorWithStackTraceNotFound(log.Errorf("指定したユーザーは存在しません:%d", userID.Value()))
 	}
 
+	allowsManagingUsers, err := r.serviceAuthorization.Allows(ctx, updateFromUserID, vo.PERMISSION_USER_MANAGE)
+	if err != nil {
+		return log.WrapErrorWithStackTrace(err)
+	}
+
 	err = r.txManager.Do(ctx, func(tx *sql.Tx) error {
 
-		if err = r.updateUserModel(inputUser, userData, updateFromUserID, roleKey); err != nil {
+		if err = r.updateUserModel(inputUser, userData, updateFromUserID, allowsManagingUsers); err != nil {
+			return log.WrapErrorWithStackTrace(err)
+		}
+
+		if err = existsRole(ctx, r.repositoryRole, userData.RoleKey()); err != nil {
 			return log.WrapErrorWithStackTrace(err)
 		}
 
@@ -82,7 +98,7 @@ func (r UserUpdateInteractor) updateUserModel(
 	inputUser UserUpdateInput,
 	userModel *user.RootUserModel,
 	updateFromUserID vo.UserID,
-	updateUserRoleKey vo.RoleKey,
+	allowsManagingUsers bool,
 ) error {
 
 	var roleKey vo.RoleKey
@@ -124,7 +140,7 @@ func (r UserUpdateInteractor) updateUserModel(
 		password,
 		displayName,
 		updateFromUserID,
-		updateUserRoleKey,
+		allowsManagingUsers,
 	)
 
 	if err != nil {
@@ -129,6 +129,198 @@ func Test_main(t *testing.T) {
 	// スケジュール削除
 	runGolden(t, "/schedule/1", "DELETE", false, "schedule/delete")
 
+	// 以降の編集系テスト用のスケジュール作成
+	runGolden(t, "/schedule/create/shibuya", "POST", false, "schedule/create-workspace")
+
+	// スケジュール編集 アイテム配置候補取得
+	runGolden(t, "/schedule/4/items/identifier_suggestion/suggestions?lesson_id=1&step=60&room_index=2&limit=3", "GET", true, "schedule/item-suggestion")
+	runGolden(t, "/schedule/4/items/identifier_suggestion/suggestions?lesson_id=1&limit=101", "GET", false, "schedule/item-suggestion-invalid")
+
+	// スケジュール編集 アイテム固定
+	runGolden(t, "/schedule/4/item-move", "POST", false, "schedule/item-pin-ready")
+	runGolden(t, "/schedule/4/item-pin", "POST", false, "schedule/item-pin")
+
+	// スケジュール編集 固定アイテムを含む教室のシフト
+	runGolden(t, "/schedule/4/item-move", "POST", false, "schedule/item-shift-pinned-ready")
+	runGolden(t, "/schedule/4/item-shift", "POST", false, "schedule/item-shift-pinned")
+
+	// スケジュール編集 アイテムグループ作成・解除
+	runGolden(t, "/schedule/4/item-group", "POST", false, "schedule/item-group")
+	runGolden(t, "/schedule/4/item-ungroup", "POST", false, "schedule/item-ungroup")
+
+	// スケジュール編集 アイテム移動 押し出し
+	runGolden(t, "/schedule/4/item-move", "POST", false, "schedule/item-move-push-ready")
+	runGolden(t, "/schedule/4/item-move", "POST", false, "schedule/item-move-push")
+
+	// スケジュール編集 シフト方法の指定
+	runGolden(t, "/schedule/4/item-shift", "POST", false, "schedule/item-shift-strategy")
+
+	// スケジュール編集 教室単位の入れ替え・複製・クリア
+	runGolden(t, "/schedule/4/room-swap", "POST", false, "schedule/room-swap")
+	runGolden(t, "/schedule/4/room-copy", "POST", false, "schedule/room-copy")
+	runGolden(t, "/schedule/4/room-clear", "POST", false, "schedule/room-clear")
+
+	// スケジュール編集 アイテムの時刻をまとめてずらす
+	runGolden(t, "/schedule/4/item-time-shift", "POST", false, "schedule/item-time-shift")
+
+	// スケジュール編集 複数アイテムの結合・等分割
+	runGolden(t, "/schedule/4/item-join", "POST", false, "schedule/item-join-multi")
+	runGolden(t, "/schedule/4/item-divide", "POST", false, "schedule/item-divide-parts")
+
+	// スケジュール編集 配置済みの講座を合わせた時間変更
+	runGolden(t, "/schedule/4/time", "PATCH", false, "schedule/time-adjust")
+	runGolden(t, "/schedule/4", "GET", false, "schedule/get-time-adjusted")
+	runGolden(t, "/schedule/4?history=22", "GET", false, "schedule/get-time-adjusted-undo")
+
+	// スケジュール編集 分単位・24時終了の時間変更
+	runGolden(t, "/schedule/4/time", "PATCH", false, "schedule/time-minutes")
+	runGolden(t, "/schedule/4", "GET", false, "schedule/get-time-minutes")
+
+	// 校舎のスケジュールポリシー 他の校舎への影響を避けるため池袋校で確認する
+	runGolden(t, "/campus/ikebukuro/policy", "GET", false, "campus/policy-get-default")
+	runGolden(t, "/campus/ikebukuro/policy", "PUT", false, "campus/policy-edit")
+	runGolden(t, "/campus/ikebukuro/policy", "GET", false, "campus/policy-get")
+	runGolden(t, "/lesson/ikebukuro", "POST", false, "lesson/policy")
+	runGolden(t, "/schedule/create/ikebukuro", "POST", false, "schedule/create-policy")
+
+	// 池袋校の教室・講座・スケジュールの準備
+	runGolden(t, "/room/ikebukuro/edit", "POST", false, "room/ikebukuro")
+	runGolden(t, "/lesson/ikebukuro", "POST", false, "lesson/ikebukuro")
+	runGolden(t, "/schedule/create/ikebukuro", "POST", false, "schedule/create-ikebukuro")
+
+	// 校舎の利用不可の時間帯
+	runGolden(t, "/campus/ikebukuro/blocked-periods", "PUT", false, "campus/blocked-period-edit")
+	runGolden(t, "/campus/ikebukuro/blocked-periods", "GET", false, "campus/blocked-period-get")
+	runGolden(t, "/schedule/5/item-move", "POST", false, "schedule/item-move-blocked")
+	runGolden(t, "/schedule/5", "GET", false, "schedule/get-blocked")
+
+	// 校舎ごとのアイテムの種類
+	runGolden(t, "/campus/ikebukuro/room-item-types", "PUT", false, "campus/room-item-type-edit")
+	runGolden(t, "/campus/ikebukuro/room-item-types", "GET", false, "campus/room-item-type-get")
+	runGolden(t, "/schedule/5/item-move", "POST", false, "schedule/item-move-custom")
+	runGolden(t, "/schedule/5", "GET", false, "schedule/get-custom-item")
+
+	// スケジュール・アイテムへのコメント
+	runGolden(t, "/schedule/5/comments", "POST", false, "schedule/comment-add")
+	runGolden(t, "/schedule/5/comments/1", "PUT", false, "schedule/comment-edit")
+	runGolden(t, "/schedule/5/comments/1/resolve", "PUT", false, "schedule/comment-resolve")
+	runGolden(t, "/schedule/5/comments?identifier=identifier_blocked", "GET", false, "schedule/comment-list")
+	runGolden(t, "/schedule/5/comments/2", "DELETE", false, "schedule/comment-delete")
+	runGolden(t, "/schedule/5/comments/999", "DELETE", false, "schedule/comment-delete-missing")
+	runGolden(t, "/schedule/5", "GET", false, "schedule/get-comment-counts")
+	runGolden(t, "/schedule/5/duplicate", "POST", false, "schedule/duplicate-comment")
+	runGolden(t, "/schedule/6/comments", "GET", false, "schedule/comment-list-duplicated")
+
+	// 同日スケジュールのまとまりと教室の衝突 複製したスケジュール6と元のスケジュール5で確認する
+	runGolden(t, "/bundle/ikebukuro", "POST", false, "bundle/add")
+	runGolden(t, "/bundle/list/ikebukuro", "GET", false, "bundle/list")
+	runGolden(t, "/bundle/1/collisions", "GET", false, "bundle/collisions")
+	runGolden(t, "/schedule/6/item-move", "POST", false, "schedule/item-move-collision")
+	runGolden(t, "/bundle/1/collisions", "GET", false, "bundle/collisions-resolved")
+	runGolden(t, "/bundle/1", "PUT", false, "bundle/edit")
+	runGolden(t, "/bundle/1", "DELETE", false, "bundle/delete")
+	runGolden(t, "/bundle/list/ikebukuro", "GET", false, "bundle/list-deleted")
+
+	// スケジュールのステータス
+	runGolden(t, "/schedule/6/status", "PUT", false, "schedule/status-change")
+	runGolden(t, "/schedule/6/item-move", "POST", false, "schedule/item-move-frozen")
+	runGolden(t, "/schedule/list/ikebukuro?status=published", "GET", false, "schedule/list-status")
+	runGolden(t, "/schedule/list/ikebukuro?status=finished", "GET", false, "schedule/list-status-invalid")
+	runGolden(t, "/schedule/6/status", "PUT", false, "schedule/status-archive")
+
+	// スケジュールの変更提案
+	runGolden(t, "/schedule/5/change-requests", "POST", false, "schedule/change-request-add")
+	runGolden(t, "/schedule/5/change-requests", "GET", false, "schedule/change-request-list")
+	runGolden(t, "/schedule/5/change-requests/1/diff", "GET", false, "schedule/change-request-diff")
+	runGolden(t, "/schedule/5/change-requests/1/approve", "PUT", false, "schedule/change-request-approve")
+	runGolden(t, "/schedule/5/change-requests/2/diff", "GET", false, "schedule/change-request-diff-rebased")
+	runGolden(t, "/schedule/5/change-requests/2/reject", "PUT", false, "schedule/change-request-reject")
+	runGolden(t, "/schedule/5/change-requests/2/approve", "PUT", false, "schedule/change-request-approve-rejected")
+
+	// 以降のテストで使用するユーザーの登録 編集者(ID:2)・閲覧者(ID:3)
+	runGolden(t, "/user", "POST", false, "user/add")
+
+	// スケジュールの共同編集者・所有権の移譲
+	runGolden(t, "/schedule/5/collaborators/2", "PUT", false, "schedule/collaborator-edit")
+	runGolden(t, "/schedule/5/collaborators/3", "PUT", false, "schedule/collaborator-edit-viewer")
+	runGolden(t, "/schedule/5/collaborators/99", "PUT", false, "schedule/collaborator-edit-missing")
+	runGolden(t, "/schedule/5/collaborators", "GET", false, "schedule/collaborator-list")
+	runGolden(t, "/schedule/5/collaborators/3", "DELETE", false, "schedule/collaborator-delete")
+	runGolden(t, "/schedule/5/owner", "PUT", false, "schedule/owner-transfer")
+	runGolden(t, "/schedule/5", "GET", false, "schedule/get-collaborators")
+
+	// ユーザーの削除・無効化時のスケジュールの引き継ぎと復元
+	runGolden(t, "/user/2", "DELETE", false, "user/delete-owner")
+	runGolden(t, "/user/1", "DELETE", false, "user/delete-self")
+	runGolden(t, "/user/2/deactivate", "PUT", false, "user/deactivate")
+	runGolden(t, "/schedule/5/collaborators", "GET", false, "schedule/collaborator-list-handover")
+	runGolden(t, "/user/2/restore", "PUT", false, "user/restore")
+	runGolden(t, "/user/3", "DELETE", false, "user/delete")
+	runGolden(t, "/user/3/restore", "PUT", false, "user/restore-deleted")
+
+	// キャンパスごとのロール
+	runGolden(t, "/user/2/campus-roles", "GET", false, "user/campus-role-list")
+	runGolden(t, "/user/99/campus-roles", "GET", false, "user/campus-role-list-missing")
+	runGolden(t, "/user/2/campus-roles", "PUT", false, "user/campus-role-edit")
+	runGolden(t, "/user/99/campus-roles", "PUT", false, "user/campus-role-edit-missing")
+	runGolden(t, "/schedule/5/collaborators/2", "PUT", false, "schedule/collaborator-edit-campus-viewer")
+	runGolden(t, "/user/2/campus-roles", "PUT", false, "user/campus-role-reset")
+	runGolden(t, "/schedule/5/collaborators/2", "PUT", false, "schedule/collaborator-edit-campus-reset")
+
+	// ロールと権限の設定
+	runGolden(t, "/role/list", "GET", false, "role/list")
+	runGolden(t, "/role", "POST", false, "role/add")
+	runGolden(t, "/role/scheduler", "PUT", false, "role/edit")
+	runGolden(t, "/role/viewer", "PUT", false, "role/edit-builtin")
+	runGolden(t, "/role/unknown", "PUT", false, "role/edit-missing")
+	runGolden(t, "/user/3/campus-roles", "PUT", false, "user/campus-role-edit-custom")
+	runGolden(t, "/schedule/5/collaborators/3", "PUT", false, "schedule/collaborator-edit-custom-role")
+
+	// 個人用のAPIトークン
+	runGolden(t, "/user/self/api-tokens", "POST", false, "user/api-token-add")
+	runGolden(t, "/user/self/api-tokens", "GET", false, "user/api-token-list")
+	runGolden(t, "/user/self/api-tokens/2", "DELETE", false, "user/api-token-revoke")
+	runGolden(t, "/user/self/api-tokens/999", "DELETE", false, "user/api-token-revoke-missing")
+	runGolden(t, "/user/self/api-tokens/abc", "DELETE", false, "user/api-token-revoke-invalid")
+	runAPITokenAuth(t)
+
+	// シングルサインオン テスト環境では無効
+	runGolden(t, "/user/oidc/login", "GET", false, "user/oidc-login-disabled")
+	runGolden(t, "/user/oidc/callback?code=code&state=state", "GET", false, "user/oidc-callback-disabled")
+	runGolden(t, "/user/oidc/callback?error=access_denied", "GET", false, "user/oidc-callback-error")
+
+	// 2段階認証 認証コードは時刻に依存するため、正しいコードでの認証はモデルのテストで確認する
+	runGolden(t, "/user/self/two-factor", "GET", false, "user/two-factor-get")
+	runGolden(t, "/user/self/two-factor/confirm", "POST", false, "user/two-factor-confirm-not-started")
+	runGolden(t, "/user/self/two-factor", "DELETE", false, "user/two-factor-disable-not-enabled")
+	runGolden(t, "/user/self/two-factor/enroll", "POST", false, "user/two-factor-enroll")
+	runGolden(t, "/user/self/two-factor/confirm", "POST", false, "user/two-factor-confirm")
+	runGolden(t, "/user/self/two-factor", "GET", false, "user/two-factor-get-enrolling")
+	runGolden(t, "/user/2/two-factor/required", "PUT", false, "user/two-factor-required")
+	runGolden(t, "/user/99/two-factor/required", "PUT", false, "user/two-factor-required-missing")
+	runGolden(t, "/user/2/two-factor", "DELETE", false, "user/two-factor-reset")
+	runGolden(t, "/user/99/two-factor", "DELETE", false, "user/two-factor-reset-missing")
+	runTwoFactorLogin(t)
+	runGolden(t, "/user/2/two-factor/required", "PUT", false, "user/two-factor-required-off")
+
+	// ログインの試行回数の制限とロックの解除
+	runGolden(t, "/user/2/login-attempts", "GET", false, "user/login-attempt-list")
+	runGolden(t, "/user/99/login-attempts", "GET", false, "user/login-attempt-list-missing")
+	runGolden(t, "/user/abc/login-attempts", "GET", false, "user/login-attempt-list-invalid")
+
+	// 失敗を繰り返すと待ち時間が延びるため、ロックされた状態を直接作成する
+	_, err = db.Exec("insert into tbl_login_throttles (throttle_type, throttle_key, failure_count, last_failed_at, locked_until) values ('account', 'editor@example.com', 10, UTC_TIMESTAMP(), DATE_ADD(UTC_TIMESTAMP(), INTERVAL 1 DAY)) on duplicate key update failure_count = values(failure_count), last_failed_at = values(last_failed_at), locked_until = values(locked_until)")
+	if err != nil {
+		panic(err)
+	}
+
+	runLogin(t, "editor@example.com", "editor123", http.StatusTooManyRequests)
+	runGolden(t, "/user/2/login-attempts", "GET", false, "user/login-attempt-list-locked")
+	runGolden(t, "/user/2/unlock", "PUT", false, "user/unlock")
+	runGolden(t, "/user/99/unlock", "PUT", false, "user/unlock-missing")
+	runLogin(t, "editor@example.com", "editor123", http.StatusOK)
+	runGolden(t, "/user/2/login-attempts", "GET", false, "user/login-attempt-list-unlocked")
+
 	// schemathesisテスト
 	runSchemathesis(t, cookieHeader)
 	runSchemathesisOne(t, cookieHeader, "DELETE /schedule/{schedule_id}")
@@ -273,6 +465,142 @@ func runSchemathesisOne(t *testing.T, cookieHeader, operation string) {
 	}
 }
 
+// APIトークンによる認証 Cookieを持たないクライアントからBearerで送信する
+func runAPITokenAuth(t *testing.T) {
+
+	session := httpexpect.WithConfig(httpexpect.Config{
+		BaseURL:  LOCAL_TEST_BASE_POINT,
+		Client:   sharedClient,
+		Reporter: httpexpect.NewAssertReporter(t),
+	})
+
+	bearer := httpexpect.WithConfig(httpexpect.Config{
+		BaseURL:  LOCAL_TEST_BASE_POINT,
+		Client:   &http.Client{},
+		Reporter: httpexpect.NewAssertReporter(t),
+	})
+
+	added := session.POST("/user/self/api-tokens").
+		WithJSON(map[string]any{"name": "一覧取得", "scope": "read", "expires_in_days": 1}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	token := added.Value("token").String().HasPrefix("llt_").Raw()
+	tokenID := int(added.Value("api_token").Object().Value("token_id").Number().Raw())
+
+	// 参照系のリクエストは実行できる
+	bearer.GET("/schedule/list/ikebukuro").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusOK)
+
+	// 参照のみのトークンでは更新系のリクエストは実行できない
+	bearer.POST("/schedule/5/comments").
+		WithHeader("Authorization", "Bearer "+token).
+		WithJSON(map[string]any{"body": "APIトークンからのコメント"}).
+		Expect().
+		Status(http.StatusForbidden)
+
+	// トークンの管理はセッションからのみ行う
+	bearer.GET("/user/self/api-tokens").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusForbidden)
+
+	// 最終利用日時が記録される
+	session.GET("/user/self/api-tokens").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("api_tokens").Array().
+		Filter(func(_ int, value *httpexpect.Value) bool {
+			return int(value.Object().Value("token_id").Number().Raw()) == tokenID
+		}).
+		Value(0).Object().Value("last_used_at").NotNull()
+
+	bearer.GET("/schedule/list/ikebukuro").
+		WithHeader("Authorization", "Basic "+token).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	bearer.GET("/schedule/list/ikebukuro").
+		WithHeader("Authorization", "Bearer llt_invalid").
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	// 失効させたトークンは利用できない
+	session.DELETE(fmt.Sprintf("/user/self/api-tokens/%d", tokenID)).
+		Expect().
+		Status(http.StatusOK)
+
+	bearer.GET("/schedule/list/ikebukuro").
+		WithHeader("Authorization", "Bearer "+token).
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+// 2段階認証が必須とされたユーザー(ID:2)のログイン 認証コードの入力までセッションは作成されない
+func runTwoFactorLogin(t *testing.T) {
+
+	newClient := func() *httpexpect.Expect {
+		jar, _ := cookiejar.New(nil)
+		return httpexpect.WithConfig(httpexpect.Config{
+			BaseURL:  LOCAL_TEST_BASE_POINT,
+			Client:   &http.Client{Jar: jar},
+			Reporter: httpexpect.NewAssertReporter(t),
+		})
+	}
+
+	// パスワード認証を済ませていない場合は認証コードを送信できない
+	newClient().POST("/user/login/two-factor").
+		WithJSON(map[string]string{"code": "123456"}).
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	editor := newClient()
+
+	login := editor.POST("/user/login").
+		WithJSON(map[string]string{"user_name": "editor@example.com", "password": "editor123"}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	login.Value("two_factor_required").Boolean().IsTrue()
+	login.Value("two_factor_enrollment_required").Boolean().IsTrue()
+
+	editor.GET("/schedule/list/ikebukuro").
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	editor.POST("/user/login/two-factor/enroll").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("secret").String().NotEmpty()
+
+	// 誤った認証コードではログインできない
+	editor.POST("/user/login/two-factor").
+		WithJSON(map[string]string{"code": "abcdef"}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	editor.GET("/schedule/list/ikebukuro").
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+// 管理者のセッションに影響しないよう、Cookieを共有しないクライアントでログインする
+func runLogin(t *testing.T, userName string, password string, status int) {
+
+	httpexpect.WithConfig(httpexpect.Config{
+		BaseURL:  LOCAL_TEST_BASE_POINT,
+		Client:   &http.Client{},
+		Reporter: httpexpect.NewAssertReporter(t),
+	}).POST("/user/login").
+		WithJSON(map[string]string{"user_name": userName, "password": password}).
+		Expect().
+		Status(status)
+}
+
 func hasNoBody(status int) bool {
 	if status >= 100 && status < 200 {
 		return true